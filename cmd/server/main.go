@@ -15,6 +15,15 @@ func main() {
 	}
 
 	database.ConnectDatabase()
+	database.LoadAuthConfig()
+	database.LoadMailConfig()
+	database.LoadServerConfig()
+	database.LoadOIDCConfig()
+	database.LoadWebAuthnConfig()
+	database.LoadPasswordConfig()
+	database.LoadStorageConfig()
+	database.LoadPrivacyConfig()
+	database.LoadWorkspaceConfig()
 
 	r := router.Router()
 
@@ -0,0 +1,6 @@
+ALTER TABLE accounts
+    ADD COLUMN email_verified_at TIMESTAMP,
+    ADD COLUMN verification_sent_at TIMESTAMP;
+
+-- accounts created before email verification existed are considered verified
+UPDATE accounts SET email_verified_at = created_at;
@@ -0,0 +1,14 @@
+ALTER TABLE accounts
+    ADD COLUMN totp_secret TEXT,
+    ADD COLUMN totp_last_used_step BIGINT,
+    ADD COLUMN two_factor_enabled_at TIMESTAMP;
+
+CREATE TABLE recovery_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX recovery_codes_account_id_code_hash_idx ON recovery_codes (account_id, code_hash);
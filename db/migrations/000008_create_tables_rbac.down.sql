@@ -0,0 +1,13 @@
+ALTER TABLE accounts ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE accounts SET is_admin = TRUE
+WHERE id IN (
+    SELECT ar.account_id FROM account_roles ar
+    JOIN roles r ON r.id = ar.role_id
+    WHERE r.name = 'system_admin' AND ar.resource_type = 'system'
+);
+
+DROP TABLE IF EXISTS account_roles;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS permissions;
+DROP TABLE IF EXISTS roles;
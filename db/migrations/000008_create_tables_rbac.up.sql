@@ -0,0 +1,55 @@
+CREATE TABLE roles (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE permissions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE role_permissions (
+    role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    permission_id UUID NOT NULL REFERENCES permissions (id) ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+-- A role is granted either system-wide (resource_type 'system', no
+-- resource_id) or on a single resource, such as a workspace.
+CREATE TABLE account_roles (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    resource_type TEXT NOT NULL DEFAULT 'system',
+    resource_id UUID,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK ((resource_type = 'system') = (resource_id IS NULL))
+);
+
+CREATE UNIQUE INDEX account_roles_assignment_idx
+    ON account_roles (account_id, role_id, resource_type, COALESCE(resource_id, '00000000-0000-0000-0000-000000000000'));
+CREATE INDEX account_roles_resource_idx ON account_roles (resource_type, resource_id);
+
+INSERT INTO roles (name, description) VALUES
+    ('system_admin', 'Administra toda a plataforma'),
+    ('workspace_owner', 'Dono de um workspace'),
+    ('member', 'Membro de um workspace'),
+    ('guest', 'Convidado com acesso de leitura');
+
+INSERT INTO permissions (name, description) VALUES
+    ('accounts:restore', 'Restaurar contas removidas'),
+    ('roles:read', 'Consultar papéis e atribuições'),
+    ('roles:assign', 'Atribuir e remover papéis');
+
+-- system administrators hold every permission of the catalogue
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p WHERE r.name = 'system_admin';
+
+INSERT INTO account_roles (account_id, role_id)
+SELECT a.id, r.id FROM accounts a CROSS JOIN roles r WHERE a.is_admin AND r.name = 'system_admin';
+
+ALTER TABLE accounts DROP COLUMN is_admin;
@@ -0,0 +1,3 @@
+DELETE FROM permissions WHERE name = 'accounts:unlock';
+
+DROP TABLE IF EXISTS sign_in_throttles;
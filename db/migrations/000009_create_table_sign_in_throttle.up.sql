@@ -0,0 +1,17 @@
+-- Failed sign-in attempts, counted per account (scope 'account', subject the
+-- email) and per source address (scope 'ip', subject the IP).
+CREATE TABLE sign_in_throttles (
+    scope TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,
+    PRIMARY KEY (scope, subject)
+);
+
+INSERT INTO permissions (name, description) VALUES
+    ('accounts:unlock', 'Desbloquear contas bloqueadas por tentativas de login');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'system_admin' AND p.name = 'accounts:unlock';
@@ -0,0 +1,27 @@
+-- A session groups the refresh tokens issued by one sign-in, across
+-- rotations, and records the device it was started from.
+CREATE TABLE sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip_address TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX sessions_account_id_idx ON sessions (account_id);
+
+ALTER TABLE refresh_tokens ADD COLUMN session_id UUID REFERENCES sessions (id) ON DELETE CASCADE;
+
+-- every refresh token issued before sessions existed becomes its own session
+INSERT INTO sessions (id, account_id, expires_at, last_seen_at, revoked_at, created_at)
+SELECT id, account_id, expires_at, COALESCE(created_at, NOW()), revoked_at, created_at
+FROM refresh_tokens;
+
+UPDATE refresh_tokens SET session_id = id;
+
+ALTER TABLE refresh_tokens ALTER COLUMN session_id SET NOT NULL;
+
+CREATE INDEX refresh_tokens_session_id_idx ON refresh_tokens (session_id);
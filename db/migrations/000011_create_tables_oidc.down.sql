@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS oidc_login_requests;
+DROP TABLE IF EXISTS account_identities;
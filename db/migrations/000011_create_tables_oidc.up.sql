@@ -0,0 +1,24 @@
+-- An identity links an account to the subject of an external OpenID Connect
+-- provider, so it can sign in through that provider.
+CREATE TABLE account_identities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX account_identities_account_id_idx ON account_identities (account_id);
+
+-- A login request holds the PKCE verifier and nonce of an authorization
+-- started with a provider until its callback comes back with the state.
+CREATE TABLE oidc_login_requests (
+    state_hash TEXT PRIMARY KEY,
+    provider TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    code_verifier TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
@@ -0,0 +1,30 @@
+-- A passkey is a WebAuthn credential registered by an account to sign in
+-- without a password.
+CREATE TABLE passkeys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    credential_id BYTEA NOT NULL UNIQUE,
+    public_key BYTEA NOT NULL,
+    attestation_type TEXT NOT NULL DEFAULT '',
+    aaguid BYTEA,
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    transports TEXT[] NOT NULL DEFAULT '{}',
+    backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+    backup_state BOOLEAN NOT NULL DEFAULT FALSE,
+    last_used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX passkeys_account_id_idx ON passkeys (account_id);
+
+-- A passkey challenge keeps the state of a registration or sign-in ceremony
+-- until the authenticator answers it. Sign-in challenges have no account.
+CREATE TABLE passkey_challenges (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID REFERENCES accounts (id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL,
+    session_data JSONB NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
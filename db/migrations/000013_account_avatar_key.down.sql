@@ -0,0 +1,2 @@
+ALTER TABLE accounts RENAME COLUMN avatar_key TO avatar;
+UPDATE accounts SET avatar = NULL;
@@ -0,0 +1,5 @@
+-- Avatars used to be free-text URLs sent by clients. They are now uploaded to
+-- the API, which stores the resized images and keeps only their storage key.
+-- The old URLs cannot be turned into resized images, so they are dropped.
+ALTER TABLE accounts RENAME COLUMN avatar TO avatar_key;
+UPDATE accounts SET avatar_key = NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS accounts_email_lower_idx;
+
+ALTER TABLE accounts ADD CONSTRAINT accounts_email_key UNIQUE (email);
@@ -0,0 +1,34 @@
+-- Emails become case-insensitive: two accounts can no longer hold emails that
+-- only differ in case. Existing accounts in that situation would make the new
+-- unique index fail, so they are reported first and the migration stops until
+-- they are merged or renamed by hand.
+DO $$
+DECLARE
+    collisions TEXT;
+BEGIN
+    SELECT string_agg(format('%s: %s', normalized, owners), E'\n' ORDER BY normalized)
+    INTO collisions
+    FROM (
+        SELECT lower(btrim(email)) AS normalized,
+               string_agg(format('%s <%s>%s', id, email, CASE WHEN deleted_at IS NOT NULL THEN ' (removida)' ELSE '' END), ', ' ORDER BY created_at) AS owners
+        FROM accounts
+        GROUP BY lower(btrim(email))
+        HAVING count(*) > 1
+    ) AS duplicates;
+
+    IF collisions IS NOT NULL THEN
+        RAISE EXCEPTION 'contas com emails que diferem apenas em maiúsculas e minúsculas:%', E'\n' || collisions
+            USING HINT = 'Una ou altere o email destas contas e rode a migration novamente.';
+    END IF;
+END
+$$;
+
+-- Stored emails are normalized as the application does: trimmed, with the
+-- domain lowercased.
+UPDATE accounts
+SET email = substring(btrim(email) FROM '^(.*)@') || '@' || lower(substring(btrim(email) FROM '@([^@]*)$'))
+WHERE btrim(email) LIKE '%@%';
+
+ALTER TABLE accounts DROP CONSTRAINT accounts_email_key;
+
+CREATE UNIQUE INDEX accounts_email_lower_idx ON accounts (lower(email));
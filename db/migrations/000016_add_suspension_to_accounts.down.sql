@@ -0,0 +1,4 @@
+DELETE FROM permissions
+WHERE name IN ('accounts:read', 'accounts:suspend', 'accounts:reset_password', 'accounts:delete');
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS suspended_at;
@@ -0,0 +1,14 @@
+-- Suspended accounts are kept, unlike deleted ones, but drop out of every
+-- lookup used to sign in until an administrator reactivates them.
+ALTER TABLE accounts ADD COLUMN suspended_at TIMESTAMP;
+
+INSERT INTO permissions (name, description) VALUES
+    ('accounts:read', 'Listar e consultar contas de qualquer estado'),
+    ('accounts:suspend', 'Suspender e reativar contas'),
+    ('accounts:reset_password', 'Forçar a redefinição da senha de contas'),
+    ('accounts:delete', 'Remover contas definitivamente');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'system_admin'
+  AND p.name IN ('accounts:read', 'accounts:suspend', 'accounts:reset_password', 'accounts:delete');
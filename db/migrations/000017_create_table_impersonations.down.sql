@@ -0,0 +1,4 @@
+DELETE FROM permissions WHERE name = 'accounts:impersonate';
+
+DROP TABLE IF EXISTS impersonation_requests;
+DROP TABLE IF EXISTS impersonations;
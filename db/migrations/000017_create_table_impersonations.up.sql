@@ -0,0 +1,38 @@
+-- An impersonation lets an administrator (actor) act as another account for
+-- a limited time. Both references are kept nullable so the audit trail
+-- outlives the removal of either account.
+CREATE TABLE impersonations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor_account_id UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    account_id UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    ip_address TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX impersonations_actor_account_id_idx ON impersonations (actor_account_id);
+CREATE INDEX impersonations_account_id_idx ON impersonations (account_id);
+
+-- Every request made during an impersonation, as answered.
+CREATE TABLE impersonation_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    impersonation_id UUID NOT NULL REFERENCES impersonations (id) ON DELETE CASCADE,
+    method TEXT NOT NULL,
+    path TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    ip_address TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX impersonation_requests_impersonation_id_idx ON impersonation_requests (impersonation_id, created_at);
+
+INSERT INTO permissions (name, description) VALUES
+    ('accounts:impersonate', 'Agir como outra conta por tempo limitado, com auditoria');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'system_admin' AND p.name = 'accounts:impersonate';
@@ -0,0 +1,3 @@
+DELETE FROM permissions WHERE name IN ('accounts:export', 'accounts:erase');
+
+DROP TABLE IF EXISTS data_jobs;
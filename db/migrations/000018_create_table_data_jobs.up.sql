@@ -0,0 +1,31 @@
+-- A data job exports or erases the personal data of an account in the
+-- background. Exports keep their archive in the storage until expires_at.
+-- Erased accounts keep their row, anonymized, so what references them stays
+-- valid; their completed erasure keeps them from being restored.
+CREATE TABLE data_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    requested_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('export', 'erasure')),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    archive_key TEXT,
+    error TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    expires_at TIMESTAMP
+);
+
+CREATE INDEX data_jobs_account_id_idx ON data_jobs (account_id, created_at);
+CREATE INDEX data_jobs_unfinished_idx ON data_jobs (created_at) WHERE status IN ('pending', 'running');
+
+-- An account has at most one unfinished job of each kind.
+CREATE UNIQUE INDEX data_jobs_account_kind_unfinished_idx ON data_jobs (account_id, kind) WHERE status IN ('pending', 'running');
+
+INSERT INTO permissions (name, description) VALUES
+    ('accounts:export', 'Exportar os dados pessoais de uma conta'),
+    ('accounts:erase', 'Apagar definitivamente os dados pessoais de uma conta');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'system_admin' AND p.name IN ('accounts:export', 'accounts:erase');
@@ -0,0 +1,20 @@
+-- A project belongs to the account that owns it. Its key is a short code
+-- (ex.: TRI) unique among the projects that are not deleted, so a deleted
+-- project frees its key.
+CREATE TABLE projects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    key TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('planned', 'active', 'on_hold', 'completed', 'cancelled')),
+    start_date DATE,
+    target_date DATE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP,
+    CHECK (target_date IS NULL OR start_date IS NULL OR target_date >= start_date)
+);
+
+CREATE UNIQUE INDEX projects_key_idx ON projects (key) WHERE deleted_at IS NULL;
+CREATE INDEX projects_owner_account_id_idx ON projects (owner_account_id, created_at);
@@ -0,0 +1,21 @@
+DELETE FROM permissions
+WHERE name IN ('workspaces:read', 'workspaces:update', 'workspaces:delete', 'workspaces:manage_members', 'projects:read', 'projects:write');
+
+DROP POLICY IF EXISTS projects_workspace_isolation ON projects;
+ALTER TABLE projects NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE projects DISABLE ROW LEVEL SECURITY;
+
+DELETE FROM account_roles WHERE resource_type = 'workspace';
+
+DROP INDEX IF EXISTS projects_workspace_id_idx;
+DROP INDEX IF EXISTS projects_workspace_key_idx;
+CREATE UNIQUE INDEX projects_key_idx ON projects (key) WHERE deleted_at IS NULL;
+
+DELETE FROM projects WHERE owner_account_id IS NULL;
+ALTER TABLE projects DROP CONSTRAINT projects_owner_account_id_fkey;
+ALTER TABLE projects ADD CONSTRAINT projects_owner_account_id_fkey
+    FOREIGN KEY (owner_account_id) REFERENCES accounts (id) ON DELETE CASCADE;
+ALTER TABLE projects ALTER COLUMN owner_account_id SET NOT NULL;
+
+ALTER TABLE projects DROP COLUMN workspace_id;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,67 @@
+-- A workspace owns projects and gathers the accounts that work on them. Its
+-- members are the accounts holding a role on it in account_roles, so one
+-- account may belong to many workspaces.
+CREATE TABLE workspaces (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    created_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Projects move into a workspace of their own for each account that owned
+-- some, and that account becomes the owner of the workspace.
+ALTER TABLE projects ADD COLUMN workspace_id UUID REFERENCES workspaces (id) ON DELETE CASCADE;
+
+WITH created AS (
+    INSERT INTO workspaces (name, created_by)
+    SELECT a.name, a.id FROM accounts a
+    WHERE a.id IN (SELECT owner_account_id FROM projects)
+    RETURNING id, created_by
+)
+UPDATE projects p SET workspace_id = c.id FROM created c WHERE c.created_by = p.owner_account_id;
+
+INSERT INTO account_roles (account_id, role_id, resource_type, resource_id)
+SELECT w.created_by, r.id, 'workspace', w.id FROM workspaces w CROSS JOIN roles r WHERE r.name = 'workspace_owner';
+
+ALTER TABLE projects ALTER COLUMN workspace_id SET NOT NULL;
+
+-- The owner is now who created the project, which outlives their account.
+ALTER TABLE projects ALTER COLUMN owner_account_id DROP NOT NULL;
+ALTER TABLE projects DROP CONSTRAINT projects_owner_account_id_fkey;
+ALTER TABLE projects ADD CONSTRAINT projects_owner_account_id_fkey
+    FOREIGN KEY (owner_account_id) REFERENCES accounts (id) ON DELETE SET NULL;
+
+DROP INDEX projects_key_idx;
+CREATE UNIQUE INDEX projects_workspace_key_idx ON projects (workspace_id, key) WHERE deleted_at IS NULL;
+CREATE INDEX projects_workspace_id_idx ON projects (workspace_id, created_at);
+
+-- Only the rows of the workspace set in app.workspace_id for the current
+-- transaction are visible; without it, none are.
+ALTER TABLE projects ENABLE ROW LEVEL SECURITY;
+ALTER TABLE projects FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY projects_workspace_isolation ON projects
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', true), '')::uuid)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', true), '')::uuid);
+
+INSERT INTO permissions (name, description) VALUES
+    ('workspaces:read', 'Consultar um workspace e seus membros'),
+    ('workspaces:update', 'Alterar um workspace'),
+    ('workspaces:delete', 'Remover um workspace'),
+    ('workspaces:manage_members', 'Adicionar e remover membros de um workspace'),
+    ('projects:read', 'Consultar os projetos de um workspace'),
+    ('projects:write', 'Criar, alterar e remover projetos de um workspace');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name IN ('system_admin', 'workspace_owner')
+  AND p.name IN ('workspaces:read', 'workspaces:update', 'workspaces:delete', 'workspaces:manage_members', 'projects:read', 'projects:write');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'member' AND p.name IN ('workspaces:read', 'projects:read', 'projects:write');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'guest' AND p.name IN ('workspaces:read', 'projects:read');
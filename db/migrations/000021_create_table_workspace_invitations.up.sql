@@ -0,0 +1,25 @@
+-- An invitation asks the owner of email to join a workspace with role. The
+-- link sent by email carries the token, kept here only as a hash. It is
+-- pending until accepted, revoked or expired; resending it replaces the
+-- token and postpones expires_at.
+CREATE TABLE workspace_invitations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('workspace_owner', 'member', 'guest')),
+    token_hash TEXT NOT NULL UNIQUE,
+    invited_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    expires_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    accepted_at TIMESTAMP,
+    accepted_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX workspace_invitations_workspace_id_idx ON workspace_invitations (workspace_id, created_at);
+
+-- An email has at most one invitation that was neither accepted nor revoked
+-- in each workspace.
+CREATE UNIQUE INDEX workspace_invitations_open_email_idx ON workspace_invitations (workspace_id, lower(email))
+    WHERE accepted_at IS NULL AND revoked_at IS NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS team_projects;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
+
+DELETE FROM permissions WHERE name IN ('teams:read', 'teams:manage', 'teams:manage_members');
+DELETE FROM roles WHERE name IN ('team_lead', 'team_member');
@@ -0,0 +1,55 @@
+-- A team groups members of a workspace, such as "Backend" or "Design". Its
+-- members hold the role of the team (role_id) on the projects assigned to
+-- it, on top of the roles they hold on the workspace.
+CREATE TABLE teams (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    role_id UUID NOT NULL REFERENCES roles (id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX teams_workspace_name_idx ON teams (workspace_id, lower(name));
+
+-- A team member holds a role on the team itself, team_lead or team_member.
+CREATE TABLE team_members (
+    team_id UUID NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles (id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (team_id, account_id)
+);
+
+CREATE INDEX team_members_account_id_idx ON team_members (account_id);
+
+CREATE TABLE team_projects (
+    team_id UUID NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (team_id, project_id)
+);
+
+CREATE INDEX team_projects_project_id_idx ON team_projects (project_id);
+
+INSERT INTO roles (name, description) VALUES
+    ('team_lead', 'Líder de uma equipe'),
+    ('team_member', 'Membro de uma equipe');
+
+INSERT INTO permissions (name, description) VALUES
+    ('teams:read', 'Consultar as equipes de um workspace, seus membros e projetos'),
+    ('teams:manage', 'Criar, alterar e remover equipes e atribuir projetos a elas'),
+    ('teams:manage_members', 'Adicionar e remover membros e líderes de uma equipe');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name IN ('system_admin', 'workspace_owner')
+  AND p.name IN ('teams:read', 'teams:manage', 'teams:manage_members');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name IN ('member', 'guest', 'team_member') AND p.name = 'teams:read';
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'team_lead' AND p.name IN ('teams:read', 'teams:manage_members');
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS project_members;
+
+UPDATE teams t
+SET role_id = r.id
+FROM roles old, roles r
+WHERE old.id = t.role_id
+  AND ((old.name IN ('project_admin', 'project_editor', 'project_commenter') AND r.name = 'member')
+       OR (old.name = 'project_viewer' AND r.name = 'guest'));
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'guest' AND p.name = 'projects:read';
+
+DELETE FROM permissions WHERE name IN ('projects:comment', 'projects:manage_members');
+DELETE FROM roles WHERE name IN ('project_admin', 'project_editor', 'project_commenter', 'project_viewer');
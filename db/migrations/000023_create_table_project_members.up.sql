@@ -0,0 +1,76 @@
+-- A project member holds a role on the project itself, on top of the role
+-- it holds on the workspace: project_admin, project_editor,
+-- project_commenter or project_viewer. Only members of the workspace join
+-- its projects, and leaving the workspace leaves them; workspace_id is kept
+-- so that can be done without reading the projects.
+CREATE TABLE project_members (
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles (id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (project_id, account_id)
+);
+
+CREATE INDEX project_members_account_id_idx ON project_members (account_id, workspace_id);
+
+INSERT INTO roles (name, description) VALUES
+    ('project_admin', 'Administrador de um projeto'),
+    ('project_editor', 'Editor de um projeto'),
+    ('project_commenter', 'Comentarista de um projeto'),
+    ('project_viewer', 'Leitor de um projeto');
+
+INSERT INTO permissions (name, description) VALUES
+    ('projects:comment', 'Comentar nos projetos de um workspace'),
+    ('projects:manage_members', 'Adicionar e remover membros de um projeto e trocar os seus papéis');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name IN ('system_admin', 'workspace_owner', 'project_admin')
+  AND p.name IN ('projects:read', 'projects:write', 'projects:comment', 'projects:manage_members')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name IN ('member', 'project_editor') AND p.name IN ('projects:read', 'projects:write', 'projects:comment')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'project_commenter' AND p.name IN ('projects:read', 'projects:comment');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id FROM roles r CROSS JOIN permissions p
+WHERE r.name = 'project_viewer' AND p.name = 'projects:read';
+
+-- Guests of a workspace no longer see all of its projects, only those they
+-- are members of, directly or through a team.
+DELETE FROM role_permissions rp
+USING roles r, permissions p
+WHERE r.id = rp.role_id AND p.id = rp.permission_id
+  AND r.name = 'guest' AND p.name = 'projects:read';
+
+-- Teams grant the project roles now, in place of the workspace roles.
+UPDATE teams t
+SET role_id = r.id
+FROM roles old, roles r
+WHERE old.id = t.role_id
+  AND ((old.name = 'member' AND r.name = 'project_editor')
+       OR (old.name = 'guest' AND r.name = 'project_viewer'));
+
+-- The accounts that created projects become their admins. Row-level
+-- security would hide every project here, so it is lifted for the owner of
+-- the table meanwhile.
+ALTER TABLE projects NO FORCE ROW LEVEL SECURITY;
+
+INSERT INTO project_members (project_id, account_id, workspace_id, role_id)
+SELECT p.id, p.owner_account_id, p.workspace_id, r.id
+FROM projects p
+JOIN roles r ON r.name = 'project_admin'
+WHERE p.owner_account_id IS NOT NULL
+  AND EXISTS (
+      SELECT 1 FROM account_roles ar
+      WHERE ar.resource_type = 'workspace' AND ar.resource_id = p.workspace_id AND ar.account_id = p.owner_account_id
+  );
+
+ALTER TABLE projects FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,22 @@
+-- A task of a project, assigned to a member of the workspace, to one of its
+-- teams, to both or to nobody. An account that leaves the workspace is
+-- unassigned from its tasks; as on project_members, workspace_id is kept so
+-- that can be done without reading the projects.
+CREATE TABLE tasks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'todo' CHECK (status IN ('todo', 'in_progress', 'done')),
+    assignee_account_id UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    assignee_team_id UUID REFERENCES teams (id) ON DELETE SET NULL,
+    created_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX tasks_project_id_idx ON tasks (project_id, created_at);
+CREATE INDEX tasks_assignee_account_id_idx ON tasks (assignee_account_id, workspace_id);
+CREATE INDEX tasks_assignee_team_id_idx ON tasks (assignee_team_id);
+CREATE INDEX tasks_created_by_idx ON tasks (created_by, workspace_id);
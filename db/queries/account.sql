@@ -1,20 +1,198 @@
 -- name: CreateAccount :one
-INSERT INTO accounts (name, email, password, avatar)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, email, password, avatar, created_at, updated_at, deleted_at;
+INSERT INTO accounts (name, email, password)
+VALUES ($1, $2, $3)
+RETURNING id, name, email, password, avatar_key, created_at, updated_at, deleted_at, email_verified_at, verification_sent_at, totp_secret, totp_last_used_step, two_factor_enabled_at, suspended_at;
 
 -- name: UpdateAccount :one
 UPDATE accounts
-SET name = $2, avatar = $3
-WHERE id = $1
-RETURNING id, name, email, password, avatar, created_at, updated_at, deleted_at;
+SET name = $2, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, email, password, avatar_key, created_at, updated_at, deleted_at, email_verified_at, verification_sent_at, totp_secret, totp_last_used_step, two_factor_enabled_at, suspended_at;
+
+-- Returns the key of the replaced avatar, read under the same row lock, so
+-- its images can be removed from storage.
+-- name: ReplaceAccountAvatar :one
+UPDATE accounts AS a
+SET avatar_key = sqlc.narg(avatar_key), updated_at = NOW()
+FROM (
+    SELECT p.id, p.avatar_key
+    FROM accounts AS p
+    WHERE p.id = sqlc.arg(id) AND p.deleted_at IS NULL
+    FOR UPDATE
+) AS previous
+WHERE a.id = previous.id
+RETURNING previous.avatar_key AS previous_avatar_key;
+
+-- name: UpdateAccountPassword :execrows
+UPDATE accounts
+SET password = $2, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- Replacing the hash only while it is still the one that was verified keeps a
+-- concurrent password change from being undone.
+-- name: RehashAccountPassword :execrows
+UPDATE accounts
+SET password = sqlc.arg(new_password)
+WHERE id = sqlc.arg(id) AND password = sqlc.arg(old_password) AND deleted_at IS NULL;
+
+-- name: SoftDeleteAccount :execrows
+UPDATE accounts
+SET deleted_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreAccount :one
+UPDATE accounts
+SET deleted_at = NULL, updated_at = NOW()
+WHERE accounts.id = $1 AND accounts.deleted_at IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM data_jobs AS j
+      WHERE j.account_id = $1 AND j.kind = 'erasure' AND j.status = 'completed'
+  )
+RETURNING id, name, email, password, avatar_key, created_at, updated_at, deleted_at, email_verified_at, verification_sent_at, totp_secret, totp_last_used_step, two_factor_enabled_at, suspended_at;
 
 -- name: FindAccount :one
-SELECT id, name, email, avatar, created_at, updated_at, deleted_at, password
+SELECT id, name, email, avatar_key, created_at, updated_at, deleted_at, password, email_verified_at, verification_sent_at, totp_secret, two_factor_enabled_at
 FROM accounts
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL AND suspended_at IS NULL;
 
 -- name: FindAccountByEmail :one
-SELECT id, name, email, avatar, created_at, updated_at, deleted_at, password
+SELECT id, name, email, avatar_key, created_at, updated_at, deleted_at, password, email_verified_at, verification_sent_at, totp_secret, two_factor_enabled_at
 FROM accounts
-WHERE email = $1;
+WHERE lower(email) = lower(sqlc.arg(email)::text) AND deleted_at IS NULL AND suspended_at IS NULL;
+
+-- name: MarkAccountVerificationSent :execrows
+UPDATE accounts
+SET verification_sent_at = sqlc.arg(sent_at)
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL
+  AND email_verified_at IS NULL
+  AND (verification_sent_at IS NULL OR verification_sent_at <= sqlc.arg(resend_before));
+
+-- name: VerifyAccountEmail :execrows
+UPDATE accounts
+SET email_verified_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND email = $2 AND deleted_at IS NULL AND email_verified_at IS NULL;
+
+-- name: SetAccountTOTPSecret :execrows
+UPDATE accounts
+SET totp_secret = $2, totp_last_used_step = NULL, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL AND two_factor_enabled_at IS NULL;
+
+-- name: EnableAccountTwoFactor :execrows
+UPDATE accounts
+SET two_factor_enabled_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL AND totp_secret IS NOT NULL AND two_factor_enabled_at IS NULL;
+
+-- name: DisableAccountTwoFactor :execrows
+UPDATE accounts
+SET totp_secret = NULL, totp_last_used_step = NULL, two_factor_enabled_at = NULL, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: UseAccountTOTPStep :execrows
+UPDATE accounts
+SET totp_last_used_step = sqlc.arg(step)::bigint
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL
+  AND (totp_last_used_step IS NULL OR totp_last_used_step < sqlc.arg(step)::bigint);
+
+-- The queries below serve the administration of accounts and, unlike the
+-- lookups above, see accounts in every state. An account is locked while its
+-- sign-in throttle, kept under the lowercased email, has a lockout running.
+
+-- name: FindAccountForAdmin :one
+SELECT a.id, a.name, a.email, a.avatar_key, a.created_at, a.updated_at, a.deleted_at, a.email_verified_at, a.two_factor_enabled_at, a.suspended_at, t.locked_until
+FROM accounts AS a
+LEFT JOIN sign_in_throttles AS t ON t.scope = 'account' AND t.subject = lower(a.email)
+WHERE a.id = $1;
+
+-- name: ListAccounts :many
+SELECT a.id, a.name, a.email, a.avatar_key, a.created_at, a.updated_at, a.deleted_at, a.email_verified_at, a.two_factor_enabled_at, a.suspended_at, t.locked_until
+FROM accounts AS a
+LEFT JOIN sign_in_throttles AS t ON t.scope = 'account' AND t.subject = lower(a.email)
+WHERE (sqlc.narg(search)::text IS NULL
+       OR a.name ILIKE '%' || sqlc.narg(search)::text || '%'
+       OR a.email ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(status)::text IS NULL OR CASE sqlc.narg(status)::text
+        WHEN 'active' THEN a.deleted_at IS NULL AND a.suspended_at IS NULL
+        WHEN 'deleted' THEN a.deleted_at IS NOT NULL
+        WHEN 'suspended' THEN a.suspended_at IS NOT NULL
+        WHEN 'locked' THEN t.locked_until > NOW()
+        WHEN 'unverified' THEN a.email_verified_at IS NULL
+      END)
+ORDER BY a.created_at DESC, a.id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: CountAccounts :one
+SELECT COUNT(*)
+FROM accounts AS a
+LEFT JOIN sign_in_throttles AS t ON t.scope = 'account' AND t.subject = lower(a.email)
+WHERE (sqlc.narg(search)::text IS NULL
+       OR a.name ILIKE '%' || sqlc.narg(search)::text || '%'
+       OR a.email ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(status)::text IS NULL OR CASE sqlc.narg(status)::text
+        WHEN 'active' THEN a.deleted_at IS NULL AND a.suspended_at IS NULL
+        WHEN 'deleted' THEN a.deleted_at IS NOT NULL
+        WHEN 'suspended' THEN a.suspended_at IS NOT NULL
+        WHEN 'locked' THEN t.locked_until > NOW()
+        WHEN 'unverified' THEN a.email_verified_at IS NULL
+      END);
+
+-- name: SuspendAccount :execrows
+UPDATE accounts
+SET suspended_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL AND suspended_at IS NULL;
+
+-- name: ReactivateAccount :execrows
+UPDATE accounts
+SET suspended_at = NULL, updated_at = NOW()
+WHERE id = $1 AND suspended_at IS NOT NULL;
+
+-- Returns the avatar key of the removed account so its images can be removed
+-- from storage. Every row referencing the account is removed with it.
+-- name: HardDeleteAccount :one
+DELETE FROM accounts
+WHERE id = $1
+RETURNING avatar_key;
+
+-- EraseAccount anonymizes the personal fields of the account, keeping its
+-- row for what references it, and removes its credentials, devices and
+-- pending requests. The email is replaced by a unique address that cannot
+-- receive mail. Erased accounts count as deleted and cannot be restored.
+-- name: EraseAccount :one
+WITH erased_identities AS (
+    DELETE FROM account_identities WHERE account_identities.account_id = $1
+), erased_passkeys AS (
+    DELETE FROM passkeys WHERE passkeys.account_id = $1
+), erased_passkey_challenges AS (
+    DELETE FROM passkey_challenges WHERE passkey_challenges.account_id = $1
+), erased_sessions AS (
+    DELETE FROM sessions WHERE sessions.account_id = $1
+), erased_password_reset_tokens AS (
+    DELETE FROM password_reset_tokens WHERE password_reset_tokens.account_id = $1
+), erased_email_change_requests AS (
+    DELETE FROM email_change_requests WHERE email_change_requests.account_id = $1
+), erased_recovery_codes AS (
+    DELETE FROM recovery_codes WHERE recovery_codes.account_id = $1
+), erased_personal_access_tokens AS (
+    DELETE FROM personal_access_tokens WHERE personal_access_tokens.account_id = $1
+), erased_throttles AS (
+    DELETE FROM sign_in_throttles
+    WHERE scope = 'account' AND subject = (SELECT lower(email) FROM accounts WHERE accounts.id = $1)
+), previous AS (
+    SELECT avatar_key FROM accounts WHERE accounts.id = $1
+)
+UPDATE accounts
+SET name = 'Conta removida',
+    email = 'erased+' || accounts.id::TEXT || '@erased.invalid',
+    password = '',
+    avatar_key = NULL,
+    email_verified_at = NULL,
+    verification_sent_at = NULL,
+    totp_secret = NULL,
+    totp_last_used_step = NULL,
+    two_factor_enabled_at = NULL,
+    deleted_at = COALESCE(deleted_at, NOW()),
+    updated_at = NOW()
+FROM previous
+WHERE accounts.id = $1
+RETURNING previous.avatar_key;
@@ -0,0 +1,56 @@
+-- name: CreateDataJob :one
+INSERT INTO data_jobs (account_id, requested_by, kind)
+VALUES ($1, $2, $3)
+RETURNING id, account_id, requested_by, kind, status, archive_key, error, created_at, started_at, finished_at, expires_at;
+
+-- name: FindDataJob :one
+SELECT id, account_id, requested_by, kind, status, archive_key, error, created_at, started_at, finished_at, expires_at
+FROM data_jobs
+WHERE id = $1;
+
+-- name: ListAccountDataJobs :many
+SELECT id, account_id, requested_by, kind, status, archive_key, error, created_at, started_at, finished_at, expires_at
+FROM data_jobs
+WHERE account_id = $1
+ORDER BY created_at DESC, id;
+
+-- ClaimDataJob marks the oldest pending job as running and returns it. Jobs
+-- left running since before stale_before, by a server that stopped midway,
+-- are claimed again. SKIP LOCKED lets several servers claim jobs at once.
+-- name: ClaimDataJob :one
+UPDATE data_jobs
+SET status = 'running', started_at = NOW()
+WHERE id = (
+    SELECT j.id FROM data_jobs AS j
+    WHERE j.status = 'pending' OR (j.status = 'running' AND j.started_at < sqlc.arg(stale_before))
+    ORDER BY j.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, account_id, requested_by, kind, status, archive_key, error, created_at, started_at, finished_at, expires_at;
+
+-- name: CompleteDataJob :exec
+UPDATE data_jobs
+SET status = 'completed', archive_key = $2, expires_at = $3, error = NULL, finished_at = NOW()
+WHERE id = $1;
+
+-- name: FailDataJob :exec
+UPDATE data_jobs
+SET status = 'failed', error = $2, finished_at = NOW()
+WHERE id = $1;
+
+-- ExpireDataJobArchives forgets the archives of exports past expires_at and
+-- returns their keys, so they can be removed from the storage.
+-- name: ExpireDataJobArchives :many
+UPDATE data_jobs
+SET archive_key = NULL
+WHERE archive_key IS NOT NULL AND expires_at <= NOW()
+RETURNING archive_key::TEXT;
+
+-- ClearAccountDataJobArchives forgets every archive of the account and
+-- returns their keys, so they can be removed from the storage.
+-- name: ClearAccountDataJobArchives :many
+UPDATE data_jobs
+SET archive_key = NULL
+WHERE account_id = $1 AND archive_key IS NOT NULL
+RETURNING archive_key::TEXT;
@@ -0,0 +1,49 @@
+-- name: CreateEmailChangeRequest :one
+INSERT INTO email_change_requests (account_id, new_email, token_hash, cancel_token_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, new_email, token_hash, cancel_token_hash, expires_at, confirmed_at, cancelled_at, created_at;
+
+-- name: FindEmailChangeRequestByTokenHash :one
+SELECT id, account_id, new_email, token_hash, cancel_token_hash, expires_at, confirmed_at, cancelled_at, created_at
+FROM email_change_requests
+WHERE token_hash = $1;
+
+-- name: FindEmailChangeRequestByCancelTokenHash :one
+SELECT id, account_id, new_email, token_hash, cancel_token_hash, expires_at, confirmed_at, cancelled_at, created_at
+FROM email_change_requests
+WHERE cancel_token_hash = $1;
+
+-- name: ListAccountEmailChangeRequests :many
+SELECT id, account_id, new_email, token_hash, cancel_token_hash, expires_at, confirmed_at, cancelled_at, created_at
+FROM email_change_requests
+WHERE account_id = $1
+ORDER BY created_at;
+
+-- Consumes the request and moves the account to the new address in a single
+-- statement, so a unique violation on accounts.email leaves the request
+-- pending. The new address counts as verified, since the link confirming the
+-- change was sent to it.
+-- name: ConfirmEmailChangeRequest :execrows
+WITH confirmed AS (
+    UPDATE email_change_requests
+    SET confirmed_at = NOW()
+    WHERE email_change_requests.id = $1
+      AND confirmed_at IS NULL
+      AND cancelled_at IS NULL
+      AND expires_at > NOW()
+    RETURNING account_id, new_email
+)
+UPDATE accounts
+SET email = confirmed.new_email, email_verified_at = NOW(), updated_at = NOW()
+FROM confirmed
+WHERE accounts.id = confirmed.account_id AND accounts.deleted_at IS NULL;
+
+-- name: CancelEmailChangeRequest :execrows
+UPDATE email_change_requests
+SET cancelled_at = NOW()
+WHERE id = $1 AND confirmed_at IS NULL AND cancelled_at IS NULL;
+
+-- name: CancelAccountEmailChangeRequests :exec
+UPDATE email_change_requests
+SET cancelled_at = NOW()
+WHERE account_id = $1 AND confirmed_at IS NULL AND cancelled_at IS NULL;
@@ -0,0 +1,24 @@
+-- name: CreateImpersonation :one
+INSERT INTO impersonations (actor_account_id, account_id, reason, ip_address, user_agent, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, actor_account_id, account_id, reason, ip_address, user_agent, expires_at, ended_at, created_at;
+
+-- name: FindImpersonation :one
+SELECT id, actor_account_id, account_id, reason, ip_address, user_agent, expires_at, ended_at, created_at
+FROM impersonations
+WHERE id = $1;
+
+-- name: EndImpersonation :execrows
+UPDATE impersonations
+SET ended_at = NOW()
+WHERE id = $1 AND ended_at IS NULL AND expires_at > NOW();
+
+-- name: CreateImpersonationRequest :exec
+INSERT INTO impersonation_requests (impersonation_id, method, path, status, ip_address, user_agent)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListImpersonationRequests :many
+SELECT id, impersonation_id, method, path, status, ip_address, user_agent, created_at
+FROM impersonation_requests
+WHERE impersonation_id = $1
+ORDER BY created_at, id;
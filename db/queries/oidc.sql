@@ -0,0 +1,29 @@
+-- name: CreateAccountIdentity :one
+INSERT INTO account_identities (account_id, provider, subject, email)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, provider, subject, email, created_at;
+
+-- name: FindAccountIdentity :one
+SELECT id, account_id, provider, subject, email, created_at
+FROM account_identities
+WHERE provider = $1 AND subject = $2;
+
+-- name: ListAccountIdentities :many
+SELECT id, account_id, provider, subject, email, created_at
+FROM account_identities
+WHERE account_id = $1
+ORDER BY created_at;
+
+-- name: CreateOIDCLoginRequest :exec
+INSERT INTO oidc_login_requests (state_hash, provider, nonce, code_verifier, expires_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- Consuming the request deletes it, so a state can only be redeemed once.
+-- name: ConsumeOIDCLoginRequest :one
+DELETE FROM oidc_login_requests
+WHERE state_hash = $1
+RETURNING state_hash, provider, nonce, code_verifier, expires_at, created_at;
+
+-- name: DeleteExpiredOIDCLoginRequests :exec
+DELETE FROM oidc_login_requests
+WHERE expires_at < NOW();
@@ -0,0 +1,43 @@
+-- name: CreatePasskey :one
+INSERT INTO passkeys (account_id, name, credential_id, public_key, attestation_type, aaguid, sign_count, transports, backup_eligible, backup_state)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, account_id, name, credential_id, public_key, attestation_type, aaguid, sign_count, transports, backup_eligible, backup_state, last_used_at, created_at;
+
+-- name: ListAccountPasskeys :many
+SELECT id, account_id, name, credential_id, public_key, attestation_type, aaguid, sign_count, transports, backup_eligible, backup_state, last_used_at, created_at
+FROM passkeys
+WHERE account_id = $1
+ORDER BY created_at;
+
+-- name: UpdatePasskeyUsage :execrows
+UPDATE passkeys
+SET sign_count = $2, backup_state = $3, last_used_at = NOW()
+WHERE id = $1;
+
+-- name: DeletePasskey :execrows
+DELETE FROM passkeys
+WHERE id = $1 AND account_id = $2;
+
+-- name: CreatePasskeyChallenge :one
+INSERT INTO passkey_challenges (account_id, purpose, session_data, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, purpose, session_data, expires_at, created_at;
+
+-- Consuming the challenge deletes it, so a ceremony can only be completed
+-- once.
+-- name: ConsumePasskeyChallenge :one
+DELETE FROM passkey_challenges
+WHERE id = $1 AND purpose = $2
+RETURNING id, account_id, purpose, session_data, expires_at, created_at;
+
+-- name: DeleteExpiredPasskeyChallenges :exec
+DELETE FROM passkey_challenges
+WHERE expires_at < NOW();
+
+-- name: DeleteAccountPasskeys :exec
+DELETE FROM passkeys
+WHERE account_id = $1;
+
+-- name: DeleteAccountPasskeyChallenges :exec
+DELETE FROM passkey_challenges
+WHERE account_id = sqlc.arg(account_id)::uuid;
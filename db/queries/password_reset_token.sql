@@ -0,0 +1,19 @@
+-- name: CreatePasswordResetToken :one
+INSERT INTO password_reset_tokens (account_id, token_hash, expires_at)
+VALUES ($1, $2, $3)
+RETURNING id, account_id, token_hash, expires_at, used_at, created_at;
+
+-- name: FindPasswordResetTokenByHash :one
+SELECT id, account_id, token_hash, expires_at, used_at, created_at
+FROM password_reset_tokens
+WHERE token_hash = $1;
+
+-- name: UsePasswordResetToken :execrows
+UPDATE password_reset_tokens
+SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL;
+
+-- name: InvalidateAccountPasswordResetTokens :exec
+UPDATE password_reset_tokens
+SET used_at = NOW()
+WHERE account_id = $1 AND used_at IS NULL;
@@ -0,0 +1,31 @@
+-- name: CreatePersonalAccessToken :one
+INSERT INTO personal_access_tokens (account_id, name, token_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, name, token_hash, scopes, expires_at, last_used_at, revoked_at, created_at;
+
+-- name: FindPersonalAccessTokenByHash :one
+SELECT id, account_id, name, token_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+FROM personal_access_tokens
+WHERE token_hash = $1;
+
+-- name: ListAccountPersonalAccessTokens :many
+SELECT id, account_id, name, token_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+FROM personal_access_tokens
+WHERE account_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: RevokePersonalAccessToken :execrows
+UPDATE personal_access_tokens
+SET revoked_at = NOW()
+WHERE id = $1 AND account_id = $2 AND revoked_at IS NULL;
+
+-- name: TouchPersonalAccessToken :exec
+UPDATE personal_access_tokens
+SET last_used_at = sqlc.arg(used_at)
+WHERE id = sqlc.arg(id)
+  AND (last_used_at IS NULL OR last_used_at <= sqlc.arg(used_before));
+
+-- name: RevokeAccountPersonalAccessTokens :exec
+UPDATE personal_access_tokens
+SET revoked_at = NOW()
+WHERE account_id = $1 AND revoked_at IS NULL;
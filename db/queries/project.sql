@@ -0,0 +1,156 @@
+-- Every query on projects runs inside the scope of a workspace, where
+-- row-level security hides the rows of the others. The workspace is still
+-- filtered explicitly so a missing scope never widens a query.
+
+-- The account that creates a project becomes its admin.
+-- name: CreateProject :one
+WITH created AS (
+    INSERT INTO projects (workspace_id, owner_account_id, name, key, description, status, start_date, target_date)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    RETURNING id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+), admin AS (
+    INSERT INTO project_members (project_id, account_id, workspace_id, role_id)
+    SELECT c.id, c.owner_account_id, c.workspace_id, r.id
+    FROM created c JOIN roles r ON r.name = 'project_admin'
+)
+SELECT id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+FROM created;
+
+-- name: FindProject :one
+SELECT id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+FROM projects
+WHERE id = $1 AND workspace_id = $2 AND deleted_at IS NULL;
+
+-- The listings only hold the projects the viewer can read: all of them
+-- when it reads the projects of the workspace, otherwise those it reads as
+-- a member of the project or of one of its teams.
+-- name: ListProjects :many
+SELECT p.id, p.owner_account_id, p.name, p.key, p.description, p.status, p.start_date, p.target_date, p.created_at, p.updated_at, p.deleted_at, p.workspace_id
+FROM projects AS p
+JOIN accounts AS viewer ON viewer.id = sqlc.arg(viewer_id)::uuid
+WHERE p.workspace_id = sqlc.arg(workspace_id) AND p.deleted_at IS NULL
+  AND (sqlc.narg(search)::text IS NULL
+       OR p.name ILIKE '%' || sqlc.narg(search)::text || '%'
+       OR p.key ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(status)::text IS NULL OR p.status = sqlc.narg(status)::text)
+  AND EXISTS (
+      SELECT 1
+      FROM (
+          SELECT ar.role_id
+          FROM account_roles ar
+          WHERE ar.account_id = viewer.id
+            AND (ar.resource_type = 'system'
+                 OR (ar.resource_type = 'workspace' AND ar.resource_id = p.workspace_id))
+          UNION ALL
+          SELECT pm.role_id
+          FROM project_members pm
+          WHERE pm.project_id = p.id AND pm.account_id = viewer.id
+          UNION ALL
+          SELECT t.role_id
+          FROM team_projects tp
+          JOIN teams t ON t.id = tp.team_id
+          JOIN team_members tm ON tm.team_id = t.id
+          WHERE tp.project_id = p.id AND tm.account_id = viewer.id
+      ) granted
+      JOIN role_permissions rp ON rp.role_id = granted.role_id
+      JOIN permissions perm ON perm.id = rp.permission_id
+      WHERE perm.name = 'projects:read'
+  )
+ORDER BY p.created_at DESC, p.id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: CountProjects :one
+SELECT COUNT(*)
+FROM projects AS p
+JOIN accounts AS viewer ON viewer.id = sqlc.arg(viewer_id)::uuid
+WHERE p.workspace_id = sqlc.arg(workspace_id) AND p.deleted_at IS NULL
+  AND (sqlc.narg(search)::text IS NULL
+       OR p.name ILIKE '%' || sqlc.narg(search)::text || '%'
+       OR p.key ILIKE '%' || sqlc.narg(search)::text || '%')
+  AND (sqlc.narg(status)::text IS NULL OR p.status = sqlc.narg(status)::text)
+  AND EXISTS (
+      SELECT 1
+      FROM (
+          SELECT ar.role_id
+          FROM account_roles ar
+          WHERE ar.account_id = viewer.id
+            AND (ar.resource_type = 'system'
+                 OR (ar.resource_type = 'workspace' AND ar.resource_id = p.workspace_id))
+          UNION ALL
+          SELECT pm.role_id
+          FROM project_members pm
+          WHERE pm.project_id = p.id AND pm.account_id = viewer.id
+          UNION ALL
+          SELECT t.role_id
+          FROM team_projects tp
+          JOIN teams t ON t.id = tp.team_id
+          JOIN team_members tm ON tm.team_id = t.id
+          WHERE tp.project_id = p.id AND tm.account_id = viewer.id
+      ) granted
+      JOIN role_permissions rp ON rp.role_id = granted.role_id
+      JOIN permissions perm ON perm.id = rp.permission_id
+      WHERE perm.name = 'projects:read'
+  );
+
+-- Every project the account created in the workspace, deleted or not, for
+-- the exports of its personal data.
+-- name: ListAccountProjects :many
+SELECT id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+FROM projects
+WHERE workspace_id = $1 AND owner_account_id = $2
+ORDER BY created_at;
+
+-- name: UpdateProject :one
+UPDATE projects
+SET name = $3, key = $4, description = $5, status = $6, start_date = $7, target_date = $8, updated_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND deleted_at IS NULL
+RETURNING id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id;
+
+-- name: SoftDeleteProject :execrows
+UPDATE projects
+SET deleted_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND deleted_at IS NULL;
+
+-- name: RestoreProject :one
+UPDATE projects
+SET deleted_at = NULL, updated_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND deleted_at IS NOT NULL
+RETURNING id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id;
+
+-- name: ListProjectMembers :many
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, pm.created_at
+FROM project_members pm
+JOIN accounts a ON a.id = pm.account_id
+JOIN roles r ON r.id = pm.role_id
+WHERE pm.project_id = $1 AND pm.workspace_id = $2
+ORDER BY a.name, a.id;
+
+-- name: FindProjectMember :one
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, pm.created_at
+FROM project_members pm
+JOIN accounts a ON a.id = pm.account_id
+JOIN roles r ON r.id = pm.role_id
+WHERE pm.project_id = $1 AND pm.workspace_id = $2 AND pm.account_id = $3;
+
+-- Adds the account to the project with the role, or changes its role when
+-- it is a member already. Only members of the workspace of the project can
+-- join it.
+-- name: SetProjectMember :execrows
+INSERT INTO project_members (project_id, account_id, workspace_id, role_id)
+SELECT p.id, sqlc.arg(account_id)::uuid, p.workspace_id, r.id
+FROM projects p CROSS JOIN roles r
+WHERE p.id = sqlc.arg(project_id)::uuid
+  AND p.workspace_id = sqlc.arg(workspace_id)::uuid
+  AND p.deleted_at IS NULL
+  AND r.name = sqlc.arg(role_name)
+  AND EXISTS (
+      SELECT 1 FROM account_roles ar
+      WHERE ar.resource_type = 'workspace' AND ar.resource_id = p.workspace_id AND ar.account_id = sqlc.arg(account_id)::uuid
+  )
+ON CONFLICT (project_id, account_id) DO UPDATE SET role_id = EXCLUDED.role_id;
+
+-- name: RemoveProjectMember :execrows
+DELETE FROM project_members AS pm
+WHERE pm.project_id = sqlc.arg(project_id)::uuid
+  AND pm.workspace_id = sqlc.arg(workspace_id)::uuid
+  AND pm.account_id = sqlc.arg(account_id)::uuid;
@@ -0,0 +1,24 @@
+-- name: CreateRecoveryCodes :exec
+INSERT INTO recovery_codes (account_id, code_hash)
+SELECT sqlc.arg(account_id), unnest(sqlc.arg(code_hashes)::text[]);
+
+-- name: UseRecoveryCode :execrows
+UPDATE recovery_codes
+SET used_at = NOW()
+WHERE account_id = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*)
+FROM recovery_codes
+WHERE account_id = $1 AND used_at IS NULL;
+
+-- name: SummarizeAccountRecoveryCodes :one
+SELECT COUNT(*)::bigint AS total,
+       COUNT(*) FILTER (WHERE used_at IS NULL)::bigint AS unused,
+       MAX(created_at)::timestamp AS created_at
+FROM recovery_codes
+WHERE account_id = $1;
+
+-- name: DeleteAccountRecoveryCodes :exec
+DELETE FROM recovery_codes
+WHERE account_id = $1;
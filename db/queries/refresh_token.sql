@@ -0,0 +1,19 @@
+-- name: CreateRefreshToken :one
+INSERT INTO refresh_tokens (account_id, session_id, token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, token_hash, expires_at, revoked_at, created_at, session_id;
+
+-- name: FindRefreshTokenByHash :one
+SELECT id, account_id, token_hash, expires_at, revoked_at, created_at, session_id
+FROM refresh_tokens
+WHERE token_hash = $1;
+
+-- name: RevokeRefreshToken :execrows
+UPDATE refresh_tokens
+SET revoked_at = NOW()
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeAccountRefreshTokens :exec
+UPDATE refresh_tokens
+SET revoked_at = NOW()
+WHERE account_id = $1 AND revoked_at IS NULL;
@@ -0,0 +1,75 @@
+-- name: ListRoles :many
+SELECT id, name, description, created_at
+FROM roles
+ORDER BY name;
+
+-- name: FindRoleByName :one
+SELECT id, name, description, created_at
+FROM roles
+WHERE name = $1;
+
+-- name: ListRolePermissions :many
+SELECT r.name AS role_name, p.name AS permission_name
+FROM role_permissions rp
+JOIN roles r ON r.id = rp.role_id
+JOIN permissions p ON p.id = rp.permission_id
+ORDER BY r.name, p.name;
+
+-- name: ListPermissions :many
+SELECT id, name, description, created_at
+FROM permissions
+ORDER BY name;
+
+-- The roles of an account on a resource are those granted to it there or
+-- system-wide, its role in a team or project when the resource is the team
+-- or project, and the role of its teams on the projects assigned to them.
+-- name: AccountHasPermission :one
+SELECT EXISTS (
+    SELECT 1
+    FROM (
+        SELECT ar.role_id
+        FROM account_roles ar
+        WHERE ar.account_id = sqlc.arg(account_id)
+          AND (ar.resource_type = 'system'
+               OR (ar.resource_type = sqlc.arg(resource_type) AND ar.resource_id = sqlc.narg(resource_id)))
+        UNION ALL
+        SELECT tm.role_id
+        FROM team_members tm
+        WHERE sqlc.arg(resource_type) = 'team'
+          AND tm.team_id = sqlc.narg(resource_id) AND tm.account_id = sqlc.arg(account_id)
+        UNION ALL
+        SELECT pm.role_id
+        FROM project_members pm
+        WHERE sqlc.arg(resource_type) = 'project'
+          AND pm.project_id = sqlc.narg(resource_id) AND pm.account_id = sqlc.arg(account_id)
+        UNION ALL
+        SELECT t.role_id
+        FROM team_projects tp
+        JOIN teams t ON t.id = tp.team_id
+        JOIN team_members tm ON tm.team_id = t.id
+        WHERE sqlc.arg(resource_type) = 'project'
+          AND tp.project_id = sqlc.narg(resource_id) AND tm.account_id = sqlc.arg(account_id)
+    ) granted
+    JOIN role_permissions rp ON rp.role_id = granted.role_id
+    JOIN permissions p ON p.id = rp.permission_id
+    WHERE p.name = sqlc.arg(permission)
+);
+
+-- name: ListAccountRoles :many
+SELECT ar.id, ar.account_id, r.name AS role_name, ar.resource_type, ar.resource_id, ar.created_at
+FROM account_roles ar
+JOIN roles r ON r.id = ar.role_id
+WHERE ar.account_id = $1
+ORDER BY ar.created_at;
+
+-- name: AssignAccountRole :execrows
+INSERT INTO account_roles (account_id, role_id, resource_type, resource_id)
+VALUES (sqlc.arg(account_id), sqlc.arg(role_id), sqlc.arg(resource_type), sqlc.narg(resource_id))
+ON CONFLICT DO NOTHING;
+
+-- name: RevokeAccountRole :execrows
+DELETE FROM account_roles
+WHERE account_id = sqlc.arg(account_id)
+  AND role_id = sqlc.arg(role_id)
+  AND resource_type = sqlc.arg(resource_type)
+  AND resource_id IS NOT DISTINCT FROM sqlc.narg(resource_id);
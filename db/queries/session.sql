@@ -0,0 +1,28 @@
+-- name: CreateSession :one
+INSERT INTO sessions (account_id, user_agent, ip_address, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, user_agent, ip_address, expires_at, last_seen_at, revoked_at, created_at;
+
+-- name: ListAccountSessions :many
+SELECT id, account_id, user_agent, ip_address, expires_at, last_seen_at, revoked_at, created_at
+FROM sessions
+WHERE account_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY last_seen_at DESC;
+
+-- TouchSession records a refresh of the session, failing on revoked ones.
+-- name: TouchSession :execrows
+UPDATE sessions
+SET user_agent = $2, ip_address = $3, expires_at = $4, last_seen_at = NOW()
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeSession :execrows
+UPDATE sessions
+SET revoked_at = NOW()
+WHERE id = $1 AND account_id = $2 AND revoked_at IS NULL;
+
+-- name: RevokeAccountSessions :exec
+UPDATE sessions
+SET revoked_at = NOW()
+WHERE account_id = sqlc.arg(account_id)
+  AND revoked_at IS NULL
+  AND id <> sqlc.arg(except_id);
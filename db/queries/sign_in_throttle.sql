@@ -0,0 +1,31 @@
+-- name: FindSignInThrottle :one
+SELECT scope, subject, failures, last_failure_at, locked_until
+FROM sign_in_throttles
+WHERE scope = $1 AND subject = $2;
+
+-- RecordSignInFailure counts a failure, restarting the count when the last
+-- failure is older than reset_before or the previous lockout has expired.
+-- name: RecordSignInFailure :one
+INSERT INTO sign_in_throttles (scope, subject, failures, last_failure_at)
+VALUES (sqlc.arg(scope), sqlc.arg(subject), 1, sqlc.arg(failed_at))
+ON CONFLICT (scope, subject) DO UPDATE
+SET failures = CASE
+        WHEN sign_in_throttles.last_failure_at <= sqlc.arg(reset_before)
+          OR sign_in_throttles.locked_until <= sqlc.arg(failed_at) THEN 1
+        ELSE sign_in_throttles.failures + 1
+    END,
+    locked_until = CASE
+        WHEN sign_in_throttles.locked_until <= sqlc.arg(failed_at) THEN NULL
+        ELSE sign_in_throttles.locked_until
+    END,
+    last_failure_at = sqlc.arg(failed_at)
+RETURNING scope, subject, failures, last_failure_at, locked_until;
+
+-- name: LockSignInThrottle :exec
+UPDATE sign_in_throttles
+SET locked_until = $3
+WHERE scope = $1 AND subject = $2;
+
+-- name: ClearSignInThrottle :execrows
+DELETE FROM sign_in_throttles
+WHERE scope = $1 AND subject = $2;
@@ -0,0 +1,81 @@
+-- Tasks are read and written inside the scope of their workspace, which
+-- lets the queries see the project they belong to. The tasks of deleted
+-- projects are out of reach until the project is restored.
+
+-- name: CreateTask :one
+INSERT INTO tasks (project_id, workspace_id, title, description, status, created_by)
+SELECT p.id, p.workspace_id, sqlc.arg(title)::text, sqlc.arg(description)::text, sqlc.arg(status)::text, sqlc.arg(created_by)::uuid
+FROM projects p
+WHERE p.id = sqlc.arg(project_id)::uuid AND p.workspace_id = sqlc.arg(workspace_id)::uuid AND p.deleted_at IS NULL
+RETURNING id, project_id, workspace_id, title, description, status, assignee_account_id, assignee_team_id, created_by, created_at, updated_at;
+
+-- name: FindTask :one
+SELECT t.id, t.project_id, t.workspace_id, t.title, t.description, t.status, t.assignee_account_id, t.assignee_team_id, t.created_by, t.created_at, t.updated_at
+FROM tasks t
+JOIN projects p ON p.id = t.project_id AND p.deleted_at IS NULL
+WHERE t.id = $1 AND t.project_id = $2 AND t.workspace_id = $3;
+
+-- name: ListTasks :many
+SELECT t.id, t.project_id, t.workspace_id, t.title, t.description, t.status, t.assignee_account_id, t.assignee_team_id, t.created_by, t.created_at, t.updated_at
+FROM tasks t
+JOIN projects p ON p.id = t.project_id AND p.deleted_at IS NULL
+WHERE t.project_id = sqlc.arg(project_id) AND t.workspace_id = sqlc.arg(workspace_id)
+  AND (sqlc.narg(status)::text IS NULL OR t.status = sqlc.narg(status)::text)
+  AND (sqlc.narg(assignee_account_id)::uuid IS NULL OR t.assignee_account_id = sqlc.narg(assignee_account_id)::uuid)
+  AND (sqlc.narg(assignee_team_id)::uuid IS NULL OR t.assignee_team_id = sqlc.narg(assignee_team_id)::uuid)
+ORDER BY t.created_at DESC, t.id
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: CountTasks :one
+SELECT COUNT(*)
+FROM tasks t
+JOIN projects p ON p.id = t.project_id AND p.deleted_at IS NULL
+WHERE t.project_id = sqlc.arg(project_id) AND t.workspace_id = sqlc.arg(workspace_id)
+  AND (sqlc.narg(status)::text IS NULL OR t.status = sqlc.narg(status)::text)
+  AND (sqlc.narg(assignee_account_id)::uuid IS NULL OR t.assignee_account_id = sqlc.narg(assignee_account_id)::uuid)
+  AND (sqlc.narg(assignee_team_id)::uuid IS NULL OR t.assignee_team_id = sqlc.narg(assignee_team_id)::uuid);
+
+-- Every task of the workspace the account created or is assigned to, for
+-- the exports of its personal data.
+-- name: ListAccountTasks :many
+SELECT id, project_id, workspace_id, title, description, status, assignee_account_id, assignee_team_id, created_by, created_at, updated_at
+FROM tasks
+WHERE workspace_id = sqlc.arg(workspace_id)
+  AND (created_by = sqlc.arg(account_id)::uuid OR assignee_account_id = sqlc.arg(account_id)::uuid)
+ORDER BY created_at;
+
+-- name: UpdateTask :one
+UPDATE tasks AS t
+SET title = sqlc.arg(title), description = sqlc.arg(description), status = sqlc.arg(status), updated_at = NOW()
+FROM projects p
+WHERE t.id = sqlc.arg(id) AND t.project_id = sqlc.arg(project_id) AND t.workspace_id = sqlc.arg(workspace_id)
+  AND p.id = t.project_id AND p.deleted_at IS NULL
+RETURNING t.id, t.project_id, t.workspace_id, t.title, t.description, t.status, t.assignee_account_id, t.assignee_team_id, t.created_by, t.created_at, t.updated_at;
+
+-- Assigns the task to the account and to the team, either of which may be
+-- null to leave the task without one. The account must be a member of the
+-- workspace of the task and the team one of its teams, otherwise the task
+-- is left untouched.
+-- name: AssignTask :one
+UPDATE tasks AS t
+SET assignee_account_id = sqlc.narg(assignee_account_id)::uuid,
+    assignee_team_id = sqlc.narg(assignee_team_id)::uuid,
+    updated_at = NOW()
+FROM projects p
+WHERE t.id = sqlc.arg(id)::uuid AND t.project_id = sqlc.arg(project_id)::uuid AND t.workspace_id = sqlc.arg(workspace_id)::uuid
+  AND p.id = t.project_id AND p.deleted_at IS NULL
+  AND (sqlc.narg(assignee_account_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM account_roles ar
+      WHERE ar.resource_type = 'workspace' AND ar.resource_id = t.workspace_id AND ar.account_id = sqlc.narg(assignee_account_id)::uuid
+  ))
+  AND (sqlc.narg(assignee_team_id)::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM teams tm
+      WHERE tm.id = sqlc.narg(assignee_team_id)::uuid AND tm.workspace_id = t.workspace_id
+  ))
+RETURNING t.id, t.project_id, t.workspace_id, t.title, t.description, t.status, t.assignee_account_id, t.assignee_team_id, t.created_by, t.created_at, t.updated_at;
+
+-- name: DeleteTask :execrows
+DELETE FROM tasks AS t
+USING projects p
+WHERE t.id = sqlc.arg(id) AND t.project_id = sqlc.arg(project_id) AND t.workspace_id = sqlc.arg(workspace_id)
+  AND p.id = t.project_id AND p.deleted_at IS NULL;
@@ -0,0 +1,108 @@
+-- name: CreateTeam :one
+WITH created AS (
+    INSERT INTO teams (workspace_id, name, role_id)
+    SELECT sqlc.arg(workspace_id)::uuid, sqlc.arg(name), r.id
+    FROM roles r
+    WHERE r.name = sqlc.arg(role_name)
+    RETURNING id, workspace_id, name, role_id, created_at, updated_at
+)
+SELECT c.id, c.workspace_id, c.name, r.name AS role_name, c.created_at, c.updated_at
+FROM created c
+JOIN roles r ON r.id = c.role_id;
+
+-- name: FindTeam :one
+SELECT t.id, t.workspace_id, t.name, r.name AS role_name, t.created_at, t.updated_at
+FROM teams t
+JOIN roles r ON r.id = t.role_id
+WHERE t.id = $1 AND t.workspace_id = $2;
+
+-- name: ListWorkspaceTeams :many
+SELECT t.id, t.workspace_id, t.name, r.name AS role_name, t.created_at, t.updated_at
+FROM teams t
+JOIN roles r ON r.id = t.role_id
+WHERE t.workspace_id = $1
+ORDER BY lower(t.name), t.id;
+
+-- The teams the account belongs to, in every workspace, with its role in
+-- each.
+-- name: ListAccountTeams :many
+SELECT t.id, t.workspace_id, t.name, r.name AS member_role, t.created_at, t.updated_at
+FROM team_members tm
+JOIN teams t ON t.id = tm.team_id
+JOIN roles r ON r.id = tm.role_id
+WHERE tm.account_id = $1
+ORDER BY lower(t.name), t.id;
+
+-- name: UpdateTeam :one
+UPDATE teams AS t
+SET name = sqlc.arg(name), role_id = r.id, updated_at = NOW()
+FROM roles r
+WHERE r.name = sqlc.arg(role_name) AND t.id = sqlc.arg(id) AND t.workspace_id = sqlc.arg(workspace_id)
+RETURNING t.id, t.workspace_id, t.name, r.name AS role_name, t.created_at, t.updated_at;
+
+-- Deleting a team deletes its memberships and project assignments.
+-- name: DeleteTeam :execrows
+DELETE FROM teams
+WHERE id = $1 AND workspace_id = $2;
+
+-- name: ListTeamMembers :many
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, tm.created_at
+FROM team_members tm
+JOIN teams t ON t.id = tm.team_id
+JOIN accounts a ON a.id = tm.account_id
+JOIN roles r ON r.id = tm.role_id
+WHERE tm.team_id = $1 AND t.workspace_id = $2
+ORDER BY a.name, a.id;
+
+-- name: FindTeamMember :one
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, tm.created_at
+FROM team_members tm
+JOIN teams t ON t.id = tm.team_id
+JOIN accounts a ON a.id = tm.account_id
+JOIN roles r ON r.id = tm.role_id
+WHERE tm.team_id = $1 AND t.workspace_id = $2 AND tm.account_id = $3;
+
+-- Adds the account to the team with the role, or changes its role when it
+-- is a member already. Only members of the workspace of the team can join
+-- it.
+-- name: SetTeamMember :execrows
+INSERT INTO team_members (team_id, account_id, role_id)
+SELECT t.id, sqlc.arg(account_id)::uuid, r.id
+FROM teams t CROSS JOIN roles r
+WHERE t.id = sqlc.arg(team_id)::uuid
+  AND t.workspace_id = sqlc.arg(workspace_id)::uuid
+  AND r.name = sqlc.arg(role_name)
+  AND EXISTS (
+      SELECT 1 FROM account_roles ar
+      WHERE ar.resource_type = 'workspace' AND ar.resource_id = t.workspace_id AND ar.account_id = sqlc.arg(account_id)::uuid
+  )
+ON CONFLICT (team_id, account_id) DO UPDATE SET role_id = EXCLUDED.role_id;
+
+-- name: RemoveTeamMember :execrows
+DELETE FROM team_members AS tm
+USING teams t
+WHERE t.id = tm.team_id AND tm.team_id = sqlc.arg(team_id)::uuid AND t.workspace_id = sqlc.arg(workspace_id)::uuid AND tm.account_id = sqlc.arg(account_id)::uuid;
+
+-- Projects are only visible within the scope of their workspace.
+-- name: ListTeamProjects :many
+SELECT p.id, p.key, p.name, tp.created_at
+FROM team_projects tp
+JOIN teams t ON t.id = tp.team_id
+JOIN projects p ON p.id = tp.project_id
+WHERE tp.team_id = $1 AND t.workspace_id = $2 AND p.deleted_at IS NULL
+ORDER BY p.name, p.id;
+
+-- Assigns a project of the workspace of the team to it. A project assigned
+-- already counts as assigned again.
+-- name: AssignTeamProject :execrows
+INSERT INTO team_projects (team_id, project_id)
+SELECT t.id, p.id
+FROM teams t
+JOIN projects p ON p.workspace_id = t.workspace_id
+WHERE t.id = sqlc.arg(team_id)::uuid AND t.workspace_id = sqlc.arg(workspace_id)::uuid AND p.id = sqlc.arg(project_id)::uuid AND p.deleted_at IS NULL
+ON CONFLICT (team_id, project_id) DO UPDATE SET created_at = team_projects.created_at;
+
+-- name: UnassignTeamProject :execrows
+DELETE FROM team_projects AS tp
+USING teams t
+WHERE t.id = tp.team_id AND tp.team_id = sqlc.arg(team_id)::uuid AND t.workspace_id = sqlc.arg(workspace_id)::uuid AND tp.project_id = sqlc.arg(project_id)::uuid;
@@ -0,0 +1,3 @@
+-- Sets the workspace row-level security lets the current transaction see.
+-- name: SetWorkspaceScope :exec
+SELECT set_config('app.workspace_id', sqlc.arg(workspace_id)::uuid::text, true);
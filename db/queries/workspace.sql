@@ -0,0 +1,132 @@
+-- The creator of a workspace becomes its owner.
+-- name: CreateWorkspace :one
+WITH created AS (
+    INSERT INTO workspaces (name, created_by)
+    VALUES (sqlc.arg(name), sqlc.arg(created_by)::uuid)
+    RETURNING id, name, created_by, created_at, updated_at
+), owner AS (
+    INSERT INTO account_roles (account_id, role_id, resource_type, resource_id)
+    SELECT sqlc.arg(created_by)::uuid, r.id, 'workspace', c.id
+    FROM created c CROSS JOIN roles r
+    WHERE r.name = 'workspace_owner'
+)
+SELECT id, name, created_by, created_at, updated_at FROM created;
+
+-- name: FindWorkspace :one
+SELECT id, name, created_by, created_at, updated_at
+FROM workspaces
+WHERE id = $1;
+
+-- name: ListAccountWorkspaces :many
+SELECT w.id, w.name, w.created_by, w.created_at, w.updated_at, r.name AS role_name
+FROM workspaces w
+JOIN account_roles ar ON ar.resource_type = 'workspace' AND ar.resource_id = w.id
+JOIN roles r ON r.id = ar.role_id
+WHERE ar.account_id = $1
+ORDER BY w.name, w.id;
+
+-- name: ListAccountWorkspaceIDs :many
+SELECT w.id
+FROM workspaces w
+JOIN account_roles ar ON ar.resource_type = 'workspace' AND ar.resource_id = w.id
+WHERE ar.account_id = $1
+ORDER BY w.created_at;
+
+-- name: UpdateWorkspace :one
+UPDATE workspaces
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, created_by, created_at, updated_at;
+
+-- Deleting a workspace deletes its projects and the roles granted on it.
+-- name: DeleteWorkspace :execrows
+WITH revoked AS (
+    DELETE FROM account_roles AS ar
+    WHERE ar.resource_type = 'workspace' AND ar.resource_id = sqlc.arg(id)::uuid
+)
+DELETE FROM workspaces AS w WHERE w.id = sqlc.arg(id)::uuid;
+
+-- name: ListWorkspaceMembers :many
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, ar.created_at
+FROM account_roles ar
+JOIN accounts a ON a.id = ar.account_id
+JOIN roles r ON r.id = ar.role_id
+WHERE ar.resource_type = 'workspace' AND ar.resource_id = sqlc.arg(workspace_id)::uuid
+ORDER BY a.name, a.id;
+
+-- name: FindWorkspaceMember :one
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, ar.created_at
+FROM account_roles ar
+JOIN accounts a ON a.id = ar.account_id
+JOIN roles r ON r.id = ar.role_id
+WHERE ar.resource_type = 'workspace' AND ar.resource_id = sqlc.arg(workspace_id)::uuid AND ar.account_id = sqlc.arg(account_id);
+
+-- The owners of the workspace are locked before counting them, so two
+-- concurrent changes cannot each take away one of the last two owners. A
+-- change that would leave the workspace without an owner is not made and is
+-- reported by last_owner.
+-- name: ChangeWorkspaceMemberRole :one
+WITH owners AS (
+    SELECT ar.account_id
+    FROM account_roles ar
+    JOIN roles r ON r.id = ar.role_id
+    WHERE ar.resource_type = 'workspace' AND ar.resource_id = sqlc.arg(workspace_id)::uuid AND r.name = 'workspace_owner'
+    ORDER BY ar.account_id
+    FOR UPDATE OF ar
+), guard AS (
+    SELECT sqlc.arg(role_name)::text <> 'workspace_owner'
+        AND sqlc.arg(account_id)::uuid IN (SELECT account_id FROM owners)
+        AND (SELECT COUNT(*) FROM owners) <= 1 AS last_owner
+), changed AS (
+    UPDATE account_roles
+    SET role_id = r.id
+    FROM roles r, guard
+    WHERE NOT guard.last_owner
+      AND r.name = sqlc.arg(role_name)::text
+      AND account_roles.resource_type = 'workspace'
+      AND account_roles.resource_id = sqlc.arg(workspace_id)::uuid
+      AND account_roles.account_id = sqlc.arg(account_id)::uuid
+    RETURNING account_roles.account_id
+)
+SELECT EXISTS (SELECT 1 FROM changed)::boolean AS changed, guard.last_owner::boolean AS last_owner
+FROM guard;
+
+-- Leaving a workspace also leaves its teams and projects and unassigns its
+-- tasks. As when changing roles, the owners are locked first and the last
+-- owner cannot leave.
+-- name: RemoveWorkspaceMember :one
+WITH owners AS (
+    SELECT ar.account_id
+    FROM account_roles ar
+    JOIN roles r ON r.id = ar.role_id
+    WHERE ar.resource_type = 'workspace' AND ar.resource_id = sqlc.arg(workspace_id)::uuid AND r.name = 'workspace_owner'
+    ORDER BY ar.account_id
+    FOR UPDATE OF ar
+), guard AS (
+    SELECT sqlc.arg(account_id)::uuid IN (SELECT account_id FROM owners)
+        AND (SELECT COUNT(*) FROM owners) <= 1 AS last_owner
+), left_teams AS (
+    DELETE FROM team_members AS tm
+    USING teams t, guard
+    WHERE NOT guard.last_owner
+      AND t.id = tm.team_id AND t.workspace_id = sqlc.arg(workspace_id)::uuid AND tm.account_id = sqlc.arg(account_id)::uuid
+), left_projects AS (
+    DELETE FROM project_members AS pm
+    USING guard
+    WHERE NOT guard.last_owner
+      AND pm.workspace_id = sqlc.arg(workspace_id)::uuid AND pm.account_id = sqlc.arg(account_id)::uuid
+), unassigned_tasks AS (
+    UPDATE tasks AS t
+    SET assignee_account_id = NULL, updated_at = NOW()
+    FROM guard
+    WHERE NOT guard.last_owner
+      AND t.workspace_id = sqlc.arg(workspace_id)::uuid AND t.assignee_account_id = sqlc.arg(account_id)::uuid
+), removed AS (
+    DELETE FROM account_roles AS ar
+    USING guard
+    WHERE NOT guard.last_owner
+      AND ar.resource_type = 'workspace' AND ar.resource_id = sqlc.arg(workspace_id)::uuid AND ar.account_id = sqlc.arg(account_id)::uuid
+    RETURNING ar.account_id
+)
+SELECT EXISTS (SELECT 1 FROM removed)::boolean AS removed, guard.last_owner::boolean AS last_owner
+FROM guard;
@@ -0,0 +1,75 @@
+-- name: CreateWorkspaceInvitation :one
+INSERT INTO workspace_invitations (workspace_id, email, role, token_hash, invited_by, expires_at)
+VALUES (sqlc.arg(workspace_id), sqlc.arg(email), sqlc.arg(role), sqlc.arg(token_hash), sqlc.arg(invited_by)::uuid, sqlc.arg(expires_at))
+RETURNING id, workspace_id, email, role, token_hash, invited_by, expires_at, sent_at, accepted_at, accepted_by, revoked_at, created_at;
+
+-- Pending invitations are neither accepted nor revoked, expired ones included
+-- so they can be resent.
+-- name: ListPendingWorkspaceInvitations :many
+SELECT id, workspace_id, email, role, token_hash, invited_by, expires_at, sent_at, accepted_at, accepted_by, revoked_at, created_at
+FROM workspace_invitations
+WHERE workspace_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL
+ORDER BY created_at, id;
+
+-- name: FindPendingWorkspaceInvitation :one
+SELECT id, workspace_id, email, role, token_hash, invited_by, expires_at, sent_at, accepted_at, accepted_by, revoked_at, created_at
+FROM workspace_invitations
+WHERE id = $1 AND workspace_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL;
+
+-- name: FindWorkspaceInvitationByHash :one
+SELECT i.id, i.workspace_id, i.email, i.role, i.token_hash, i.invited_by, i.expires_at, i.sent_at, i.accepted_at, i.accepted_by, i.revoked_at, i.created_at,
+       w.name AS workspace_name, COALESCE(a.name, '')::text AS inviter_name
+FROM workspace_invitations i
+JOIN workspaces w ON w.id = i.workspace_id
+LEFT JOIN accounts a ON a.id = i.invited_by
+WHERE i.token_hash = $1;
+
+-- name: RenewWorkspaceInvitation :one
+UPDATE workspace_invitations
+SET token_hash = $3, expires_at = $4, sent_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL
+RETURNING id, workspace_id, email, role, token_hash, invited_by, expires_at, sent_at, accepted_at, accepted_by, revoked_at, created_at;
+
+-- name: RevokeWorkspaceInvitation :execrows
+UPDATE workspace_invitations
+SET revoked_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL;
+
+-- Accepting the invitation and granting the invited role are one statement,
+-- so an invitation is never used up without the account joining. An account
+-- that is a member of the workspace already keeps its role.
+-- name: AcceptWorkspaceInvitation :one
+WITH accepted AS (
+    UPDATE workspace_invitations
+    SET accepted_at = NOW(), accepted_by = sqlc.arg(accepted_by)::uuid
+    WHERE workspace_invitations.id = sqlc.arg(id) AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()
+    RETURNING workspace_id, role
+), joined AS (
+    INSERT INTO account_roles (account_id, role_id, resource_type, resource_id)
+    SELECT sqlc.arg(accepted_by)::uuid, r.id, 'workspace', accepted.workspace_id
+    FROM accepted
+    JOIN roles r ON r.name = accepted.role
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM account_roles ar
+        WHERE ar.account_id = sqlc.arg(accepted_by)::uuid
+          AND ar.resource_type = 'workspace'
+          AND ar.resource_id = accepted.workspace_id
+    )
+    ON CONFLICT DO NOTHING
+)
+SELECT EXISTS (SELECT 1 FROM accepted)::boolean AS accepted;
+
+-- Invitations of an account are the ones sent to its email or accepted by it.
+-- name: ListAccountWorkspaceInvitations :many
+SELECT i.id, i.workspace_id, w.name AS workspace_name, i.email, i.role, i.expires_at, i.sent_at, i.accepted_at, i.revoked_at, i.created_at
+FROM workspace_invitations i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE lower(i.email) = (SELECT lower(a.email) FROM accounts a WHERE a.id = sqlc.arg(account_id)::uuid)
+   OR i.accepted_by = sqlc.arg(account_id)::uuid
+ORDER BY i.created_at, i.id;
+
+-- name: DeleteAccountWorkspaceInvitations :exec
+DELETE FROM workspace_invitations
+WHERE lower(email) = (SELECT lower(a.email) FROM accounts a WHERE a.id = sqlc.arg(account_id)::uuid)
+   OR accepted_by = sqlc.arg(account_id)::uuid;
@@ -3,10 +3,399 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 CREATE TABLE accounts (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     name TEXT NOT NULL,
-    email TEXT NOT NULL UNIQUE,
+    email TEXT NOT NULL,
     password TEXT NOT NULL,
-    avatar TEXT,
+    avatar_key TEXT,
     created_at TIMESTAMP DEFAULT NOW(),
     updated_at TIMESTAMP DEFAULT NOW(),
-    deleted_at TIMESTAMP
+    deleted_at TIMESTAMP,
+    email_verified_at TIMESTAMP,
+    verification_sent_at TIMESTAMP,
+    totp_secret TEXT,
+    totp_last_used_step BIGINT,
+    two_factor_enabled_at TIMESTAMP,
+    suspended_at TIMESTAMP
 );
+
+-- Emails are unique regardless of case.
+CREATE UNIQUE INDEX accounts_email_lower_idx ON accounts (lower(email));
+
+-- An identity links an account to the subject of an external OpenID Connect
+-- provider, so it can sign in through that provider.
+CREATE TABLE account_identities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX account_identities_account_id_idx ON account_identities (account_id);
+
+-- A login request holds the PKCE verifier and nonce of an authorization
+-- started with a provider until its callback comes back with the state.
+CREATE TABLE oidc_login_requests (
+    state_hash TEXT PRIMARY KEY,
+    provider TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    code_verifier TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- A passkey is a WebAuthn credential registered by an account to sign in
+-- without a password.
+CREATE TABLE passkeys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    credential_id BYTEA NOT NULL UNIQUE,
+    public_key BYTEA NOT NULL,
+    attestation_type TEXT NOT NULL DEFAULT '',
+    aaguid BYTEA,
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    transports TEXT[] NOT NULL DEFAULT '{}',
+    backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+    backup_state BOOLEAN NOT NULL DEFAULT FALSE,
+    last_used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX passkeys_account_id_idx ON passkeys (account_id);
+
+-- A passkey challenge keeps the state of a registration or sign-in ceremony
+-- until the authenticator answers it. Sign-in challenges have no account.
+CREATE TABLE passkey_challenges (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID REFERENCES accounts (id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL,
+    session_data JSONB NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- A session groups the refresh tokens issued by one sign-in, across
+-- rotations, and records the device it was started from.
+CREATE TABLE sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip_address TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX sessions_account_id_idx ON sessions (account_id);
+
+CREATE TABLE refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    session_id UUID NOT NULL REFERENCES sessions (id) ON DELETE CASCADE
+);
+
+CREATE INDEX refresh_tokens_account_id_idx ON refresh_tokens (account_id);
+CREATE INDEX refresh_tokens_session_id_idx ON refresh_tokens (session_id);
+
+CREATE TABLE password_reset_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX password_reset_tokens_account_id_idx ON password_reset_tokens (account_id);
+
+-- An email change request holds a new address until it is confirmed from a
+-- link sent to it, or cancelled from a link sent to the current address.
+CREATE TABLE email_change_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    new_email TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    cancel_token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    confirmed_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX email_change_requests_account_id_idx ON email_change_requests (account_id);
+
+CREATE TABLE recovery_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX recovery_codes_account_id_code_hash_idx ON recovery_codes (account_id, code_hash);
+
+CREATE TABLE personal_access_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX personal_access_tokens_account_id_idx ON personal_access_tokens (account_id);
+
+CREATE TABLE roles (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE permissions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE role_permissions (
+    role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    permission_id UUID NOT NULL REFERENCES permissions (id) ON DELETE CASCADE,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+-- A role is granted either system-wide (resource_type 'system', no
+-- resource_id) or on a single resource, such as a workspace.
+CREATE TABLE account_roles (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    resource_type TEXT NOT NULL DEFAULT 'system',
+    resource_id UUID,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK ((resource_type = 'system') = (resource_id IS NULL))
+);
+
+CREATE UNIQUE INDEX account_roles_assignment_idx
+    ON account_roles (account_id, role_id, resource_type, COALESCE(resource_id, '00000000-0000-0000-0000-000000000000'));
+CREATE INDEX account_roles_resource_idx ON account_roles (resource_type, resource_id);
+
+-- Failed sign-in attempts, counted per account (scope 'account', subject the
+-- email) and per source address (scope 'ip', subject the IP).
+CREATE TABLE sign_in_throttles (
+    scope TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,
+    PRIMARY KEY (scope, subject)
+);
+
+-- An impersonation lets an administrator (actor) act as another account for
+-- a limited time. Both references are kept nullable so the audit trail
+-- outlives the removal of either account.
+CREATE TABLE impersonations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor_account_id UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    account_id UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    ip_address TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX impersonations_actor_account_id_idx ON impersonations (actor_account_id);
+CREATE INDEX impersonations_account_id_idx ON impersonations (account_id);
+
+-- Every request made during an impersonation, as answered.
+CREATE TABLE impersonation_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    impersonation_id UUID NOT NULL REFERENCES impersonations (id) ON DELETE CASCADE,
+    method TEXT NOT NULL,
+    path TEXT NOT NULL,
+    status INTEGER NOT NULL,
+    ip_address TEXT NOT NULL,
+    user_agent TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX impersonation_requests_impersonation_id_idx ON impersonation_requests (impersonation_id, created_at);
+
+-- A data job exports or erases the personal data of an account in the
+-- background. Exports keep their archive in the storage until expires_at.
+-- Erased accounts keep their row, anonymized, so what references them stays
+-- valid; their completed erasure keeps them from being restored.
+CREATE TABLE data_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    requested_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('export', 'erasure')),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    archive_key TEXT,
+    error TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    expires_at TIMESTAMP
+);
+
+CREATE INDEX data_jobs_account_id_idx ON data_jobs (account_id, created_at);
+CREATE INDEX data_jobs_unfinished_idx ON data_jobs (created_at) WHERE status IN ('pending', 'running');
+
+-- An account has at most one unfinished job of each kind.
+CREATE UNIQUE INDEX data_jobs_account_kind_unfinished_idx ON data_jobs (account_id, kind) WHERE status IN ('pending', 'running');
+
+-- A workspace owns projects and gathers the accounts that work on them. Its
+-- members are the accounts holding a role on it in account_roles, so one
+-- account may belong to many workspaces.
+CREATE TABLE workspaces (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    created_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- A project belongs to a workspace and keeps the account that created it.
+-- Its key is a short code (ex.: TRI) unique among the projects of the
+-- workspace that are not deleted, so a deleted project frees its key.
+CREATE TABLE projects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_account_id UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    name TEXT NOT NULL,
+    key TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('planned', 'active', 'on_hold', 'completed', 'cancelled')),
+    start_date DATE,
+    target_date DATE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at TIMESTAMP,
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    CHECK (target_date IS NULL OR start_date IS NULL OR target_date >= start_date)
+);
+
+CREATE UNIQUE INDEX projects_workspace_key_idx ON projects (workspace_id, key) WHERE deleted_at IS NULL;
+CREATE INDEX projects_workspace_id_idx ON projects (workspace_id, created_at);
+CREATE INDEX projects_owner_account_id_idx ON projects (owner_account_id, created_at);
+
+-- Only the rows of the workspace set in app.workspace_id for the current
+-- transaction are visible; without it, none are.
+ALTER TABLE projects ENABLE ROW LEVEL SECURITY;
+ALTER TABLE projects FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY projects_workspace_isolation ON projects
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', true), '')::uuid)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', true), '')::uuid);
+
+-- An invitation asks the owner of email to join a workspace with role. The
+-- link sent by email carries the token, kept here only as a hash. It is
+-- pending until accepted, revoked or expired; resending it replaces the
+-- token and postpones expires_at.
+CREATE TABLE workspace_invitations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('workspace_owner', 'member', 'guest')),
+    token_hash TEXT NOT NULL UNIQUE,
+    invited_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    expires_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    accepted_at TIMESTAMP,
+    accepted_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX workspace_invitations_workspace_id_idx ON workspace_invitations (workspace_id, created_at);
+
+-- An email has at most one invitation that was neither accepted nor revoked
+-- in each workspace.
+CREATE UNIQUE INDEX workspace_invitations_open_email_idx ON workspace_invitations (workspace_id, lower(email))
+    WHERE accepted_at IS NULL AND revoked_at IS NULL;
+
+-- A team groups members of a workspace, such as "Backend" or "Design". Its
+-- members hold the role of the team (role_id) on the projects assigned to
+-- it, on top of the roles they hold on the workspace.
+CREATE TABLE teams (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    role_id UUID NOT NULL REFERENCES roles (id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX teams_workspace_name_idx ON teams (workspace_id, lower(name));
+
+-- A team member holds a role on the team itself, team_lead or team_member.
+CREATE TABLE team_members (
+    team_id UUID NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles (id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (team_id, account_id)
+);
+
+CREATE INDEX team_members_account_id_idx ON team_members (account_id);
+
+CREATE TABLE team_projects (
+    team_id UUID NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (team_id, project_id)
+);
+
+CREATE INDEX team_projects_project_id_idx ON team_projects (project_id);
+
+-- A project member holds a role on the project itself, on top of the role
+-- it holds on the workspace: project_admin, project_editor,
+-- project_commenter or project_viewer. Only members of the workspace join
+-- its projects, and leaving the workspace leaves them; workspace_id is kept
+-- so that can be done without reading the projects.
+CREATE TABLE project_members (
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles (id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (project_id, account_id)
+);
+
+CREATE INDEX project_members_account_id_idx ON project_members (account_id, workspace_id);
+
+-- A task of a project, assigned to a member of the workspace, to one of its
+-- teams, to both or to nobody. An account that leaves the workspace is
+-- unassigned from its tasks; as on project_members, workspace_id is kept so
+-- that can be done without reading the projects.
+CREATE TABLE tasks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    workspace_id UUID NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'todo' CHECK (status IN ('todo', 'in_progress', 'done')),
+    assignee_account_id UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    assignee_team_id UUID REFERENCES teams (id) ON DELETE SET NULL,
+    created_by UUID REFERENCES accounts (id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX tasks_project_id_idx ON tasks (project_id, created_at);
+CREATE INDEX tasks_assignee_account_id_idx ON tasks (assignee_account_id, workspace_id);
+CREATE INDEX tasks_assignee_team_id_idx ON tasks (assignee_team_id);
+CREATE INDEX tasks_created_by_idx ON tasks (created_by, workspace_id);
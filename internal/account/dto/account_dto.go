@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"encoding/json"
+	"time"
 	"trilha-api/internal/shared/dto"
 
 	"github.com/google/uuid"
@@ -8,27 +10,216 @@ import (
 
 type AccountResponse struct {
 	dto.Default
-	Name   string `json:"name"`
-	Email  string `json:"email"`
-	Avatar string `json:"avatar"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Avatar holds the URL of the avatar for each size, keyed by the size in
+	// pixels, or null when the account has no avatar.
+	Avatar           map[string]string `json:"avatar"`
+	EmailVerifiedAt  *time.Time        `json:"email_verified_at"`
+	TwoFactorEnabled bool              `json:"two_factor_enabled"`
+}
+
+// AdminAccountResponse is an account as administrators see it, in any state.
+type AdminAccountResponse struct {
+	AccountResponse
+	SuspendedAt *time.Time `json:"suspended_at"`
+	// LockedUntil is when the sign-in lockout of the account ends, or null
+	// when it is not locked.
+	LockedUntil *time.Time `json:"locked_until"`
+}
+
+// ListAccountsQuery holds the query string of the administration listing.
+type ListAccountsQuery struct {
+	Search  string `form:"search"`
+	Status  string `form:"status" binding:"omitempty,oneof=active deleted suspended locked unverified"`
+	Page    int    `form:"page" binding:"omitempty,min=1"`
+	PerPage int    `form:"per_page" binding:"omitempty,min=1"`
+}
+
+// StartImpersonationRequest explains why an administrator needs to act as
+// the account, which is kept in the audit trail.
+type StartImpersonationRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type ImpersonationResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	ActorID   *uuid.UUID `json:"actor_id"`
+	AccountID *uuid.UUID `json:"account_id"`
+	Reason    string     `json:"reason"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	// AccessToken acts as the account until the impersonation ends. It is
+	// only returned when the impersonation starts.
+	AccessToken string `json:"access_token,omitempty"`
+}
+
+type ImpersonationRequestResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Status    int       `json:"status"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DataJobResponse is the status of a data export or erasure. The archive of
+// a completed export can be downloaded while ArchiveAvailable is set.
+type DataJobResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	AccountID        uuid.UUID  `json:"account_id"`
+	Kind             string     `json:"kind"`
+	Status           string     `json:"status"`
+	ArchiveAvailable bool       `json:"archive_available"`
+	CreatedAt        time.Time  `json:"created_at"`
+	StartedAt        *time.Time `json:"started_at"`
+	FinishedAt       *time.Time `json:"finished_at"`
+	ExpiresAt        *time.Time `json:"expires_at"`
 }
 
 type CreateAccountRequest struct {
 	Name     string `json:"name" binding:"required"`
 	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=8"`
-	Avatar   string `json:"avatar"`
+	Password string `json:"password" binding:"required"`
 }
 
+// UpdadeAccountRequest holds the profile fields of a PATCH request; fields
+// left out of the body are kept unchanged. Email is only accepted when it is
+// the current one: changing it goes through ChangeEmailRequest.
 type UpdadeAccountRequest struct {
-	ID       uuid.UUID `json:"id"`
-	Name     string    `json:"name" binding:"required"`
-	Email    string    `json:"email" binding:"required,email"`
-	Password string    `json:"password" binding:"required,min=8"`
-	Avatar   string    `json:"avatar"`
+	Name  *string `json:"name" binding:"omitempty,min=1"`
+	Email *string `json:"email" binding:"omitempty,email"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+type ChangeEmailRequest struct {
+	NewEmail        string `json:"new_email" binding:"required,email"`
+	CurrentPassword string `json:"current_password" binding:"required"`
+}
+
+type EmailChangeTokenRequest struct {
+	Token string `json:"token" binding:"required"`
 }
 
 type SignInAccountRequest struct {
 	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=8"`
+	Password string `json:"password" binding:"required"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type AuthTokensResponse struct {
+	TokenType             string           `json:"token_type"`
+	AccessToken           string           `json:"access_token"`
+	AccessTokenExpiresAt  time.Time        `json:"access_token_expires_at"`
+	RefreshToken          string           `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time        `json:"refresh_token_expires_at"`
+	Account               *AccountResponse `json:"account,omitempty"`
+}
+
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	Current    bool      `json:"current"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type UnlockAccountRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type TwoFactorEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TwoFactorChallengeResponse struct {
+	TwoFactorRequired bool      `json:"two_factor_required"`
+	ChallengeToken    string    `json:"challenge_token"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+type TwoFactorSignInRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
+
+type CreatePersonalAccessTokenRequest struct {
+	Name          string   `json:"name" binding:"required,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1,dive,oneof=read write"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"required,min=1,max=365"`
+}
+
+type PersonalAccessTokenResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Token      string     `json:"token,omitempty"`
+}
+
+// PasskeyOptionsResponse carries the options to pass to the WebAuthn API of
+// the browser, and the challenge to send back with its answer.
+type PasskeyOptionsResponse struct {
+	ChallengeID uuid.UUID `json:"challenge_id"`
+	Options     any       `json:"options"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// RegisterPasskeyRequest carries the credential created by the browser, as
+// serialized by the WebAuthn API.
+type RegisterPasskeyRequest struct {
+	ChallengeID uuid.UUID       `json:"challenge_id" binding:"required"`
+	Name        string          `json:"name" binding:"required,max=100"`
+	Credential  json.RawMessage `json:"credential" binding:"required"`
+}
+
+// PasskeySignInRequest carries the assertion signed by the authenticator, as
+// serialized by the WebAuthn API.
+type PasskeySignInRequest struct {
+	ChallengeID uuid.UUID       `json:"challenge_id" binding:"required"`
+	Credential  json.RawMessage `json:"credential" binding:"required"`
+}
+
+type PasskeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Transports []string   `json:"transports"`
+	Synced     bool       `json:"synced"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
 }
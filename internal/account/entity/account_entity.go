@@ -7,12 +7,36 @@ import (
 )
 
 type AccountEntity struct {
-	ID        uuid.UUID
-	Name      string
-	Email     string
-	Password  string
-	Avatar    string
+	ID       uuid.UUID
+	Name     string
+	Email    string
+	Password string
+	// AvatarKey is the storage key the resized images of the avatar are
+	// kept under, empty when the account has no avatar.
+	AvatarKey string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
+
+	EmailVerifiedAt    *time.Time
+	VerificationSentAt *time.Time
+
+	TOTPSecret         string
+	TwoFactorEnabledAt *time.Time
+
+	// SuspendedAt is set while an administrator keeps the account from
+	// signing in.
+	SuspendedAt *time.Time
+}
+
+func (a *AccountEntity) IsEmailVerified() bool {
+	return a.EmailVerifiedAt != nil
+}
+
+func (a *AccountEntity) IsTwoFactorEnabled() bool {
+	return a.TwoFactorEnabledAt != nil
+}
+
+func (a *AccountEntity) IsSuspended() bool {
+	return a.SuspendedAt != nil
 }
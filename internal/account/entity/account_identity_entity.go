@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountIdentityEntity links an account to the subject it has at an
+// external OpenID Connect provider.
+type AccountIdentityEntity struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+}
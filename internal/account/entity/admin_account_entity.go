@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// Account states the administration listing can be filtered by.
+const (
+	AccountStatusActive     = "active"
+	AccountStatusDeleted    = "deleted"
+	AccountStatusSuspended  = "suspended"
+	AccountStatusLocked     = "locked"
+	AccountStatusUnverified = "unverified"
+)
+
+// AdminAccountEntity is an account as administrators see it, in any state,
+// along with the sign-in lockout it may be under.
+type AdminAccountEntity struct {
+	AccountEntity
+	LockedUntil *time.Time
+}
+
+func (a *AdminAccountEntity) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && a.LockedUntil.After(now)
+}
+
+// AccountFilter narrows and pages the administration listing. An empty
+// Search or Status does not filter.
+type AccountFilter struct {
+	Search  string
+	Status  string
+	Page    int
+	PerPage int
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DataJobKindExport  = "export"
+	DataJobKindErasure = "erasure"
+)
+
+const (
+	DataJobStatusPending   = "pending"
+	DataJobStatusRunning   = "running"
+	DataJobStatusCompleted = "completed"
+	DataJobStatusFailed    = "failed"
+)
+
+// DataJobEntity is a background job exporting or erasing the personal data
+// of an account. RequestedBy is the account that asked for it, the account
+// itself or an administrator, and uuid.Nil once that account is removed.
+// ArchiveKey locates the archive of a completed export in the storage until
+// ExpiresAt, and is empty afterwards.
+type DataJobEntity struct {
+	ID          uuid.UUID
+	AccountID   uuid.UUID
+	RequestedBy uuid.UUID
+	Kind        string
+	Status      string
+	ArchiveKey  string
+	Error       string
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	ExpiresAt   *time.Time
+}
+
+// HasArchive reports whether the archive of the export can be downloaded at
+// now.
+func (j *DataJobEntity) HasArchive(now time.Time) bool {
+	return j.ArchiveKey != "" && j.ExpiresAt != nil && now.Before(*j.ExpiresAt)
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailChangeRequestEntity is a pending move of an account to NewEmail. Token
+// confirms it from the new address and CancelToken cancels it from the
+// current one; both are only known when the request is created.
+type EmailChangeRequestEntity struct {
+	ID              uuid.UUID
+	AccountID       uuid.UUID
+	NewEmail        string
+	Token           string
+	TokenHash       string
+	CancelToken     string
+	CancelTokenHash string
+	ExpiresAt       time.Time
+	ConfirmedAt     *time.Time
+	CancelledAt     *time.Time
+	CreatedAt       time.Time
+}
+
+// IsPending reports whether the request can still be confirmed at now.
+func (r *EmailChangeRequestEntity) IsPending(now time.Time) bool {
+	return r.ConfirmedAt == nil && r.CancelledAt == nil && now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImpersonationEntity is a period during which an administrator, the actor,
+// acts as another account. ActorID and AccountID are uuid.Nil once the
+// account they referenced is removed. Token is the access token issued for
+// the impersonation, only known when it starts.
+type ImpersonationEntity struct {
+	ID        uuid.UUID
+	ActorID   uuid.UUID
+	AccountID uuid.UUID
+	Reason    string
+	IPAddress string
+	UserAgent string
+	Token     string
+	ExpiresAt time.Time
+	EndedAt   *time.Time
+	CreatedAt time.Time
+}
+
+// IsActive reports whether the impersonation is still running at now.
+func (i *ImpersonationEntity) IsActive(now time.Time) bool {
+	return i.EndedAt == nil && now.Before(i.ExpiresAt)
+}
+
+// ImpersonationRequestEntity is a request made during an impersonation, as
+// kept in its audit trail.
+type ImpersonationRequestEntity struct {
+	ID              uuid.UUID
+	ImpersonationID uuid.UUID
+	Method          string
+	Path            string
+	Status          int
+	IPAddress       string
+	UserAgent       string
+	CreatedAt       time.Time
+}
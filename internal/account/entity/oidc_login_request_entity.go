@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// OIDCLoginRequestEntity is a login started with a provider, waiting for its
+// callback. Only the hash of the state is stored.
+type OIDCLoginRequestEntity struct {
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+// OIDCAuthorizationEntity is where the user is sent to sign in with a
+// provider, and the state the callback must come back with.
+type OIDCAuthorizationEntity struct {
+	URL       string
+	State     string
+	ExpiresAt time.Time
+}
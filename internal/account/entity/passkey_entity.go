@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasskeyEntity is a WebAuthn credential registered by an account.
+// SignCount is the last counter reported by the authenticator, which never
+// goes back for authenticators that keep one.
+type PasskeyEntity struct {
+	ID              uuid.UUID
+	AccountID       uuid.UUID
+	Name            string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	AAGUID          []byte
+	SignCount       uint32
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+	LastUsedAt      *time.Time
+	CreatedAt       time.Time
+}
+
+const (
+	PasskeyPurposeRegistration = "registration"
+	PasskeyPurposeSignIn       = "sign_in"
+)
+
+// PasskeyChallengeEntity keeps the state of a ceremony between the options
+// sent to the client and the answer of its authenticator. AccountID is nil
+// for sign-in, as the account is only known from the answer.
+type PasskeyChallengeEntity struct {
+	ID          uuid.UUID
+	AccountID   *uuid.UUID
+	Purpose     string
+	SessionData []byte
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+// PasskeyOptionsEntity is what the client passes to the WebAuthn API of the
+// browser, along with the challenge to send back with the answer.
+type PasskeyOptionsEntity struct {
+	ChallengeID uuid.UUID
+	Options     any
+	ExpiresAt   time.Time
+}
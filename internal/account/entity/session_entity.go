@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionEntity is a signed-in device. It outlives the rotations of its
+// refresh token and ends when revoked or when its last token expires.
+type SessionEntity struct {
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	UserAgent  string
+	IPAddress  string
+	ExpiresAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// ClientEntity describes the device a request comes from.
+type ClientEntity struct {
+	UserAgent string
+	IP        string
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// SignInThrottleEntity counts the failed sign-in attempts of a subject, an
+// email or a source IP depending on the scope.
+type SignInThrottleEntity struct {
+	Scope         string
+	Subject       string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+func (t *SignInThrottleEntity) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && t.LockedUntil.After(now)
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+type TwoFactorEnrollmentEntity struct {
+	Secret string
+	URI    string
+}
+
+// RecoveryCodesEntity describes the recovery codes of an account without
+// them: how many were issued, how many are left and when they were issued.
+type RecoveryCodesEntity struct {
+	Count     int64
+	Unused    int64
+	CreatedAt *time.Time
+}
+
+type TwoFactorChallengeEntity struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// SignInEntity is the outcome of a sign-in: either the issued tokens or, for
+// accounts with two-factor authentication, the challenge to complete first.
+type SignInEntity struct {
+	Tokens    *AuthTokensEntity
+	Challenge *TwoFactorChallengeEntity
+}
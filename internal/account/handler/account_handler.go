@@ -3,11 +3,17 @@ package handler
 import (
 	"database/sql"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"trilha-api/internal/account/dto"
 	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
 	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
 	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/shared/password"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -15,10 +21,11 @@ import (
 
 type AccountHandler struct {
 	usecase usecase.AccountUseCaseInterface
+	avatars usecase.AvatarUseCaseInterface
 }
 
-func New(uc usecase.AccountUseCaseInterface) *AccountHandler {
-	return &AccountHandler{usecase: uc}
+func New(uc usecase.AccountUseCaseInterface, avatars usecase.AvatarUseCaseInterface) *AccountHandler {
+	return &AccountHandler{usecase: uc, avatars: avatars}
 }
 
 func (h *AccountHandler) Register(c *gin.Context) {
@@ -36,7 +43,6 @@ func (h *AccountHandler) Register(c *gin.Context) {
 		Name:     req.Name,
 		Email:    req.Email,
 		Password: req.Password,
-		Avatar:   req.Avatar,
 	}
 
 	// Check if user already exists
@@ -58,6 +64,18 @@ func (h *AccountHandler) Register(c *gin.Context) {
 
 	// Register new user
 	if err := h.usecase.Register(&model); err != nil {
+		if respondPasswordPolicy(c, "password", err) {
+			return
+		}
+
+		if errors.Is(err, repository.ErrEmailAlreadyInUse) {
+			c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+				Status:  http.StatusConflict,
+				Message: "account with this email already exists",
+			})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
 			Status:  http.StatusInternalServerError,
 			Message: err.Error(),
@@ -120,7 +138,7 @@ func (h *AccountHandler) Find(c *gin.Context) {
 			},
 			Name:   account.Name,
 			Email:  account.Email,
-			Avatar: account.Avatar,
+			Avatar: h.avatars.URLs(account),
 		},
 	})
 }
@@ -161,7 +179,350 @@ func (h *AccountHandler) FindByEmail(c *gin.Context) {
 			},
 			Name:   account.Name,
 			Email:  account.Email,
-			Avatar: account.Avatar,
+			Avatar: h.avatars.URLs(account),
 		},
 	})
 }
+
+func (h *AccountHandler) SignIn(c *gin.Context) {
+	req := dto.SignInAccountRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{
+		Email:    req.Email,
+		Password: req.Password,
+	}
+
+	result, err := h.usecase.SignIn(account, clientOf(c))
+
+	if err != nil {
+		if respondSignInThrottled(c, err) {
+			return
+		}
+
+		if errors.Is(err, usecase.ErrInvalidCredentials) {
+			c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+				Status:  http.StatusUnauthorized,
+				Message: "Invalid email or password",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	respondSignIn(c, h.avatars, account, result)
+}
+
+func (h *AccountHandler) Me(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: principal.AccountID,
+	}
+
+	if err := h.usecase.Find(account); err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.AccountResponse]{
+		Status: http.StatusOK,
+		Data:   toAccountResponse(account, h.avatars),
+	})
+}
+
+func (h *AccountHandler) Update(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.UpdadeAccountRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: principal.AccountID,
+	}
+
+	if err := h.usecase.Find(account); err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	// Emails are compared case-insensitively, so the current one may be
+	// sent back in any case.
+	if req.Email != nil && !strings.EqualFold(strings.TrimSpace(*req.Email), account.Email) {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Email cannot be changed through this endpoint, use POST /accounts/me/email",
+		})
+		return
+	}
+
+	if req.Name != nil {
+		account.Name = *req.Name
+	}
+
+	if err := h.usecase.Update(account); err != nil {
+		if errors.Is(err, usecase.ErrEmailNotVerified) {
+			c.JSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+				Status:  http.StatusForbidden,
+				Message: "Email verification required",
+			})
+			return
+		}
+
+		respondAccountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.AccountResponse]{
+		Status: http.StatusOK,
+		Data:   toAccountResponse(account, h.avatars),
+	})
+}
+
+func (h *AccountHandler) ChangePassword(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.ChangePasswordRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: principal.AccountID,
+	}
+
+	err := h.usecase.ChangePassword(account, principal.SessionID, req.CurrentPassword, req.NewPassword)
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidCredentials) {
+			c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+				Status:  http.StatusBadRequest,
+				Message: "Current password is incorrect",
+			})
+			return
+		}
+
+		if respondPasswordPolicy(c, "new_password", err) {
+			return
+		}
+
+		respondAccountError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AccountHandler) Delete(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: principal.AccountID,
+	}
+
+	if err := h.usecase.Delete(account); err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AccountHandler) Restore(c *gin.Context) {
+	accountId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid account ID",
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: accountId,
+	}
+
+	if err := h.usecase.Restore(account); err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.AccountResponse]{
+		Status: http.StatusOK,
+		Data:   toAccountResponse(account, h.avatars),
+	})
+}
+
+// requirePrincipal returns the authenticated caller, answering 401 when the
+// route was reached without one.
+func requirePrincipal(c *gin.Context) (*auth.Principal, bool) {
+	principal, ok := auth.CurrentPrincipal(c)
+
+	if !ok {
+		c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+			Status:  http.StatusUnauthorized,
+			Message: "Authentication required",
+		})
+	}
+
+	return principal, ok
+}
+
+// respondSignInThrottled answers 429 with a Retry-After header when err tells
+// that sign-in is throttled, and reports whether it did.
+func respondSignInThrottled(c *gin.Context, err error) bool {
+	var throttled *usecase.SignInThrottledError
+
+	if !errors.As(err, &throttled) {
+		return false
+	}
+
+	retryAfter := int(math.Ceil(throttled.RetryAfter.Seconds()))
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(http.StatusTooManyRequests, sharedDto.APIResponse[any]{
+		Status:  http.StatusTooManyRequests,
+		Message: "Too many failed sign-in attempts, please try again later",
+	})
+
+	return true
+}
+
+// respondPasswordPolicy answers 422 with an error per broken rule when err
+// tells that the password sent in field does not follow the password policy,
+// and reports whether it did.
+func respondPasswordPolicy(c *gin.Context, field string, err error) bool {
+	var policyErr *password.PolicyError
+
+	if !errors.As(err, &policyErr) {
+		return false
+	}
+
+	fieldErrors := make([]sharedDto.FieldError, len(policyErr.Violations))
+	for i, violation := range policyErr.Violations {
+		fieldErrors[i] = sharedDto.FieldError{
+			Field:   field,
+			Code:    violation.Code,
+			Message: violation.Message,
+		}
+	}
+
+	c.JSON(http.StatusUnprocessableEntity, sharedDto.APIResponse[[]sharedDto.FieldError]{
+		Status:  http.StatusUnprocessableEntity,
+		Data:    fieldErrors,
+		Message: "Password does not meet the requirements",
+	})
+
+	return true
+}
+
+func respondAccountError(c *gin.Context, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Account not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+		Status:  http.StatusInternalServerError,
+		Message: "Internal server error",
+	})
+}
+
+// respondSignIn answers with the tokens of a sign-in or, for accounts with
+// two-factor authentication, with the challenge to complete.
+func respondSignIn(c *gin.Context, avatars usecase.AvatarUseCaseInterface, account *entity.AccountEntity, result *entity.SignInEntity) {
+	if result.Challenge != nil {
+		c.JSON(http.StatusOK, sharedDto.APIResponse[dto.TwoFactorChallengeResponse]{
+			Status: http.StatusOK,
+			Data: dto.TwoFactorChallengeResponse{
+				TwoFactorRequired: true,
+				ChallengeToken:    result.Challenge.Token,
+				ExpiresAt:         result.Challenge.ExpiresAt,
+			},
+			Message: "Two-factor authentication required",
+		})
+		return
+	}
+
+	respondSignedIn(c, avatars, account, result.Tokens)
+}
+
+func respondSignedIn(c *gin.Context, avatars usecase.AvatarUseCaseInterface, account *entity.AccountEntity, tokens *entity.AuthTokensEntity) {
+	res := toAuthTokensResponse(tokens)
+	accountRes := toAccountResponse(account, avatars)
+	res.Account = &accountRes
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.AuthTokensResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func toAccountResponse(account *entity.AccountEntity, avatars usecase.AvatarUseCaseInterface) dto.AccountResponse {
+	return dto.AccountResponse{
+		Default: sharedDto.Default{
+			ID:        account.ID,
+			CreatedAt: account.CreatedAt,
+			UpdatedAt: account.UpdatedAt,
+			DeletedAt: account.DeletedAt,
+		},
+		Name:             account.Name,
+		Email:            account.Email,
+		Avatar:           avatars.URLs(account),
+		EmailVerifiedAt:  account.EmailVerifiedAt,
+		TwoFactorEnabled: account.IsTwoFactorEnabled(),
+	}
+}
+
+func toAuthTokensResponse(tokens *entity.AuthTokensEntity) dto.AuthTokensResponse {
+	return dto.AuthTokensResponse{
+		TokenType:             "Bearer",
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  tokens.AccessTokenExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+	}
+}
@@ -14,7 +14,12 @@ import (
 	"trilha-api/internal/account/entity"
 	"trilha-api/internal/account/handler"
 	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
 	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/storage"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -26,16 +31,43 @@ func setup(t *testing.T) (*gin.Engine, *mocks.MockAccountUseCaseInterface) {
 	gin.SetMode(gin.TestMode)
 	ctrl := gomock.NewController(t)
 	mock := mocks.NewMockAccountUseCaseInterface(ctrl)
-	h := handler.New(mock)
+	h := handler.New(mock, testAvatars)
 	router := gin.Default()
+	router.Use(fakeAuthentication())
 
 	router.POST("/api/v1/accounts", h.Register)
 	router.GET("/api/v1/accounts/:id", h.Find)
 	router.GET("/api/v1/accounts/find_by_email/:email", h.FindByEmail)
+	router.POST("/api/v1/accounts/sign_in", h.SignIn)
+	router.GET("/api/v1/accounts/me", h.Me)
+	router.PATCH("/api/v1/accounts/me", h.Update)
+	router.PUT("/api/v1/accounts/me/password", h.ChangePassword)
+	router.DELETE("/api/v1/accounts/me", h.Delete)
+	router.POST("/api/v1/accounts/:id/restore", h.Restore)
 
 	return router, mock
 }
 
+// testAvatars resolves avatar URLs as the application does, from a local
+// storage served at http://trilha.test/storage.
+var testAvatars = usecase.NewAvatarUseCase(
+	nil,
+	storage.NewLocalStorage("", "http://trilha.test/storage"),
+	config.AvatarConfig{Sizes: []int{32, 128}},
+)
+
+// fakeAuthentication authenticates requests as the account in the
+// X-Account-ID header, standing in for the bearer token middleware.
+func fakeAuthentication() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if accountID, err := uuid.Parse(c.GetHeader("X-Account-ID")); err == nil {
+			sessionID, _ := uuid.Parse(c.GetHeader("X-Session-ID"))
+			auth.SetPrincipal(c, &auth.Principal{AccountID: accountID, SessionID: sessionID})
+		}
+		c.Next()
+	}
+}
+
 func TestAccountHandler_Register(t *testing.T) {
 
 	router, mockUseCase := setup(t)
@@ -47,7 +79,6 @@ func TestAccountHandler_Register(t *testing.T) {
 			Name:     "Test User",
 			Email:    "test@example.com",
 			Password: "password123",
-			Avatar:   "test",
 		}
 
 		mockUseCase.EXPECT().FindByEmail(gomock.Any()).Return(sql.ErrNoRows)
@@ -143,7 +174,6 @@ func TestAccountHandler_Register(t *testing.T) {
 			Name:     "Test User",
 			Email:    "test@example.com",
 			Password: "password123",
-			Avatar:   "test",
 		}
 		body, _ := json.Marshal(createAccountReq)
 		w := httptest.NewRecorder()
@@ -159,8 +189,32 @@ func TestAccountHandler_Register(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusInternalServerError, responseBody.Status)
 	})
-}
 
+	t.Run("should return status 422 with an error per broken password rule", func(t *testing.T) {
+		mockUseCase.EXPECT().FindByEmail(gomock.Any()).Return(sql.ErrNoRows)
+		mockUseCase.EXPECT().Register(gomock.Any()).Return(&password.PolicyError{Violations: []password.Violation{
+			{Code: password.CodeTooShort, Message: "Password must be at least 8 characters long"},
+			{Code: password.CodeBreached, Message: "Password has appeared in a data breach, choose another one"},
+		}})
+
+		body, _ := json.Marshal(dto.CreateAccountRequest{Name: "Test User", Email: "test@example.com", Password: "1234"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var responseBody sharedDto.APIResponse[[]sharedDto.FieldError]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, []sharedDto.FieldError{
+			{Field: "password", Code: password.CodeTooShort, Message: "Password must be at least 8 characters long"},
+			{Field: "password", Code: password.CodeBreached, Message: "Password has appeared in a data breach, choose another one"},
+		}, responseBody.Data)
+	})
+}
 func TestAccountHandler_Find(t *testing.T) {
 
 	router, mockUseCase := setup(t)
@@ -174,7 +228,7 @@ func TestAccountHandler_Find(t *testing.T) {
 			Name:      "Gandalf",
 			Email:     "gandalf@lor.com.br",
 			Password:  "123mago",
-			Avatar:    "url",
+			AvatarKey: "avatars/1/key",
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
@@ -198,7 +252,10 @@ func TestAccountHandler_Find(t *testing.T) {
 		assert.Equal(t, accountID, data.ID)
 		assert.Equal(t, expectedAccount.Name, data.Name)
 		assert.Equal(t, expectedAccount.Email, data.Email)
-		assert.Equal(t, expectedAccount.Avatar, data.Avatar)
+		assert.Equal(t, map[string]string{
+			"32":  "http://trilha.test/storage/avatars/1/key/32.jpg",
+			"128": "http://trilha.test/storage/avatars/1/key/128.jpg",
+		}, data.Avatar)
 		assert.WithinDuration(t, expectedAccount.CreatedAt, data.CreatedAt, time.Second)
 		assert.WithinDuration(t, expectedAccount.UpdatedAt, data.UpdatedAt, time.Second)
 	})
@@ -248,7 +305,7 @@ func TestAccountHandler_FindByEmail(t *testing.T) {
 			Name:      "Gandalf",
 			Email:     "gandalf@lor.com.br",
 			Password:  "123mago",
-			Avatar:    "url",
+			AvatarKey: "avatars/1/key",
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
@@ -270,7 +327,10 @@ func TestAccountHandler_FindByEmail(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Equal(t, http.StatusOK, responseBody.Status)
 		assert.Equal(t, expectedAccount.ID, data.ID)
-		assert.Equal(t, expectedAccount.Avatar, data.Avatar)
+		assert.Equal(t, map[string]string{
+			"32":  "http://trilha.test/storage/avatars/1/key/32.jpg",
+			"128": "http://trilha.test/storage/avatars/1/key/128.jpg",
+		}, data.Avatar)
 		assert.Equal(t, expectedAccount.Email, data.Email)
 		assert.Equal(t, expectedAccount.Name, data.Name)
 		assert.WithinDuration(t, expectedAccount.CreatedAt, data.CreatedAt, time.Second)
@@ -311,3 +371,302 @@ func TestAccountHandler_FindByEmail(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, responseBody.Status)
 	})
 }
+
+func TestAccountHandler_SignIn(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	signInReq := dto.SignInAccountRequest{
+		Email:    "gandalf@lor.com.br",
+		Password: "password123",
+	}
+
+	t.Run("should return status 200 and the token pair", func(t *testing.T) {
+		accountID := uuid.New()
+
+		mockUseCase.EXPECT().SignIn(gomock.Any(), gomock.Any()).DoAndReturn(func(account *entity.AccountEntity, _ entity.ClientEntity) (*entity.SignInEntity, error) {
+			assert.Equal(t, signInReq.Email, account.Email)
+			assert.Equal(t, signInReq.Password, account.Password)
+			account.ID = accountID
+			return &entity.SignInEntity{
+				Tokens: &entity.AuthTokensEntity{
+					AccessToken:  "access-token",
+					RefreshToken: "refresh-token",
+				},
+			}, nil
+		})
+
+		body, _ := json.Marshal(signInReq)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AuthTokensResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer", responseBody.Data.TokenType)
+		assert.Equal(t, "access-token", responseBody.Data.AccessToken)
+		assert.Equal(t, "refresh-token", responseBody.Data.RefreshToken)
+		assert.Equal(t, accountID, responseBody.Data.Account.ID)
+	})
+
+	t.Run("should return a challenge when two-factor authentication is enabled", func(t *testing.T) {
+		mockUseCase.EXPECT().SignIn(gomock.Any(), gomock.Any()).Return(&entity.SignInEntity{
+			Challenge: &entity.TwoFactorChallengeEntity{Token: "challenge-token"},
+		}, nil)
+
+		body, _ := json.Marshal(signInReq)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.TwoFactorChallengeResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.True(t, responseBody.Data.TwoFactorRequired)
+		assert.Equal(t, "challenge-token", responseBody.Data.ChallengeToken)
+	})
+
+	t.Run("should return status 401 when credentials are invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().SignIn(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrInvalidCredentials)
+
+		body, _ := json.Marshal(signInReq)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return status 429 with Retry-After while sign-in is throttled", func(t *testing.T) {
+		mockUseCase.EXPECT().SignIn(gomock.Any(), gomock.Any()).Return(nil, &usecase.SignInThrottledError{RetryAfter: 1500 * time.Millisecond})
+
+		body, _ := json.Marshal(signInReq)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("should return status 400 for invalid body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in", bytes.NewBuffer([]byte(`{"email":"invalid"}`)))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 500 when use case fails", func(t *testing.T) {
+		mockUseCase.EXPECT().SignIn(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+
+		body, _ := json.Marshal(signInReq)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAccountHandler_Me(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	t.Run("should return status 200 and the authenticated account", func(t *testing.T) {
+		accountID := uuid.New()
+
+		mockUseCase.EXPECT().Find(gomock.Any()).DoAndReturn(func(account *entity.AccountEntity) error {
+			assert.Equal(t, accountID, account.ID)
+			account.Name = "Gandalf"
+			account.Email = "gandalf@lor.com.br"
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/me", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AccountResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, accountID, responseBody.Data.ID)
+		assert.Equal(t, "Gandalf", responseBody.Data.Name)
+	})
+
+	t.Run("should return status 401 without an authenticated account", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/me", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestAccountHandler_Update(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+
+	t.Run("should update only the provided fields", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).DoAndReturn(func(account *entity.AccountEntity) error {
+			account.Name = "Gandalf"
+			account.Email = "gandalf@lor.com.br"
+			account.AvatarKey = "avatars/1/key"
+			return nil
+		})
+		mockUseCase.EXPECT().Update(gomock.Any()).DoAndReturn(func(account *entity.AccountEntity) error {
+			assert.Equal(t, accountID, account.ID)
+			assert.Equal(t, "Gandalf O Branco", account.Name)
+			assert.Equal(t, "avatars/1/key", account.AvatarKey)
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/api/v1/accounts/me", bytes.NewBufferString(`{"name":"Gandalf O Branco"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should refuse to change the email", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).DoAndReturn(func(account *entity.AccountEntity) error {
+			account.Email = "gandalf@lor.com.br"
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/api/v1/accounts/me", bytes.NewBufferString(`{"email":"saruman@lor.com.br"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAccountHandler_ChangePassword(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+	body, _ := json.Marshal(dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "new-password123"})
+
+	t.Run("should return status 204 when the password is changed", func(t *testing.T) {
+		mockUseCase.EXPECT().ChangePassword(gomock.Any(), gomock.Any(), "password123", "new-password123").Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/accounts/me/password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 400 when the current password is wrong", func(t *testing.T) {
+		mockUseCase.EXPECT().ChangePassword(gomock.Any(), gomock.Any(), "password123", "new-password123").Return(usecase.ErrInvalidCredentials)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/accounts/me/password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 422 when the new password breaks the policy", func(t *testing.T) {
+		mockUseCase.EXPECT().ChangePassword(gomock.Any(), gomock.Any(), "password123", "new-password123").Return(&password.PolicyError{
+			Violations: []password.Violation{{Code: password.CodePersonalInfo, Message: "Password must not contain your name or email"}},
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/accounts/me/password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var responseBody sharedDto.APIResponse[[]sharedDto.FieldError]
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &responseBody))
+		assert.Equal(t, "new_password", responseBody.Data[0].Field)
+	})
+}
+
+func TestAccountHandler_Delete(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+
+	mockUseCase.EXPECT().Delete(gomock.Any()).DoAndReturn(func(account *entity.AccountEntity) error {
+		assert.Equal(t, accountID, account.ID)
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me", nil)
+	req.Header.Set("X-Account-ID", accountID.String())
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestAccountHandler_Restore(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	t.Run("should return status 200 and the restored account", func(t *testing.T) {
+		accountID := uuid.New()
+
+		mockUseCase.EXPECT().Restore(gomock.Any()).DoAndReturn(func(account *entity.AccountEntity) error {
+			assert.Equal(t, accountID, account.ID)
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/accounts/%s/restore", accountID), nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 404 when there is no deleted account", func(t *testing.T) {
+		mockUseCase.EXPECT().Restore(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/accounts/%s/restore", uuid.New()), nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AdminAccountHandler serves the administration of accounts in any state.
+type AdminAccountHandler struct {
+	usecase usecase.AdminAccountUseCaseInterface
+	avatars usecase.AvatarUseCaseInterface
+}
+
+func NewAdminAccountHandler(uc usecase.AdminAccountUseCaseInterface, avatars usecase.AvatarUseCaseInterface) *AdminAccountHandler {
+	return &AdminAccountHandler{usecase: uc, avatars: avatars}
+}
+
+func (h *AdminAccountHandler) List(c *gin.Context) {
+	query := dto.ListAccountsQuery{}
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	filter := &entity.AccountFilter{
+		Search:  query.Search,
+		Status:  query.Status,
+		Page:    query.Page,
+		PerPage: query.PerPage,
+	}
+
+	accounts, total, err := h.usecase.List(filter)
+
+	if err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	items := make([]dto.AdminAccountResponse, 0, len(accounts))
+	for i := range accounts {
+		items = append(items, toAdminAccountResponse(&accounts[i], h.avatars))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[sharedDto.Page[dto.AdminAccountResponse]]{
+		Status: http.StatusOK,
+		Data: sharedDto.Page[dto.AdminAccountResponse]{
+			Items:   items,
+			Page:    filter.Page,
+			PerPage: filter.PerPage,
+			Total:   total,
+		},
+	})
+}
+
+func (h *AdminAccountHandler) Find(c *gin.Context) {
+	account, ok := parseAdminAccount(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Find(account); err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	h.respondAccount(c, account, "")
+}
+
+func (h *AdminAccountHandler) Suspend(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account, ok := parseAdminAccount(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Suspend(principal.AccountID, account); err != nil {
+		respondAdminAccountError(c, err)
+		return
+	}
+
+	h.respondAccount(c, account, "Account suspended")
+}
+
+func (h *AdminAccountHandler) Reactivate(c *gin.Context) {
+	account, ok := parseAdminAccount(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Reactivate(account); err != nil {
+		respondAdminAccountError(c, err)
+		return
+	}
+
+	h.respondAccount(c, account, "Account reactivated")
+}
+
+// ForcePasswordReset clears the password of the account and emails its owner
+// a link to choose a new one.
+func (h *AdminAccountHandler) ForcePasswordReset(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account, ok := parseAdminAccount(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.ForcePasswordReset(principal.AccountID, account); err != nil {
+		respondAdminAccountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[any]{
+		Status:  http.StatusOK,
+		Message: "Password cleared and reset link sent",
+	})
+}
+
+// Delete removes the account for good. Soft deletion and restoring stay on
+// the account routes.
+func (h *AdminAccountHandler) Delete(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account, ok := parseAdminAccount(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.HardDelete(principal.AccountID, &account.AccountEntity); err != nil {
+		respondAdminAccountError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AdminAccountHandler) respondAccount(c *gin.Context, account *entity.AdminAccountEntity, message string) {
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.AdminAccountResponse]{
+		Status:  http.StatusOK,
+		Data:    toAdminAccountResponse(account, h.avatars),
+		Message: message,
+	})
+}
+
+// parseAdminAccount reads the account from the id route parameter, answering
+// 400 when it is not a valid ID.
+func parseAdminAccount(c *gin.Context) (*entity.AdminAccountEntity, bool) {
+	accountId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid account ID",
+		})
+		return nil, false
+	}
+
+	return &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: accountId}}, true
+}
+
+func respondAdminAccountError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrCannotManageOwnAccount):
+		c.JSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+			Status:  http.StatusForbidden,
+			Message: "Administrators cannot do this to their own account",
+		})
+	case errors.Is(err, usecase.ErrAccountSuspended):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Account is suspended",
+		})
+	case errors.Is(err, usecase.ErrAccountNotSuspended):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Account is not suspended",
+		})
+	case errors.Is(err, usecase.ErrAccountDeleted):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Account is deleted",
+		})
+	default:
+		respondAccountError(c, err)
+	}
+}
+
+func toAdminAccountResponse(account *entity.AdminAccountEntity, avatars usecase.AvatarUseCaseInterface) dto.AdminAccountResponse {
+	return dto.AdminAccountResponse{
+		AccountResponse: toAccountResponse(&account.AccountEntity, avatars),
+		SuspendedAt:     account.SuspendedAt,
+		LockedUntil:     account.LockedUntil,
+	}
+}
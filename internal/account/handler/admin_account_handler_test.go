@@ -0,0 +1,242 @@
+package handler_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupAdminAccount(t *testing.T) (*gin.Engine, *mocks.MockAdminAccountUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockAdminAccountUseCaseInterface(ctrl)
+	h := handler.NewAdminAccountHandler(mock, testAvatars)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.GET("/api/v1/admin/accounts", h.List)
+	router.GET("/api/v1/admin/accounts/:id", h.Find)
+	router.POST("/api/v1/admin/accounts/:id/suspend", h.Suspend)
+	router.POST("/api/v1/admin/accounts/:id/reactivate", h.Reactivate)
+	router.POST("/api/v1/admin/accounts/:id/password_reset", h.ForcePasswordReset)
+	router.DELETE("/api/v1/admin/accounts/:id", h.Delete)
+
+	return router, mock
+}
+
+func TestAdminAccountHandler_List(t *testing.T) {
+	router, mockUseCase := setupAdminAccount(t)
+
+	t.Run("should return status 200 and the page of accounts", func(t *testing.T) {
+		lockedUntil := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+
+		mockUseCase.EXPECT().List(gomock.Any()).DoAndReturn(func(filter *entity.AccountFilter) ([]entity.AdminAccountEntity, int64, error) {
+			assert.Equal(t, "gandalf", filter.Search)
+			assert.Equal(t, entity.AccountStatusLocked, filter.Status)
+			assert.Equal(t, 2, filter.Page)
+			filter.PerPage = usecase.DefaultAccountsPerPage
+			return []entity.AdminAccountEntity{{
+				AccountEntity: entity.AccountEntity{ID: uuid.New(), Name: "Gandalf"},
+				LockedUntil:   &lockedUntil,
+			}}, 21, nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/accounts?search=gandalf&status=locked&page=2", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[sharedDto.Page[dto.AdminAccountResponse]]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Len(t, responseBody.Data.Items, 1)
+		assert.Equal(t, "Gandalf", responseBody.Data.Items[0].Name)
+		assert.True(t, lockedUntil.Equal(*responseBody.Data.Items[0].LockedUntil))
+		assert.Equal(t, 2, responseBody.Data.Page)
+		assert.Equal(t, usecase.DefaultAccountsPerPage, responseBody.Data.PerPage)
+		assert.Equal(t, int64(21), responseBody.Data.Total)
+	})
+
+	t.Run("should return status 400 for an unknown status", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/accounts?status=banished", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAdminAccountHandler_Find(t *testing.T) {
+	router, mockUseCase := setupAdminAccount(t)
+
+	t.Run("should return status 404 when the account does not exist", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/accounts/"+uuid.NewString(), nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid ID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/accounts/gandalf", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAdminAccountHandler_Suspend(t *testing.T) {
+	router, mockUseCase := setupAdminAccount(t)
+
+	adminID := uuid.New()
+	accountID := uuid.New()
+
+	request := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+accountID.String()+"/suspend", nil)
+		req.Header.Set("X-Account-ID", adminID.String())
+		return req
+	}
+
+	t.Run("should return status 200 and the suspended account", func(t *testing.T) {
+		mockUseCase.EXPECT().Suspend(adminID, gomock.Any()).DoAndReturn(func(_ uuid.UUID, account *entity.AdminAccountEntity) error {
+			assert.Equal(t, accountID, account.ID)
+			now := time.Now().UTC()
+			account.SuspendedAt = &now
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AdminAccountResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.NotNil(t, responseBody.Data.SuspendedAt)
+	})
+
+	t.Run("should return status 409 when the account is already suspended", func(t *testing.T) {
+		mockUseCase.EXPECT().Suspend(gomock.Any(), gomock.Any()).Return(usecase.ErrAccountSuspended)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 403 for the own account", func(t *testing.T) {
+		mockUseCase.EXPECT().Suspend(gomock.Any(), gomock.Any()).Return(usecase.ErrCannotManageOwnAccount)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+accountID.String()+"/suspend", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestAdminAccountHandler_Reactivate(t *testing.T) {
+	router, mockUseCase := setupAdminAccount(t)
+
+	t.Run("should return status 409 when the account is not suspended", func(t *testing.T) {
+		mockUseCase.EXPECT().Reactivate(gomock.Any()).Return(usecase.ErrAccountNotSuspended)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+uuid.NewString()+"/reactivate", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestAdminAccountHandler_ForcePasswordReset(t *testing.T) {
+	router, mockUseCase := setupAdminAccount(t)
+
+	adminID := uuid.New()
+
+	request := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+uuid.NewString()+"/password_reset", nil)
+		req.Header.Set("X-Account-ID", adminID.String())
+		return req
+	}
+
+	t.Run("should return status 200 once the link is sent", func(t *testing.T) {
+		mockUseCase.EXPECT().ForcePasswordReset(adminID, gomock.Any()).Return(nil)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 409 when the account is deleted", func(t *testing.T) {
+		mockUseCase.EXPECT().ForcePasswordReset(gomock.Any(), gomock.Any()).Return(usecase.ErrAccountDeleted)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestAdminAccountHandler_Delete(t *testing.T) {
+	router, mockUseCase := setupAdminAccount(t)
+
+	adminID := uuid.New()
+
+	request := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/admin/accounts/"+uuid.NewString(), nil)
+		req.Header.Set("X-Account-ID", adminID.String())
+		return req
+	}
+
+	t.Run("should return status 204 once the account is removed", func(t *testing.T) {
+		mockUseCase.EXPECT().HardDelete(adminID, gomock.Any()).Return(nil)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when the account does not exist", func(t *testing.T) {
+		mockUseCase.EXPECT().HardDelete(gomock.Any(), gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// avatarFormField is the multipart field the image is uploaded in.
+const avatarFormField = "avatar"
+
+// multipartOverhead is allowed on top of the image for the boundaries and
+// headers of the multipart body.
+const multipartOverhead = 64 << 10
+
+var errAvatarTooLarge = errors.New("avatar too large")
+
+type AvatarHandler struct {
+	usecase  usecase.AvatarUseCaseInterface
+	maxBytes int64
+}
+
+func NewAvatarHandler(uc usecase.AvatarUseCaseInterface, avatarConfig config.AvatarConfig) *AvatarHandler {
+	return &AvatarHandler{usecase: uc, maxBytes: avatarConfig.MaxBytes}
+}
+
+// Upload replaces the avatar of the caller with the image sent in the
+// avatar field of a multipart form.
+func (h *AvatarHandler) Upload(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxBytes+multipartOverhead)
+
+	image, err := h.readImage(c)
+
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) || errors.Is(err, errAvatarTooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, sharedDto.APIResponse[any]{
+				Status:  http.StatusRequestEntityTooLarge,
+				Message: fmt.Sprintf("Avatar must be at most %d bytes", h.maxBytes),
+			})
+			return
+		}
+
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "The image must be sent in the avatar field of a multipart form",
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{ID: principal.AccountID}
+
+	if err := h.usecase.Upload(account, image); err != nil {
+		respondAvatarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.AccountResponse]{
+		Status: http.StatusOK,
+		Data:   toAccountResponse(account, h.usecase),
+	})
+}
+
+// Delete removes the avatar of the caller.
+func (h *AvatarHandler) Delete(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account := &entity.AccountEntity{ID: principal.AccountID}
+
+	if err := h.usecase.Delete(account); err != nil {
+		respondAvatarError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.AccountResponse]{
+		Status: http.StatusOK,
+		Data:   toAccountResponse(account, h.usecase),
+	})
+}
+
+func (h *AvatarHandler) readImage(c *gin.Context) ([]byte, error) {
+	header, err := c.FormFile(avatarFormField)
+	if err != nil {
+		return nil, err
+	}
+	if header.Size > h.maxBytes {
+		return nil, errAvatarTooLarge
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(io.LimitReader(file, h.maxBytes))
+}
+
+func respondAvatarError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrUnsupportedAvatar):
+		c.JSON(http.StatusUnsupportedMediaType, sharedDto.APIResponse[any]{
+			Status:  http.StatusUnsupportedMediaType,
+			Message: "Avatar must be a JPEG, PNG, GIF or WebP image",
+		})
+	case errors.Is(err, usecase.ErrInvalidAvatar):
+		c.JSON(http.StatusUnprocessableEntity, sharedDto.APIResponse[any]{
+			Status:  http.StatusUnprocessableEntity,
+			Message: "Avatar image is invalid or too large to process",
+		})
+	default:
+		respondAccountError(c, err)
+	}
+}
@@ -0,0 +1,157 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupAvatar(t *testing.T) (*gin.Engine, *mocks.MockAvatarUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockAvatarUseCaseInterface(ctrl)
+	h := handler.NewAvatarHandler(mock, config.AvatarConfig{MaxBytes: 1024})
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.PUT("/api/v1/accounts/me/avatar", h.Upload)
+	router.DELETE("/api/v1/accounts/me/avatar", h.Delete)
+
+	return router, mock
+}
+
+// avatarRequest builds a multipart upload with content in the given field.
+func avatarRequest(t *testing.T, field string, content []byte) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile(field, "avatar.png")
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	req, _ := http.NewRequest(http.MethodPut, "/api/v1/accounts/me/avatar", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestAvatarHandler_Upload(t *testing.T) {
+	router, mockUseCase := setupAvatar(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return status 200 and the account with the avatar URLs", func(t *testing.T) {
+		urls := map[string]string{"32": "http://trilha.test/storage/avatars/1/key/32.jpg"}
+
+		mockUseCase.EXPECT().Upload(gomock.Any(), []byte("image")).DoAndReturn(func(account *entity.AccountEntity, image []byte) error {
+			assert.Equal(t, accountID, account.ID)
+			account.Name = "Gandalf"
+			account.AvatarKey = "avatars/1/key"
+			return nil
+		})
+		mockUseCase.EXPECT().URLs(gomock.Any()).Return(urls)
+
+		w := httptest.NewRecorder()
+		req := avatarRequest(t, "avatar", []byte("image"))
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AccountResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Gandalf", responseBody.Data.Name)
+		assert.Equal(t, urls, responseBody.Data.Avatar)
+	})
+
+	t.Run("should return status 400 when the avatar field is missing", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := avatarRequest(t, "image", []byte("image"))
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 413 when the image is too large", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := avatarRequest(t, "avatar", bytes.Repeat([]byte("a"), 2048))
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("should return status 415 when the format is not supported", func(t *testing.T) {
+		mockUseCase.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(usecase.ErrUnsupportedAvatar)
+
+		w := httptest.NewRecorder()
+		req := avatarRequest(t, "avatar", []byte("%PDF-1.7"))
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("should return status 422 when the image is invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(usecase.ErrInvalidAvatar)
+
+		w := httptest.NewRecorder()
+		req := avatarRequest(t, "avatar", []byte("\x89PNG\r\n\x1a\n"))
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, avatarRequest(t, "avatar", []byte("image")))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestAvatarHandler_Delete(t *testing.T) {
+	router, mockUseCase := setupAvatar(t)
+
+	t.Run("should return status 200 and the account without avatar", func(t *testing.T) {
+		mockUseCase.EXPECT().Delete(gomock.Any()).Return(nil)
+		mockUseCase.EXPECT().URLs(gomock.Any()).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/avatar", nil)
+		req.Header.Set("X-Account-ID", uuid.NewString())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AccountResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Nil(t, responseBody.Data.Avatar)
+	})
+}
@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DataJobHandler serves the exports and erasures of personal data, both to
+// the owner of the account and to administrators.
+type DataJobHandler struct {
+	usecase usecase.DataJobUseCaseInterface
+}
+
+func NewDataJobHandler(uc usecase.DataJobUseCaseInterface) *DataJobHandler {
+	return &DataJobHandler{usecase: uc}
+}
+
+func (h *DataJobHandler) List(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	h.list(c, principal.AccountID)
+}
+
+func (h *DataJobHandler) RequestExport(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	h.request(c, h.usecase.RequestExport, principal.AccountID, principal.AccountID)
+}
+
+// RequestErasure queues the erasure of the caller's personal data. Once it
+// runs, the account is anonymized and can no longer be used.
+func (h *DataJobHandler) RequestErasure(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	h.request(c, h.usecase.RequestErasure, principal.AccountID, principal.AccountID)
+}
+
+func (h *DataJobHandler) Find(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	h.find(c, principal.AccountID)
+}
+
+func (h *DataJobHandler) DownloadArchive(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	h.downloadArchive(c, principal.AccountID)
+}
+
+func (h *DataJobHandler) AdminList(c *gin.Context) {
+	account, ok := parseAdminAccount(c)
+
+	if !ok {
+		return
+	}
+
+	h.list(c, account.ID)
+}
+
+func (h *DataJobHandler) AdminRequestExport(c *gin.Context) {
+	h.adminRequest(c, h.usecase.RequestExport)
+}
+
+func (h *DataJobHandler) AdminRequestErasure(c *gin.Context) {
+	h.adminRequest(c, h.usecase.RequestErasure)
+}
+
+func (h *DataJobHandler) AdminFind(c *gin.Context) {
+	h.find(c, uuid.Nil)
+}
+
+func (h *DataJobHandler) AdminDownloadArchive(c *gin.Context) {
+	h.downloadArchive(c, uuid.Nil)
+}
+
+func (h *DataJobHandler) adminRequest(c *gin.Context, request func(job *entity.DataJobEntity) error) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account, ok := parseAdminAccount(c)
+
+	if !ok {
+		return
+	}
+
+	h.request(c, request, account.ID, principal.AccountID)
+}
+
+func (h *DataJobHandler) list(c *gin.Context, accountID uuid.UUID) {
+	jobs, err := h.usecase.List(accountID)
+
+	if err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	response := make([]dto.DataJobResponse, 0, len(jobs))
+	for i := range jobs {
+		response = append(response, toDataJobResponse(&jobs[i], now))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.DataJobResponse]{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+func (h *DataJobHandler) request(c *gin.Context, request func(job *entity.DataJobEntity) error, accountID, requestedBy uuid.UUID) {
+	job := &entity.DataJobEntity{AccountID: accountID, RequestedBy: requestedBy}
+
+	if err := request(job); err != nil {
+		if errors.Is(err, repository.ErrDataJobInProgress) {
+			c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+				Status:  http.StatusConflict,
+				Message: "A job of this kind is already in progress for the account",
+			})
+			return
+		}
+		respondAccountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, sharedDto.APIResponse[dto.DataJobResponse]{
+		Status:  http.StatusAccepted,
+		Data:    toDataJobResponse(job, time.Now().UTC()),
+		Message: "Job queued",
+	})
+}
+
+// find answers the job, restricted to the jobs of accountID unless it is
+// uuid.Nil.
+func (h *DataJobHandler) find(c *gin.Context, accountID uuid.UUID) {
+	job, ok := parseDataJob(c, accountID)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Find(job); err != nil {
+		respondDataJobError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.DataJobResponse]{
+		Status: http.StatusOK,
+		Data:   toDataJobResponse(job, time.Now().UTC()),
+	})
+}
+
+func (h *DataJobHandler) downloadArchive(c *gin.Context, accountID uuid.UUID) {
+	job, ok := parseDataJob(c, accountID)
+
+	if !ok {
+		return
+	}
+
+	archive, err := h.usecase.OpenArchive(job)
+
+	if err != nil {
+		respondDataJobError(c, err)
+		return
+	}
+	defer archive.Close()
+
+	c.DataFromReader(http.StatusOK, -1, "application/zip", archive, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="trilha-%s.zip"`, job.ID),
+		"Cache-Control":       "no-store",
+	})
+}
+
+func parseDataJob(c *gin.Context, accountID uuid.UUID) (*entity.DataJobEntity, bool) {
+	jobId, err := uuid.Parse(c.Param("job_id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid job ID",
+		})
+		return nil, false
+	}
+
+	return &entity.DataJobEntity{ID: jobId, AccountID: accountID}, true
+}
+
+func respondDataJobError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Job not found",
+		})
+	case errors.Is(err, usecase.ErrArchiveUnavailable):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Archive not available",
+		})
+	default:
+		respondAccountError(c, err)
+	}
+}
+
+func toDataJobResponse(job *entity.DataJobEntity, now time.Time) dto.DataJobResponse {
+	return dto.DataJobResponse{
+		ID:               job.ID,
+		AccountID:        job.AccountID,
+		Kind:             job.Kind,
+		Status:           job.Status,
+		ArchiveAvailable: job.HasArchive(now),
+		CreatedAt:        job.CreatedAt,
+		StartedAt:        job.StartedAt,
+		FinishedAt:       job.FinishedAt,
+		ExpiresAt:        job.ExpiresAt,
+	}
+}
@@ -0,0 +1,231 @@
+package handler_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	"trilha-api/internal/account/repository"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupDataJob(t *testing.T) (*gin.Engine, *mocks.MockDataJobUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockDataJobUseCaseInterface(ctrl)
+	h := handler.NewDataJobHandler(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.GET("/api/v1/accounts/me/data_jobs", h.List)
+	router.POST("/api/v1/accounts/me/data_jobs/export", h.RequestExport)
+	router.POST("/api/v1/accounts/me/data_jobs/erasure", h.RequestErasure)
+	router.GET("/api/v1/accounts/me/data_jobs/:job_id", h.Find)
+	router.GET("/api/v1/accounts/me/data_jobs/:job_id/archive", h.DownloadArchive)
+	router.POST("/api/v1/admin/accounts/:id/data_jobs/erasure", h.AdminRequestErasure)
+	router.GET("/api/v1/admin/data_jobs/:job_id", h.AdminFind)
+
+	return router, mock
+}
+
+func TestDataJobHandler_RequestExport(t *testing.T) {
+	router, mockUseCase := setupDataJob(t)
+
+	accountID := uuid.New()
+
+	request := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/data_jobs/export", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+		return req
+	}
+
+	t.Run("should return status 202 and the queued job", func(t *testing.T) {
+		mockUseCase.EXPECT().RequestExport(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			assert.Equal(t, accountID, job.AccountID)
+			assert.Equal(t, accountID, job.RequestedBy)
+			job.ID = uuid.New()
+			job.Kind = entity.DataJobKindExport
+			job.Status = entity.DataJobStatusPending
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.DataJobResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.DataJobKindExport, responseBody.Data.Kind)
+		assert.Equal(t, entity.DataJobStatusPending, responseBody.Data.Status)
+		assert.False(t, responseBody.Data.ArchiveAvailable)
+	})
+
+	t.Run("should return status 409 while an export is in progress", func(t *testing.T) {
+		mockUseCase.EXPECT().RequestExport(gomock.Any()).Return(repository.ErrDataJobInProgress)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/data_jobs/export", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestDataJobHandler_Find(t *testing.T) {
+	router, mockUseCase := setupDataJob(t)
+
+	accountID := uuid.New()
+	jobID := uuid.New()
+
+	request := func(path string) *http.Request {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+		return req
+	}
+
+	t.Run("should return status 200 and the status of the export", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			assert.Equal(t, jobID, job.ID)
+			assert.Equal(t, accountID, job.AccountID)
+			expiresAt := time.Now().UTC().Add(time.Hour)
+			job.Kind = entity.DataJobKindExport
+			job.Status = entity.DataJobStatusCompleted
+			job.ArchiveKey = "exports/1/key.zip"
+			job.ExpiresAt = &expiresAt
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request("/api/v1/accounts/me/data_jobs/"+jobID.String()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.DataJobResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.DataJobStatusCompleted, responseBody.Data.Status)
+		assert.True(t, responseBody.Data.ArchiveAvailable)
+		assert.NotContains(t, w.Body.String(), "exports/1/key.zip")
+	})
+
+	t.Run("should return status 404 for a job of another account", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request("/api/v1/accounts/me/data_jobs/"+jobID.String()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid ID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request("/api/v1/accounts/me/data_jobs/gandalf"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should let administrators find any job", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			assert.Equal(t, uuid.Nil, job.AccountID)
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request("/api/v1/admin/data_jobs/"+jobID.String()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestDataJobHandler_DownloadArchive(t *testing.T) {
+	router, mockUseCase := setupDataJob(t)
+
+	accountID := uuid.New()
+	jobID := uuid.New()
+
+	request := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/me/data_jobs/"+jobID.String()+"/archive", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+		return req
+	}
+
+	t.Run("should return the archive as an attachment", func(t *testing.T) {
+		mockUseCase.EXPECT().OpenArchive(gomock.Any()).Return(io.NopCloser(strings.NewReader("archive")), nil)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "trilha-"+jobID.String()+".zip")
+		assert.Equal(t, "archive", w.Body.String())
+	})
+
+	t.Run("should return status 404 once the archive expires", func(t *testing.T) {
+		mockUseCase.EXPECT().OpenArchive(gomock.Any()).Return(nil, usecase.ErrArchiveUnavailable)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDataJobHandler_AdminRequestErasure(t *testing.T) {
+	router, mockUseCase := setupDataJob(t)
+
+	adminID := uuid.New()
+	accountID := uuid.New()
+
+	t.Run("should queue the erasure on behalf of the administrator", func(t *testing.T) {
+		mockUseCase.EXPECT().RequestErasure(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			assert.Equal(t, accountID, job.AccountID)
+			assert.Equal(t, adminID, job.RequestedBy)
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+accountID.String()+"/data_jobs/erasure", nil)
+		req.Header.Set("X-Account-ID", adminID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("should return status 404 when the account does not exist", func(t *testing.T) {
+		mockUseCase.EXPECT().RequestErasure(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+accountID.String()+"/data_jobs/erasure", nil)
+		req.Header.Set("X-Account-ID", adminID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
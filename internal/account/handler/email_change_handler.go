@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailChangeHandler struct {
+	usecase usecase.EmailChangeUseCaseInterface
+}
+
+func NewEmailChangeHandler(uc usecase.EmailChangeUseCaseInterface) *EmailChangeHandler {
+	return &EmailChangeHandler{usecase: uc}
+}
+
+// RequestChange starts moving the account of the caller to a new email, which
+// only happens once it is confirmed from the new address.
+func (h *EmailChangeHandler) RequestChange(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.ChangeEmailRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{ID: principal.AccountID}
+
+	err := h.usecase.RequestChange(account, req.NewEmail, req.CurrentPassword)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidCredentials):
+			c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+				Status:  http.StatusBadRequest,
+				Message: "Current password is incorrect",
+			})
+		case errors.Is(err, usecase.ErrEmailUnchanged):
+			c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+				Status:  http.StatusBadRequest,
+				Message: "New email must differ from the current one",
+			})
+		case errors.Is(err, repository.ErrEmailAlreadyInUse):
+			respondEmailInUse(c)
+		default:
+			respondAccountError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, sharedDto.APIResponse[any]{
+		Status:  http.StatusAccepted,
+		Message: "A confirmation link has been sent to the new email",
+	})
+}
+
+func (h *EmailChangeHandler) Confirm(c *gin.Context) {
+	req := dto.EmailChangeTokenRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := h.usecase.Confirm(req.Token)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidEmailChangeToken):
+			respondInvalidEmailChangeToken(c)
+		case errors.Is(err, repository.ErrEmailAlreadyInUse):
+			respondEmailInUse(c)
+		default:
+			c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+				Status:  http.StatusInternalServerError,
+				Message: "Internal server error",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[any]{
+		Status:  http.StatusOK,
+		Message: "Email changed",
+	})
+}
+
+func (h *EmailChangeHandler) Cancel(c *gin.Context) {
+	req := dto.EmailChangeTokenRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := h.usecase.Cancel(req.Token)
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidEmailChangeToken) {
+			respondInvalidEmailChangeToken(c)
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[any]{
+		Status:  http.StatusOK,
+		Message: "Email change cancelled",
+	})
+}
+
+func respondInvalidEmailChangeToken(c *gin.Context) {
+	c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+		Status:  http.StatusBadRequest,
+		Message: "Invalid or expired email change token",
+	})
+}
+
+func respondEmailInUse(c *gin.Context) {
+	c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+		Status:  http.StatusConflict,
+		Message: "account with this email already exists",
+	})
+}
@@ -0,0 +1,171 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	"trilha-api/internal/account/repository"
+	usecase "trilha-api/internal/account/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupEmailChange(t *testing.T) (*gin.Engine, *mocks.MockEmailChangeUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockEmailChangeUseCaseInterface(ctrl)
+	h := handler.NewEmailChangeHandler(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.POST("/api/v1/accounts/me/email", h.RequestChange)
+	router.POST("/api/v1/accounts/confirm_email_change", h.Confirm)
+	router.POST("/api/v1/accounts/cancel_email_change", h.Cancel)
+
+	return router, mock
+}
+
+func TestEmailChangeHandler_RequestChange(t *testing.T) {
+	router, mockUseCase := setupEmailChange(t)
+
+	accountID := uuid.New()
+	body, _ := json.Marshal(dto.ChangeEmailRequest{NewEmail: "mithrandir@lor.com.br", CurrentPassword: "password123"})
+
+	request := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/email", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+		return req
+	}
+
+	t.Run("should return status 202 once the links are sent", func(t *testing.T) {
+		mockUseCase.EXPECT().RequestChange(gomock.Any(), "mithrandir@lor.com.br", "password123").DoAndReturn(func(account *entity.AccountEntity, newEmail, currentPassword string) error {
+			assert.Equal(t, accountID, account.ID)
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("should return status 400 when the password is incorrect", func(t *testing.T) {
+		mockUseCase.EXPECT().RequestChange(gomock.Any(), gomock.Any(), gomock.Any()).Return(usecase.ErrInvalidCredentials)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 409 when the email is in use", func(t *testing.T) {
+		mockUseCase.EXPECT().RequestChange(gomock.Any(), gomock.Any(), gomock.Any()).Return(repository.ErrEmailAlreadyInUse)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 400 when the email is invalid", func(t *testing.T) {
+		invalid, _ := json.Marshal(dto.ChangeEmailRequest{NewEmail: "mithrandir", CurrentPassword: "password123"})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/email", bytes.NewBuffer(invalid))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/email", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestEmailChangeHandler_Confirm(t *testing.T) {
+	router, mockUseCase := setupEmailChange(t)
+
+	body, _ := json.Marshal(dto.EmailChangeTokenRequest{Token: "token"})
+
+	request := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/confirm_email_change", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("should return status 200 once the email is changed", func(t *testing.T) {
+		mockUseCase.EXPECT().Confirm("token").Return(nil)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 400 when the token is invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().Confirm("token").Return(usecase.ErrInvalidEmailChangeToken)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 409 when the email was taken meanwhile", func(t *testing.T) {
+		mockUseCase.EXPECT().Confirm("token").Return(repository.ErrEmailAlreadyInUse)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestEmailChangeHandler_Cancel(t *testing.T) {
+	router, mockUseCase := setupEmailChange(t)
+
+	body, _ := json.Marshal(dto.EmailChangeTokenRequest{Token: "cancel"})
+
+	request := func() *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/cancel_email_change", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("should return status 200 once the change is cancelled", func(t *testing.T) {
+		mockUseCase.EXPECT().Cancel("cancel").Return(nil)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 400 when the change was already confirmed", func(t *testing.T) {
+		mockUseCase.EXPECT().Cancel("cancel").Return(usecase.ErrInvalidEmailChangeToken)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request())
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailVerificationHandler struct {
+	usecase usecase.EmailVerificationUseCaseInterface
+}
+
+func NewEmailVerificationHandler(uc usecase.EmailVerificationUseCaseInterface) *EmailVerificationHandler {
+	return &EmailVerificationHandler{usecase: uc}
+}
+
+func (h *EmailVerificationHandler) Verify(c *gin.Context) {
+	req := dto.VerifyEmailRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := h.usecase.Verify(req.Token)
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidVerificationToken) {
+			c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+				Status:  http.StatusBadRequest,
+				Message: "Invalid or expired verification token",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[any]{
+		Status:  http.StatusOK,
+		Message: "Email verified",
+	})
+}
+
+func (h *EmailVerificationHandler) Resend(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: principal.AccountID,
+	}
+
+	err := h.usecase.Resend(account)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrEmailAlreadyVerified):
+			c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+				Status:  http.StatusConflict,
+				Message: "Email already verified",
+			})
+		case errors.Is(err, usecase.ErrVerificationThrottled):
+			c.JSON(http.StatusTooManyRequests, sharedDto.APIResponse[any]{
+				Status:  http.StatusTooManyRequests,
+				Message: "A verification email was sent recently, please wait before requesting another one",
+			})
+		default:
+			respondAccountError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, sharedDto.APIResponse[any]{
+		Status:  http.StatusAccepted,
+		Message: "Verification email sent",
+	})
+}
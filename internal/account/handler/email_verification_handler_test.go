@@ -0,0 +1,104 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupEmailVerification(t *testing.T) (*gin.Engine, *mocks.MockEmailVerificationUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockEmailVerificationUseCaseInterface(ctrl)
+	h := handler.NewEmailVerificationHandler(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.POST("/api/v1/accounts/verify_email", h.Verify)
+	router.POST("/api/v1/accounts/me/resend_verification", h.Resend)
+
+	return router, mock
+}
+
+func TestEmailVerificationHandler_Verify(t *testing.T) {
+	router, mockUseCase := setupEmailVerification(t)
+
+	body, _ := json.Marshal(dto.VerifyEmailRequest{Token: "token"})
+
+	t.Run("should return status 200 when the email is verified", func(t *testing.T) {
+		mockUseCase.EXPECT().Verify("token").Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/verify_email", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 400 when the token is invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().Verify("token").Return(usecase.ErrInvalidVerificationToken)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/verify_email", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestEmailVerificationHandler_Resend(t *testing.T) {
+	router, mockUseCase := setupEmailVerification(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return status 202 when the email is sent", func(t *testing.T) {
+		mockUseCase.EXPECT().Resend(gomock.Any()).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/resend_verification", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("should return status 429 when throttled", func(t *testing.T) {
+		mockUseCase.EXPECT().Resend(gomock.Any()).Return(usecase.ErrVerificationThrottled)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/resend_verification", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+
+	t.Run("should return status 409 when already verified", func(t *testing.T) {
+		mockUseCase.EXPECT().Resend(gomock.Any()).Return(usecase.ErrEmailAlreadyVerified)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/resend_verification", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
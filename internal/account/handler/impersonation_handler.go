@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ImpersonationHandler lets administrators act as other accounts and review
+// what was done meanwhile.
+type ImpersonationHandler struct {
+	usecase usecase.ImpersonationUseCaseInterface
+}
+
+func NewImpersonationHandler(uc usecase.ImpersonationUseCaseInterface) *ImpersonationHandler {
+	return &ImpersonationHandler{usecase: uc}
+}
+
+// Start begins an impersonation of the account and answers the access token
+// to act as it.
+func (h *ImpersonationHandler) Start(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account, ok := parseAdminAccount(c)
+
+	if !ok {
+		return
+	}
+
+	request := dto.StartImpersonationRequest{}
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	impersonation := &entity.ImpersonationEntity{
+		ActorID:   principal.AccountID,
+		AccountID: account.ID,
+		Reason:    request.Reason,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+
+	if err := h.usecase.Start(impersonation); err != nil {
+		respondImpersonationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.ImpersonationResponse]{
+		Status:  http.StatusCreated,
+		Data:    toImpersonationResponse(impersonation),
+		Message: "Impersonation started",
+	})
+}
+
+func (h *ImpersonationHandler) Find(c *gin.Context) {
+	impersonation, ok := parseImpersonation(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Find(impersonation); err != nil {
+		respondImpersonationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.ImpersonationResponse]{
+		Status: http.StatusOK,
+		Data:   toImpersonationResponse(impersonation),
+	})
+}
+
+// End stops the impersonation, invalidating its access token.
+func (h *ImpersonationHandler) End(c *gin.Context) {
+	impersonation, ok := parseImpersonation(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.End(impersonation); err != nil {
+		respondImpersonationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.ImpersonationResponse]{
+		Status:  http.StatusOK,
+		Data:    toImpersonationResponse(impersonation),
+		Message: "Impersonation ended",
+	})
+}
+
+// ListRequests answers the audit trail of the impersonation.
+func (h *ImpersonationHandler) ListRequests(c *gin.Context) {
+	impersonation, ok := parseImpersonation(c)
+
+	if !ok {
+		return
+	}
+
+	requests, err := h.usecase.ListRequests(impersonation)
+
+	if err != nil {
+		respondImpersonationError(c, err)
+		return
+	}
+
+	response := make([]dto.ImpersonationRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		response = append(response, dto.ImpersonationRequestResponse{
+			ID:        request.ID,
+			Method:    request.Method,
+			Path:      request.Path,
+			Status:    request.Status,
+			IPAddress: request.IPAddress,
+			UserAgent: request.UserAgent,
+			CreatedAt: request.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.ImpersonationRequestResponse]{
+		Status: http.StatusOK,
+		Data:   response,
+	})
+}
+
+func parseImpersonation(c *gin.Context) (*entity.ImpersonationEntity, bool) {
+	impersonationId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid impersonation ID",
+		})
+		return nil, false
+	}
+
+	return &entity.ImpersonationEntity{ID: impersonationId}, true
+}
+
+func respondImpersonationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrImpersonationEnded):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Impersonation has already ended",
+		})
+	default:
+		respondAdminAccountError(c, err)
+	}
+}
+
+func toImpersonationResponse(impersonation *entity.ImpersonationEntity) dto.ImpersonationResponse {
+	return dto.ImpersonationResponse{
+		ID:          impersonation.ID,
+		ActorID:     optionalUUID(impersonation.ActorID),
+		AccountID:   optionalUUID(impersonation.AccountID),
+		Reason:      impersonation.Reason,
+		ExpiresAt:   impersonation.ExpiresAt,
+		EndedAt:     impersonation.EndedAt,
+		CreatedAt:   impersonation.CreatedAt,
+		AccessToken: impersonation.Token,
+	}
+}
+
+// optionalUUID answers nil for uuid.Nil, so removed accounts show as null.
+func optionalUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,180 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupImpersonation(t *testing.T) (*gin.Engine, *mocks.MockImpersonationUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockImpersonationUseCaseInterface(ctrl)
+	h := handler.NewImpersonationHandler(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.POST("/api/v1/admin/accounts/:id/impersonate", h.Start)
+	router.GET("/api/v1/admin/impersonations/:id", h.Find)
+	router.DELETE("/api/v1/admin/impersonations/:id", h.End)
+	router.GET("/api/v1/admin/impersonations/:id/requests", h.ListRequests)
+
+	return router, mock
+}
+
+func TestImpersonationHandler_Start(t *testing.T) {
+	router, mockUseCase := setupImpersonation(t)
+
+	adminID := uuid.New()
+	accountID := uuid.New()
+
+	request := func(body any) *http.Request {
+		jsonBody, _ := json.Marshal(body)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/accounts/"+accountID.String()+"/impersonate", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", adminID.String())
+		return req
+	}
+
+	t.Run("should return status 201 and the access token", func(t *testing.T) {
+		mockUseCase.EXPECT().Start(gomock.Any()).DoAndReturn(func(impersonation *entity.ImpersonationEntity) error {
+			assert.Equal(t, adminID, impersonation.ActorID)
+			assert.Equal(t, accountID, impersonation.AccountID)
+			assert.Equal(t, "Ticket 42", impersonation.Reason)
+			impersonation.ID = uuid.New()
+			impersonation.ExpiresAt = time.Now().UTC().Add(30 * time.Minute)
+			impersonation.Token = "impersonation-token"
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request(dto.StartImpersonationRequest{Reason: "Ticket 42"}))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.ImpersonationResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "impersonation-token", responseBody.Data.AccessToken)
+		assert.Equal(t, adminID, *responseBody.Data.ActorID)
+	})
+
+	t.Run("should return status 400 without a reason", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request(dto.StartImpersonationRequest{}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 403 for the own account", func(t *testing.T) {
+		mockUseCase.EXPECT().Start(gomock.Any()).Return(usecase.ErrCannotManageOwnAccount)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request(dto.StartImpersonationRequest{Reason: "Ticket 42"}))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return status 404 when the account is not active", func(t *testing.T) {
+		mockUseCase.EXPECT().Start(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request(dto.StartImpersonationRequest{Reason: "Ticket 42"}))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestImpersonationHandler_End(t *testing.T) {
+	router, mockUseCase := setupImpersonation(t)
+
+	t.Run("should return status 200 once the impersonation ends", func(t *testing.T) {
+		mockUseCase.EXPECT().End(gomock.Any()).DoAndReturn(func(impersonation *entity.ImpersonationEntity) error {
+			now := time.Now().UTC()
+			impersonation.EndedAt = &now
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/admin/impersonations/"+uuid.NewString(), nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.ImpersonationResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.NotNil(t, responseBody.Data.EndedAt)
+		assert.Empty(t, responseBody.Data.AccessToken)
+	})
+
+	t.Run("should return status 409 when it has already ended", func(t *testing.T) {
+		mockUseCase.EXPECT().End(gomock.Any()).Return(usecase.ErrImpersonationEnded)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/admin/impersonations/"+uuid.NewString(), nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid ID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/admin/impersonations/gandalf", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestImpersonationHandler_ListRequests(t *testing.T) {
+	router, mockUseCase := setupImpersonation(t)
+
+	t.Run("should return status 200 and the audit trail", func(t *testing.T) {
+		mockUseCase.EXPECT().ListRequests(gomock.Any()).Return([]entity.ImpersonationRequestEntity{
+			{ID: uuid.New(), Method: http.MethodGet, Path: "/api/v1/accounts/me", Status: http.StatusOK},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/impersonations/"+uuid.NewString()+"/requests", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[[]dto.ImpersonationRequestResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Len(t, responseBody.Data, 1)
+		assert.Equal(t, "/api/v1/accounts/me", responseBody.Data[0].Path)
+	})
+
+	t.Run("should return status 404 when the impersonation does not exist", func(t *testing.T) {
+		mockUseCase.EXPECT().ListRequests(gomock.Any()).Return(nil, sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/impersonations/"+uuid.NewString()+"/requests", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
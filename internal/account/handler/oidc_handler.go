@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"path"
+	"time"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// oidcStateCookie binds a login to the browser that started it, so a
+// callback cannot be replayed from another browser.
+const oidcStateCookie = "oidc_state"
+
+type OIDCHandler struct {
+	usecase usecase.OIDCUseCaseInterface
+	avatars usecase.AvatarUseCaseInterface
+}
+
+func NewOIDCHandler(uc usecase.OIDCUseCaseInterface, avatars usecase.AvatarUseCaseInterface) *OIDCHandler {
+	return &OIDCHandler{usecase: uc, avatars: avatars}
+}
+
+// Authorize redirects to the provider to sign in.
+func (h *OIDCHandler) Authorize(c *gin.Context) {
+	authorization, err := h.usecase.Authorize(c.Param("provider"))
+
+	if err != nil {
+		respondOIDCError(c, err)
+		return
+	}
+
+	maxAge := int(time.Until(authorization.ExpiresAt).Seconds())
+	setOIDCStateCookie(c, authorization.State, maxAge)
+
+	c.Redirect(http.StatusFound, authorization.URL)
+}
+
+// Callback completes the sign-in when the provider redirects back, answering
+// like the password sign-in.
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	if c.Query("error") != "" {
+		c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+			Status:  http.StatusUnauthorized,
+			Message: "Sign-in was not completed at the identity provider",
+		})
+		return
+	}
+
+	state := c.Query("state")
+	code := c.Query("code")
+	cookie, _ := c.Cookie(oidcStateCookie)
+
+	setOIDCStateCookie(c, "", -1)
+
+	if state == "" || code == "" || subtle.ConstantTimeCompare([]byte(state), []byte(cookie)) != 1 {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid or expired sign-in request",
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{}
+
+	result, err := h.usecase.SignIn(account, c.Param("provider"), state, code, clientOf(c))
+
+	if err != nil {
+		respondOIDCError(c, err)
+		return
+	}
+
+	respondSignIn(c, h.avatars, account, result)
+}
+
+// setOIDCStateCookie scopes the cookie to the routes of the provider, which
+// share the parent path of the request.
+func setOIDCStateCookie(c *gin.Context, state string, maxAge int) {
+	secure := c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, state, maxAge, path.Dir(c.Request.URL.Path), "", secure, true)
+}
+
+func respondOIDCError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrUnknownOIDCProvider):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Identity provider not found",
+		})
+	case errors.Is(err, usecase.ErrInvalidOIDCLogin):
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid or expired sign-in request",
+		})
+	case errors.Is(err, usecase.ErrOIDCEmailNotVerified):
+		c.JSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+			Status:  http.StatusForbidden,
+			Message: "The identity provider has not verified the email of the account",
+		})
+	case errors.Is(err, usecase.ErrInvalidCredentials):
+		c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+			Status:  http.StatusUnauthorized,
+			Message: "Account not available",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
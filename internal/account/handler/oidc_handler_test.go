@@ -0,0 +1,140 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupOIDC(t *testing.T) (*gin.Engine, *mocks.MockOIDCUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockOIDCUseCaseInterface(ctrl)
+	h := handler.NewOIDCHandler(mock, testAvatars)
+	router := gin.Default()
+
+	router.GET("/api/v1/accounts/oidc/:provider/authorize", h.Authorize)
+	router.GET("/api/v1/accounts/oidc/:provider/callback", h.Callback)
+
+	return router, mock
+}
+
+func TestOIDCHandler_Authorize(t *testing.T) {
+	router, mockUseCase := setupOIDC(t)
+
+	t.Run("should redirect to the provider and set the state cookie", func(t *testing.T) {
+		mockUseCase.EXPECT().Authorize("keycloak").Return(&entity.OIDCAuthorizationEntity{
+			URL:       "https://idp.example.com/authorize?state=state",
+			State:     "state",
+			ExpiresAt: time.Now().Add(10 * time.Minute),
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/oidc/keycloak/authorize", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "https://idp.example.com/authorize?state=state", w.Header().Get("Location"))
+
+		cookies := w.Result().Cookies()
+		assert.Len(t, cookies, 1)
+		assert.Equal(t, "state", cookies[0].Value)
+		assert.Equal(t, "/api/v1/accounts/oidc/keycloak", cookies[0].Path)
+		assert.True(t, cookies[0].HttpOnly)
+	})
+
+	t.Run("should return status 404 for an unknown provider", func(t *testing.T) {
+		mockUseCase.EXPECT().Authorize("unknown").Return(nil, usecase.ErrUnknownOIDCProvider)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/oidc/unknown/authorize", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestOIDCHandler_Callback(t *testing.T) {
+	router, mockUseCase := setupOIDC(t)
+
+	callback := func(query string, cookie string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/oidc/keycloak/callback?"+query, nil)
+		if cookie != "" {
+			req.AddCookie(&http.Cookie{Name: "oidc_state", Value: cookie})
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("should return status 200 and the tokens on success", func(t *testing.T) {
+		accountID := uuid.New()
+
+		mockUseCase.EXPECT().SignIn(gomock.Any(), "keycloak", "state", "code", gomock.Any()).DoAndReturn(
+			func(account *entity.AccountEntity, _, _, _ string, _ entity.ClientEntity) (*entity.SignInEntity, error) {
+				account.ID = accountID
+				account.Email = "gandalf@lor.com.br"
+				return &entity.SignInEntity{Tokens: &entity.AuthTokensEntity{AccessToken: "access-token"}}, nil
+			},
+		)
+
+		w := callback("state=state&code=code", "state")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AuthTokensResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", responseBody.Data.AccessToken)
+		assert.Equal(t, accountID, responseBody.Data.Account.ID)
+	})
+
+	t.Run("should return status 400 when the state does not match the cookie", func(t *testing.T) {
+		w := callback("state=state&code=code", "another-state")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 without the state cookie", func(t *testing.T) {
+		w := callback("state=state&code=code", "")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 when the login is invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().SignIn(gomock.Any(), "keycloak", "state", "code", gomock.Any()).Return(nil, usecase.ErrInvalidOIDCLogin)
+
+		w := callback("state=state&code=code", "state")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 403 when the email is not verified", func(t *testing.T) {
+		mockUseCase.EXPECT().SignIn(gomock.Any(), "keycloak", "state", "code", gomock.Any()).Return(nil, usecase.ErrOIDCEmailNotVerified)
+
+		w := callback("state=state&code=code", "state")
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return status 401 when the provider reports an error", func(t *testing.T) {
+		w := callback("error=access_denied&state=state", "state")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
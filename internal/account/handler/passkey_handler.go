@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PasskeyHandler struct {
+	usecase usecase.PasskeyUseCaseInterface
+	avatars usecase.AvatarUseCaseInterface
+}
+
+func NewPasskeyHandler(uc usecase.PasskeyUseCaseInterface, avatars usecase.AvatarUseCaseInterface) *PasskeyHandler {
+	return &PasskeyHandler{usecase: uc, avatars: avatars}
+}
+
+// BeginRegistration returns the options to create a passkey for the caller.
+func (h *PasskeyHandler) BeginRegistration(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	options, err := h.usecase.BeginRegistration(&entity.AccountEntity{ID: principal.AccountID})
+
+	if err != nil {
+		respondPasskeyError(c, err)
+		return
+	}
+
+	respondPasskeyOptions(c, options)
+}
+
+// FinishRegistration saves the passkey created by the browser.
+func (h *PasskeyHandler) FinishRegistration(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.RegisterPasskeyRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	passkey := &entity.PasskeyEntity{
+		AccountID: principal.AccountID,
+		Name:      req.Name,
+	}
+
+	if err := h.usecase.FinishRegistration(passkey, req.ChallengeID, req.Credential); err != nil {
+		if errors.Is(err, usecase.ErrInvalidPasskey) {
+			c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+				Status:  http.StatusBadRequest,
+				Message: "Invalid passkey",
+			})
+			return
+		}
+
+		respondPasskeyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.PasskeyResponse]{
+		Status: http.StatusCreated,
+		Data:   toPasskeyResponse(passkey),
+	})
+}
+
+// BeginSignIn returns the options to sign in with a passkey.
+func (h *PasskeyHandler) BeginSignIn(c *gin.Context) {
+	options, err := h.usecase.BeginSignIn()
+
+	if err != nil {
+		respondPasskeyError(c, err)
+		return
+	}
+
+	respondPasskeyOptions(c, options)
+}
+
+// FinishSignIn completes the sign-in with the assertion of the
+// authenticator, answering like the password sign-in.
+func (h *PasskeyHandler) FinishSignIn(c *gin.Context) {
+	req := dto.PasskeySignInRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{}
+
+	result, err := h.usecase.FinishSignIn(account, req.ChallengeID, req.Credential, clientOf(c))
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPasskey) {
+			c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+				Status:  http.StatusUnauthorized,
+				Message: "Invalid passkey",
+			})
+			return
+		}
+
+		respondPasskeyError(c, err)
+		return
+	}
+
+	respondSignIn(c, h.avatars, account, result)
+}
+
+func (h *PasskeyHandler) List(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	passkeys, err := h.usecase.List(&entity.AccountEntity{ID: principal.AccountID})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	res := make([]dto.PasskeyResponse, 0, len(passkeys))
+	for i := range passkeys {
+		res = append(res, toPasskeyResponse(&passkeys[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.PasskeyResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func (h *PasskeyHandler) Delete(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	passkeyID, err := uuid.Parse(c.Param("passkey_id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid passkey ID",
+		})
+		return
+	}
+
+	passkey := &entity.PasskeyEntity{
+		ID:        passkeyID,
+		AccountID: principal.AccountID,
+	}
+
+	if err := h.usecase.Delete(passkey); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+				Status:  http.StatusNotFound,
+				Message: "Passkey not found",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func respondPasskeyOptions(c *gin.Context, options *entity.PasskeyOptionsEntity) {
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.PasskeyOptionsResponse]{
+		Status: http.StatusOK,
+		Data: dto.PasskeyOptionsResponse{
+			ChallengeID: options.ChallengeID,
+			Options:     options.Options,
+			ExpiresAt:   options.ExpiresAt,
+		},
+	})
+}
+
+func respondPasskeyError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidPasskeyChallenge):
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid or expired passkey challenge",
+		})
+	case errors.Is(err, usecase.ErrEmailNotVerified):
+		c.JSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+			Status:  http.StatusForbidden,
+			Message: "Email verification required",
+		})
+	case errors.Is(err, repository.ErrPasskeyAlreadyRegistered):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Passkey already registered",
+		})
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Account not found",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
+
+func toPasskeyResponse(passkey *entity.PasskeyEntity) dto.PasskeyResponse {
+	return dto.PasskeyResponse{
+		ID:         passkey.ID,
+		Name:       passkey.Name,
+		Transports: passkey.Transports,
+		Synced:     passkey.BackupState,
+		LastUsedAt: passkey.LastUsedAt,
+		CreatedAt:  passkey.CreatedAt,
+	}
+}
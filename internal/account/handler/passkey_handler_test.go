@@ -0,0 +1,274 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	"trilha-api/internal/account/repository"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPasskey(t *testing.T) (*gin.Engine, *mocks.MockPasskeyUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockPasskeyUseCaseInterface(ctrl)
+	h := handler.NewPasskeyHandler(mock, testAvatars)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.POST("/api/v1/accounts/sign_in/passkey/options", h.BeginSignIn)
+	router.POST("/api/v1/accounts/sign_in/passkey", h.FinishSignIn)
+	router.GET("/api/v1/accounts/me/passkeys", h.List)
+	router.POST("/api/v1/accounts/me/passkeys/options", h.BeginRegistration)
+	router.POST("/api/v1/accounts/me/passkeys", h.FinishRegistration)
+	router.DELETE("/api/v1/accounts/me/passkeys/:passkey_id", h.Delete)
+
+	return router, mock
+}
+
+func TestPasskeyHandler_BeginRegistration(t *testing.T) {
+	router, mockUseCase := setupPasskey(t)
+	accountID := uuid.New()
+
+	t.Run("should return status 200 and the options", func(t *testing.T) {
+		challengeID := uuid.New()
+
+		mockUseCase.EXPECT().BeginRegistration(&entity.AccountEntity{ID: accountID}).Return(&entity.PasskeyOptionsEntity{
+			ChallengeID: challengeID,
+			Options:     map[string]any{"publicKey": map[string]any{"challenge": "abc"}},
+			ExpiresAt:   time.Now().Add(5 * time.Minute),
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/passkeys/options", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.PasskeyOptionsResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, challengeID, responseBody.Data.ChallengeID)
+		assert.NotNil(t, responseBody.Data.Options)
+	})
+
+	t.Run("should return status 403 for an unverified account", func(t *testing.T) {
+		mockUseCase.EXPECT().BeginRegistration(&entity.AccountEntity{ID: accountID}).Return(nil, usecase.ErrEmailNotVerified)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/passkeys/options", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return status 401 without authentication", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/passkeys/options", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestPasskeyHandler_FinishRegistration(t *testing.T) {
+	router, mockUseCase := setupPasskey(t)
+	accountID := uuid.New()
+	challengeID := uuid.New()
+
+	request := func(body any) *httptest.ResponseRecorder {
+		payload, _ := json.Marshal(body)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/passkeys", bytes.NewBuffer(payload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	valid := dto.RegisterPasskeyRequest{
+		ChallengeID: challengeID,
+		Name:        "Laptop",
+		Credential:  json.RawMessage(`{"id":"abc"}`),
+	}
+
+	t.Run("should return status 201 and the passkey", func(t *testing.T) {
+		mockUseCase.EXPECT().FinishRegistration(gomock.Any(), challengeID, []byte(`{"id":"abc"}`)).DoAndReturn(
+			func(passkey *entity.PasskeyEntity, _ uuid.UUID, _ []byte) error {
+				assert.Equal(t, accountID, passkey.AccountID)
+				passkey.ID = uuid.New()
+				passkey.Transports = []string{"internal"}
+				return nil
+			},
+		)
+
+		w := request(valid)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.PasskeyResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Laptop", responseBody.Data.Name)
+		assert.Equal(t, []string{"internal"}, responseBody.Data.Transports)
+	})
+
+	t.Run("should return status 400 without the credential", func(t *testing.T) {
+		w := request(map[string]any{"challenge_id": challengeID, "name": "Laptop"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid passkey", func(t *testing.T) {
+		mockUseCase.EXPECT().FinishRegistration(gomock.Any(), challengeID, gomock.Any()).Return(usecase.ErrInvalidPasskey)
+
+		w := request(valid)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 409 for a passkey already registered", func(t *testing.T) {
+		mockUseCase.EXPECT().FinishRegistration(gomock.Any(), challengeID, gomock.Any()).Return(repository.ErrPasskeyAlreadyRegistered)
+
+		w := request(valid)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestPasskeyHandler_FinishSignIn(t *testing.T) {
+	router, mockUseCase := setupPasskey(t)
+	challengeID := uuid.New()
+
+	body, _ := json.Marshal(dto.PasskeySignInRequest{
+		ChallengeID: challengeID,
+		Credential:  json.RawMessage(`{"id":"abc"}`),
+	})
+
+	request := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in/passkey", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("should return status 200 and the tokens", func(t *testing.T) {
+		accountID := uuid.New()
+
+		mockUseCase.EXPECT().FinishSignIn(gomock.Any(), challengeID, []byte(`{"id":"abc"}`), gomock.Any()).DoAndReturn(
+			func(account *entity.AccountEntity, _ uuid.UUID, _ []byte, _ entity.ClientEntity) (*entity.SignInEntity, error) {
+				account.ID = accountID
+				return &entity.SignInEntity{Tokens: &entity.AuthTokensEntity{AccessToken: "access-token"}}, nil
+			},
+		)
+
+		w := request()
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AuthTokensResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", responseBody.Data.AccessToken)
+		assert.Equal(t, accountID, responseBody.Data.Account.ID)
+	})
+
+	t.Run("should return status 401 for an invalid passkey", func(t *testing.T) {
+		mockUseCase.EXPECT().FinishSignIn(gomock.Any(), challengeID, gomock.Any(), gomock.Any()).Return(nil, usecase.ErrInvalidPasskey)
+
+		w := request()
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return status 400 for an expired challenge", func(t *testing.T) {
+		mockUseCase.EXPECT().FinishSignIn(gomock.Any(), challengeID, gomock.Any(), gomock.Any()).Return(nil, usecase.ErrInvalidPasskeyChallenge)
+
+		w := request()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPasskeyHandler_List(t *testing.T) {
+	router, mockUseCase := setupPasskey(t)
+	accountID := uuid.New()
+
+	mockUseCase.EXPECT().List(&entity.AccountEntity{ID: accountID}).Return([]entity.PasskeyEntity{
+		{ID: uuid.New(), Name: "Laptop", BackupState: true},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/me/passkeys", nil)
+	req.Header.Set("X-Account-ID", accountID.String())
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var responseBody sharedDto.APIResponse[[]dto.PasskeyResponse]
+	err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+	assert.NoError(t, err)
+	assert.Len(t, responseBody.Data, 1)
+	assert.True(t, responseBody.Data[0].Synced)
+}
+
+func TestPasskeyHandler_Delete(t *testing.T) {
+	router, mockUseCase := setupPasskey(t)
+	accountID := uuid.New()
+	passkeyID := uuid.New()
+
+	request := func(id string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/passkeys/"+id, nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("should return status 204", func(t *testing.T) {
+		mockUseCase.EXPECT().Delete(&entity.PasskeyEntity{ID: passkeyID, AccountID: accountID}).Return(nil)
+
+		w := request(passkeyID.String())
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 for a passkey of another account", func(t *testing.T) {
+		mockUseCase.EXPECT().Delete(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := request(passkeyID.String())
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid ID", func(t *testing.T) {
+		w := request("not-a-uuid")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PasswordResetHandler struct {
+	usecase usecase.PasswordResetUseCaseInterface
+}
+
+func NewPasswordResetHandler(uc usecase.PasswordResetUseCaseInterface) *PasswordResetHandler {
+	return &PasswordResetHandler{usecase: uc}
+}
+
+func (h *PasswordResetHandler) ForgotPassword(c *gin.Context) {
+	req := dto.ForgotPasswordRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.usecase.ForgotPassword(req.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, sharedDto.APIResponse[any]{
+		Status:  http.StatusAccepted,
+		Message: "If the email is registered, a password reset link has been sent",
+	})
+}
+
+func (h *PasswordResetHandler) ResetPassword(c *gin.Context) {
+	req := dto.ResetPasswordRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := h.usecase.ResetPassword(req.Token, req.NewPassword)
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPasswordResetToken) {
+			c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+				Status:  http.StatusBadRequest,
+				Message: "Invalid or expired password reset token",
+			})
+			return
+		}
+
+		if respondPasswordPolicy(c, "new_password", err) {
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
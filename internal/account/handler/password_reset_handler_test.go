@@ -0,0 +1,115 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/shared/password"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPasswordReset(t *testing.T) (*gin.Engine, *mocks.MockPasswordResetUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockPasswordResetUseCaseInterface(ctrl)
+	h := handler.NewPasswordResetHandler(mock)
+	router := gin.Default()
+
+	router.POST("/api/v1/accounts/forgot_password", h.ForgotPassword)
+	router.POST("/api/v1/accounts/reset_password", h.ResetPassword)
+
+	return router, mock
+}
+
+func TestPasswordResetHandler_ForgotPassword(t *testing.T) {
+	router, mockUseCase := setupPasswordReset(t)
+
+	body, _ := json.Marshal(dto.ForgotPasswordRequest{Email: "gandalf@lor.com.br"})
+
+	t.Run("should return status 202", func(t *testing.T) {
+		mockUseCase.EXPECT().ForgotPassword("gandalf@lor.com.br").Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/forgot_password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("should return status 500 when use case fails", func(t *testing.T) {
+		mockUseCase.EXPECT().ForgotPassword("gandalf@lor.com.br").Return(errors.New("smtp error"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/forgot_password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestPasswordResetHandler_ResetPassword(t *testing.T) {
+	router, mockUseCase := setupPasswordReset(t)
+
+	body, _ := json.Marshal(dto.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password123"})
+
+	t.Run("should return status 204 when the password is reset", func(t *testing.T) {
+		mockUseCase.EXPECT().ResetPassword("reset-token", "new-password123").Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/reset_password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 400 when the token is invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().ResetPassword("reset-token", "new-password123").Return(usecase.ErrInvalidPasswordResetToken)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/reset_password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 422 when the new password breaks the policy", func(t *testing.T) {
+		mockUseCase.EXPECT().ResetPassword("reset-token", "new-password123").Return(&password.PolicyError{
+			Violations: []password.Violation{{Code: password.CodeBreached, Message: "Password has appeared in a data breach, choose another one"}},
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/reset_password", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var responseBody sharedDto.APIResponse[[]sharedDto.FieldError]
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &responseBody))
+		assert.Equal(t, []sharedDto.FieldError{{
+			Field:   "new_password",
+			Code:    password.CodeBreached,
+			Message: "Password has appeared in a data breach, choose another one",
+		}}, responseBody.Data)
+	})
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PersonalAccessTokenHandler struct {
+	usecase usecase.PersonalAccessTokenUseCaseInterface
+}
+
+func NewPersonalAccessTokenHandler(uc usecase.PersonalAccessTokenUseCaseInterface) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{usecase: uc}
+}
+
+func (h *PersonalAccessTokenHandler) Create(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.CreatePersonalAccessTokenRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	token := &entity.PersonalAccessTokenEntity{
+		AccountID: principal.AccountID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: time.Now().UTC().AddDate(0, 0, req.ExpiresInDays),
+	}
+
+	if err := h.usecase.Create(token); err != nil {
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	res := toPersonalAccessTokenResponse(token)
+	res.Token = token.Token
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.PersonalAccessTokenResponse]{
+		Status:  http.StatusCreated,
+		Data:    res,
+		Message: "Store the token in a safe place, it will not be shown again",
+	})
+}
+
+func (h *PersonalAccessTokenHandler) List(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	tokens, err := h.usecase.List(principal.AccountID)
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	res := make([]dto.PersonalAccessTokenResponse, 0, len(tokens))
+	for i := range tokens {
+		res = append(res, toPersonalAccessTokenResponse(&tokens[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.PersonalAccessTokenResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func (h *PersonalAccessTokenHandler) Revoke(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	tokenID, err := uuid.Parse(c.Param("token_id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid token ID",
+		})
+		return
+	}
+
+	token := &entity.PersonalAccessTokenEntity{
+		ID:        tokenID,
+		AccountID: principal.AccountID,
+	}
+
+	if err := h.usecase.Revoke(token); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+				Status:  http.StatusNotFound,
+				Message: "Token not found",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func toPersonalAccessTokenResponse(token *entity.PersonalAccessTokenEntity) dto.PersonalAccessTokenResponse {
+	return dto.PersonalAccessTokenResponse{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
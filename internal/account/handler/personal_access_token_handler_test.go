@@ -0,0 +1,135 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPersonalAccessToken(t *testing.T) (*gin.Engine, *mocks.MockPersonalAccessTokenUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockPersonalAccessTokenUseCaseInterface(ctrl)
+	h := handler.NewPersonalAccessTokenHandler(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.GET("/api/v1/accounts/me/tokens", h.List)
+	router.POST("/api/v1/accounts/me/tokens", h.Create)
+	router.DELETE("/api/v1/accounts/me/tokens/:token_id", h.Revoke)
+
+	return router, mock
+}
+
+func TestPersonalAccessTokenHandler_Create(t *testing.T) {
+	router, mockUseCase := setupPersonalAccessToken(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return status 201 and the token only once", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).DoAndReturn(func(token *entity.PersonalAccessTokenEntity) error {
+			assert.Equal(t, accountID, token.AccountID)
+			assert.Equal(t, "ci", token.Name)
+			assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), token.ExpiresAt, time.Minute)
+			token.ID = uuid.New()
+			token.Token = "trl_pat_secret"
+			return nil
+		})
+
+		body, _ := json.Marshal(dto.CreatePersonalAccessTokenRequest{Name: "ci", Scopes: []string{"read"}, ExpiresInDays: 30})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/tokens", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.PersonalAccessTokenResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "trl_pat_secret", responseBody.Data.Token)
+	})
+
+	t.Run("should return status 400 for unknown scopes", func(t *testing.T) {
+		body, _ := json.Marshal(dto.CreatePersonalAccessTokenRequest{Name: "ci", Scopes: []string{"admin"}, ExpiresInDays: 30})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/tokens", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPersonalAccessTokenHandler_List(t *testing.T) {
+	router, mockUseCase := setupPersonalAccessToken(t)
+
+	accountID := uuid.New()
+
+	mockUseCase.EXPECT().List(accountID).Return([]entity.PersonalAccessTokenEntity{
+		{ID: uuid.New(), Name: "ci", Token: "trl_pat_secret"},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/me/tokens", nil)
+	req.Header.Set("X-Account-ID", accountID.String())
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var responseBody sharedDto.APIResponse[[]dto.PersonalAccessTokenResponse]
+	err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+	assert.NoError(t, err)
+	assert.Len(t, responseBody.Data, 1)
+	assert.Empty(t, responseBody.Data[0].Token)
+}
+
+func TestPersonalAccessTokenHandler_Revoke(t *testing.T) {
+	router, mockUseCase := setupPersonalAccessToken(t)
+
+	accountID := uuid.New()
+	tokenID := uuid.New()
+
+	t.Run("should return status 204", func(t *testing.T) {
+		mockUseCase.EXPECT().Revoke(&entity.PersonalAccessTokenEntity{ID: tokenID, AccountID: accountID}).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/tokens/"+tokenID.String(), nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when the token is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Revoke(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/tokens/"+tokenID.String(), nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
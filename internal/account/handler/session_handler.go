@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SessionHandler struct {
+	usecase usecase.SessionUseCaseInterface
+}
+
+func NewSessionHandler(uc usecase.SessionUseCaseInterface) *SessionHandler {
+	return &SessionHandler{usecase: uc}
+}
+
+func (h *SessionHandler) Refresh(c *gin.Context) {
+	req := dto.RefreshTokenRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	tokens, err := h.usecase.Refresh(req.RefreshToken, clientOf(c))
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidRefreshToken) {
+			c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+				Status:  http.StatusUnauthorized,
+				Message: "Invalid refresh token",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.AuthTokensResponse]{
+		Status: http.StatusOK,
+		Data:   toAuthTokensResponse(tokens),
+	})
+}
+
+func (h *SessionHandler) SignOut(c *gin.Context) {
+	req := dto.RefreshTokenRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := h.usecase.Revoke(req.RefreshToken)
+
+	if err != nil && !errors.Is(err, usecase.ErrInvalidRefreshToken) {
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// List returns the active sessions of the caller, flagging the one the
+// request was made from.
+func (h *SessionHandler) List(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	sessions, err := h.usecase.List(&entity.AccountEntity{ID: principal.AccountID})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	res := make([]dto.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		res = append(res, dto.SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			Current:    session.ID == principal.SessionID,
+			LastSeenAt: session.LastSeenAt,
+			ExpiresAt:  session.ExpiresAt,
+			CreatedAt:  session.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.SessionResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func (h *SessionHandler) RevokeSession(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("session_id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid session ID",
+		})
+		return
+	}
+
+	session := &entity.SessionEntity{
+		ID:        sessionID,
+		AccountID: principal.AccountID,
+	}
+
+	if err := h.usecase.RevokeSession(session); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+				Status:  http.StatusNotFound,
+				Message: "Session not found",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SignOutEverywhere revokes every session of the caller, including the
+// current one.
+func (h *SessionHandler) SignOutEverywhere(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.RevokeAll(&entity.AccountEntity{ID: principal.AccountID}); err != nil {
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// clientOf describes the device the request comes from.
+func clientOf(c *gin.Context) entity.ClientEntity {
+	return entity.ClientEntity{
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	}
+}
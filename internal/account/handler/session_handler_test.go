@@ -0,0 +1,192 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSession(t *testing.T) (*gin.Engine, *mocks.MockSessionUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockSessionUseCaseInterface(ctrl)
+	h := handler.NewSessionHandler(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.POST("/api/v1/accounts/refresh_token", h.Refresh)
+	router.POST("/api/v1/accounts/sign_out", h.SignOut)
+	router.GET("/api/v1/accounts/me/sessions", h.List)
+	router.DELETE("/api/v1/accounts/me/sessions", h.SignOutEverywhere)
+	router.DELETE("/api/v1/accounts/me/sessions/:session_id", h.RevokeSession)
+
+	return router, mock
+}
+
+func TestSessionHandler_Refresh(t *testing.T) {
+	router, mockUseCase := setupSession(t)
+
+	body, _ := json.Marshal(dto.RefreshTokenRequest{RefreshToken: "refresh-token"})
+
+	t.Run("should return status 200 and a new token pair", func(t *testing.T) {
+		mockUseCase.EXPECT().Refresh("refresh-token", gomock.Any()).Return(&entity.AuthTokensEntity{
+			AccessToken:  "new-access-token",
+			RefreshToken: "new-refresh-token",
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/refresh_token", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AuthTokensResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "new-access-token", responseBody.Data.AccessToken)
+		assert.Equal(t, "new-refresh-token", responseBody.Data.RefreshToken)
+	})
+
+	t.Run("should return status 401 when refresh token is invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().Refresh("refresh-token", gomock.Any()).Return(nil, usecase.ErrInvalidRefreshToken)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/refresh_token", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestSessionHandler_SignOut(t *testing.T) {
+	router, mockUseCase := setupSession(t)
+
+	body, _ := json.Marshal(dto.RefreshTokenRequest{RefreshToken: "refresh-token"})
+
+	mockUseCase.EXPECT().Revoke("refresh-token").Return(nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_out", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestSessionHandler_List(t *testing.T) {
+	router, mockUseCase := setupSession(t)
+
+	accountID := uuid.New()
+	currentID := uuid.New()
+	otherID := uuid.New()
+
+	t.Run("should return status 200 and flag the current session", func(t *testing.T) {
+		mockUseCase.EXPECT().List(&entity.AccountEntity{ID: accountID}).Return([]entity.SessionEntity{
+			{ID: currentID, AccountID: accountID, UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.1", LastSeenAt: time.Now()},
+			{ID: otherID, AccountID: accountID, UserAgent: "curl/8.0", IPAddress: "10.0.0.2", LastSeenAt: time.Now()},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/me/sessions", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+		req.Header.Set("X-Session-ID", currentID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[[]dto.SessionResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Len(t, responseBody.Data, 2)
+		assert.True(t, responseBody.Data[0].Current)
+		assert.False(t, responseBody.Data[1].Current)
+		assert.Equal(t, "curl/8.0", responseBody.Data[1].UserAgent)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/accounts/me/sessions", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestSessionHandler_RevokeSession(t *testing.T) {
+	router, mockUseCase := setupSession(t)
+
+	accountID := uuid.New()
+	sessionID := uuid.New()
+
+	t.Run("should return status 204 when the session is revoked", func(t *testing.T) {
+		mockUseCase.EXPECT().RevokeSession(&entity.SessionEntity{ID: sessionID, AccountID: accountID}).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/sessions/"+sessionID.String(), nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when the session does not exist", func(t *testing.T) {
+		mockUseCase.EXPECT().RevokeSession(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/sessions/"+sessionID.String(), nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid session ID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/sessions/not-a-uuid", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSessionHandler_SignOutEverywhere(t *testing.T) {
+	router, mockUseCase := setupSession(t)
+
+	accountID := uuid.New()
+
+	mockUseCase.EXPECT().RevokeAll(&entity.AccountEntity{ID: accountID}).Return(nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/sessions", nil)
+	req.Header.Set("X-Account-ID", accountID.String())
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
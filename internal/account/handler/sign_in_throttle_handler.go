@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SignInThrottleHandler struct {
+	usecase usecase.SignInThrottleUseCaseInterface
+}
+
+func NewSignInThrottleHandler(uc usecase.SignInThrottleUseCaseInterface) *SignInThrottleHandler {
+	return &SignInThrottleHandler{usecase: uc}
+}
+
+// Unlock lifts a lockout with the token emailed to the account owner.
+func (h *SignInThrottleHandler) Unlock(c *gin.Context) {
+	req := dto.UnlockAccountRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.usecase.Unlock(req.Token); err != nil {
+		if errors.Is(err, usecase.ErrInvalidUnlockToken) {
+			c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+				Status:  http.StatusBadRequest,
+				Message: "Invalid or expired unlock token",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[any]{
+		Status:  http.StatusOK,
+		Message: "Account unlocked",
+	})
+}
+
+// Clear lifts the lockout of any account, for administrators.
+func (h *SignInThrottleHandler) Clear(c *gin.Context) {
+	accountId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid account ID",
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: accountId,
+	}
+
+	if err := h.usecase.Clear(account); err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,94 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSignInThrottle(t *testing.T) (*gin.Engine, *mocks.MockSignInThrottleUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockSignInThrottleUseCaseInterface(ctrl)
+	h := handler.NewSignInThrottleHandler(mock)
+	router := gin.Default()
+
+	router.POST("/api/v1/accounts/unlock", h.Unlock)
+	router.DELETE("/api/v1/accounts/:id/lockout", h.Clear)
+
+	return router, mock
+}
+
+func TestSignInThrottleHandler_Unlock(t *testing.T) {
+	router, mockUseCase := setupSignInThrottle(t)
+
+	body, _ := json.Marshal(dto.UnlockAccountRequest{Token: "token"})
+
+	t.Run("should return status 200 when the account is unlocked", func(t *testing.T) {
+		mockUseCase.EXPECT().Unlock("token").Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/unlock", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid token", func(t *testing.T) {
+		mockUseCase.EXPECT().Unlock("token").Return(usecase.ErrInvalidUnlockToken)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/unlock", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSignInThrottleHandler_Clear(t *testing.T) {
+	router, mockUseCase := setupSignInThrottle(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return status 204 when the lockout is cleared", func(t *testing.T) {
+		mockUseCase.EXPECT().Clear(gomock.Any()).DoAndReturn(func(account *entity.AccountEntity) error {
+			assert.Equal(t, accountID, account.ID)
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/"+accountID.String()+"/lockout", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 for an unknown account", func(t *testing.T) {
+		mockUseCase.EXPECT().Clear(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/"+accountID.String()+"/lockout", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
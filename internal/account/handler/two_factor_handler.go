@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TwoFactorHandler struct {
+	usecase usecase.TwoFactorUseCaseInterface
+	avatars usecase.AvatarUseCaseInterface
+}
+
+func NewTwoFactorHandler(uc usecase.TwoFactorUseCaseInterface, avatars usecase.AvatarUseCaseInterface) *TwoFactorHandler {
+	return &TwoFactorHandler{usecase: uc, avatars: avatars}
+}
+
+func (h *TwoFactorHandler) Enroll(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: principal.AccountID,
+	}
+
+	enrollment, err := h.usecase.Enroll(account)
+
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.TwoFactorEnrollmentResponse]{
+		Status: http.StatusOK,
+		Data: dto.TwoFactorEnrollmentResponse{
+			Secret:     enrollment.Secret,
+			OTPAuthURI: enrollment.URI,
+		},
+	})
+}
+
+func (h *TwoFactorHandler) Confirm(c *gin.Context) {
+	h.respondRecoveryCodes(c, h.usecase.Confirm)
+}
+
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	h.respondRecoveryCodes(c, h.usecase.RegenerateRecoveryCodes)
+}
+
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.TwoFactorCodeRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: principal.AccountID,
+	}
+
+	if err := h.usecase.Disable(account, req.Code); err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SignIn completes the second step of a sign-in started at /sign_in.
+func (h *TwoFactorHandler) SignIn(c *gin.Context) {
+	req := dto.TwoFactorSignInRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{}
+
+	tokens, err := h.usecase.CompleteSignIn(account, req.ChallengeToken, req.Code, clientOf(c))
+
+	if err != nil {
+		if respondSignInThrottled(c, err) {
+			return
+		}
+
+		if errors.Is(err, usecase.ErrInvalidTwoFactorChallenge) || errors.Is(err, usecase.ErrInvalidTwoFactorCode) {
+			c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+				Status:  http.StatusUnauthorized,
+				Message: "Invalid or expired two-factor code",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+		return
+	}
+
+	respondSignedIn(c, h.avatars, account, tokens)
+}
+
+func (h *TwoFactorHandler) respondRecoveryCodes(c *gin.Context, action func(*entity.AccountEntity, string) ([]string, error)) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.TwoFactorCodeRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	account := &entity.AccountEntity{
+		ID: principal.AccountID,
+	}
+
+	codes, err := action(account, req.Code)
+
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.RecoveryCodesResponse]{
+		Status:  http.StatusOK,
+		Data:    dto.RecoveryCodesResponse{RecoveryCodes: codes},
+		Message: "Store the recovery codes in a safe place, they will not be shown again",
+	})
+}
+
+func respondTwoFactorError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidTwoFactorCode):
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid two-factor code",
+		})
+	case errors.Is(err, usecase.ErrTwoFactorNotEnrolled):
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Two-factor enrollment not started",
+		})
+	case errors.Is(err, usecase.ErrTwoFactorAlreadyEnabled):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Two-factor authentication already enabled",
+		})
+	case errors.Is(err, usecase.ErrTwoFactorNotEnabled):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Two-factor authentication not enabled",
+		})
+	default:
+		respondAccountError(c, err)
+	}
+}
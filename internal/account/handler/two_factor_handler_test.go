@@ -0,0 +1,195 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/account/dto"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/handler"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTwoFactor(t *testing.T) (*gin.Engine, *mocks.MockTwoFactorUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockTwoFactorUseCaseInterface(ctrl)
+	h := handler.NewTwoFactorHandler(mock, testAvatars)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.POST("/api/v1/accounts/sign_in/two_factor", h.SignIn)
+	router.POST("/api/v1/accounts/me/two_factor", h.Enroll)
+	router.POST("/api/v1/accounts/me/two_factor/confirm", h.Confirm)
+	router.DELETE("/api/v1/accounts/me/two_factor", h.Disable)
+
+	return router, mock
+}
+
+func TestTwoFactorHandler_Enroll(t *testing.T) {
+	router, mockUseCase := setupTwoFactor(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return status 200 and the otpauth uri", func(t *testing.T) {
+		mockUseCase.EXPECT().Enroll(gomock.Any()).Return(&entity.TwoFactorEnrollmentEntity{
+			Secret: "SECRET",
+			URI:    "otpauth://totp/Trilha:gandalf@lor.com.br?secret=SECRET",
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/two_factor", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.TwoFactorEnrollmentResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "SECRET", responseBody.Data.Secret)
+	})
+
+	t.Run("should return status 409 when already enabled", func(t *testing.T) {
+		mockUseCase.EXPECT().Enroll(gomock.Any()).Return(nil, usecase.ErrTwoFactorAlreadyEnabled)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/two_factor", nil)
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/two_factor", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestTwoFactorHandler_Confirm(t *testing.T) {
+	router, mockUseCase := setupTwoFactor(t)
+
+	accountID := uuid.New()
+	body, _ := json.Marshal(dto.TwoFactorCodeRequest{Code: "123456"})
+
+	t.Run("should return status 200 and the recovery codes", func(t *testing.T) {
+		mockUseCase.EXPECT().Confirm(gomock.Any(), "123456").Return([]string{"abcde-fghij"}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/two_factor/confirm", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.RecoveryCodesResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"abcde-fghij"}, responseBody.Data.RecoveryCodes)
+	})
+
+	t.Run("should return status 400 when the code is invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().Confirm(gomock.Any(), "123456").Return(nil, usecase.ErrInvalidTwoFactorCode)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/me/two_factor/confirm", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTwoFactorHandler_Disable(t *testing.T) {
+	router, mockUseCase := setupTwoFactor(t)
+
+	accountID := uuid.New()
+	body, _ := json.Marshal(dto.TwoFactorCodeRequest{Code: "123456"})
+
+	t.Run("should return status 204", func(t *testing.T) {
+		mockUseCase.EXPECT().Disable(gomock.Any(), "123456").Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/two_factor", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 409 when not enabled", func(t *testing.T) {
+		mockUseCase.EXPECT().Disable(gomock.Any(), "123456").Return(usecase.ErrTwoFactorNotEnabled)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/accounts/me/two_factor", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Account-ID", accountID.String())
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestTwoFactorHandler_SignIn(t *testing.T) {
+	router, mockUseCase := setupTwoFactor(t)
+
+	body, _ := json.Marshal(dto.TwoFactorSignInRequest{ChallengeToken: "challenge", Code: "123456"})
+
+	t.Run("should return status 200 and the token pair", func(t *testing.T) {
+		accountID := uuid.New()
+
+		mockUseCase.EXPECT().CompleteSignIn(gomock.Any(), "challenge", "123456", gomock.Any()).DoAndReturn(func(account *entity.AccountEntity, _, _ string, _ entity.ClientEntity) (*entity.AuthTokensEntity, error) {
+			account.ID = accountID
+			return &entity.AuthTokensEntity{AccessToken: "access-token", RefreshToken: "refresh-token"}, nil
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in/two_factor", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.AuthTokensResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", responseBody.Data.AccessToken)
+		assert.Equal(t, accountID, responseBody.Data.Account.ID)
+	})
+
+	t.Run("should return status 401 when the code is invalid", func(t *testing.T) {
+		mockUseCase.EXPECT().CompleteSignIn(gomock.Any(), "challenge", "123456", gomock.Any()).Return(nil, usecase.ErrInvalidTwoFactorCode)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/accounts/sign_in/two_factor", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: account_identity_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=account_identity_repository.go -destination=../mocks/account_identity_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccountIdentityRepositoryInterface is a mock of AccountIdentityRepositoryInterface interface.
+type MockAccountIdentityRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountIdentityRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountIdentityRepositoryInterfaceMockRecorder is the mock recorder for MockAccountIdentityRepositoryInterface.
+type MockAccountIdentityRepositoryInterfaceMockRecorder struct {
+	mock *MockAccountIdentityRepositoryInterface
+}
+
+// NewMockAccountIdentityRepositoryInterface creates a new mock instance.
+func NewMockAccountIdentityRepositoryInterface(ctrl *gomock.Controller) *MockAccountIdentityRepositoryInterface {
+	mock := &MockAccountIdentityRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockAccountIdentityRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountIdentityRepositoryInterface) EXPECT() *MockAccountIdentityRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAccountIdentityRepositoryInterface) Create(identity *entity.AccountIdentityEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccountIdentityRepositoryInterfaceMockRecorder) Create(identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountIdentityRepositoryInterface)(nil).Create), identity)
+}
+
+// FindBySubject mocks base method.
+func (m *MockAccountIdentityRepositoryInterface) FindBySubject(identity *entity.AccountIdentityEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySubject", identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindBySubject indicates an expected call of FindBySubject.
+func (mr *MockAccountIdentityRepositoryInterfaceMockRecorder) FindBySubject(identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySubject", reflect.TypeOf((*MockAccountIdentityRepositoryInterface)(nil).FindBySubject), identity)
+}
+
+// ListByAccount mocks base method.
+func (m *MockAccountIdentityRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.AccountIdentityEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.AccountIdentityEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockAccountIdentityRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockAccountIdentityRepositoryInterface)(nil).ListByAccount), accountID)
+}
@@ -11,6 +11,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 	entity "trilha-api/internal/account/entity"
 
 	gomock "go.uber.org/mock/gomock"
@@ -40,6 +41,65 @@ func (m *MockAccountRepositoryInterface) EXPECT() *MockAccountRepositoryInterfac
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockAccountRepositoryInterface) Count(filter entity.AccountFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Count(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Count), filter)
+}
+
+// DisableTwoFactor mocks base method.
+func (m *MockAccountRepositoryInterface) DisableTwoFactor(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) DisableTwoFactor(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).DisableTwoFactor), account)
+}
+
+// EnableTwoFactor mocks base method.
+func (m *MockAccountRepositoryInterface) EnableTwoFactor(account *entity.AccountEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", account)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) EnableTwoFactor(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).EnableTwoFactor), account)
+}
+
+// Erase mocks base method.
+func (m *MockAccountRepositoryInterface) Erase(account *entity.AccountEntity) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Erase", account)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Erase indicates an expected call of Erase.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Erase(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Erase", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Erase), account)
+}
+
 // Find mocks base method.
 func (m *MockAccountRepositoryInterface) Find(account *entity.AccountEntity) error {
 	m.ctrl.T.Helper()
@@ -68,6 +128,80 @@ func (mr *MockAccountRepositoryInterfaceMockRecorder) FindByEmail(account any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).FindByEmail), account)
 }
 
+// FindForAdmin mocks base method.
+func (m *MockAccountRepositoryInterface) FindForAdmin(account *entity.AdminAccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForAdmin", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindForAdmin indicates an expected call of FindForAdmin.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) FindForAdmin(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForAdmin", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).FindForAdmin), account)
+}
+
+// HardDelete mocks base method.
+func (m *MockAccountRepositoryInterface) HardDelete(account *entity.AccountEntity) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDelete", account)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HardDelete indicates an expected call of HardDelete.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) HardDelete(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).HardDelete), account)
+}
+
+// List mocks base method.
+func (m *MockAccountRepositoryInterface) List(filter entity.AccountFilter) ([]entity.AdminAccountEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", filter)
+	ret0, _ := ret[0].([]entity.AdminAccountEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) List(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).List), filter)
+}
+
+// MarkVerificationSent mocks base method.
+func (m *MockAccountRepositoryInterface) MarkVerificationSent(account *entity.AccountEntity, resendBefore time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkVerificationSent", account, resendBefore)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkVerificationSent indicates an expected call of MarkVerificationSent.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) MarkVerificationSent(account, resendBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVerificationSent", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).MarkVerificationSent), account, resendBefore)
+}
+
+// Reactivate mocks base method.
+func (m *MockAccountRepositoryInterface) Reactivate(account *entity.AccountEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactivate", account)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reactivate indicates an expected call of Reactivate.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Reactivate(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactivate", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Reactivate), account)
+}
+
 // Register mocks base method.
 func (m *MockAccountRepositoryInterface) Register(account *entity.AccountEntity) error {
 	m.ctrl.T.Helper()
@@ -81,3 +215,148 @@ func (mr *MockAccountRepositoryInterfaceMockRecorder) Register(account any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Register), account)
 }
+
+// RehashPassword mocks base method.
+func (m *MockAccountRepositoryInterface) RehashPassword(account *entity.AccountEntity, oldHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RehashPassword", account, oldHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RehashPassword indicates an expected call of RehashPassword.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) RehashPassword(account, oldHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehashPassword", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).RehashPassword), account, oldHash)
+}
+
+// ReplaceAvatar mocks base method.
+func (m *MockAccountRepositoryInterface) ReplaceAvatar(account *entity.AccountEntity) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAvatar", account)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceAvatar indicates an expected call of ReplaceAvatar.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) ReplaceAvatar(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAvatar", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).ReplaceAvatar), account)
+}
+
+// Restore mocks base method.
+func (m *MockAccountRepositoryInterface) Restore(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Restore(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Restore), account)
+}
+
+// SetTOTPSecret mocks base method.
+func (m *MockAccountRepositoryInterface) SetTOTPSecret(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTOTPSecret", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTOTPSecret indicates an expected call of SetTOTPSecret.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) SetTOTPSecret(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTOTPSecret", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).SetTOTPSecret), account)
+}
+
+// SoftDelete mocks base method.
+func (m *MockAccountRepositoryInterface) SoftDelete(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) SoftDelete(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).SoftDelete), account)
+}
+
+// Suspend mocks base method.
+func (m *MockAccountRepositoryInterface) Suspend(account *entity.AccountEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", account)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Suspend(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Suspend), account)
+}
+
+// Update mocks base method.
+func (m *MockAccountRepositoryInterface) Update(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Update(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Update), account)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockAccountRepositoryInterface) UpdatePassword(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) UpdatePassword(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).UpdatePassword), account)
+}
+
+// UseTOTPStep mocks base method.
+func (m *MockAccountRepositoryInterface) UseTOTPStep(account *entity.AccountEntity, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTOTPStep", account, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTOTPStep indicates an expected call of UseTOTPStep.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) UseTOTPStep(account, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTOTPStep", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).UseTOTPStep), account, step)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockAccountRepositoryInterface) VerifyEmail(account *entity.AccountEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", account)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAccountRepositoryInterfaceMockRecorder) VerifyEmail(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).VerifyEmail), account)
+}
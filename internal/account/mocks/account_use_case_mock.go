@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 	entity "trilha-api/internal/account/entity"
 
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +41,34 @@ func (m *MockAccountUseCaseInterface) EXPECT() *MockAccountUseCaseInterfaceMockR
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAccountUseCaseInterface) ChangePassword(account *entity.AccountEntity, sessionID uuid.UUID, currentPassword, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", account, sessionID, currentPassword, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAccountUseCaseInterfaceMockRecorder) ChangePassword(account, sessionID, currentPassword, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAccountUseCaseInterface)(nil).ChangePassword), account, sessionID, currentPassword, newPassword)
+}
+
+// Delete mocks base method.
+func (m *MockAccountUseCaseInterface) Delete(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAccountUseCaseInterfaceMockRecorder) Delete(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountUseCaseInterface)(nil).Delete), account)
+}
+
 // Find mocks base method.
 func (m *MockAccountUseCaseInterface) Find(account *entity.AccountEntity) error {
 	m.ctrl.T.Helper()
@@ -81,3 +110,46 @@ func (mr *MockAccountUseCaseInterfaceMockRecorder) Register(account any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAccountUseCaseInterface)(nil).Register), account)
 }
+
+// Restore mocks base method.
+func (m *MockAccountUseCaseInterface) Restore(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAccountUseCaseInterfaceMockRecorder) Restore(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAccountUseCaseInterface)(nil).Restore), account)
+}
+
+// SignIn mocks base method.
+func (m *MockAccountUseCaseInterface) SignIn(account *entity.AccountEntity, client entity.ClientEntity) (*entity.SignInEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignIn", account, client)
+	ret0, _ := ret[0].(*entity.SignInEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignIn indicates an expected call of SignIn.
+func (mr *MockAccountUseCaseInterfaceMockRecorder) SignIn(account, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockAccountUseCaseInterface)(nil).SignIn), account, client)
+}
+
+// Update mocks base method.
+func (m *MockAccountUseCaseInterface) Update(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAccountUseCaseInterfaceMockRecorder) Update(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccountUseCaseInterface)(nil).Update), account)
+}
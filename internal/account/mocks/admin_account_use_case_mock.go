@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin_account_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=admin_account_use_case.go -destination=../mocks/admin_account_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminAccountUseCaseInterface is a mock of AdminAccountUseCaseInterface interface.
+type MockAdminAccountUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminAccountUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminAccountUseCaseInterfaceMockRecorder is the mock recorder for MockAdminAccountUseCaseInterface.
+type MockAdminAccountUseCaseInterfaceMockRecorder struct {
+	mock *MockAdminAccountUseCaseInterface
+}
+
+// NewMockAdminAccountUseCaseInterface creates a new mock instance.
+func NewMockAdminAccountUseCaseInterface(ctrl *gomock.Controller) *MockAdminAccountUseCaseInterface {
+	mock := &MockAdminAccountUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockAdminAccountUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminAccountUseCaseInterface) EXPECT() *MockAdminAccountUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockAdminAccountUseCaseInterface) Find(account *entity.AdminAccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAdminAccountUseCaseInterfaceMockRecorder) Find(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAdminAccountUseCaseInterface)(nil).Find), account)
+}
+
+// ForcePasswordReset mocks base method.
+func (m *MockAdminAccountUseCaseInterface) ForcePasswordReset(adminID uuid.UUID, account *entity.AdminAccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForcePasswordReset", adminID, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForcePasswordReset indicates an expected call of ForcePasswordReset.
+func (mr *MockAdminAccountUseCaseInterfaceMockRecorder) ForcePasswordReset(adminID, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForcePasswordReset", reflect.TypeOf((*MockAdminAccountUseCaseInterface)(nil).ForcePasswordReset), adminID, account)
+}
+
+// HardDelete mocks base method.
+func (m *MockAdminAccountUseCaseInterface) HardDelete(adminID uuid.UUID, account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDelete", adminID, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HardDelete indicates an expected call of HardDelete.
+func (mr *MockAdminAccountUseCaseInterfaceMockRecorder) HardDelete(adminID, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockAdminAccountUseCaseInterface)(nil).HardDelete), adminID, account)
+}
+
+// List mocks base method.
+func (m *MockAdminAccountUseCaseInterface) List(filter *entity.AccountFilter) ([]entity.AdminAccountEntity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", filter)
+	ret0, _ := ret[0].([]entity.AdminAccountEntity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockAdminAccountUseCaseInterfaceMockRecorder) List(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAdminAccountUseCaseInterface)(nil).List), filter)
+}
+
+// Reactivate mocks base method.
+func (m *MockAdminAccountUseCaseInterface) Reactivate(account *entity.AdminAccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactivate", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reactivate indicates an expected call of Reactivate.
+func (mr *MockAdminAccountUseCaseInterfaceMockRecorder) Reactivate(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactivate", reflect.TypeOf((*MockAdminAccountUseCaseInterface)(nil).Reactivate), account)
+}
+
+// Suspend mocks base method.
+func (m *MockAdminAccountUseCaseInterface) Suspend(adminID uuid.UUID, account *entity.AdminAccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", adminID, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockAdminAccountUseCaseInterfaceMockRecorder) Suspend(adminID, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockAdminAccountUseCaseInterface)(nil).Suspend), adminID, account)
+}
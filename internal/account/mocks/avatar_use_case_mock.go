@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: avatar_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=avatar_use_case.go -destination=../mocks/avatar_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAvatarUseCaseInterface is a mock of AvatarUseCaseInterface interface.
+type MockAvatarUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvatarUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAvatarUseCaseInterfaceMockRecorder is the mock recorder for MockAvatarUseCaseInterface.
+type MockAvatarUseCaseInterfaceMockRecorder struct {
+	mock *MockAvatarUseCaseInterface
+}
+
+// NewMockAvatarUseCaseInterface creates a new mock instance.
+func NewMockAvatarUseCaseInterface(ctrl *gomock.Controller) *MockAvatarUseCaseInterface {
+	mock := &MockAvatarUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockAvatarUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvatarUseCaseInterface) EXPECT() *MockAvatarUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAvatarUseCaseInterface) Delete(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAvatarUseCaseInterfaceMockRecorder) Delete(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAvatarUseCaseInterface)(nil).Delete), account)
+}
+
+// RemoveImages mocks base method.
+func (m *MockAvatarUseCaseInterface) RemoveImages(key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveImages", key)
+}
+
+// RemoveImages indicates an expected call of RemoveImages.
+func (mr *MockAvatarUseCaseInterfaceMockRecorder) RemoveImages(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImages", reflect.TypeOf((*MockAvatarUseCaseInterface)(nil).RemoveImages), key)
+}
+
+// URLs mocks base method.
+func (m *MockAvatarUseCaseInterface) URLs(account *entity.AccountEntity) map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URLs", account)
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// URLs indicates an expected call of URLs.
+func (mr *MockAvatarUseCaseInterfaceMockRecorder) URLs(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URLs", reflect.TypeOf((*MockAvatarUseCaseInterface)(nil).URLs), account)
+}
+
+// Upload mocks base method.
+func (m *MockAvatarUseCaseInterface) Upload(account *entity.AccountEntity, image []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", account, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAvatarUseCaseInterfaceMockRecorder) Upload(account, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAvatarUseCaseInterface)(nil).Upload), account, image)
+}
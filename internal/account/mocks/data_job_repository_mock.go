@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: data_job_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=data_job_repository.go -destination=../mocks/data_job_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDataJobRepositoryInterface is a mock of DataJobRepositoryInterface interface.
+type MockDataJobRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataJobRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDataJobRepositoryInterfaceMockRecorder is the mock recorder for MockDataJobRepositoryInterface.
+type MockDataJobRepositoryInterfaceMockRecorder struct {
+	mock *MockDataJobRepositoryInterface
+}
+
+// NewMockDataJobRepositoryInterface creates a new mock instance.
+func NewMockDataJobRepositoryInterface(ctrl *gomock.Controller) *MockDataJobRepositoryInterface {
+	mock := &MockDataJobRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockDataJobRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataJobRepositoryInterface) EXPECT() *MockDataJobRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockDataJobRepositoryInterface) Claim(staleBefore time.Time, job *entity.DataJobEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", staleBefore, job)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockDataJobRepositoryInterfaceMockRecorder) Claim(staleBefore, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockDataJobRepositoryInterface)(nil).Claim), staleBefore, job)
+}
+
+// ClearArchives mocks base method.
+func (m *MockDataJobRepositoryInterface) ClearArchives(accountID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearArchives", accountID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearArchives indicates an expected call of ClearArchives.
+func (mr *MockDataJobRepositoryInterfaceMockRecorder) ClearArchives(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearArchives", reflect.TypeOf((*MockDataJobRepositoryInterface)(nil).ClearArchives), accountID)
+}
+
+// Complete mocks base method.
+func (m *MockDataJobRepositoryInterface) Complete(job *entity.DataJobEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockDataJobRepositoryInterfaceMockRecorder) Complete(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockDataJobRepositoryInterface)(nil).Complete), job)
+}
+
+// Create mocks base method.
+func (m *MockDataJobRepositoryInterface) Create(job *entity.DataJobEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataJobRepositoryInterfaceMockRecorder) Create(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataJobRepositoryInterface)(nil).Create), job)
+}
+
+// ExpireArchives mocks base method.
+func (m *MockDataJobRepositoryInterface) ExpireArchives() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireArchives")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireArchives indicates an expected call of ExpireArchives.
+func (mr *MockDataJobRepositoryInterfaceMockRecorder) ExpireArchives() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireArchives", reflect.TypeOf((*MockDataJobRepositoryInterface)(nil).ExpireArchives))
+}
+
+// Fail mocks base method.
+func (m *MockDataJobRepositoryInterface) Fail(job *entity.DataJobEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fail", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fail indicates an expected call of Fail.
+func (mr *MockDataJobRepositoryInterfaceMockRecorder) Fail(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fail", reflect.TypeOf((*MockDataJobRepositoryInterface)(nil).Fail), job)
+}
+
+// Find mocks base method.
+func (m *MockDataJobRepositoryInterface) Find(job *entity.DataJobEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockDataJobRepositoryInterfaceMockRecorder) Find(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockDataJobRepositoryInterface)(nil).Find), job)
+}
+
+// ListByAccount mocks base method.
+func (m *MockDataJobRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.DataJobEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.DataJobEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockDataJobRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockDataJobRepositoryInterface)(nil).ListByAccount), accountID)
+}
@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: data_job_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=data_job_use_case.go -destination=../mocks/data_job_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	io "io"
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDataJobUseCaseInterface is a mock of DataJobUseCaseInterface interface.
+type MockDataJobUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataJobUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDataJobUseCaseInterfaceMockRecorder is the mock recorder for MockDataJobUseCaseInterface.
+type MockDataJobUseCaseInterfaceMockRecorder struct {
+	mock *MockDataJobUseCaseInterface
+}
+
+// NewMockDataJobUseCaseInterface creates a new mock instance.
+func NewMockDataJobUseCaseInterface(ctrl *gomock.Controller) *MockDataJobUseCaseInterface {
+	mock := &MockDataJobUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockDataJobUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataJobUseCaseInterface) EXPECT() *MockDataJobUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockDataJobUseCaseInterface) Find(job *entity.DataJobEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockDataJobUseCaseInterfaceMockRecorder) Find(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockDataJobUseCaseInterface)(nil).Find), job)
+}
+
+// List mocks base method.
+func (m *MockDataJobUseCaseInterface) List(accountID uuid.UUID) ([]entity.DataJobEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", accountID)
+	ret0, _ := ret[0].([]entity.DataJobEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDataJobUseCaseInterfaceMockRecorder) List(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDataJobUseCaseInterface)(nil).List), accountID)
+}
+
+// OpenArchive mocks base method.
+func (m *MockDataJobUseCaseInterface) OpenArchive(job *entity.DataJobEntity) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenArchive", job)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenArchive indicates an expected call of OpenArchive.
+func (mr *MockDataJobUseCaseInterfaceMockRecorder) OpenArchive(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenArchive", reflect.TypeOf((*MockDataJobUseCaseInterface)(nil).OpenArchive), job)
+}
+
+// RequestErasure mocks base method.
+func (m *MockDataJobUseCaseInterface) RequestErasure(job *entity.DataJobEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestErasure", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestErasure indicates an expected call of RequestErasure.
+func (mr *MockDataJobUseCaseInterfaceMockRecorder) RequestErasure(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestErasure", reflect.TypeOf((*MockDataJobUseCaseInterface)(nil).RequestErasure), job)
+}
+
+// RequestExport mocks base method.
+func (m *MockDataJobUseCaseInterface) RequestExport(job *entity.DataJobEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestExport", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestExport indicates an expected call of RequestExport.
+func (mr *MockDataJobUseCaseInterfaceMockRecorder) RequestExport(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestExport", reflect.TypeOf((*MockDataJobUseCaseInterface)(nil).RequestExport), job)
+}
+
+// Work mocks base method.
+func (m *MockDataJobUseCaseInterface) Work() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Work")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Work indicates an expected call of Work.
+func (mr *MockDataJobUseCaseInterfaceMockRecorder) Work() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Work", reflect.TypeOf((*MockDataJobUseCaseInterface)(nil).Work))
+}
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: email_change_request_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=email_change_request_repository.go -destination=../mocks/email_change_request_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailChangeRequestRepositoryInterface is a mock of EmailChangeRequestRepositoryInterface interface.
+type MockEmailChangeRequestRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailChangeRequestRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailChangeRequestRepositoryInterfaceMockRecorder is the mock recorder for MockEmailChangeRequestRepositoryInterface.
+type MockEmailChangeRequestRepositoryInterfaceMockRecorder struct {
+	mock *MockEmailChangeRequestRepositoryInterface
+}
+
+// NewMockEmailChangeRequestRepositoryInterface creates a new mock instance.
+func NewMockEmailChangeRequestRepositoryInterface(ctrl *gomock.Controller) *MockEmailChangeRequestRepositoryInterface {
+	mock := &MockEmailChangeRequestRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockEmailChangeRequestRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailChangeRequestRepositoryInterface) EXPECT() *MockEmailChangeRequestRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockEmailChangeRequestRepositoryInterface) Cancel(request *entity.EmailChangeRequestEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", request)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockEmailChangeRequestRepositoryInterfaceMockRecorder) Cancel(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockEmailChangeRequestRepositoryInterface)(nil).Cancel), request)
+}
+
+// CancelAllByAccount mocks base method.
+func (m *MockEmailChangeRequestRepositoryInterface) CancelAllByAccount(accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAllByAccount", accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAllByAccount indicates an expected call of CancelAllByAccount.
+func (mr *MockEmailChangeRequestRepositoryInterfaceMockRecorder) CancelAllByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAllByAccount", reflect.TypeOf((*MockEmailChangeRequestRepositoryInterface)(nil).CancelAllByAccount), accountID)
+}
+
+// Confirm mocks base method.
+func (m *MockEmailChangeRequestRepositoryInterface) Confirm(request *entity.EmailChangeRequestEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", request)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockEmailChangeRequestRepositoryInterfaceMockRecorder) Confirm(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockEmailChangeRequestRepositoryInterface)(nil).Confirm), request)
+}
+
+// Create mocks base method.
+func (m *MockEmailChangeRequestRepositoryInterface) Create(request *entity.EmailChangeRequestEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEmailChangeRequestRepositoryInterfaceMockRecorder) Create(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmailChangeRequestRepositoryInterface)(nil).Create), request)
+}
+
+// FindByCancelTokenHash mocks base method.
+func (m *MockEmailChangeRequestRepositoryInterface) FindByCancelTokenHash(request *entity.EmailChangeRequestEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCancelTokenHash", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByCancelTokenHash indicates an expected call of FindByCancelTokenHash.
+func (mr *MockEmailChangeRequestRepositoryInterfaceMockRecorder) FindByCancelTokenHash(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCancelTokenHash", reflect.TypeOf((*MockEmailChangeRequestRepositoryInterface)(nil).FindByCancelTokenHash), request)
+}
+
+// FindByTokenHash mocks base method.
+func (m *MockEmailChangeRequestRepositoryInterface) FindByTokenHash(request *entity.EmailChangeRequestEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTokenHash", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByTokenHash indicates an expected call of FindByTokenHash.
+func (mr *MockEmailChangeRequestRepositoryInterfaceMockRecorder) FindByTokenHash(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTokenHash", reflect.TypeOf((*MockEmailChangeRequestRepositoryInterface)(nil).FindByTokenHash), request)
+}
+
+// ListByAccount mocks base method.
+func (m *MockEmailChangeRequestRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.EmailChangeRequestEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.EmailChangeRequestEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockEmailChangeRequestRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockEmailChangeRequestRepositoryInterface)(nil).ListByAccount), accountID)
+}
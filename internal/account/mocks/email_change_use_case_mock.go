@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: email_change_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=email_change_use_case.go -destination=../mocks/email_change_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailChangeUseCaseInterface is a mock of EmailChangeUseCaseInterface interface.
+type MockEmailChangeUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailChangeUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailChangeUseCaseInterfaceMockRecorder is the mock recorder for MockEmailChangeUseCaseInterface.
+type MockEmailChangeUseCaseInterfaceMockRecorder struct {
+	mock *MockEmailChangeUseCaseInterface
+}
+
+// NewMockEmailChangeUseCaseInterface creates a new mock instance.
+func NewMockEmailChangeUseCaseInterface(ctrl *gomock.Controller) *MockEmailChangeUseCaseInterface {
+	mock := &MockEmailChangeUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockEmailChangeUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailChangeUseCaseInterface) EXPECT() *MockEmailChangeUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockEmailChangeUseCaseInterface) Cancel(token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockEmailChangeUseCaseInterfaceMockRecorder) Cancel(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockEmailChangeUseCaseInterface)(nil).Cancel), token)
+}
+
+// Confirm mocks base method.
+func (m *MockEmailChangeUseCaseInterface) Confirm(token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockEmailChangeUseCaseInterfaceMockRecorder) Confirm(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockEmailChangeUseCaseInterface)(nil).Confirm), token)
+}
+
+// RequestChange mocks base method.
+func (m *MockEmailChangeUseCaseInterface) RequestChange(account *entity.AccountEntity, newEmail, currentPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestChange", account, newEmail, currentPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestChange indicates an expected call of RequestChange.
+func (mr *MockEmailChangeUseCaseInterfaceMockRecorder) RequestChange(account, newEmail, currentPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestChange", reflect.TypeOf((*MockEmailChangeUseCaseInterface)(nil).RequestChange), account, newEmail, currentPassword)
+}
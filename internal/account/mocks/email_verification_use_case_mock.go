@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: email_verification_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=email_verification_use_case.go -destination=../mocks/email_verification_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailVerificationUseCaseInterface is a mock of EmailVerificationUseCaseInterface interface.
+type MockEmailVerificationUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailVerificationUseCaseInterfaceMockRecorder is the mock recorder for MockEmailVerificationUseCaseInterface.
+type MockEmailVerificationUseCaseInterfaceMockRecorder struct {
+	mock *MockEmailVerificationUseCaseInterface
+}
+
+// NewMockEmailVerificationUseCaseInterface creates a new mock instance.
+func NewMockEmailVerificationUseCaseInterface(ctrl *gomock.Controller) *MockEmailVerificationUseCaseInterface {
+	mock := &MockEmailVerificationUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationUseCaseInterface) EXPECT() *MockEmailVerificationUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Resend mocks base method.
+func (m *MockEmailVerificationUseCaseInterface) Resend(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockEmailVerificationUseCaseInterfaceMockRecorder) Resend(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockEmailVerificationUseCaseInterface)(nil).Resend), account)
+}
+
+// SendVerification mocks base method.
+func (m *MockEmailVerificationUseCaseInterface) SendVerification(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerification", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendVerification indicates an expected call of SendVerification.
+func (mr *MockEmailVerificationUseCaseInterfaceMockRecorder) SendVerification(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerification", reflect.TypeOf((*MockEmailVerificationUseCaseInterface)(nil).SendVerification), account)
+}
+
+// Verify mocks base method.
+func (m *MockEmailVerificationUseCaseInterface) Verify(token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockEmailVerificationUseCaseInterfaceMockRecorder) Verify(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockEmailVerificationUseCaseInterface)(nil).Verify), token)
+}
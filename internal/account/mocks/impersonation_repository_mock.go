@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: impersonation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=impersonation_repository.go -destination=../mocks/impersonation_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImpersonationRepositoryInterface is a mock of ImpersonationRepositoryInterface interface.
+type MockImpersonationRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockImpersonationRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockImpersonationRepositoryInterfaceMockRecorder is the mock recorder for MockImpersonationRepositoryInterface.
+type MockImpersonationRepositoryInterfaceMockRecorder struct {
+	mock *MockImpersonationRepositoryInterface
+}
+
+// NewMockImpersonationRepositoryInterface creates a new mock instance.
+func NewMockImpersonationRepositoryInterface(ctrl *gomock.Controller) *MockImpersonationRepositoryInterface {
+	mock := &MockImpersonationRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockImpersonationRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImpersonationRepositoryInterface) EXPECT() *MockImpersonationRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockImpersonationRepositoryInterface) Create(impersonation *entity.ImpersonationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", impersonation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockImpersonationRepositoryInterfaceMockRecorder) Create(impersonation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImpersonationRepositoryInterface)(nil).Create), impersonation)
+}
+
+// End mocks base method.
+func (m *MockImpersonationRepositoryInterface) End(impersonation *entity.ImpersonationEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "End", impersonation)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// End indicates an expected call of End.
+func (mr *MockImpersonationRepositoryInterfaceMockRecorder) End(impersonation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "End", reflect.TypeOf((*MockImpersonationRepositoryInterface)(nil).End), impersonation)
+}
+
+// Find mocks base method.
+func (m *MockImpersonationRepositoryInterface) Find(impersonation *entity.ImpersonationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", impersonation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockImpersonationRepositoryInterfaceMockRecorder) Find(impersonation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockImpersonationRepositoryInterface)(nil).Find), impersonation)
+}
+
+// ListRequests mocks base method.
+func (m *MockImpersonationRepositoryInterface) ListRequests(impersonationID uuid.UUID) ([]entity.ImpersonationRequestEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequests", impersonationID)
+	ret0, _ := ret[0].([]entity.ImpersonationRequestEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRequests indicates an expected call of ListRequests.
+func (mr *MockImpersonationRepositoryInterfaceMockRecorder) ListRequests(impersonationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequests", reflect.TypeOf((*MockImpersonationRepositoryInterface)(nil).ListRequests), impersonationID)
+}
+
+// RecordRequest mocks base method.
+func (m *MockImpersonationRepositoryInterface) RecordRequest(request *entity.ImpersonationRequestEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRequest", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordRequest indicates an expected call of RecordRequest.
+func (mr *MockImpersonationRepositoryInterfaceMockRecorder) RecordRequest(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRequest", reflect.TypeOf((*MockImpersonationRepositoryInterface)(nil).RecordRequest), request)
+}
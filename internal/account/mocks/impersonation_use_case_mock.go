@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: impersonation_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=impersonation_use_case.go -destination=../mocks/impersonation_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+	auth "trilha-api/internal/shared/auth"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImpersonationUseCaseInterface is a mock of ImpersonationUseCaseInterface interface.
+type MockImpersonationUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockImpersonationUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockImpersonationUseCaseInterfaceMockRecorder is the mock recorder for MockImpersonationUseCaseInterface.
+type MockImpersonationUseCaseInterfaceMockRecorder struct {
+	mock *MockImpersonationUseCaseInterface
+}
+
+// NewMockImpersonationUseCaseInterface creates a new mock instance.
+func NewMockImpersonationUseCaseInterface(ctrl *gomock.Controller) *MockImpersonationUseCaseInterface {
+	mock := &MockImpersonationUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockImpersonationUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImpersonationUseCaseInterface) EXPECT() *MockImpersonationUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// End mocks base method.
+func (m *MockImpersonationUseCaseInterface) End(impersonation *entity.ImpersonationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "End", impersonation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// End indicates an expected call of End.
+func (mr *MockImpersonationUseCaseInterfaceMockRecorder) End(impersonation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "End", reflect.TypeOf((*MockImpersonationUseCaseInterface)(nil).End), impersonation)
+}
+
+// Find mocks base method.
+func (m *MockImpersonationUseCaseInterface) Find(impersonation *entity.ImpersonationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", impersonation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockImpersonationUseCaseInterfaceMockRecorder) Find(impersonation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockImpersonationUseCaseInterface)(nil).Find), impersonation)
+}
+
+// ListRequests mocks base method.
+func (m *MockImpersonationUseCaseInterface) ListRequests(impersonation *entity.ImpersonationEntity) ([]entity.ImpersonationRequestEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequests", impersonation)
+	ret0, _ := ret[0].([]entity.ImpersonationRequestEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRequests indicates an expected call of ListRequests.
+func (mr *MockImpersonationUseCaseInterfaceMockRecorder) ListRequests(impersonation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequests", reflect.TypeOf((*MockImpersonationUseCaseInterface)(nil).ListRequests), impersonation)
+}
+
+// RecordImpersonatedRequest mocks base method.
+func (m *MockImpersonationUseCaseInterface) RecordImpersonatedRequest(request auth.ImpersonatedRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordImpersonatedRequest", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordImpersonatedRequest indicates an expected call of RecordImpersonatedRequest.
+func (mr *MockImpersonationUseCaseInterfaceMockRecorder) RecordImpersonatedRequest(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordImpersonatedRequest", reflect.TypeOf((*MockImpersonationUseCaseInterface)(nil).RecordImpersonatedRequest), request)
+}
+
+// Start mocks base method.
+func (m *MockImpersonationUseCaseInterface) Start(impersonation *entity.ImpersonationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", impersonation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockImpersonationUseCaseInterfaceMockRecorder) Start(impersonation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockImpersonationUseCaseInterface)(nil).Start), impersonation)
+}
+
+// VerifyImpersonation mocks base method.
+func (m *MockImpersonationUseCaseInterface) VerifyImpersonation(principal *auth.Principal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyImpersonation", principal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyImpersonation indicates an expected call of VerifyImpersonation.
+func (mr *MockImpersonationUseCaseInterfaceMockRecorder) VerifyImpersonation(principal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyImpersonation", reflect.TypeOf((*MockImpersonationUseCaseInterface)(nil).VerifyImpersonation), principal)
+}
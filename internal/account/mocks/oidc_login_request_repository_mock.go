@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc_login_request_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oidc_login_request_repository.go -destination=../mocks/oidc_login_request_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOIDCLoginRequestRepositoryInterface is a mock of OIDCLoginRequestRepositoryInterface interface.
+type MockOIDCLoginRequestRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCLoginRequestRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockOIDCLoginRequestRepositoryInterfaceMockRecorder is the mock recorder for MockOIDCLoginRequestRepositoryInterface.
+type MockOIDCLoginRequestRepositoryInterfaceMockRecorder struct {
+	mock *MockOIDCLoginRequestRepositoryInterface
+}
+
+// NewMockOIDCLoginRequestRepositoryInterface creates a new mock instance.
+func NewMockOIDCLoginRequestRepositoryInterface(ctrl *gomock.Controller) *MockOIDCLoginRequestRepositoryInterface {
+	mock := &MockOIDCLoginRequestRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockOIDCLoginRequestRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCLoginRequestRepositoryInterface) EXPECT() *MockOIDCLoginRequestRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockOIDCLoginRequestRepositoryInterface) Consume(request *entity.OIDCLoginRequestEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockOIDCLoginRequestRepositoryInterfaceMockRecorder) Consume(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockOIDCLoginRequestRepositoryInterface)(nil).Consume), request)
+}
+
+// Create mocks base method.
+func (m *MockOIDCLoginRequestRepositoryInterface) Create(request *entity.OIDCLoginRequestEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOIDCLoginRequestRepositoryInterfaceMockRecorder) Create(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOIDCLoginRequestRepositoryInterface)(nil).Create), request)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockOIDCLoginRequestRepositoryInterface) DeleteExpired() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockOIDCLoginRequestRepositoryInterfaceMockRecorder) DeleteExpired() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockOIDCLoginRequestRepositoryInterface)(nil).DeleteExpired))
+}
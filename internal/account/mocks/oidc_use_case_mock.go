@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oidc_use_case.go -destination=../mocks/oidc_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOIDCUseCaseInterface is a mock of OIDCUseCaseInterface interface.
+type MockOIDCUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockOIDCUseCaseInterfaceMockRecorder is the mock recorder for MockOIDCUseCaseInterface.
+type MockOIDCUseCaseInterfaceMockRecorder struct {
+	mock *MockOIDCUseCaseInterface
+}
+
+// NewMockOIDCUseCaseInterface creates a new mock instance.
+func NewMockOIDCUseCaseInterface(ctrl *gomock.Controller) *MockOIDCUseCaseInterface {
+	mock := &MockOIDCUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockOIDCUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCUseCaseInterface) EXPECT() *MockOIDCUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockOIDCUseCaseInterface) Authorize(provider string) (*entity.OIDCAuthorizationEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", provider)
+	ret0, _ := ret[0].(*entity.OIDCAuthorizationEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockOIDCUseCaseInterfaceMockRecorder) Authorize(provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockOIDCUseCaseInterface)(nil).Authorize), provider)
+}
+
+// SignIn mocks base method.
+func (m *MockOIDCUseCaseInterface) SignIn(account *entity.AccountEntity, provider, state, code string, client entity.ClientEntity) (*entity.SignInEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignIn", account, provider, state, code, client)
+	ret0, _ := ret[0].(*entity.SignInEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignIn indicates an expected call of SignIn.
+func (mr *MockOIDCUseCaseInterfaceMockRecorder) SignIn(account, provider, state, code, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockOIDCUseCaseInterface)(nil).SignIn), account, provider, state, code, client)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: passkey_challenge_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=passkey_challenge_repository.go -destination=../mocks/passkey_challenge_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasskeyChallengeRepositoryInterface is a mock of PasskeyChallengeRepositoryInterface interface.
+type MockPasskeyChallengeRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasskeyChallengeRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPasskeyChallengeRepositoryInterfaceMockRecorder is the mock recorder for MockPasskeyChallengeRepositoryInterface.
+type MockPasskeyChallengeRepositoryInterfaceMockRecorder struct {
+	mock *MockPasskeyChallengeRepositoryInterface
+}
+
+// NewMockPasskeyChallengeRepositoryInterface creates a new mock instance.
+func NewMockPasskeyChallengeRepositoryInterface(ctrl *gomock.Controller) *MockPasskeyChallengeRepositoryInterface {
+	mock := &MockPasskeyChallengeRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPasskeyChallengeRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasskeyChallengeRepositoryInterface) EXPECT() *MockPasskeyChallengeRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockPasskeyChallengeRepositoryInterface) Consume(challenge *entity.PasskeyChallengeEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockPasskeyChallengeRepositoryInterfaceMockRecorder) Consume(challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockPasskeyChallengeRepositoryInterface)(nil).Consume), challenge)
+}
+
+// Create mocks base method.
+func (m *MockPasskeyChallengeRepositoryInterface) Create(challenge *entity.PasskeyChallengeEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPasskeyChallengeRepositoryInterfaceMockRecorder) Create(challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPasskeyChallengeRepositoryInterface)(nil).Create), challenge)
+}
+
+// DeleteAllByAccount mocks base method.
+func (m *MockPasskeyChallengeRepositoryInterface) DeleteAllByAccount(accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllByAccount", accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllByAccount indicates an expected call of DeleteAllByAccount.
+func (mr *MockPasskeyChallengeRepositoryInterfaceMockRecorder) DeleteAllByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllByAccount", reflect.TypeOf((*MockPasskeyChallengeRepositoryInterface)(nil).DeleteAllByAccount), accountID)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockPasskeyChallengeRepositoryInterface) DeleteExpired() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockPasskeyChallengeRepositoryInterfaceMockRecorder) DeleteExpired() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockPasskeyChallengeRepositoryInterface)(nil).DeleteExpired))
+}
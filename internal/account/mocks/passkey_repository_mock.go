@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: passkey_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=passkey_repository.go -destination=../mocks/passkey_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasskeyRepositoryInterface is a mock of PasskeyRepositoryInterface interface.
+type MockPasskeyRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasskeyRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPasskeyRepositoryInterfaceMockRecorder is the mock recorder for MockPasskeyRepositoryInterface.
+type MockPasskeyRepositoryInterfaceMockRecorder struct {
+	mock *MockPasskeyRepositoryInterface
+}
+
+// NewMockPasskeyRepositoryInterface creates a new mock instance.
+func NewMockPasskeyRepositoryInterface(ctrl *gomock.Controller) *MockPasskeyRepositoryInterface {
+	mock := &MockPasskeyRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPasskeyRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasskeyRepositoryInterface) EXPECT() *MockPasskeyRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPasskeyRepositoryInterface) Create(passkey *entity.PasskeyEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", passkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPasskeyRepositoryInterfaceMockRecorder) Create(passkey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPasskeyRepositoryInterface)(nil).Create), passkey)
+}
+
+// Delete mocks base method.
+func (m *MockPasskeyRepositoryInterface) Delete(passkey *entity.PasskeyEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", passkey)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPasskeyRepositoryInterfaceMockRecorder) Delete(passkey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPasskeyRepositoryInterface)(nil).Delete), passkey)
+}
+
+// DeleteAllByAccount mocks base method.
+func (m *MockPasskeyRepositoryInterface) DeleteAllByAccount(accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllByAccount", accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllByAccount indicates an expected call of DeleteAllByAccount.
+func (mr *MockPasskeyRepositoryInterfaceMockRecorder) DeleteAllByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllByAccount", reflect.TypeOf((*MockPasskeyRepositoryInterface)(nil).DeleteAllByAccount), accountID)
+}
+
+// ListByAccount mocks base method.
+func (m *MockPasskeyRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.PasskeyEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.PasskeyEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockPasskeyRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockPasskeyRepositoryInterface)(nil).ListByAccount), accountID)
+}
+
+// UpdateUsage mocks base method.
+func (m *MockPasskeyRepositoryInterface) UpdateUsage(passkey *entity.PasskeyEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsage", passkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUsage indicates an expected call of UpdateUsage.
+func (mr *MockPasskeyRepositoryInterfaceMockRecorder) UpdateUsage(passkey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsage", reflect.TypeOf((*MockPasskeyRepositoryInterface)(nil).UpdateUsage), passkey)
+}
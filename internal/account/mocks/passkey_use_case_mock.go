@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: passkey_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=passkey_use_case.go -destination=../mocks/passkey_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasskeyUseCaseInterface is a mock of PasskeyUseCaseInterface interface.
+type MockPasskeyUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasskeyUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPasskeyUseCaseInterfaceMockRecorder is the mock recorder for MockPasskeyUseCaseInterface.
+type MockPasskeyUseCaseInterfaceMockRecorder struct {
+	mock *MockPasskeyUseCaseInterface
+}
+
+// NewMockPasskeyUseCaseInterface creates a new mock instance.
+func NewMockPasskeyUseCaseInterface(ctrl *gomock.Controller) *MockPasskeyUseCaseInterface {
+	mock := &MockPasskeyUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockPasskeyUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasskeyUseCaseInterface) EXPECT() *MockPasskeyUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// BeginRegistration mocks base method.
+func (m *MockPasskeyUseCaseInterface) BeginRegistration(account *entity.AccountEntity) (*entity.PasskeyOptionsEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginRegistration", account)
+	ret0, _ := ret[0].(*entity.PasskeyOptionsEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginRegistration indicates an expected call of BeginRegistration.
+func (mr *MockPasskeyUseCaseInterfaceMockRecorder) BeginRegistration(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginRegistration", reflect.TypeOf((*MockPasskeyUseCaseInterface)(nil).BeginRegistration), account)
+}
+
+// BeginSignIn mocks base method.
+func (m *MockPasskeyUseCaseInterface) BeginSignIn() (*entity.PasskeyOptionsEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginSignIn")
+	ret0, _ := ret[0].(*entity.PasskeyOptionsEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginSignIn indicates an expected call of BeginSignIn.
+func (mr *MockPasskeyUseCaseInterfaceMockRecorder) BeginSignIn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginSignIn", reflect.TypeOf((*MockPasskeyUseCaseInterface)(nil).BeginSignIn))
+}
+
+// Delete mocks base method.
+func (m *MockPasskeyUseCaseInterface) Delete(passkey *entity.PasskeyEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", passkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPasskeyUseCaseInterfaceMockRecorder) Delete(passkey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPasskeyUseCaseInterface)(nil).Delete), passkey)
+}
+
+// FinishRegistration mocks base method.
+func (m *MockPasskeyUseCaseInterface) FinishRegistration(passkey *entity.PasskeyEntity, challengeID uuid.UUID, response []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRegistration", passkey, challengeID, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishRegistration indicates an expected call of FinishRegistration.
+func (mr *MockPasskeyUseCaseInterfaceMockRecorder) FinishRegistration(passkey, challengeID, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRegistration", reflect.TypeOf((*MockPasskeyUseCaseInterface)(nil).FinishRegistration), passkey, challengeID, response)
+}
+
+// FinishSignIn mocks base method.
+func (m *MockPasskeyUseCaseInterface) FinishSignIn(account *entity.AccountEntity, challengeID uuid.UUID, response []byte, client entity.ClientEntity) (*entity.SignInEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishSignIn", account, challengeID, response, client)
+	ret0, _ := ret[0].(*entity.SignInEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishSignIn indicates an expected call of FinishSignIn.
+func (mr *MockPasskeyUseCaseInterfaceMockRecorder) FinishSignIn(account, challengeID, response, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishSignIn", reflect.TypeOf((*MockPasskeyUseCaseInterface)(nil).FinishSignIn), account, challengeID, response, client)
+}
+
+// List mocks base method.
+func (m *MockPasskeyUseCaseInterface) List(account *entity.AccountEntity) ([]entity.PasskeyEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", account)
+	ret0, _ := ret[0].([]entity.PasskeyEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPasskeyUseCaseInterfaceMockRecorder) List(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPasskeyUseCaseInterface)(nil).List), account)
+}
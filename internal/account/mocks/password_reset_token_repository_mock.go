@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: password_reset_token_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=password_reset_token_repository.go -destination=../mocks/password_reset_token_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasswordResetTokenRepositoryInterface is a mock of PasswordResetTokenRepositoryInterface interface.
+type MockPasswordResetTokenRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetTokenRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPasswordResetTokenRepositoryInterfaceMockRecorder is the mock recorder for MockPasswordResetTokenRepositoryInterface.
+type MockPasswordResetTokenRepositoryInterfaceMockRecorder struct {
+	mock *MockPasswordResetTokenRepositoryInterface
+}
+
+// NewMockPasswordResetTokenRepositoryInterface creates a new mock instance.
+func NewMockPasswordResetTokenRepositoryInterface(ctrl *gomock.Controller) *MockPasswordResetTokenRepositoryInterface {
+	mock := &MockPasswordResetTokenRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetTokenRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetTokenRepositoryInterface) EXPECT() *MockPasswordResetTokenRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPasswordResetTokenRepositoryInterface) Create(token *entity.PasswordResetTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPasswordResetTokenRepositoryInterfaceMockRecorder) Create(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPasswordResetTokenRepositoryInterface)(nil).Create), token)
+}
+
+// FindByHash mocks base method.
+func (m *MockPasswordResetTokenRepositoryInterface) FindByHash(token *entity.PasswordResetTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockPasswordResetTokenRepositoryInterfaceMockRecorder) FindByHash(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockPasswordResetTokenRepositoryInterface)(nil).FindByHash), token)
+}
+
+// InvalidateAllByAccount mocks base method.
+func (m *MockPasswordResetTokenRepositoryInterface) InvalidateAllByAccount(accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateAllByAccount", accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateAllByAccount indicates an expected call of InvalidateAllByAccount.
+func (mr *MockPasswordResetTokenRepositoryInterfaceMockRecorder) InvalidateAllByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateAllByAccount", reflect.TypeOf((*MockPasswordResetTokenRepositoryInterface)(nil).InvalidateAllByAccount), accountID)
+}
+
+// MarkUsed mocks base method.
+func (m *MockPasswordResetTokenRepositoryInterface) MarkUsed(token *entity.PasswordResetTokenEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockPasswordResetTokenRepositoryInterfaceMockRecorder) MarkUsed(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockPasswordResetTokenRepositoryInterface)(nil).MarkUsed), token)
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: password_reset_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=password_reset_use_case.go -destination=../mocks/password_reset_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasswordResetUseCaseInterface is a mock of PasswordResetUseCaseInterface interface.
+type MockPasswordResetUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPasswordResetUseCaseInterfaceMockRecorder is the mock recorder for MockPasswordResetUseCaseInterface.
+type MockPasswordResetUseCaseInterfaceMockRecorder struct {
+	mock *MockPasswordResetUseCaseInterface
+}
+
+// NewMockPasswordResetUseCaseInterface creates a new mock instance.
+func NewMockPasswordResetUseCaseInterface(ctrl *gomock.Controller) *MockPasswordResetUseCaseInterface {
+	mock := &MockPasswordResetUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetUseCaseInterface) EXPECT() *MockPasswordResetUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ForgotPassword mocks base method.
+func (m *MockPasswordResetUseCaseInterface) ForgotPassword(email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockPasswordResetUseCaseInterfaceMockRecorder) ForgotPassword(email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockPasswordResetUseCaseInterface)(nil).ForgotPassword), email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockPasswordResetUseCaseInterface) ResetPassword(token, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", token, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockPasswordResetUseCaseInterfaceMockRecorder) ResetPassword(token, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockPasswordResetUseCaseInterface)(nil).ResetPassword), token, newPassword)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: personal_access_token_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=personal_access_token_repository.go -destination=../mocks/personal_access_token_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPersonalAccessTokenRepositoryInterface is a mock of PersonalAccessTokenRepositoryInterface interface.
+type MockPersonalAccessTokenRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersonalAccessTokenRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPersonalAccessTokenRepositoryInterfaceMockRecorder is the mock recorder for MockPersonalAccessTokenRepositoryInterface.
+type MockPersonalAccessTokenRepositoryInterfaceMockRecorder struct {
+	mock *MockPersonalAccessTokenRepositoryInterface
+}
+
+// NewMockPersonalAccessTokenRepositoryInterface creates a new mock instance.
+func NewMockPersonalAccessTokenRepositoryInterface(ctrl *gomock.Controller) *MockPersonalAccessTokenRepositoryInterface {
+	mock := &MockPersonalAccessTokenRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPersonalAccessTokenRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersonalAccessTokenRepositoryInterface) EXPECT() *MockPersonalAccessTokenRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPersonalAccessTokenRepositoryInterface) Create(token *entity.PersonalAccessTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPersonalAccessTokenRepositoryInterfaceMockRecorder) Create(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPersonalAccessTokenRepositoryInterface)(nil).Create), token)
+}
+
+// FindByHash mocks base method.
+func (m *MockPersonalAccessTokenRepositoryInterface) FindByHash(token *entity.PersonalAccessTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockPersonalAccessTokenRepositoryInterfaceMockRecorder) FindByHash(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockPersonalAccessTokenRepositoryInterface)(nil).FindByHash), token)
+}
+
+// ListByAccount mocks base method.
+func (m *MockPersonalAccessTokenRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.PersonalAccessTokenEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.PersonalAccessTokenEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockPersonalAccessTokenRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockPersonalAccessTokenRepositoryInterface)(nil).ListByAccount), accountID)
+}
+
+// Revoke mocks base method.
+func (m *MockPersonalAccessTokenRepositoryInterface) Revoke(token *entity.PersonalAccessTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockPersonalAccessTokenRepositoryInterfaceMockRecorder) Revoke(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockPersonalAccessTokenRepositoryInterface)(nil).Revoke), token)
+}
+
+// RevokeAllByAccount mocks base method.
+func (m *MockPersonalAccessTokenRepositoryInterface) RevokeAllByAccount(accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByAccount", accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllByAccount indicates an expected call of RevokeAllByAccount.
+func (mr *MockPersonalAccessTokenRepositoryInterfaceMockRecorder) RevokeAllByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByAccount", reflect.TypeOf((*MockPersonalAccessTokenRepositoryInterface)(nil).RevokeAllByAccount), accountID)
+}
+
+// Touch mocks base method.
+func (m *MockPersonalAccessTokenRepositoryInterface) Touch(token *entity.PersonalAccessTokenEntity, usedBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", token, usedBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockPersonalAccessTokenRepositoryInterfaceMockRecorder) Touch(token, usedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockPersonalAccessTokenRepositoryInterface)(nil).Touch), token, usedBefore)
+}
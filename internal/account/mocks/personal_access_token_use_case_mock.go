@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: personal_access_token_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=personal_access_token_use_case.go -destination=../mocks/personal_access_token_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+	auth "trilha-api/internal/shared/auth"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPersonalAccessTokenUseCaseInterface is a mock of PersonalAccessTokenUseCaseInterface interface.
+type MockPersonalAccessTokenUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersonalAccessTokenUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPersonalAccessTokenUseCaseInterfaceMockRecorder is the mock recorder for MockPersonalAccessTokenUseCaseInterface.
+type MockPersonalAccessTokenUseCaseInterfaceMockRecorder struct {
+	mock *MockPersonalAccessTokenUseCaseInterface
+}
+
+// NewMockPersonalAccessTokenUseCaseInterface creates a new mock instance.
+func NewMockPersonalAccessTokenUseCaseInterface(ctrl *gomock.Controller) *MockPersonalAccessTokenUseCaseInterface {
+	mock := &MockPersonalAccessTokenUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockPersonalAccessTokenUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersonalAccessTokenUseCaseInterface) EXPECT() *MockPersonalAccessTokenUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticatePersonalAccessToken mocks base method.
+func (m *MockPersonalAccessTokenUseCaseInterface) AuthenticatePersonalAccessToken(token string) (*auth.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticatePersonalAccessToken", token)
+	ret0, _ := ret[0].(*auth.Principal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticatePersonalAccessToken indicates an expected call of AuthenticatePersonalAccessToken.
+func (mr *MockPersonalAccessTokenUseCaseInterfaceMockRecorder) AuthenticatePersonalAccessToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticatePersonalAccessToken", reflect.TypeOf((*MockPersonalAccessTokenUseCaseInterface)(nil).AuthenticatePersonalAccessToken), token)
+}
+
+// Create mocks base method.
+func (m *MockPersonalAccessTokenUseCaseInterface) Create(token *entity.PersonalAccessTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPersonalAccessTokenUseCaseInterfaceMockRecorder) Create(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPersonalAccessTokenUseCaseInterface)(nil).Create), token)
+}
+
+// List mocks base method.
+func (m *MockPersonalAccessTokenUseCaseInterface) List(accountID uuid.UUID) ([]entity.PersonalAccessTokenEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", accountID)
+	ret0, _ := ret[0].([]entity.PersonalAccessTokenEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPersonalAccessTokenUseCaseInterfaceMockRecorder) List(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPersonalAccessTokenUseCaseInterface)(nil).List), accountID)
+}
+
+// Revoke mocks base method.
+func (m *MockPersonalAccessTokenUseCaseInterface) Revoke(token *entity.PersonalAccessTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockPersonalAccessTokenUseCaseInterfaceMockRecorder) Revoke(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockPersonalAccessTokenUseCaseInterface)(nil).Revoke), token)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recovery_code_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=recovery_code_repository.go -destination=../mocks/recovery_code_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecoveryCodeRepositoryInterface is a mock of RecoveryCodeRepositoryInterface interface.
+type MockRecoveryCodeRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecoveryCodeRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRecoveryCodeRepositoryInterfaceMockRecorder is the mock recorder for MockRecoveryCodeRepositoryInterface.
+type MockRecoveryCodeRepositoryInterfaceMockRecorder struct {
+	mock *MockRecoveryCodeRepositoryInterface
+}
+
+// NewMockRecoveryCodeRepositoryInterface creates a new mock instance.
+func NewMockRecoveryCodeRepositoryInterface(ctrl *gomock.Controller) *MockRecoveryCodeRepositoryInterface {
+	mock := &MockRecoveryCodeRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockRecoveryCodeRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecoveryCodeRepositoryInterface) EXPECT() *MockRecoveryCodeRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CountUnused mocks base method.
+func (m *MockRecoveryCodeRepositoryInterface) CountUnused(accountID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnused", accountID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnused indicates an expected call of CountUnused.
+func (mr *MockRecoveryCodeRepositoryInterfaceMockRecorder) CountUnused(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnused", reflect.TypeOf((*MockRecoveryCodeRepositoryInterface)(nil).CountUnused), accountID)
+}
+
+// DeleteAllByAccount mocks base method.
+func (m *MockRecoveryCodeRepositoryInterface) DeleteAllByAccount(accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllByAccount", accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllByAccount indicates an expected call of DeleteAllByAccount.
+func (mr *MockRecoveryCodeRepositoryInterfaceMockRecorder) DeleteAllByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllByAccount", reflect.TypeOf((*MockRecoveryCodeRepositoryInterface)(nil).DeleteAllByAccount), accountID)
+}
+
+// ReplaceAll mocks base method.
+func (m *MockRecoveryCodeRepositoryInterface) ReplaceAll(accountID uuid.UUID, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAll", accountID, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceAll indicates an expected call of ReplaceAll.
+func (mr *MockRecoveryCodeRepositoryInterfaceMockRecorder) ReplaceAll(accountID, codeHashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAll", reflect.TypeOf((*MockRecoveryCodeRepositoryInterface)(nil).ReplaceAll), accountID, codeHashes)
+}
+
+// Summarize mocks base method.
+func (m *MockRecoveryCodeRepositoryInterface) Summarize(accountID uuid.UUID) (entity.RecoveryCodesEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summarize", accountID)
+	ret0, _ := ret[0].(entity.RecoveryCodesEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summarize indicates an expected call of Summarize.
+func (mr *MockRecoveryCodeRepositoryInterfaceMockRecorder) Summarize(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockRecoveryCodeRepositoryInterface)(nil).Summarize), accountID)
+}
+
+// Use mocks base method.
+func (m *MockRecoveryCodeRepositoryInterface) Use(accountID uuid.UUID, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Use", accountID, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockRecoveryCodeRepositoryInterfaceMockRecorder) Use(accountID, codeHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockRecoveryCodeRepositoryInterface)(nil).Use), accountID, codeHash)
+}
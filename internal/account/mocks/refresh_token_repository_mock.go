@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: refresh_token_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=refresh_token_repository.go -destination=../mocks/refresh_token_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTokenRepositoryInterface is a mock of RefreshTokenRepositoryInterface interface.
+type MockRefreshTokenRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokenRepositoryInterfaceMockRecorder is the mock recorder for MockRefreshTokenRepositoryInterface.
+type MockRefreshTokenRepositoryInterfaceMockRecorder struct {
+	mock *MockRefreshTokenRepositoryInterface
+}
+
+// NewMockRefreshTokenRepositoryInterface creates a new mock instance.
+func NewMockRefreshTokenRepositoryInterface(ctrl *gomock.Controller) *MockRefreshTokenRepositoryInterface {
+	mock := &MockRefreshTokenRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenRepositoryInterface) EXPECT() *MockRefreshTokenRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRefreshTokenRepositoryInterface) Create(token *entity.RefreshTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) Create(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).Create), token)
+}
+
+// FindByHash mocks base method.
+func (m *MockRefreshTokenRepositoryInterface) FindByHash(token *entity.RefreshTokenEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) FindByHash(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).FindByHash), token)
+}
+
+// Revoke mocks base method.
+func (m *MockRefreshTokenRepositoryInterface) Revoke(token *entity.RefreshTokenEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) Revoke(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).Revoke), token)
+}
+
+// RevokeAllByAccount mocks base method.
+func (m *MockRefreshTokenRepositoryInterface) RevokeAllByAccount(accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByAccount", accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllByAccount indicates an expected call of RevokeAllByAccount.
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) RevokeAllByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByAccount", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).RevokeAllByAccount), accountID)
+}
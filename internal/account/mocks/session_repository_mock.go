@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: session_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=session_repository.go -destination=../mocks/session_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSessionRepositoryInterface is a mock of SessionRepositoryInterface interface.
+type MockSessionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionRepositoryInterfaceMockRecorder is the mock recorder for MockSessionRepositoryInterface.
+type MockSessionRepositoryInterfaceMockRecorder struct {
+	mock *MockSessionRepositoryInterface
+}
+
+// NewMockSessionRepositoryInterface creates a new mock instance.
+func NewMockSessionRepositoryInterface(ctrl *gomock.Controller) *MockSessionRepositoryInterface {
+	mock := &MockSessionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepositoryInterface) EXPECT() *MockSessionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSessionRepositoryInterface) Create(session *entity.SessionEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) Create(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).Create), session)
+}
+
+// ListByAccount mocks base method.
+func (m *MockSessionRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.SessionEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.SessionEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).ListByAccount), accountID)
+}
+
+// Revoke mocks base method.
+func (m *MockSessionRepositoryInterface) Revoke(session *entity.SessionEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", session)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) Revoke(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).Revoke), session)
+}
+
+// RevokeAllByAccount mocks base method.
+func (m *MockSessionRepositoryInterface) RevokeAllByAccount(accountID, exceptID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByAccount", accountID, exceptID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllByAccount indicates an expected call of RevokeAllByAccount.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) RevokeAllByAccount(accountID, exceptID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByAccount", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).RevokeAllByAccount), accountID, exceptID)
+}
+
+// Touch mocks base method.
+func (m *MockSessionRepositoryInterface) Touch(session *entity.SessionEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", session)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockSessionRepositoryInterfaceMockRecorder) Touch(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockSessionRepositoryInterface)(nil).Touch), session)
+}
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: session_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=session_use_case.go -destination=../mocks/session_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSessionUseCaseInterface is a mock of SessionUseCaseInterface interface.
+type MockSessionUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionUseCaseInterfaceMockRecorder is the mock recorder for MockSessionUseCaseInterface.
+type MockSessionUseCaseInterfaceMockRecorder struct {
+	mock *MockSessionUseCaseInterface
+}
+
+// NewMockSessionUseCaseInterface creates a new mock instance.
+func NewMockSessionUseCaseInterface(ctrl *gomock.Controller) *MockSessionUseCaseInterface {
+	mock := &MockSessionUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockSessionUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionUseCaseInterface) EXPECT() *MockSessionUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockSessionUseCaseInterface) Issue(account *entity.AccountEntity, client entity.ClientEntity) (*entity.AuthTokensEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", account, client)
+	ret0, _ := ret[0].(*entity.AuthTokensEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockSessionUseCaseInterfaceMockRecorder) Issue(account, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockSessionUseCaseInterface)(nil).Issue), account, client)
+}
+
+// List mocks base method.
+func (m *MockSessionUseCaseInterface) List(account *entity.AccountEntity) ([]entity.SessionEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", account)
+	ret0, _ := ret[0].([]entity.SessionEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSessionUseCaseInterfaceMockRecorder) List(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSessionUseCaseInterface)(nil).List), account)
+}
+
+// Refresh mocks base method.
+func (m *MockSessionUseCaseInterface) Refresh(refreshToken string, client entity.ClientEntity) (*entity.AuthTokensEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", refreshToken, client)
+	ret0, _ := ret[0].(*entity.AuthTokensEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockSessionUseCaseInterfaceMockRecorder) Refresh(refreshToken, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockSessionUseCaseInterface)(nil).Refresh), refreshToken, client)
+}
+
+// Revoke mocks base method.
+func (m *MockSessionUseCaseInterface) Revoke(refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionUseCaseInterfaceMockRecorder) Revoke(refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionUseCaseInterface)(nil).Revoke), refreshToken)
+}
+
+// RevokeAll mocks base method.
+func (m *MockSessionUseCaseInterface) RevokeAll(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAll", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockSessionUseCaseInterfaceMockRecorder) RevokeAll(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockSessionUseCaseInterface)(nil).RevokeAll), account)
+}
+
+// RevokeOthers mocks base method.
+func (m *MockSessionUseCaseInterface) RevokeOthers(account *entity.AccountEntity, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOthers", account, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeOthers indicates an expected call of RevokeOthers.
+func (mr *MockSessionUseCaseInterfaceMockRecorder) RevokeOthers(account, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOthers", reflect.TypeOf((*MockSessionUseCaseInterface)(nil).RevokeOthers), account, sessionID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockSessionUseCaseInterface) RevokeSession(session *entity.SessionEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionUseCaseInterfaceMockRecorder) RevokeSession(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionUseCaseInterface)(nil).RevokeSession), session)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sign_in_throttle_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sign_in_throttle_repository.go -destination=../mocks/sign_in_throttle_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+	entity "trilha-api/internal/account/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSignInThrottleRepositoryInterface is a mock of SignInThrottleRepositoryInterface interface.
+type MockSignInThrottleRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignInThrottleRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockSignInThrottleRepositoryInterfaceMockRecorder is the mock recorder for MockSignInThrottleRepositoryInterface.
+type MockSignInThrottleRepositoryInterfaceMockRecorder struct {
+	mock *MockSignInThrottleRepositoryInterface
+}
+
+// NewMockSignInThrottleRepositoryInterface creates a new mock instance.
+func NewMockSignInThrottleRepositoryInterface(ctrl *gomock.Controller) *MockSignInThrottleRepositoryInterface {
+	mock := &MockSignInThrottleRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockSignInThrottleRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignInThrottleRepositoryInterface) EXPECT() *MockSignInThrottleRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockSignInThrottleRepositoryInterface) Clear(throttle *entity.SignInThrottleEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", throttle)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockSignInThrottleRepositoryInterfaceMockRecorder) Clear(throttle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockSignInThrottleRepositoryInterface)(nil).Clear), throttle)
+}
+
+// Find mocks base method.
+func (m *MockSignInThrottleRepositoryInterface) Find(throttle *entity.SignInThrottleEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", throttle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSignInThrottleRepositoryInterfaceMockRecorder) Find(throttle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSignInThrottleRepositoryInterface)(nil).Find), throttle)
+}
+
+// Lock mocks base method.
+func (m *MockSignInThrottleRepositoryInterface) Lock(throttle *entity.SignInThrottleEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", throttle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockSignInThrottleRepositoryInterfaceMockRecorder) Lock(throttle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockSignInThrottleRepositoryInterface)(nil).Lock), throttle)
+}
+
+// RecordFailure mocks base method.
+func (m *MockSignInThrottleRepositoryInterface) RecordFailure(throttle *entity.SignInThrottleEntity, resetBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", throttle, resetBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockSignInThrottleRepositoryInterfaceMockRecorder) RecordFailure(throttle, resetBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockSignInThrottleRepositoryInterface)(nil).RecordFailure), throttle, resetBefore)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sign_in_throttle_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sign_in_throttle_use_case.go -destination=../mocks/sign_in_throttle_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSignInThrottleUseCaseInterface is a mock of SignInThrottleUseCaseInterface interface.
+type MockSignInThrottleUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignInThrottleUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockSignInThrottleUseCaseInterfaceMockRecorder is the mock recorder for MockSignInThrottleUseCaseInterface.
+type MockSignInThrottleUseCaseInterfaceMockRecorder struct {
+	mock *MockSignInThrottleUseCaseInterface
+}
+
+// NewMockSignInThrottleUseCaseInterface creates a new mock instance.
+func NewMockSignInThrottleUseCaseInterface(ctrl *gomock.Controller) *MockSignInThrottleUseCaseInterface {
+	mock := &MockSignInThrottleUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockSignInThrottleUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignInThrottleUseCaseInterface) EXPECT() *MockSignInThrottleUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockSignInThrottleUseCaseInterface) Check(email, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", email, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockSignInThrottleUseCaseInterfaceMockRecorder) Check(email, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockSignInThrottleUseCaseInterface)(nil).Check), email, ip)
+}
+
+// Clear mocks base method.
+func (m *MockSignInThrottleUseCaseInterface) Clear(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockSignInThrottleUseCaseInterfaceMockRecorder) Clear(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockSignInThrottleUseCaseInterface)(nil).Clear), account)
+}
+
+// RecordFailure mocks base method.
+func (m *MockSignInThrottleUseCaseInterface) RecordFailure(email, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", email, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockSignInThrottleUseCaseInterfaceMockRecorder) RecordFailure(email, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockSignInThrottleUseCaseInterface)(nil).RecordFailure), email, ip)
+}
+
+// RecordSuccess mocks base method.
+func (m *MockSignInThrottleUseCaseInterface) RecordSuccess(email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSuccess", email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSuccess indicates an expected call of RecordSuccess.
+func (mr *MockSignInThrottleUseCaseInterfaceMockRecorder) RecordSuccess(email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSuccess", reflect.TypeOf((*MockSignInThrottleUseCaseInterface)(nil).RecordSuccess), email)
+}
+
+// Unlock mocks base method.
+func (m *MockSignInThrottleUseCaseInterface) Unlock(token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockSignInThrottleUseCaseInterfaceMockRecorder) Unlock(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockSignInThrottleUseCaseInterface)(nil).Unlock), token)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: two_factor_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=two_factor_use_case.go -destination=../mocks/two_factor_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTwoFactorUseCaseInterface is a mock of TwoFactorUseCaseInterface interface.
+type MockTwoFactorUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwoFactorUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTwoFactorUseCaseInterfaceMockRecorder is the mock recorder for MockTwoFactorUseCaseInterface.
+type MockTwoFactorUseCaseInterfaceMockRecorder struct {
+	mock *MockTwoFactorUseCaseInterface
+}
+
+// NewMockTwoFactorUseCaseInterface creates a new mock instance.
+func NewMockTwoFactorUseCaseInterface(ctrl *gomock.Controller) *MockTwoFactorUseCaseInterface {
+	mock := &MockTwoFactorUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockTwoFactorUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwoFactorUseCaseInterface) EXPECT() *MockTwoFactorUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Challenge mocks base method.
+func (m *MockTwoFactorUseCaseInterface) Challenge(account *entity.AccountEntity) (*entity.TwoFactorChallengeEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Challenge", account)
+	ret0, _ := ret[0].(*entity.TwoFactorChallengeEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Challenge indicates an expected call of Challenge.
+func (mr *MockTwoFactorUseCaseInterfaceMockRecorder) Challenge(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Challenge", reflect.TypeOf((*MockTwoFactorUseCaseInterface)(nil).Challenge), account)
+}
+
+// CompleteSignIn mocks base method.
+func (m *MockTwoFactorUseCaseInterface) CompleteSignIn(account *entity.AccountEntity, challengeToken, code string, client entity.ClientEntity) (*entity.AuthTokensEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSignIn", account, challengeToken, code, client)
+	ret0, _ := ret[0].(*entity.AuthTokensEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteSignIn indicates an expected call of CompleteSignIn.
+func (mr *MockTwoFactorUseCaseInterfaceMockRecorder) CompleteSignIn(account, challengeToken, code, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSignIn", reflect.TypeOf((*MockTwoFactorUseCaseInterface)(nil).CompleteSignIn), account, challengeToken, code, client)
+}
+
+// Confirm mocks base method.
+func (m *MockTwoFactorUseCaseInterface) Confirm(account *entity.AccountEntity, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", account, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockTwoFactorUseCaseInterfaceMockRecorder) Confirm(account, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockTwoFactorUseCaseInterface)(nil).Confirm), account, code)
+}
+
+// Disable mocks base method.
+func (m *MockTwoFactorUseCaseInterface) Disable(account *entity.AccountEntity, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", account, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockTwoFactorUseCaseInterfaceMockRecorder) Disable(account, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockTwoFactorUseCaseInterface)(nil).Disable), account, code)
+}
+
+// Enroll mocks base method.
+func (m *MockTwoFactorUseCaseInterface) Enroll(account *entity.AccountEntity) (*entity.TwoFactorEnrollmentEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", account)
+	ret0, _ := ret[0].(*entity.TwoFactorEnrollmentEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockTwoFactorUseCaseInterfaceMockRecorder) Enroll(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockTwoFactorUseCaseInterface)(nil).Enroll), account)
+}
+
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockTwoFactorUseCaseInterface) RegenerateRecoveryCodes(account *entity.AccountEntity, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", account, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockTwoFactorUseCaseInterfaceMockRecorder) RegenerateRecoveryCodes(account, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockTwoFactorUseCaseInterface)(nil).RegenerateRecoveryCodes), account, code)
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+
+	"github.com/google/uuid"
+)
+
+type AccountIdentityRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=account_identity_repository.go -destination=../mocks/account_identity_repository_mock.go -package=mocks
+
+type AccountIdentityRepositoryInterface interface {
+	Create(identity *entity.AccountIdentityEntity) error
+	FindBySubject(identity *entity.AccountIdentityEntity) error
+	ListByAccount(accountID uuid.UUID) ([]entity.AccountIdentityEntity, error)
+}
+
+func NewAccountIdentityRepository(db db.Querier) *AccountIdentityRepository {
+	return &AccountIdentityRepository{db: db}
+}
+
+func (r *AccountIdentityRepository) Create(identity *entity.AccountIdentityEntity) error {
+	fields := db.CreateAccountIdentityParams{
+		AccountID: identity.AccountID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+	}
+
+	created, err := r.db.CreateAccountIdentity(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao vincular identidade externa: %w", err)
+	}
+
+	*identity = toAccountIdentityEntity(created)
+
+	return nil
+}
+
+// FindBySubject fills identity with the link of identity.Provider and
+// identity.Subject, returning sql.ErrNoRows when there is none.
+func (r *AccountIdentityRepository) FindBySubject(identity *entity.AccountIdentityEntity) error {
+	fields := db.FindAccountIdentityParams{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	}
+
+	found, err := r.db.FindAccountIdentity(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*identity = toAccountIdentityEntity(found)
+
+	return nil
+}
+
+// ListByAccount returns the external identities linked to the account.
+func (r *AccountIdentityRepository) ListByAccount(accountID uuid.UUID) ([]entity.AccountIdentityEntity, error) {
+	rows, err := r.db.ListAccountIdentities(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar identidades externas: %w", err)
+	}
+
+	identities := make([]entity.AccountIdentityEntity, 0, len(rows))
+	for _, row := range rows {
+		identities = append(identities, toAccountIdentityEntity(row))
+	}
+
+	return identities, nil
+}
+
+func toAccountIdentityEntity(identity db.AccountIdentity) entity.AccountIdentityEntity {
+	return entity.AccountIdentityEntity{
+		ID:        identity.ID,
+		AccountID: identity.AccountID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		CreatedAt: identity.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupAccountIdentity(t *testing.T) (*mocks.MockQuerier, *AccountIdentityRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewAccountIdentityRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestAccountIdentityRepository_Create(t *testing.T) {
+	dbMock, repo := setupAccountIdentity(t)
+
+	identity := &entity.AccountIdentityEntity{
+		AccountID: uuid.New(),
+		Provider:  "keycloak",
+		Subject:   "user-123",
+		Email:     "gandalf@lor.com.br",
+	}
+
+	t.Run("should persist the identity", func(t *testing.T) {
+		id := uuid.New()
+
+		dbMock.EXPECT().CreateAccountIdentity(context.Background(), db.CreateAccountIdentityParams{
+			AccountID: identity.AccountID,
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			Email:     identity.Email,
+		}).Return(db.AccountIdentity{
+			ID:        id,
+			AccountID: identity.AccountID,
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			Email:     identity.Email,
+		}, nil)
+
+		err := repo.Create(identity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, identity.ID)
+	})
+
+	t.Run("should return an error when insert fails", func(t *testing.T) {
+		dbMock.EXPECT().CreateAccountIdentity(context.Background(), gomock.Any()).Return(db.AccountIdentity{}, errors.New("database error"))
+
+		err := repo.Create(identity)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAccountIdentityRepository_FindBySubject(t *testing.T) {
+	dbMock, repo := setupAccountIdentity(t)
+
+	t.Run("should fill the linked account", func(t *testing.T) {
+		accountID := uuid.New()
+		identity := &entity.AccountIdentityEntity{Provider: "keycloak", Subject: "user-123"}
+
+		dbMock.EXPECT().FindAccountIdentity(context.Background(), db.FindAccountIdentityParams{
+			Provider: "keycloak",
+			Subject:  "user-123",
+		}).Return(db.AccountIdentity{AccountID: accountID, Provider: "keycloak", Subject: "user-123"}, nil)
+
+		err := repo.FindBySubject(identity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, accountID, identity.AccountID)
+	})
+
+	t.Run("should return sql.ErrNoRows when the identity is not linked", func(t *testing.T) {
+		dbMock.EXPECT().FindAccountIdentity(context.Background(), gomock.Any()).Return(db.AccountIdentity{}, sql.ErrNoRows)
+
+		err := repo.FindBySubject(&entity.AccountIdentityEntity{})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestAccountIdentityRepository_ListByAccount(t *testing.T) {
+	dbMock, repo := setupAccountIdentity(t)
+
+	accountID := uuid.New()
+
+	t.Run("should list the identities of the account", func(t *testing.T) {
+		dbMock.EXPECT().ListAccountIdentities(context.Background(), accountID).Return([]db.AccountIdentity{
+			{ID: uuid.New(), AccountID: accountID, Provider: "keycloak", Subject: "user-123", Email: "gandalf@lor.com.br"},
+		}, nil)
+
+		identities, err := repo.ListByAccount(accountID)
+
+		assert.NoError(t, err)
+		assert.Len(t, identities, 1)
+		assert.Equal(t, "keycloak", identities[0].Provider)
+		assert.Equal(t, "gandalf@lor.com.br", identities[0].Email)
+	})
+
+	t.Run("should wrap errors", func(t *testing.T) {
+		dbMock.EXPECT().ListAccountIdentities(context.Background(), accountID).Return(nil, errors.New("db down"))
+
+		_, err := repo.ListByAccount(accountID)
+
+		assert.Error(t, err)
+	})
+}
@@ -2,13 +2,22 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 	"trilha-api/internal/account/entity"
 	db "trilha-api/internal/shared/database/sqlc"
 	"trilha-api/internal/shared/utils"
+
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// uniqueViolationCode is the Postgres SQLSTATE for unique constraint violations.
+const uniqueViolationCode = "23505"
+
+var ErrEmailAlreadyInUse = errors.New("email already in use")
+
 type AccountRepository struct {
 	db db.Querier
 }
@@ -19,6 +28,25 @@ type AccountRepositoryInterface interface {
 	Register(account *entity.AccountEntity) error
 	Find(account *entity.AccountEntity) error
 	FindByEmail(account *entity.AccountEntity) error
+	Update(account *entity.AccountEntity) error
+	UpdatePassword(account *entity.AccountEntity) error
+	ReplaceAvatar(account *entity.AccountEntity) (string, error)
+	RehashPassword(account *entity.AccountEntity, oldHash string) (bool, error)
+	SoftDelete(account *entity.AccountEntity) error
+	Restore(account *entity.AccountEntity) error
+	MarkVerificationSent(account *entity.AccountEntity, resendBefore time.Time) (bool, error)
+	VerifyEmail(account *entity.AccountEntity) (bool, error)
+	SetTOTPSecret(account *entity.AccountEntity) error
+	EnableTwoFactor(account *entity.AccountEntity) (bool, error)
+	DisableTwoFactor(account *entity.AccountEntity) error
+	UseTOTPStep(account *entity.AccountEntity, step int64) (bool, error)
+	List(filter entity.AccountFilter) ([]entity.AdminAccountEntity, error)
+	Count(filter entity.AccountFilter) (int64, error)
+	FindForAdmin(account *entity.AdminAccountEntity) error
+	Suspend(account *entity.AccountEntity) (bool, error)
+	Reactivate(account *entity.AccountEntity) (bool, error)
+	HardDelete(account *entity.AccountEntity) (string, error)
+	Erase(account *entity.AccountEntity) (string, error)
 }
 
 func New(db db.Querier) *AccountRepository {
@@ -30,15 +58,29 @@ func (r *AccountRepository) Register(account *entity.AccountEntity) error {
 		Name:     account.Name,
 		Email:    account.Email,
 		Password: account.Password,
-		Avatar:   utils.ToPgText(account.Avatar),
 	}
 
 	acc, err := r.db.CreateAccount(context.Background(), fields)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrEmailAlreadyInUse
+		}
 		return fmt.Errorf("erro ao registrar conta: %w", err)
 	}
 
+	*account = toAccountEntity(acc)
+
+	return nil
+}
+
+func (r *AccountRepository) Find(account *entity.AccountEntity) error {
+	acc, err := r.db.FindAccount(context.Background(), account.ID)
+
+	if err != nil {
+		return err
+	}
+
 	var deletedAt *time.Time
 	if acc.DeletedAt.Valid {
 		deletedAt = &acc.DeletedAt.Time
@@ -52,14 +94,20 @@ func (r *AccountRepository) Register(account *entity.AccountEntity) error {
 		CreatedAt: acc.CreatedAt.Time,
 		UpdatedAt: acc.UpdatedAt.Time,
 		DeletedAt: deletedAt,
-		Avatar:    acc.Avatar.String,
+		AvatarKey: acc.AvatarKey.String,
+
+		EmailVerifiedAt:    utils.PgTimestampToTime(acc.EmailVerifiedAt),
+		VerificationSentAt: utils.PgTimestampToTime(acc.VerificationSentAt),
+
+		TOTPSecret:         acc.TotpSecret.String,
+		TwoFactorEnabledAt: utils.PgTimestampToTime(acc.TwoFactorEnabledAt),
 	}
 
 	return nil
 }
 
-func (r *AccountRepository) Find(account *entity.AccountEntity) error {
-	acc, err := r.db.FindAccount(context.Background(), account.ID)
+func (r *AccountRepository) FindByEmail(account *entity.AccountEntity) error {
+	acc, err := r.db.FindAccountByEmail(context.Background(), account.Email)
 
 	if err != nil {
 		return err
@@ -75,37 +123,384 @@ func (r *AccountRepository) Find(account *entity.AccountEntity) error {
 		Name:      acc.Name,
 		Email:     acc.Email,
 		Password:  acc.Password,
+		AvatarKey: acc.AvatarKey.String,
 		CreatedAt: acc.CreatedAt.Time,
 		UpdatedAt: acc.UpdatedAt.Time,
 		DeletedAt: deletedAt,
-		Avatar:    acc.Avatar.String,
+
+		EmailVerifiedAt:    utils.PgTimestampToTime(acc.EmailVerifiedAt),
+		VerificationSentAt: utils.PgTimestampToTime(acc.VerificationSentAt),
+
+		TOTPSecret:         acc.TotpSecret.String,
+		TwoFactorEnabledAt: utils.PgTimestampToTime(acc.TwoFactorEnabledAt),
 	}
 
 	return nil
 }
 
-func (r *AccountRepository) FindByEmail(account *entity.AccountEntity) error {
-	acc, err := r.db.FindAccountByEmail(context.Background(), account.Email)
+func (r *AccountRepository) Update(account *entity.AccountEntity) error {
+	fields := db.UpdateAccountParams{
+		ID:   account.ID,
+		Name: account.Name,
+	}
+
+	acc, err := r.db.UpdateAccount(context.Background(), fields)
 
 	if err != nil {
 		return err
 	}
 
-	var deletedAt *time.Time
-	if acc.DeletedAt.Valid {
-		deletedAt = &acc.DeletedAt.Time
+	*account = toAccountEntity(acc)
+
+	return nil
+}
+
+// ReplaceAvatar sets the avatar key of the account, returning the key of the
+// avatar it replaced, if any, or sql.ErrNoRows when the account does not
+// exist.
+func (r *AccountRepository) ReplaceAvatar(account *entity.AccountEntity) (string, error) {
+	fields := db.ReplaceAccountAvatarParams{
+		ID:        account.ID,
+		AvatarKey: utils.ToPgText(account.AvatarKey),
 	}
 
-	*account = entity.AccountEntity{
+	previous, err := r.db.ReplaceAccountAvatar(context.Background(), fields)
+
+	if err != nil {
+		return "", err
+	}
+
+	return previous.String, nil
+}
+
+func (r *AccountRepository) UpdatePassword(account *entity.AccountEntity) error {
+	fields := db.UpdateAccountPasswordParams{
+		ID:       account.ID,
+		Password: account.Password,
+	}
+
+	rows, err := r.db.UpdateAccountPassword(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao atualizar senha: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// RehashPassword replaces oldHash by the hash in account.Password, and
+// reports whether oldHash was still the stored hash.
+func (r *AccountRepository) RehashPassword(account *entity.AccountEntity, oldHash string) (bool, error) {
+	fields := db.RehashAccountPasswordParams{
+		ID:          account.ID,
+		NewPassword: account.Password,
+		OldPassword: oldHash,
+	}
+
+	rows, err := r.db.RehashAccountPassword(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao atualizar hash da senha: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *AccountRepository) SoftDelete(account *entity.AccountEntity) error {
+	rows, err := r.db.SoftDeleteAccount(context.Background(), account.ID)
+
+	if err != nil {
+		return fmt.Errorf("erro ao remover conta: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) Restore(account *entity.AccountEntity) error {
+	acc, err := r.db.RestoreAccount(context.Background(), account.ID)
+
+	if err != nil {
+		return err
+	}
+
+	*account = toAccountEntity(acc)
+
+	return nil
+}
+
+// MarkVerificationSent records that a verification email is being sent now,
+// unless one was already sent after resendBefore. It reports whether the
+// caller may send the email.
+func (r *AccountRepository) MarkVerificationSent(account *entity.AccountEntity, resendBefore time.Time) (bool, error) {
+	sentAt := time.Now().UTC()
+
+	fields := db.MarkAccountVerificationSentParams{
+		ID:           account.ID,
+		SentAt:       utils.TimeToPgTimestamp(&sentAt),
+		ResendBefore: utils.TimeToPgTimestamp(&resendBefore),
+	}
+
+	rows, err := r.db.MarkAccountVerificationSent(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao registrar envio de verificação: %w", err)
+	}
+
+	if rows > 0 {
+		account.VerificationSentAt = &sentAt
+	}
+
+	return rows > 0, nil
+}
+
+// VerifyEmail marks the email of the account as verified, as long as it is
+// still the email held by account. It reports whether the account changed.
+func (r *AccountRepository) VerifyEmail(account *entity.AccountEntity) (bool, error) {
+	fields := db.VerifyAccountEmailParams{
+		ID:    account.ID,
+		Email: account.Email,
+	}
+
+	rows, err := r.db.VerifyAccountEmail(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao verificar email: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// SetTOTPSecret stores a pending TOTP secret, replacing any earlier one that
+// was never confirmed. It fails with sql.ErrNoRows when two-factor
+// authentication is already enabled.
+func (r *AccountRepository) SetTOTPSecret(account *entity.AccountEntity) error {
+	fields := db.SetAccountTOTPSecretParams{
+		ID:         account.ID,
+		TotpSecret: utils.ToPgText(account.TOTPSecret),
+	}
+
+	rows, err := r.db.SetAccountTOTPSecret(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar segredo TOTP: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// EnableTwoFactor turns on two-factor authentication with the pending secret.
+// It reports whether the account changed.
+func (r *AccountRepository) EnableTwoFactor(account *entity.AccountEntity) (bool, error) {
+	rows, err := r.db.EnableAccountTwoFactor(context.Background(), account.ID)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao ativar autenticação em duas etapas: %w", err)
+	}
+
+	if rows > 0 {
+		now := time.Now().UTC()
+		account.TwoFactorEnabledAt = &now
+	}
+
+	return rows > 0, nil
+}
+
+func (r *AccountRepository) DisableTwoFactor(account *entity.AccountEntity) error {
+	rows, err := r.db.DisableAccountTwoFactor(context.Background(), account.ID)
+
+	if err != nil {
+		return fmt.Errorf("erro ao desativar autenticação em duas etapas: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	account.TOTPSecret = ""
+	account.TwoFactorEnabledAt = nil
+
+	return nil
+}
+
+// UseTOTPStep records step as the last TOTP time step accepted for the
+// account. It reports false when that step, or a later one, was already used,
+// so a code cannot be replayed.
+func (r *AccountRepository) UseTOTPStep(account *entity.AccountEntity, step int64) (bool, error) {
+	fields := db.UseAccountTOTPStepParams{
+		ID:   account.ID,
+		Step: step,
+	}
+
+	rows, err := r.db.UseAccountTOTPStep(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao registrar código TOTP: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// List returns a page of accounts in any state, newest first, matching the
+// filter.
+func (r *AccountRepository) List(filter entity.AccountFilter) ([]entity.AdminAccountEntity, error) {
+	fields := db.ListAccountsParams{
+		Search:    utils.ToPgText(filter.Search),
+		Status:    utils.ToPgText(filter.Status),
+		RowLimit:  int32(filter.PerPage),
+		RowOffset: int32((filter.Page - 1) * filter.PerPage),
+	}
+
+	rows, err := r.db.ListAccounts(context.Background(), fields)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar contas: %w", err)
+	}
+
+	accounts := make([]entity.AdminAccountEntity, 0, len(rows))
+	for _, row := range rows {
+		accounts = append(accounts, toAdminAccountEntity(row))
+	}
+
+	return accounts, nil
+}
+
+// Count returns how many accounts match the filter, regardless of its page.
+func (r *AccountRepository) Count(filter entity.AccountFilter) (int64, error) {
+	fields := db.CountAccountsParams{
+		Search: utils.ToPgText(filter.Search),
+		Status: utils.ToPgText(filter.Status),
+	}
+
+	total, err := r.db.CountAccounts(context.Background(), fields)
+
+	if err != nil {
+		return 0, fmt.Errorf("erro ao contar contas: %w", err)
+	}
+
+	return total, nil
+}
+
+// FindForAdmin loads the account whether it is deleted or suspended.
+func (r *AccountRepository) FindForAdmin(account *entity.AdminAccountEntity) error {
+	acc, err := r.db.FindAccountForAdmin(context.Background(), account.ID)
+
+	if err != nil {
+		return err
+	}
+
+	*account = toAdminAccountEntity(db.ListAccountsRow(acc))
+
+	return nil
+}
+
+// Suspend suspends the account unless it is deleted or already suspended. It
+// reports whether the account changed.
+func (r *AccountRepository) Suspend(account *entity.AccountEntity) (bool, error) {
+	rows, err := r.db.SuspendAccount(context.Background(), account.ID)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao suspender conta: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// Reactivate lifts the suspension of the account. It reports whether the
+// account was suspended.
+func (r *AccountRepository) Reactivate(account *entity.AccountEntity) (bool, error) {
+	rows, err := r.db.ReactivateAccount(context.Background(), account.ID)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao reativar conta: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// HardDelete removes the account for good, along with every row referencing
+// it, returning its avatar key, if any, or sql.ErrNoRows when the account
+// does not exist.
+func (r *AccountRepository) HardDelete(account *entity.AccountEntity) (string, error) {
+	avatarKey, err := r.db.HardDeleteAccount(context.Background(), account.ID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+		return "", fmt.Errorf("erro ao remover conta definitivamente: %w", err)
+	}
+
+	return avatarKey.String, nil
+}
+
+// Erase anonymizes the account and removes its credentials, returning the
+// key of the avatar it had, if any, to remove its images.
+func (r *AccountRepository) Erase(account *entity.AccountEntity) (string, error) {
+	avatarKey, err := r.db.EraseAccount(context.Background(), account.ID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+		return "", fmt.Errorf("erro ao apagar dados da conta: %w", err)
+	}
+
+	return avatarKey.String, nil
+}
+
+func toAccountEntity(acc db.Account) entity.AccountEntity {
+	return entity.AccountEntity{
 		ID:        acc.ID,
 		Name:      acc.Name,
 		Email:     acc.Email,
 		Password:  acc.Password,
-		Avatar:    acc.Avatar.String,
+		AvatarKey: acc.AvatarKey.String,
 		CreatedAt: acc.CreatedAt.Time,
 		UpdatedAt: acc.UpdatedAt.Time,
-		DeletedAt: deletedAt,
+		DeletedAt: utils.PgTimestampToTime(acc.DeletedAt),
+
+		EmailVerifiedAt:    utils.PgTimestampToTime(acc.EmailVerifiedAt),
+		VerificationSentAt: utils.PgTimestampToTime(acc.VerificationSentAt),
+
+		TOTPSecret:         acc.TotpSecret.String,
+		TwoFactorEnabledAt: utils.PgTimestampToTime(acc.TwoFactorEnabledAt),
+
+		SuspendedAt: utils.PgTimestampToTime(acc.SuspendedAt),
 	}
+}
 
-	return nil
+func toAdminAccountEntity(acc db.ListAccountsRow) entity.AdminAccountEntity {
+	return entity.AdminAccountEntity{
+		AccountEntity: entity.AccountEntity{
+			ID:        acc.ID,
+			Name:      acc.Name,
+			Email:     acc.Email,
+			AvatarKey: acc.AvatarKey.String,
+			CreatedAt: acc.CreatedAt.Time,
+			UpdatedAt: acc.UpdatedAt.Time,
+			DeletedAt: utils.PgTimestampToTime(acc.DeletedAt),
+
+			EmailVerifiedAt:    utils.PgTimestampToTime(acc.EmailVerifiedAt),
+			TwoFactorEnabledAt: utils.PgTimestampToTime(acc.TwoFactorEnabledAt),
+			SuspendedAt:        utils.PgTimestampToTime(acc.SuspendedAt),
+		},
+		LockedUntil: utils.PgTimestampToTime(acc.LockedUntil),
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"trilha-api/internal/shared/utils"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -33,14 +36,12 @@ func TestAccountRepository_Register(t *testing.T) {
 			Name:     "Test User",
 			Email:    "test@example.com",
 			Password: "password",
-			Avatar:   "test image",
 		}
 
 		createAccountParams := db.CreateAccountParams{
 			Name:     account.Name,
 			Email:    account.Email,
 			Password: account.Password,
-			Avatar:   utils.ToPgText(account.Avatar),
 		}
 
 		expectedAccount := db.Account{
@@ -63,14 +64,12 @@ func TestAccountRepository_Register(t *testing.T) {
 			Name:     "Test User",
 			Email:    "test@example.com",
 			Password: "password",
-			Avatar:   "test image",
 		}
 
 		createAccountParams := db.CreateAccountParams{
 			Name:     account.Name,
 			Email:    account.Email,
 			Password: account.Password,
-			Avatar:   utils.ToPgText(account.Avatar),
 		}
 
 		dbMock.EXPECT().CreateAccount(context.Background(), createAccountParams).Return(db.Account{}, errors.New("database error"))
@@ -79,6 +78,20 @@ func TestAccountRepository_Register(t *testing.T) {
 
 		assert.Error(t, err)
 	})
+
+	t.Run("should return email already in use on unique violation", func(t *testing.T) {
+		account := &entity.AccountEntity{
+			Name:     "Test User",
+			Email:    "test@example.com",
+			Password: "password",
+		}
+
+		dbMock.EXPECT().CreateAccount(context.Background(), gomock.Any()).Return(db.Account{}, &pgconn.PgError{Code: "23505"})
+
+		err := repo.Register(account)
+
+		assert.ErrorIs(t, err, ErrEmailAlreadyInUse)
+	})
 }
 
 func TestAccountRepository_Find(t *testing.T) {
@@ -90,7 +103,7 @@ func TestAccountRepository_Find(t *testing.T) {
 			Name:      "Gandalf O Branco",
 			Email:     "gandalf@gmail.com",
 			Password:  "123mudar",
-			Avatar:    "url",
+			AvatarKey: "avatars/1/key",
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 			DeletedAt: func() *time.Time { t := time.Now(); return &t }(),
@@ -101,7 +114,7 @@ func TestAccountRepository_Find(t *testing.T) {
 			Name:      account.Name,
 			Email:     account.Email,
 			Password:  account.Password,
-			Avatar:    utils.ToPgText(account.Avatar),
+			AvatarKey: utils.ToPgText(account.AvatarKey),
 			CreatedAt: utils.TimeToPgTimestamp(&account.CreatedAt),
 			UpdatedAt: utils.TimeToPgTimestamp(&account.UpdatedAt),
 			DeletedAt: utils.TimeToPgTimestamp(account.DeletedAt),
@@ -121,7 +134,7 @@ func TestAccountRepository_Find(t *testing.T) {
 			Name:      "Gandalf O Branco",
 			Email:     "gandalf@gmail.com",
 			Password:  "123mudar",
-			Avatar:    "url",
+			AvatarKey: "avatars/1/key",
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 			DeletedAt: func() *time.Time { t := time.Now(); return &t }(),
@@ -150,7 +163,7 @@ func TestAccountRepository_FindByEmail(t *testing.T) {
 			ID:        uuid.New(),
 			Name:      "gandalf",
 			Password:  "gandalf123",
-			Avatar:    utils.ToPgText("url"),
+			AvatarKey: utils.ToPgText("avatars/1/key"),
 			CreatedAt: utils.TimeToPgTimestamp(&now),
 			UpdatedAt: utils.TimeToPgTimestamp(&now),
 		}
@@ -161,7 +174,7 @@ func TestAccountRepository_FindByEmail(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedAccount.Email, account.Email)
-		assert.Equal(t, expectedAccount.Avatar.String, account.Avatar)
+		assert.Equal(t, expectedAccount.AvatarKey.String, account.AvatarKey)
 		assert.Equal(t, expectedAccount.ID, account.ID)
 		assert.Equal(t, expectedAccount.Name, account.Name)
 		assert.Equal(t, expectedAccount.Password, account.Password)
@@ -181,3 +194,323 @@ func TestAccountRepository_FindByEmail(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestAccountRepository_ReplaceAvatar(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New(), AvatarKey: "avatars/1/new"}
+
+	t.Run("should return the key of the replaced avatar", func(t *testing.T) {
+		dbMock.EXPECT().ReplaceAccountAvatar(context.Background(), db.ReplaceAccountAvatarParams{
+			ID:        account.ID,
+			AvatarKey: utils.ToPgText("avatars/1/new"),
+		}).Return(utils.ToPgText("avatars/1/old"), nil)
+
+		previous, err := repo.ReplaceAvatar(account)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "avatars/1/old", previous)
+	})
+
+	t.Run("should clear the avatar with an empty key", func(t *testing.T) {
+		dbMock.EXPECT().ReplaceAccountAvatar(context.Background(), db.ReplaceAccountAvatarParams{
+			ID: account.ID,
+		}).Return(pgtype.Text{}, nil)
+
+		previous, err := repo.ReplaceAvatar(&entity.AccountEntity{ID: account.ID})
+
+		assert.NoError(t, err)
+		assert.Empty(t, previous)
+	})
+
+	t.Run("should return an error when the account does not exist", func(t *testing.T) {
+		dbMock.EXPECT().ReplaceAccountAvatar(context.Background(), gomock.Any()).Return(pgtype.Text{}, sql.ErrNoRows)
+
+		_, err := repo.ReplaceAvatar(account)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestAccountRepository_Update(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{
+		ID:   uuid.New(),
+		Name: "Gandalf O Cinzento",
+	}
+
+	t.Run("should update the profile fields", func(t *testing.T) {
+		dbMock.EXPECT().UpdateAccount(context.Background(), db.UpdateAccountParams{
+			ID:   account.ID,
+			Name: account.Name,
+		}).Return(db.Account{ID: account.ID, Name: account.Name, Email: "gandalf@lor.com.br"}, nil)
+
+		err := repo.Update(account)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "gandalf@lor.com.br", account.Email)
+	})
+
+	t.Run("should return an error when the account does not exist", func(t *testing.T) {
+		dbMock.EXPECT().UpdateAccount(context.Background(), gomock.Any()).Return(db.Account{}, sql.ErrNoRows)
+
+		err := repo.Update(account)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestAccountRepository_UpdatePassword(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New(), Password: "hash"}
+
+	t.Run("should update the password hash", func(t *testing.T) {
+		dbMock.EXPECT().UpdateAccountPassword(context.Background(), db.UpdateAccountPasswordParams{
+			ID:       account.ID,
+			Password: account.Password,
+		}).Return(int64(1), nil)
+
+		assert.NoError(t, repo.UpdatePassword(account))
+	})
+
+	t.Run("should return no rows when the account does not exist", func(t *testing.T) {
+		dbMock.EXPECT().UpdateAccountPassword(context.Background(), gomock.Any()).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.UpdatePassword(account), sql.ErrNoRows)
+	})
+}
+
+func TestAccountRepository_SoftDelete(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New()}
+
+	t.Run("should soft delete the account", func(t *testing.T) {
+		dbMock.EXPECT().SoftDeleteAccount(context.Background(), account.ID).Return(int64(1), nil)
+
+		assert.NoError(t, repo.SoftDelete(account))
+	})
+
+	t.Run("should return no rows when the account is already deleted", func(t *testing.T) {
+		dbMock.EXPECT().SoftDeleteAccount(context.Background(), account.ID).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.SoftDelete(account), sql.ErrNoRows)
+	})
+}
+
+func TestAccountRepository_Restore(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New()}
+
+	dbMock.EXPECT().RestoreAccount(context.Background(), account.ID).Return(db.Account{ID: account.ID, Name: "Gandalf"}, nil)
+
+	err := repo.Restore(account)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Gandalf", account.Name)
+	assert.Nil(t, account.DeletedAt)
+}
+
+func TestAccountRepository_MarkVerificationSent(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New()}
+	resendBefore := time.Now().UTC().Add(-time.Minute)
+
+	t.Run("should record the email when allowed", func(t *testing.T) {
+		dbMock.EXPECT().MarkAccountVerificationSent(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, arg db.MarkAccountVerificationSentParams) (int64, error) {
+			assert.Equal(t, account.ID, arg.ID)
+			assert.Equal(t, resendBefore, arg.ResendBefore.Time)
+			return 1, nil
+		})
+
+		allowed, err := repo.MarkVerificationSent(account, resendBefore)
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.NotNil(t, account.VerificationSentAt)
+	})
+
+	t.Run("should refuse when an email was sent recently", func(t *testing.T) {
+		dbMock.EXPECT().MarkAccountVerificationSent(context.Background(), gomock.Any()).Return(int64(0), nil)
+
+		allowed, err := repo.MarkVerificationSent(account, resendBefore)
+
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
+
+func TestAccountRepository_VerifyEmail(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New(), Email: "gandalf@lor.com.br"}
+
+	dbMock.EXPECT().VerifyAccountEmail(context.Background(), db.VerifyAccountEmailParams{
+		ID:    account.ID,
+		Email: account.Email,
+	}).Return(int64(1), nil)
+
+	verified, err := repo.VerifyEmail(account)
+
+	assert.NoError(t, err)
+	assert.True(t, verified)
+}
+
+func TestAccountRepository_RehashPassword(t *testing.T) {
+	dbMock, repo := setup(t)
+	account := &entity.AccountEntity{ID: uuid.New(), Password: "new-hash"}
+	fields := db.RehashAccountPasswordParams{ID: account.ID, NewPassword: "new-hash", OldPassword: "old-hash"}
+
+	t.Run("should replace the hash still stored", func(t *testing.T) {
+		dbMock.EXPECT().RehashAccountPassword(context.Background(), fields).Return(int64(1), nil)
+
+		replaced, err := repo.RehashPassword(account, "old-hash")
+
+		assert.NoError(t, err)
+		assert.True(t, replaced)
+	})
+
+	t.Run("should leave a password changed meanwhile", func(t *testing.T) {
+		dbMock.EXPECT().RehashAccountPassword(context.Background(), fields).Return(int64(0), nil)
+
+		replaced, err := repo.RehashPassword(account, "old-hash")
+
+		assert.NoError(t, err)
+		assert.False(t, replaced)
+	})
+}
+
+func TestAccountRepository_List(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	lockedUntil := time.Now().UTC().Add(time.Hour)
+	filter := entity.AccountFilter{Search: "gandalf", Status: entity.AccountStatusLocked, Page: 3, PerPage: 20}
+
+	dbMock.EXPECT().ListAccounts(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, arg db.ListAccountsParams) ([]db.ListAccountsRow, error) {
+		assert.Equal(t, "gandalf", arg.Search.String)
+		assert.Equal(t, entity.AccountStatusLocked, arg.Status.String)
+		assert.Equal(t, int32(20), arg.RowLimit)
+		assert.Equal(t, int32(40), arg.RowOffset)
+		return []db.ListAccountsRow{{
+			ID:          uuid.New(),
+			Name:        "Gandalf",
+			LockedUntil: pgtype.Timestamp{Time: lockedUntil, Valid: true},
+		}}, nil
+	})
+
+	accounts, err := repo.List(filter)
+
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 1)
+	assert.Equal(t, "Gandalf", accounts[0].Name)
+	assert.True(t, accounts[0].IsLocked(time.Now().UTC()))
+}
+
+func TestAccountRepository_Count(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	dbMock.EXPECT().CountAccounts(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, arg db.CountAccountsParams) (int64, error) {
+		assert.False(t, arg.Search.Valid)
+		assert.Equal(t, entity.AccountStatusDeleted, arg.Status.String)
+		return 7, nil
+	})
+
+	total, err := repo.Count(entity.AccountFilter{Status: entity.AccountStatusDeleted})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), total)
+}
+
+func TestAccountRepository_FindForAdmin(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}}
+	suspendedAt := time.Now().UTC()
+
+	dbMock.EXPECT().FindAccountForAdmin(context.Background(), account.ID).Return(db.FindAccountForAdminRow{
+		ID:          account.ID,
+		Name:        "Gandalf",
+		SuspendedAt: pgtype.Timestamp{Time: suspendedAt, Valid: true},
+	}, nil)
+
+	err := repo.FindForAdmin(account)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Gandalf", account.Name)
+	assert.True(t, account.IsSuspended())
+	assert.Nil(t, account.LockedUntil)
+}
+
+func TestAccountRepository_Suspend(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New()}
+
+	t.Run("should report the account suspended", func(t *testing.T) {
+		dbMock.EXPECT().SuspendAccount(context.Background(), account.ID).Return(int64(1), nil)
+
+		suspended, err := repo.Suspend(account)
+
+		assert.NoError(t, err)
+		assert.True(t, suspended)
+	})
+
+	t.Run("should report an account already suspended or deleted", func(t *testing.T) {
+		dbMock.EXPECT().SuspendAccount(context.Background(), account.ID).Return(int64(0), nil)
+
+		suspended, err := repo.Suspend(account)
+
+		assert.NoError(t, err)
+		assert.False(t, suspended)
+	})
+}
+
+func TestAccountRepository_HardDelete(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New()}
+
+	t.Run("should return the avatar key of the removed account", func(t *testing.T) {
+		dbMock.EXPECT().HardDeleteAccount(context.Background(), account.ID).Return(pgtype.Text{String: "avatars/1/key", Valid: true}, nil)
+
+		avatarKey, err := repo.HardDelete(account)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "avatars/1/key", avatarKey)
+	})
+
+	t.Run("should return no rows when the account does not exist", func(t *testing.T) {
+		dbMock.EXPECT().HardDeleteAccount(context.Background(), account.ID).Return(pgtype.Text{}, sql.ErrNoRows)
+
+		_, err := repo.HardDelete(account)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestAccountRepository_Erase(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New()}
+
+	t.Run("should return the avatar key the account had", func(t *testing.T) {
+		dbMock.EXPECT().EraseAccount(context.Background(), account.ID).Return(pgtype.Text{String: "avatars/1/key", Valid: true}, nil)
+
+		avatarKey, err := repo.Erase(account)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "avatars/1/key", avatarKey)
+	})
+
+	t.Run("should return no rows when the account does not exist", func(t *testing.T) {
+		dbMock.EXPECT().EraseAccount(context.Background(), account.ID).Return(pgtype.Text{}, sql.ErrNoRows)
+
+		_, err := repo.Erase(account)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
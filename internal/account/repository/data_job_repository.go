@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrDataJobInProgress is returned when the account already has an
+// unfinished job of the same kind.
+var ErrDataJobInProgress = errors.New("data job already in progress")
+
+type DataJobRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=data_job_repository.go -destination=../mocks/data_job_repository_mock.go -package=mocks
+
+type DataJobRepositoryInterface interface {
+	Create(job *entity.DataJobEntity) error
+	Find(job *entity.DataJobEntity) error
+	ListByAccount(accountID uuid.UUID) ([]entity.DataJobEntity, error)
+	Claim(staleBefore time.Time, job *entity.DataJobEntity) (bool, error)
+	Complete(job *entity.DataJobEntity) error
+	Fail(job *entity.DataJobEntity) error
+	ExpireArchives() ([]string, error)
+	ClearArchives(accountID uuid.UUID) ([]string, error)
+}
+
+func NewDataJobRepository(db db.Querier) *DataJobRepository {
+	return &DataJobRepository{db: db}
+}
+
+func (r *DataJobRepository) Create(job *entity.DataJobEntity) error {
+	var requestedBy *uuid.UUID
+	if job.RequestedBy != uuid.Nil {
+		requestedBy = &job.RequestedBy
+	}
+
+	fields := db.CreateDataJobParams{
+		AccountID:   job.AccountID,
+		RequestedBy: utils.UUIDToPgUUID(requestedBy),
+		Kind:        job.Kind,
+	}
+
+	row, err := r.db.CreateDataJob(context.Background(), fields)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDataJobInProgress
+		}
+		return fmt.Errorf("erro ao registrar tarefa de dados: %w", err)
+	}
+
+	*job = toDataJobEntity(row)
+
+	return nil
+}
+
+func (r *DataJobRepository) Find(job *entity.DataJobEntity) error {
+	row, err := r.db.FindDataJob(context.Background(), job.ID)
+
+	if err != nil {
+		return err
+	}
+
+	*job = toDataJobEntity(row)
+
+	return nil
+}
+
+// ListByAccount returns the jobs of the account, newest first.
+func (r *DataJobRepository) ListByAccount(accountID uuid.UUID) ([]entity.DataJobEntity, error) {
+	rows, err := r.db.ListAccountDataJobs(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar tarefas de dados: %w", err)
+	}
+
+	jobs := make([]entity.DataJobEntity, 0, len(rows))
+	for _, row := range rows {
+		jobs = append(jobs, toDataJobEntity(row))
+	}
+
+	return jobs, nil
+}
+
+// Claim marks the oldest pending job, or one left running since before
+// staleBefore, as running and loads it into job. It reports whether there
+// was a job to claim.
+func (r *DataJobRepository) Claim(staleBefore time.Time, job *entity.DataJobEntity) (bool, error) {
+	row, err := r.db.ClaimDataJob(context.Background(), utils.TimeToPgTimestamp(&staleBefore))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("erro ao buscar tarefa de dados pendente: %w", err)
+	}
+
+	*job = toDataJobEntity(row)
+
+	return true, nil
+}
+
+func (r *DataJobRepository) Complete(job *entity.DataJobEntity) error {
+	fields := db.CompleteDataJobParams{
+		ID:         job.ID,
+		ArchiveKey: utils.ToPgText(job.ArchiveKey),
+		ExpiresAt:  utils.TimeToPgTimestamp(job.ExpiresAt),
+	}
+
+	if err := r.db.CompleteDataJob(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao concluir tarefa de dados: %w", err)
+	}
+
+	job.Status = entity.DataJobStatusCompleted
+
+	return nil
+}
+
+func (r *DataJobRepository) Fail(job *entity.DataJobEntity) error {
+	fields := db.FailDataJobParams{
+		ID:    job.ID,
+		Error: utils.ToPgText(job.Error),
+	}
+
+	if err := r.db.FailDataJob(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao registrar falha da tarefa de dados: %w", err)
+	}
+
+	job.Status = entity.DataJobStatusFailed
+
+	return nil
+}
+
+// ExpireArchives forgets the archives of exports past their expiration and
+// returns their keys, to remove them from the storage.
+func (r *DataJobRepository) ExpireArchives() ([]string, error) {
+	keys, err := r.db.ExpireDataJobArchives(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao expirar arquivos de exportação: %w", err)
+	}
+
+	return keys, nil
+}
+
+// ClearArchives forgets every archive of the account and returns their keys,
+// to remove them from the storage.
+func (r *DataJobRepository) ClearArchives(accountID uuid.UUID) ([]string, error) {
+	keys, err := r.db.ClearAccountDataJobArchives(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao remover arquivos de exportação da conta: %w", err)
+	}
+
+	return keys, nil
+}
+
+func toDataJobEntity(row db.DataJob) entity.DataJobEntity {
+	job := entity.DataJobEntity{
+		ID:         row.ID,
+		AccountID:  row.AccountID,
+		Kind:       row.Kind,
+		Status:     row.Status,
+		ArchiveKey: row.ArchiveKey.String,
+		Error:      row.Error.String,
+		CreatedAt:  row.CreatedAt.Time,
+		StartedAt:  utils.PgTimestampToTime(row.StartedAt),
+		FinishedAt: utils.PgTimestampToTime(row.FinishedAt),
+		ExpiresAt:  utils.PgTimestampToTime(row.ExpiresAt),
+	}
+
+	if requestedBy := utils.PgUUIDToUUID(row.RequestedBy); requestedBy != nil {
+		job.RequestedBy = *requestedBy
+	}
+
+	return job
+}
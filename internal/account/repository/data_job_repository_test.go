@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupDataJob(t *testing.T) (*mocks.MockQuerier, *DataJobRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewDataJobRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestDataJobRepository_Create(t *testing.T) {
+	dbMock, repo := setupDataJob(t)
+
+	t.Run("should create a pending job requested by the account itself", func(t *testing.T) {
+		accountID := uuid.New()
+		id := uuid.New()
+
+		dbMock.EXPECT().CreateDataJob(context.Background(), db.CreateDataJobParams{
+			AccountID:   accountID,
+			RequestedBy: pgtype.UUID{Bytes: accountID, Valid: true},
+			Kind:        entity.DataJobKindExport,
+		}).Return(db.DataJob{ID: id, AccountID: accountID, RequestedBy: pgtype.UUID{Bytes: accountID, Valid: true}, Kind: entity.DataJobKindExport, Status: entity.DataJobStatusPending}, nil)
+
+		job := &entity.DataJobEntity{AccountID: accountID, RequestedBy: accountID, Kind: entity.DataJobKindExport}
+		err := repo.Create(job)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, job.ID)
+		assert.Equal(t, accountID, job.RequestedBy)
+		assert.Equal(t, entity.DataJobStatusPending, job.Status)
+	})
+
+	t.Run("should report a job of the same kind in progress", func(t *testing.T) {
+		dbMock.EXPECT().CreateDataJob(context.Background(), gomock.Any()).Return(db.DataJob{}, &pgconn.PgError{Code: uniqueViolationCode})
+
+		err := repo.Create(&entity.DataJobEntity{AccountID: uuid.New(), Kind: entity.DataJobKindErasure})
+
+		assert.ErrorIs(t, err, ErrDataJobInProgress)
+	})
+}
+
+func TestDataJobRepository_Claim(t *testing.T) {
+	dbMock, repo := setupDataJob(t)
+
+	staleBefore := time.Now().UTC().Add(-30 * time.Minute)
+
+	t.Run("should load the claimed job", func(t *testing.T) {
+		id := uuid.New()
+
+		dbMock.EXPECT().ClaimDataJob(context.Background(), utils.TimeToPgTimestamp(&staleBefore)).Return(db.DataJob{ID: id, Status: entity.DataJobStatusRunning}, nil)
+
+		job := &entity.DataJobEntity{}
+		claimed, err := repo.Claim(staleBefore, job)
+
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+		assert.Equal(t, id, job.ID)
+		assert.Equal(t, uuid.Nil, job.RequestedBy)
+	})
+
+	t.Run("should report when there is no pending job", func(t *testing.T) {
+		dbMock.EXPECT().ClaimDataJob(context.Background(), gomock.Any()).Return(db.DataJob{}, sql.ErrNoRows)
+
+		claimed, err := repo.Claim(staleBefore, &entity.DataJobEntity{})
+
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+	})
+}
+
+func TestDataJobRepository_Complete(t *testing.T) {
+	dbMock, repo := setupDataJob(t)
+
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	job := &entity.DataJobEntity{ID: uuid.New(), ArchiveKey: "exports/1/key.zip", ExpiresAt: &expiresAt}
+
+	dbMock.EXPECT().CompleteDataJob(context.Background(), db.CompleteDataJobParams{
+		ID:         job.ID,
+		ArchiveKey: pgtype.Text{String: "exports/1/key.zip", Valid: true},
+		ExpiresAt:  utils.TimeToPgTimestamp(&expiresAt),
+	}).Return(nil)
+
+	assert.NoError(t, repo.Complete(job))
+	assert.Equal(t, entity.DataJobStatusCompleted, job.Status)
+}
+
+func TestDataJobRepository_ExpireArchives(t *testing.T) {
+	dbMock, repo := setupDataJob(t)
+
+	dbMock.EXPECT().ExpireDataJobArchives(context.Background()).Return([]string{"exports/1/key.zip"}, nil)
+
+	keys, err := repo.ExpireArchives()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"exports/1/key.zip"}, keys)
+}
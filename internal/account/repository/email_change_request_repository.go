@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+type EmailChangeRequestRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=email_change_request_repository.go -destination=../mocks/email_change_request_repository_mock.go -package=mocks
+
+type EmailChangeRequestRepositoryInterface interface {
+	Create(request *entity.EmailChangeRequestEntity) error
+	FindByTokenHash(request *entity.EmailChangeRequestEntity) error
+	FindByCancelTokenHash(request *entity.EmailChangeRequestEntity) error
+	Confirm(request *entity.EmailChangeRequestEntity) (bool, error)
+	Cancel(request *entity.EmailChangeRequestEntity) (bool, error)
+	CancelAllByAccount(accountID uuid.UUID) error
+	ListByAccount(accountID uuid.UUID) ([]entity.EmailChangeRequestEntity, error)
+}
+
+func NewEmailChangeRequestRepository(db db.Querier) *EmailChangeRequestRepository {
+	return &EmailChangeRequestRepository{db: db}
+}
+
+func (r *EmailChangeRequestRepository) Create(request *entity.EmailChangeRequestEntity) error {
+	fields := db.CreateEmailChangeRequestParams{
+		AccountID:       request.AccountID,
+		NewEmail:        request.NewEmail,
+		TokenHash:       request.TokenHash,
+		CancelTokenHash: request.CancelTokenHash,
+		ExpiresAt:       utils.TimeToPgTimestamp(&request.ExpiresAt),
+	}
+
+	ecr, err := r.db.CreateEmailChangeRequest(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar pedido de troca de email: %w", err)
+	}
+
+	request.ID = ecr.ID
+	request.ExpiresAt = ecr.ExpiresAt.Time
+	request.CreatedAt = ecr.CreatedAt.Time
+
+	return nil
+}
+
+func (r *EmailChangeRequestRepository) FindByTokenHash(request *entity.EmailChangeRequestEntity) error {
+	ecr, err := r.db.FindEmailChangeRequestByTokenHash(context.Background(), request.TokenHash)
+
+	if err != nil {
+		return err
+	}
+
+	*request = toEmailChangeRequestEntity(ecr)
+
+	return nil
+}
+
+func (r *EmailChangeRequestRepository) FindByCancelTokenHash(request *entity.EmailChangeRequestEntity) error {
+	ecr, err := r.db.FindEmailChangeRequestByCancelTokenHash(context.Background(), request.CancelTokenHash)
+
+	if err != nil {
+		return err
+	}
+
+	*request = toEmailChangeRequestEntity(ecr)
+
+	return nil
+}
+
+// Confirm moves the account to the new email of the request and reports
+// whether the request was still pending. It fails with ErrEmailAlreadyInUse,
+// leaving the request pending, when another account took the email meanwhile.
+func (r *EmailChangeRequestRepository) Confirm(request *entity.EmailChangeRequestEntity) (bool, error) {
+	rows, err := r.db.ConfirmEmailChangeRequest(context.Background(), request.ID)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return false, ErrEmailAlreadyInUse
+		}
+		return false, fmt.Errorf("erro ao confirmar troca de email: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// Cancel reports whether the request was still open, so a request confirmed
+// in the meantime is not reported as cancelled.
+func (r *EmailChangeRequestRepository) Cancel(request *entity.EmailChangeRequestEntity) (bool, error) {
+	rows, err := r.db.CancelEmailChangeRequest(context.Background(), request.ID)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao cancelar troca de email: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *EmailChangeRequestRepository) CancelAllByAccount(accountID uuid.UUID) error {
+	if err := r.db.CancelAccountEmailChangeRequests(context.Background(), accountID); err != nil {
+		return fmt.Errorf("erro ao cancelar pedidos de troca de email: %w", err)
+	}
+
+	return nil
+}
+
+// ListByAccount returns every email change the account requested, whether
+// pending, confirmed or cancelled.
+func (r *EmailChangeRequestRepository) ListByAccount(accountID uuid.UUID) ([]entity.EmailChangeRequestEntity, error) {
+	rows, err := r.db.ListAccountEmailChangeRequests(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar pedidos de troca de email: %w", err)
+	}
+
+	requests := make([]entity.EmailChangeRequestEntity, 0, len(rows))
+	for _, row := range rows {
+		requests = append(requests, toEmailChangeRequestEntity(row))
+	}
+
+	return requests, nil
+}
+
+func toEmailChangeRequestEntity(ecr db.EmailChangeRequest) entity.EmailChangeRequestEntity {
+	return entity.EmailChangeRequestEntity{
+		ID:              ecr.ID,
+		AccountID:       ecr.AccountID,
+		NewEmail:        ecr.NewEmail,
+		TokenHash:       ecr.TokenHash,
+		CancelTokenHash: ecr.CancelTokenHash,
+		ExpiresAt:       ecr.ExpiresAt.Time,
+		ConfirmedAt:     utils.PgTimestampToTime(ecr.ConfirmedAt),
+		CancelledAt:     utils.PgTimestampToTime(ecr.CancelledAt),
+		CreatedAt:       ecr.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupEmailChangeRequest(t *testing.T) (*mocks.MockQuerier, *EmailChangeRequestRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewEmailChangeRequestRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestEmailChangeRequestRepository_Create(t *testing.T) {
+	dbMock, repo := setupEmailChangeRequest(t)
+
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	request := &entity.EmailChangeRequestEntity{
+		AccountID:       uuid.New(),
+		NewEmail:        "mithrandir@lor.com.br",
+		TokenHash:       "hash",
+		CancelTokenHash: "cancel-hash",
+		ExpiresAt:       expiresAt,
+	}
+
+	t.Run("should persist the request", func(t *testing.T) {
+		id := uuid.New()
+
+		dbMock.EXPECT().CreateEmailChangeRequest(context.Background(), db.CreateEmailChangeRequestParams{
+			AccountID:       request.AccountID,
+			NewEmail:        request.NewEmail,
+			TokenHash:       request.TokenHash,
+			CancelTokenHash: request.CancelTokenHash,
+			ExpiresAt:       utils.TimeToPgTimestamp(&expiresAt),
+		}).Return(db.EmailChangeRequest{ID: id, ExpiresAt: utils.TimeToPgTimestamp(&expiresAt)}, nil)
+
+		err := repo.Create(request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, request.ID)
+	})
+
+	t.Run("should return an error when insert fails", func(t *testing.T) {
+		dbMock.EXPECT().CreateEmailChangeRequest(context.Background(), gomock.Any()).Return(db.EmailChangeRequest{}, errors.New("database error"))
+
+		assert.Error(t, repo.Create(request))
+	})
+}
+
+func TestEmailChangeRequestRepository_FindByCancelTokenHash(t *testing.T) {
+	dbMock, repo := setupEmailChangeRequest(t)
+
+	t.Run("should load the request", func(t *testing.T) {
+		id := uuid.New()
+
+		dbMock.EXPECT().FindEmailChangeRequestByCancelTokenHash(context.Background(), "cancel-hash").Return(db.EmailChangeRequest{
+			ID:              id,
+			NewEmail:        "mithrandir@lor.com.br",
+			CancelTokenHash: "cancel-hash",
+		}, nil)
+
+		request := &entity.EmailChangeRequestEntity{CancelTokenHash: "cancel-hash"}
+		err := repo.FindByCancelTokenHash(request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, request.ID)
+		assert.Equal(t, "mithrandir@lor.com.br", request.NewEmail)
+	})
+
+	t.Run("should return the raw error when not found", func(t *testing.T) {
+		dbMock.EXPECT().FindEmailChangeRequestByCancelTokenHash(context.Background(), "unknown").Return(db.EmailChangeRequest{}, sql.ErrNoRows)
+
+		err := repo.FindByCancelTokenHash(&entity.EmailChangeRequestEntity{CancelTokenHash: "unknown"})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestEmailChangeRequestRepository_Confirm(t *testing.T) {
+	dbMock, repo := setupEmailChangeRequest(t)
+
+	request := &entity.EmailChangeRequestEntity{ID: uuid.New()}
+
+	t.Run("should confirm a pending request", func(t *testing.T) {
+		dbMock.EXPECT().ConfirmEmailChangeRequest(context.Background(), request.ID).Return(int64(1), nil)
+
+		confirmed, err := repo.Confirm(request)
+
+		assert.NoError(t, err)
+		assert.True(t, confirmed)
+	})
+
+	t.Run("should not confirm a request twice", func(t *testing.T) {
+		dbMock.EXPECT().ConfirmEmailChangeRequest(context.Background(), request.ID).Return(int64(0), nil)
+
+		confirmed, err := repo.Confirm(request)
+
+		assert.NoError(t, err)
+		assert.False(t, confirmed)
+	})
+
+	t.Run("should report an email taken by another account", func(t *testing.T) {
+		dbMock.EXPECT().ConfirmEmailChangeRequest(context.Background(), request.ID).Return(int64(0), &pgconn.PgError{Code: "23505"})
+
+		_, err := repo.Confirm(request)
+
+		assert.ErrorIs(t, err, ErrEmailAlreadyInUse)
+	})
+}
+
+func TestEmailChangeRequestRepository_Cancel(t *testing.T) {
+	dbMock, repo := setupEmailChangeRequest(t)
+
+	request := &entity.EmailChangeRequestEntity{ID: uuid.New()}
+
+	t.Run("should cancel an open request", func(t *testing.T) {
+		dbMock.EXPECT().CancelEmailChangeRequest(context.Background(), request.ID).Return(int64(1), nil)
+
+		cancelled, err := repo.Cancel(request)
+
+		assert.NoError(t, err)
+		assert.True(t, cancelled)
+	})
+
+	t.Run("should not cancel a confirmed request", func(t *testing.T) {
+		dbMock.EXPECT().CancelEmailChangeRequest(context.Background(), request.ID).Return(int64(0), nil)
+
+		cancelled, err := repo.Cancel(request)
+
+		assert.NoError(t, err)
+		assert.False(t, cancelled)
+	})
+}
+
+func TestEmailChangeRequestRepository_ListByAccount(t *testing.T) {
+	dbMock, repo := setupEmailChangeRequest(t)
+
+	accountID := uuid.New()
+
+	t.Run("should list every request of the account", func(t *testing.T) {
+		dbMock.EXPECT().ListAccountEmailChangeRequests(context.Background(), accountID).Return([]db.EmailChangeRequest{
+			{ID: uuid.New(), AccountID: accountID, NewEmail: "mithrandir@lor.com.br"},
+		}, nil)
+
+		requests, err := repo.ListByAccount(accountID)
+
+		assert.NoError(t, err)
+		assert.Len(t, requests, 1)
+		assert.Equal(t, "mithrandir@lor.com.br", requests[0].NewEmail)
+	})
+
+	t.Run("should wrap errors", func(t *testing.T) {
+		dbMock.EXPECT().ListAccountEmailChangeRequests(context.Background(), accountID).Return(nil, errors.New("db down"))
+
+		_, err := repo.ListByAccount(accountID)
+
+		assert.Error(t, err)
+	})
+}
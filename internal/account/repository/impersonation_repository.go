@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+type ImpersonationRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=impersonation_repository.go -destination=../mocks/impersonation_repository_mock.go -package=mocks
+
+type ImpersonationRepositoryInterface interface {
+	Create(impersonation *entity.ImpersonationEntity) error
+	Find(impersonation *entity.ImpersonationEntity) error
+	End(impersonation *entity.ImpersonationEntity) (bool, error)
+	RecordRequest(request *entity.ImpersonationRequestEntity) error
+	ListRequests(impersonationID uuid.UUID) ([]entity.ImpersonationRequestEntity, error)
+}
+
+func NewImpersonationRepository(db db.Querier) *ImpersonationRepository {
+	return &ImpersonationRepository{db: db}
+}
+
+func (r *ImpersonationRepository) Create(impersonation *entity.ImpersonationEntity) error {
+	fields := db.CreateImpersonationParams{
+		ActorAccountID: utils.UUIDToPgUUID(&impersonation.ActorID),
+		AccountID:      utils.UUIDToPgUUID(&impersonation.AccountID),
+		Reason:         impersonation.Reason,
+		IpAddress:      impersonation.IPAddress,
+		UserAgent:      impersonation.UserAgent,
+		ExpiresAt:      utils.TimeToPgTimestamp(&impersonation.ExpiresAt),
+	}
+
+	imp, err := r.db.CreateImpersonation(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar personificação: %w", err)
+	}
+
+	impersonation.ID = imp.ID
+	impersonation.ExpiresAt = imp.ExpiresAt.Time
+	impersonation.CreatedAt = imp.CreatedAt.Time
+
+	return nil
+}
+
+func (r *ImpersonationRepository) Find(impersonation *entity.ImpersonationEntity) error {
+	imp, err := r.db.FindImpersonation(context.Background(), impersonation.ID)
+
+	if err != nil {
+		return err
+	}
+
+	*impersonation = toImpersonationEntity(imp)
+
+	return nil
+}
+
+// End ends the impersonation unless it already ended or expired. It reports
+// whether the impersonation was running.
+func (r *ImpersonationRepository) End(impersonation *entity.ImpersonationEntity) (bool, error) {
+	rows, err := r.db.EndImpersonation(context.Background(), impersonation.ID)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao encerrar personificação: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *ImpersonationRepository) RecordRequest(request *entity.ImpersonationRequestEntity) error {
+	fields := db.CreateImpersonationRequestParams{
+		ImpersonationID: request.ImpersonationID,
+		Method:          request.Method,
+		Path:            request.Path,
+		Status:          int32(request.Status),
+		IpAddress:       request.IPAddress,
+		UserAgent:       request.UserAgent,
+	}
+
+	if err := r.db.CreateImpersonationRequest(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao registrar requisição da personificação: %w", err)
+	}
+
+	return nil
+}
+
+// ListRequests returns the audit trail of the impersonation, oldest first.
+func (r *ImpersonationRepository) ListRequests(impersonationID uuid.UUID) ([]entity.ImpersonationRequestEntity, error) {
+	rows, err := r.db.ListImpersonationRequests(context.Background(), impersonationID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar requisições da personificação: %w", err)
+	}
+
+	requests := make([]entity.ImpersonationRequestEntity, 0, len(rows))
+	for _, row := range rows {
+		requests = append(requests, entity.ImpersonationRequestEntity{
+			ID:              row.ID,
+			ImpersonationID: row.ImpersonationID,
+			Method:          row.Method,
+			Path:            row.Path,
+			Status:          int(row.Status),
+			IPAddress:       row.IpAddress,
+			UserAgent:       row.UserAgent,
+			CreatedAt:       row.CreatedAt.Time,
+		})
+	}
+
+	return requests, nil
+}
+
+func toImpersonationEntity(imp db.Impersonation) entity.ImpersonationEntity {
+	impersonation := entity.ImpersonationEntity{
+		ID:        imp.ID,
+		Reason:    imp.Reason,
+		IPAddress: imp.IpAddress,
+		UserAgent: imp.UserAgent,
+		ExpiresAt: imp.ExpiresAt.Time,
+		EndedAt:   utils.PgTimestampToTime(imp.EndedAt),
+		CreatedAt: imp.CreatedAt.Time,
+	}
+
+	if actorID := utils.PgUUIDToUUID(imp.ActorAccountID); actorID != nil {
+		impersonation.ActorID = *actorID
+	}
+	if accountID := utils.PgUUIDToUUID(imp.AccountID); accountID != nil {
+		impersonation.AccountID = *accountID
+	}
+
+	return impersonation
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupImpersonation(t *testing.T) (*mocks.MockQuerier, *ImpersonationRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewImpersonationRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestImpersonationRepository_Create(t *testing.T) {
+	dbMock, repo := setupImpersonation(t)
+
+	expiresAt := time.Now().UTC().Add(30 * time.Minute)
+	impersonation := &entity.ImpersonationEntity{
+		ActorID:   uuid.New(),
+		AccountID: uuid.New(),
+		Reason:    "Ticket 42",
+		IPAddress: "127.0.0.1",
+		UserAgent: "Firefox",
+		ExpiresAt: expiresAt,
+	}
+	id := uuid.New()
+
+	dbMock.EXPECT().CreateImpersonation(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, arg db.CreateImpersonationParams) (db.Impersonation, error) {
+		assert.Equal(t, impersonation.ActorID, uuid.UUID(arg.ActorAccountID.Bytes))
+		assert.Equal(t, impersonation.AccountID, uuid.UUID(arg.AccountID.Bytes))
+		assert.Equal(t, "Ticket 42", arg.Reason)
+		return db.Impersonation{ID: id, ExpiresAt: arg.ExpiresAt, CreatedAt: utils.TimeToPgTimestamp(&expiresAt)}, nil
+	})
+
+	err := repo.Create(impersonation)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, impersonation.ID)
+	assert.Equal(t, expiresAt, impersonation.ExpiresAt)
+}
+
+func TestImpersonationRepository_Find(t *testing.T) {
+	dbMock, repo := setupImpersonation(t)
+
+	t.Run("should load an impersonation whose actor was removed", func(t *testing.T) {
+		id := uuid.New()
+		accountID := uuid.New()
+
+		dbMock.EXPECT().FindImpersonation(context.Background(), id).Return(db.Impersonation{
+			ID:        id,
+			AccountID: pgtype.UUID{Bytes: accountID, Valid: true},
+			Reason:    "Ticket 42",
+		}, nil)
+
+		impersonation := &entity.ImpersonationEntity{ID: id}
+		err := repo.Find(impersonation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, impersonation.ActorID)
+		assert.Equal(t, accountID, impersonation.AccountID)
+		assert.Equal(t, "Ticket 42", impersonation.Reason)
+	})
+
+	t.Run("should return no rows when it does not exist", func(t *testing.T) {
+		dbMock.EXPECT().FindImpersonation(context.Background(), gomock.Any()).Return(db.Impersonation{}, sql.ErrNoRows)
+
+		assert.ErrorIs(t, repo.Find(&entity.ImpersonationEntity{ID: uuid.New()}), sql.ErrNoRows)
+	})
+}
+
+func TestImpersonationRepository_End(t *testing.T) {
+	dbMock, repo := setupImpersonation(t)
+
+	impersonation := &entity.ImpersonationEntity{ID: uuid.New()}
+
+	dbMock.EXPECT().EndImpersonation(context.Background(), impersonation.ID).Return(int64(0), nil)
+
+	ended, err := repo.End(impersonation)
+
+	assert.NoError(t, err)
+	assert.False(t, ended)
+}
+
+func TestImpersonationRepository_ListRequests(t *testing.T) {
+	dbMock, repo := setupImpersonation(t)
+
+	impersonationID := uuid.New()
+
+	dbMock.EXPECT().ListImpersonationRequests(context.Background(), impersonationID).Return([]db.ImpersonationRequest{
+		{ID: uuid.New(), ImpersonationID: impersonationID, Method: "GET", Path: "/api/v1/accounts/me", Status: 200},
+	}, nil)
+
+	requests, err := repo.ListRequests(impersonationID)
+
+	assert.NoError(t, err)
+	assert.Len(t, requests, 1)
+	assert.Equal(t, "/api/v1/accounts/me", requests[0].Path)
+	assert.Equal(t, 200, requests[0].Status)
+}
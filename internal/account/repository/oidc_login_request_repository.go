@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+)
+
+type OIDCLoginRequestRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=oidc_login_request_repository.go -destination=../mocks/oidc_login_request_repository_mock.go -package=mocks
+
+type OIDCLoginRequestRepositoryInterface interface {
+	Create(request *entity.OIDCLoginRequestEntity) error
+	Consume(request *entity.OIDCLoginRequestEntity) error
+	DeleteExpired() error
+}
+
+func NewOIDCLoginRequestRepository(db db.Querier) *OIDCLoginRequestRepository {
+	return &OIDCLoginRequestRepository{db: db}
+}
+
+func (r *OIDCLoginRequestRepository) Create(request *entity.OIDCLoginRequestEntity) error {
+	fields := db.CreateOIDCLoginRequestParams{
+		StateHash:    request.StateHash,
+		Provider:     request.Provider,
+		Nonce:        request.Nonce,
+		CodeVerifier: request.CodeVerifier,
+		ExpiresAt:    utils.TimeToPgTimestamp(&request.ExpiresAt),
+	}
+
+	if err := r.db.CreateOIDCLoginRequest(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao registrar login externo: %w", err)
+	}
+
+	return nil
+}
+
+// Consume removes the request of request.StateHash and fills request with
+// it, returning sql.ErrNoRows when it does not exist or was already used.
+func (r *OIDCLoginRequestRepository) Consume(request *entity.OIDCLoginRequestEntity) error {
+	consumed, err := r.db.ConsumeOIDCLoginRequest(context.Background(), request.StateHash)
+
+	if err != nil {
+		return err
+	}
+
+	*request = entity.OIDCLoginRequestEntity{
+		StateHash:    consumed.StateHash,
+		Provider:     consumed.Provider,
+		Nonce:        consumed.Nonce,
+		CodeVerifier: consumed.CodeVerifier,
+		ExpiresAt:    consumed.ExpiresAt.Time,
+		CreatedAt:    consumed.CreatedAt.Time,
+	}
+
+	return nil
+}
+
+func (r *OIDCLoginRequestRepository) DeleteExpired() error {
+	if err := r.db.DeleteExpiredOIDCLoginRequests(context.Background()); err != nil {
+		return fmt.Errorf("erro ao remover logins externos expirados: %w", err)
+	}
+
+	return nil
+}
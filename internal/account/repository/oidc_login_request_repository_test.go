@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupOIDCLoginRequest(t *testing.T) (*mocks.MockQuerier, *OIDCLoginRequestRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewOIDCLoginRequestRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestOIDCLoginRequestRepository_Create(t *testing.T) {
+	dbMock, repo := setupOIDCLoginRequest(t)
+
+	expiresAt := time.Now().UTC().Add(10 * time.Minute)
+	request := &entity.OIDCLoginRequestEntity{
+		StateHash:    "hash",
+		Provider:     "keycloak",
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		ExpiresAt:    expiresAt,
+	}
+
+	dbMock.EXPECT().CreateOIDCLoginRequest(context.Background(), db.CreateOIDCLoginRequestParams{
+		StateHash:    "hash",
+		Provider:     "keycloak",
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		ExpiresAt:    utils.TimeToPgTimestamp(&expiresAt),
+	}).Return(nil)
+
+	err := repo.Create(request)
+
+	assert.NoError(t, err)
+}
+
+func TestOIDCLoginRequestRepository_Consume(t *testing.T) {
+	dbMock, repo := setupOIDCLoginRequest(t)
+
+	t.Run("should fill the request", func(t *testing.T) {
+		expiresAt := time.Now().UTC().Add(10 * time.Minute)
+		request := &entity.OIDCLoginRequestEntity{StateHash: "hash"}
+
+		dbMock.EXPECT().ConsumeOIDCLoginRequest(context.Background(), "hash").Return(db.OidcLoginRequest{
+			StateHash:    "hash",
+			Provider:     "keycloak",
+			Nonce:        "nonce",
+			CodeVerifier: "verifier",
+			ExpiresAt:    utils.TimeToPgTimestamp(&expiresAt),
+		}, nil)
+
+		err := repo.Consume(request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "keycloak", request.Provider)
+		assert.Equal(t, "verifier", request.CodeVerifier)
+	})
+
+	t.Run("should return sql.ErrNoRows for an unknown state", func(t *testing.T) {
+		dbMock.EXPECT().ConsumeOIDCLoginRequest(context.Background(), "unknown").Return(db.OidcLoginRequest{}, sql.ErrNoRows)
+
+		err := repo.Consume(&entity.OIDCLoginRequestEntity{StateHash: "unknown"})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
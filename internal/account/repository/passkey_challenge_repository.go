@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+type PasskeyChallengeRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=passkey_challenge_repository.go -destination=../mocks/passkey_challenge_repository_mock.go -package=mocks
+
+type PasskeyChallengeRepositoryInterface interface {
+	Create(challenge *entity.PasskeyChallengeEntity) error
+	Consume(challenge *entity.PasskeyChallengeEntity) error
+	DeleteExpired() error
+	DeleteAllByAccount(accountID uuid.UUID) error
+}
+
+func NewPasskeyChallengeRepository(db db.Querier) *PasskeyChallengeRepository {
+	return &PasskeyChallengeRepository{db: db}
+}
+
+func (r *PasskeyChallengeRepository) Create(challenge *entity.PasskeyChallengeEntity) error {
+	fields := db.CreatePasskeyChallengeParams{
+		AccountID:   utils.UUIDToPgUUID(challenge.AccountID),
+		Purpose:     challenge.Purpose,
+		SessionData: challenge.SessionData,
+		ExpiresAt:   utils.TimeToPgTimestamp(&challenge.ExpiresAt),
+	}
+
+	created, err := r.db.CreatePasskeyChallenge(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar desafio de passkey: %w", err)
+	}
+
+	*challenge = toPasskeyChallengeEntity(created)
+
+	return nil
+}
+
+// Consume removes the challenge of challenge.ID and challenge.Purpose and
+// fills challenge with it, returning sql.ErrNoRows when it does not exist or
+// was already used.
+func (r *PasskeyChallengeRepository) Consume(challenge *entity.PasskeyChallengeEntity) error {
+	fields := db.ConsumePasskeyChallengeParams{
+		ID:      challenge.ID,
+		Purpose: challenge.Purpose,
+	}
+
+	consumed, err := r.db.ConsumePasskeyChallenge(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*challenge = toPasskeyChallengeEntity(consumed)
+
+	return nil
+}
+
+func (r *PasskeyChallengeRepository) DeleteExpired() error {
+	if err := r.db.DeleteExpiredPasskeyChallenges(context.Background()); err != nil {
+		return fmt.Errorf("erro ao remover desafios de passkey expirados: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAllByAccount removes the pending ceremonies of the account. Sign-in
+// challenges, which are not tied to an account, are left alone.
+func (r *PasskeyChallengeRepository) DeleteAllByAccount(accountID uuid.UUID) error {
+	if err := r.db.DeleteAccountPasskeyChallenges(context.Background(), accountID); err != nil {
+		return fmt.Errorf("erro ao remover desafios de passkey da conta: %w", err)
+	}
+
+	return nil
+}
+
+func toPasskeyChallengeEntity(challenge db.PasskeyChallenge) entity.PasskeyChallengeEntity {
+	return entity.PasskeyChallengeEntity{
+		ID:          challenge.ID,
+		AccountID:   utils.PgUUIDToUUID(challenge.AccountID),
+		Purpose:     challenge.Purpose,
+		SessionData: challenge.SessionData,
+		ExpiresAt:   challenge.ExpiresAt.Time,
+		CreatedAt:   challenge.CreatedAt.Time,
+	}
+}
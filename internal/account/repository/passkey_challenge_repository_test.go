@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPasskeyChallenge(t *testing.T) (*mocks.MockQuerier, *PasskeyChallengeRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewPasskeyChallengeRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestPasskeyChallengeRepository_Create(t *testing.T) {
+	dbMock, repo := setupPasskeyChallenge(t)
+
+	t.Run("should save a sign-in challenge without account", func(t *testing.T) {
+		expiresAt := time.Now().UTC().Add(5 * time.Minute)
+		challenge := &entity.PasskeyChallengeEntity{
+			Purpose:     entity.PasskeyPurposeSignIn,
+			SessionData: []byte(`{"challenge":"abc"}`),
+			ExpiresAt:   expiresAt,
+		}
+
+		dbMock.EXPECT().CreatePasskeyChallenge(context.Background(), db.CreatePasskeyChallengeParams{
+			AccountID:   pgtype.UUID{},
+			Purpose:     entity.PasskeyPurposeSignIn,
+			SessionData: []byte(`{"challenge":"abc"}`),
+			ExpiresAt:   utils.TimeToPgTimestamp(&expiresAt),
+		}).Return(db.PasskeyChallenge{
+			ID:          uuid.New(),
+			Purpose:     entity.PasskeyPurposeSignIn,
+			SessionData: []byte(`{"challenge":"abc"}`),
+			ExpiresAt:   utils.TimeToPgTimestamp(&expiresAt),
+		}, nil)
+
+		err := repo.Create(challenge)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, challenge.ID)
+		assert.Nil(t, challenge.AccountID)
+	})
+}
+
+func TestPasskeyChallengeRepository_Consume(t *testing.T) {
+	dbMock, repo := setupPasskeyChallenge(t)
+
+	t.Run("should fill the challenge", func(t *testing.T) {
+		challengeID := uuid.New()
+		accountID := uuid.New()
+
+		dbMock.EXPECT().ConsumePasskeyChallenge(context.Background(), db.ConsumePasskeyChallengeParams{
+			ID:      challengeID,
+			Purpose: entity.PasskeyPurposeRegistration,
+		}).Return(db.PasskeyChallenge{
+			ID:          challengeID,
+			AccountID:   utils.UUIDToPgUUID(&accountID),
+			Purpose:     entity.PasskeyPurposeRegistration,
+			SessionData: []byte(`{"challenge":"abc"}`),
+		}, nil)
+
+		challenge := &entity.PasskeyChallengeEntity{ID: challengeID, Purpose: entity.PasskeyPurposeRegistration}
+
+		err := repo.Consume(challenge)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &accountID, challenge.AccountID)
+		assert.Equal(t, []byte(`{"challenge":"abc"}`), challenge.SessionData)
+	})
+
+	t.Run("should return sql.ErrNoRows for an unknown challenge", func(t *testing.T) {
+		dbMock.EXPECT().ConsumePasskeyChallenge(context.Background(), gomock.Any()).Return(db.PasskeyChallenge{}, sql.ErrNoRows)
+
+		err := repo.Consume(&entity.PasskeyChallengeEntity{ID: uuid.New(), Purpose: entity.PasskeyPurposeSignIn})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestPasskeyChallengeRepository_DeleteAllByAccount(t *testing.T) {
+	dbMock, repo := setupPasskeyChallenge(t)
+
+	accountID := uuid.New()
+
+	dbMock.EXPECT().DeleteAccountPasskeyChallenges(context.Background(), accountID).Return(nil)
+
+	assert.NoError(t, repo.DeleteAllByAccount(accountID))
+}
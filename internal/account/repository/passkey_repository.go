@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+var ErrPasskeyAlreadyRegistered = errors.New("passkey already registered")
+
+type PasskeyRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=passkey_repository.go -destination=../mocks/passkey_repository_mock.go -package=mocks
+
+type PasskeyRepositoryInterface interface {
+	Create(passkey *entity.PasskeyEntity) error
+	ListByAccount(accountID uuid.UUID) ([]entity.PasskeyEntity, error)
+	UpdateUsage(passkey *entity.PasskeyEntity) error
+	Delete(passkey *entity.PasskeyEntity) (bool, error)
+	DeleteAllByAccount(accountID uuid.UUID) error
+}
+
+func NewPasskeyRepository(db db.Querier) *PasskeyRepository {
+	return &PasskeyRepository{db: db}
+}
+
+func (r *PasskeyRepository) Create(passkey *entity.PasskeyEntity) error {
+	fields := db.CreatePasskeyParams{
+		AccountID:       passkey.AccountID,
+		Name:            passkey.Name,
+		CredentialID:    passkey.CredentialID,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		Aaguid:          passkey.AAGUID,
+		SignCount:       int64(passkey.SignCount),
+		Transports:      passkey.Transports,
+		BackupEligible:  passkey.BackupEligible,
+		BackupState:     passkey.BackupState,
+	}
+
+	if fields.Transports == nil {
+		fields.Transports = []string{}
+	}
+
+	created, err := r.db.CreatePasskey(context.Background(), fields)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrPasskeyAlreadyRegistered
+		}
+		return fmt.Errorf("erro ao registrar passkey: %w", err)
+	}
+
+	*passkey = toPasskeyEntity(created)
+
+	return nil
+}
+
+// ListByAccount returns the passkeys of the account, oldest first.
+func (r *PasskeyRepository) ListByAccount(accountID uuid.UUID) ([]entity.PasskeyEntity, error) {
+	passkeys, err := r.db.ListAccountPasskeys(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar passkeys: %w", err)
+	}
+
+	result := make([]entity.PasskeyEntity, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		result = append(result, toPasskeyEntity(passkey))
+	}
+
+	return result, nil
+}
+
+// UpdateUsage records a sign-in with the passkey, saving the counter and
+// backup state reported by the authenticator.
+func (r *PasskeyRepository) UpdateUsage(passkey *entity.PasskeyEntity) error {
+	fields := db.UpdatePasskeyUsageParams{
+		ID:          passkey.ID,
+		SignCount:   int64(passkey.SignCount),
+		BackupState: passkey.BackupState,
+	}
+
+	if _, err := r.db.UpdatePasskeyUsage(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao atualizar passkey: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the passkey if it belongs to passkey.AccountID, and reports
+// whether it existed.
+func (r *PasskeyRepository) Delete(passkey *entity.PasskeyEntity) (bool, error) {
+	fields := db.DeletePasskeyParams{
+		ID:        passkey.ID,
+		AccountID: passkey.AccountID,
+	}
+
+	rows, err := r.db.DeletePasskey(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao remover passkey: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *PasskeyRepository) DeleteAllByAccount(accountID uuid.UUID) error {
+	if err := r.db.DeleteAccountPasskeys(context.Background(), accountID); err != nil {
+		return fmt.Errorf("erro ao remover passkeys da conta: %w", err)
+	}
+
+	return nil
+}
+
+func toPasskeyEntity(passkey db.Passkey) entity.PasskeyEntity {
+	return entity.PasskeyEntity{
+		ID:              passkey.ID,
+		AccountID:       passkey.AccountID,
+		Name:            passkey.Name,
+		CredentialID:    passkey.CredentialID,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		AAGUID:          passkey.Aaguid,
+		SignCount:       uint32(passkey.SignCount),
+		Transports:      passkey.Transports,
+		BackupEligible:  passkey.BackupEligible,
+		BackupState:     passkey.BackupState,
+		LastUsedAt:      utils.PgTimestampToTime(passkey.LastUsedAt),
+		CreatedAt:       passkey.CreatedAt.Time,
+	}
+}
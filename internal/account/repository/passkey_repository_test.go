@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPasskey(t *testing.T) (*mocks.MockQuerier, *PasskeyRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewPasskeyRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestPasskeyRepository_Create(t *testing.T) {
+	dbMock, repo := setupPasskey(t)
+	accountID := uuid.New()
+
+	t.Run("should save the passkey", func(t *testing.T) {
+		passkey := &entity.PasskeyEntity{
+			AccountID:      accountID,
+			Name:           "Laptop",
+			CredentialID:   []byte("credential"),
+			PublicKey:      []byte("public-key"),
+			SignCount:      3,
+			BackupEligible: true,
+		}
+
+		dbMock.EXPECT().CreatePasskey(context.Background(), db.CreatePasskeyParams{
+			AccountID:      accountID,
+			Name:           "Laptop",
+			CredentialID:   []byte("credential"),
+			PublicKey:      []byte("public-key"),
+			SignCount:      3,
+			Transports:     []string{},
+			BackupEligible: true,
+		}).Return(db.Passkey{
+			ID:           uuid.New(),
+			AccountID:    accountID,
+			Name:         "Laptop",
+			CredentialID: []byte("credential"),
+			SignCount:    3,
+		}, nil)
+
+		err := repo.Create(passkey)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, passkey.ID)
+		assert.Equal(t, uint32(3), passkey.SignCount)
+	})
+
+	t.Run("should return ErrPasskeyAlreadyRegistered for a known credential", func(t *testing.T) {
+		dbMock.EXPECT().CreatePasskey(context.Background(), gomock.Any()).Return(db.Passkey{}, &pgconn.PgError{Code: uniqueViolationCode})
+
+		err := repo.Create(&entity.PasskeyEntity{AccountID: accountID})
+
+		assert.ErrorIs(t, err, ErrPasskeyAlreadyRegistered)
+	})
+}
+
+func TestPasskeyRepository_ListByAccount(t *testing.T) {
+	dbMock, repo := setupPasskey(t)
+	accountID := uuid.New()
+
+	dbMock.EXPECT().ListAccountPasskeys(context.Background(), accountID).Return([]db.Passkey{
+		{ID: uuid.New(), AccountID: accountID, Name: "Laptop", Transports: []string{"internal"}},
+	}, nil)
+
+	passkeys, err := repo.ListByAccount(accountID)
+
+	assert.NoError(t, err)
+	assert.Len(t, passkeys, 1)
+	assert.Equal(t, "Laptop", passkeys[0].Name)
+	assert.Equal(t, []string{"internal"}, passkeys[0].Transports)
+}
+
+func TestPasskeyRepository_UpdateUsage(t *testing.T) {
+	dbMock, repo := setupPasskey(t)
+	passkey := &entity.PasskeyEntity{ID: uuid.New(), SignCount: 7, BackupState: true}
+
+	dbMock.EXPECT().UpdatePasskeyUsage(context.Background(), db.UpdatePasskeyUsageParams{
+		ID:          passkey.ID,
+		SignCount:   7,
+		BackupState: true,
+	}).Return(int64(1), nil)
+
+	err := repo.UpdateUsage(passkey)
+
+	assert.NoError(t, err)
+}
+
+func TestPasskeyRepository_Delete(t *testing.T) {
+	dbMock, repo := setupPasskey(t)
+	passkey := &entity.PasskeyEntity{ID: uuid.New(), AccountID: uuid.New()}
+	fields := db.DeletePasskeyParams{ID: passkey.ID, AccountID: passkey.AccountID}
+
+	t.Run("should report a deleted passkey", func(t *testing.T) {
+		dbMock.EXPECT().DeletePasskey(context.Background(), fields).Return(int64(1), nil)
+
+		deleted, err := repo.Delete(passkey)
+
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("should report a passkey of another account", func(t *testing.T) {
+		dbMock.EXPECT().DeletePasskey(context.Background(), fields).Return(int64(0), nil)
+
+		deleted, err := repo.Delete(passkey)
+
+		assert.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("should wrap database errors", func(t *testing.T) {
+		dbMock.EXPECT().DeletePasskey(context.Background(), fields).Return(int64(0), errors.New("db error"))
+
+		_, err := repo.Delete(passkey)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestPasskeyRepository_DeleteAllByAccount(t *testing.T) {
+	dbMock, repo := setupPasskey(t)
+
+	accountID := uuid.New()
+
+	dbMock.EXPECT().DeleteAccountPasskeys(context.Background(), accountID).Return(nil)
+
+	assert.NoError(t, repo.DeleteAllByAccount(accountID))
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+type PasswordResetTokenRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=password_reset_token_repository.go -destination=../mocks/password_reset_token_repository_mock.go -package=mocks
+
+type PasswordResetTokenRepositoryInterface interface {
+	Create(token *entity.PasswordResetTokenEntity) error
+	FindByHash(token *entity.PasswordResetTokenEntity) error
+	MarkUsed(token *entity.PasswordResetTokenEntity) (bool, error)
+	InvalidateAllByAccount(accountID uuid.UUID) error
+}
+
+func NewPasswordResetTokenRepository(db db.Querier) *PasswordResetTokenRepository {
+	return &PasswordResetTokenRepository{db: db}
+}
+
+func (r *PasswordResetTokenRepository) Create(token *entity.PasswordResetTokenEntity) error {
+	fields := db.CreatePasswordResetTokenParams{
+		AccountID: token.AccountID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: utils.TimeToPgTimestamp(&token.ExpiresAt),
+	}
+
+	prt, err := r.db.CreatePasswordResetToken(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar token de redefinição de senha: %w", err)
+	}
+
+	token.ID = prt.ID
+	token.ExpiresAt = prt.ExpiresAt.Time
+	token.CreatedAt = prt.CreatedAt.Time
+
+	return nil
+}
+
+func (r *PasswordResetTokenRepository) FindByHash(token *entity.PasswordResetTokenEntity) error {
+	prt, err := r.db.FindPasswordResetTokenByHash(context.Background(), token.TokenHash)
+
+	if err != nil {
+		return err
+	}
+
+	*token = entity.PasswordResetTokenEntity{
+		ID:        prt.ID,
+		AccountID: prt.AccountID,
+		Token:     token.Token,
+		TokenHash: prt.TokenHash,
+		ExpiresAt: prt.ExpiresAt.Time,
+		UsedAt:    utils.PgTimestampToTime(prt.UsedAt),
+		CreatedAt: prt.CreatedAt.Time,
+	}
+
+	return nil
+}
+
+// MarkUsed reports whether the token was still unused, so a token can only be
+// consumed once even under concurrent requests.
+func (r *PasswordResetTokenRepository) MarkUsed(token *entity.PasswordResetTokenEntity) (bool, error) {
+	rows, err := r.db.UsePasswordResetToken(context.Background(), token.ID)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao consumir token de redefinição de senha: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *PasswordResetTokenRepository) InvalidateAllByAccount(accountID uuid.UUID) error {
+	if err := r.db.InvalidateAccountPasswordResetTokens(context.Background(), accountID); err != nil {
+		return fmt.Errorf("erro ao invalidar tokens de redefinição de senha: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPasswordResetToken(t *testing.T) (*mocks.MockQuerier, *PasswordResetTokenRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewPasswordResetTokenRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestPasswordResetTokenRepository_Create(t *testing.T) {
+	dbMock, repo := setupPasswordResetToken(t)
+
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	token := &entity.PasswordResetTokenEntity{
+		AccountID: uuid.New(),
+		TokenHash: "hash",
+		ExpiresAt: expiresAt,
+	}
+
+	t.Run("should persist the token", func(t *testing.T) {
+		id := uuid.New()
+
+		dbMock.EXPECT().CreatePasswordResetToken(context.Background(), db.CreatePasswordResetTokenParams{
+			AccountID: token.AccountID,
+			TokenHash: token.TokenHash,
+			ExpiresAt: utils.TimeToPgTimestamp(&expiresAt),
+		}).Return(db.PasswordResetToken{ID: id, ExpiresAt: utils.TimeToPgTimestamp(&expiresAt)}, nil)
+
+		err := repo.Create(token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, token.ID)
+	})
+
+	t.Run("should return an error when insert fails", func(t *testing.T) {
+		dbMock.EXPECT().CreatePasswordResetToken(context.Background(), gomock.Any()).Return(db.PasswordResetToken{}, errors.New("database error"))
+
+		assert.Error(t, repo.Create(token))
+	})
+}
+
+func TestPasswordResetTokenRepository_MarkUsed(t *testing.T) {
+	dbMock, repo := setupPasswordResetToken(t)
+
+	token := &entity.PasswordResetTokenEntity{ID: uuid.New()}
+
+	t.Run("should consume an unused token", func(t *testing.T) {
+		dbMock.EXPECT().UsePasswordResetToken(context.Background(), token.ID).Return(int64(1), nil)
+
+		used, err := repo.MarkUsed(token)
+
+		assert.NoError(t, err)
+		assert.True(t, used)
+	})
+
+	t.Run("should not consume a token twice", func(t *testing.T) {
+		dbMock.EXPECT().UsePasswordResetToken(context.Background(), token.ID).Return(int64(0), nil)
+
+		used, err := repo.MarkUsed(token)
+
+		assert.NoError(t, err)
+		assert.False(t, used)
+	})
+}
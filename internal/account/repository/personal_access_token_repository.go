@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+type PersonalAccessTokenRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=personal_access_token_repository.go -destination=../mocks/personal_access_token_repository_mock.go -package=mocks
+
+type PersonalAccessTokenRepositoryInterface interface {
+	Create(token *entity.PersonalAccessTokenEntity) error
+	FindByHash(token *entity.PersonalAccessTokenEntity) error
+	ListByAccount(accountID uuid.UUID) ([]entity.PersonalAccessTokenEntity, error)
+	Revoke(token *entity.PersonalAccessTokenEntity) error
+	RevokeAllByAccount(accountID uuid.UUID) error
+	Touch(token *entity.PersonalAccessTokenEntity, usedBefore time.Time) error
+}
+
+func NewPersonalAccessTokenRepository(db db.Querier) *PersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepository{db: db}
+}
+
+func (r *PersonalAccessTokenRepository) Create(token *entity.PersonalAccessTokenEntity) error {
+	fields := db.CreatePersonalAccessTokenParams{
+		AccountID: token.AccountID,
+		Name:      token.Name,
+		TokenHash: token.TokenHash,
+		Scopes:    token.Scopes,
+		ExpiresAt: utils.TimeToPgTimestamp(&token.ExpiresAt),
+	}
+
+	pat, err := r.db.CreatePersonalAccessToken(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar token de acesso pessoal: %w", err)
+	}
+
+	*token = toPersonalAccessTokenEntity(pat, token.Token)
+
+	return nil
+}
+
+func (r *PersonalAccessTokenRepository) FindByHash(token *entity.PersonalAccessTokenEntity) error {
+	pat, err := r.db.FindPersonalAccessTokenByHash(context.Background(), token.TokenHash)
+
+	if err != nil {
+		return err
+	}
+
+	*token = toPersonalAccessTokenEntity(pat, token.Token)
+
+	return nil
+}
+
+// ListByAccount returns the tokens of the account that were not revoked,
+// newest first. Expired tokens are included so they can be cleaned up.
+func (r *PersonalAccessTokenRepository) ListByAccount(accountID uuid.UUID) ([]entity.PersonalAccessTokenEntity, error) {
+	pats, err := r.db.ListAccountPersonalAccessTokens(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar tokens de acesso pessoal: %w", err)
+	}
+
+	tokens := make([]entity.PersonalAccessTokenEntity, 0, len(pats))
+	for _, pat := range pats {
+		tokens = append(tokens, toPersonalAccessTokenEntity(pat, ""))
+	}
+
+	return tokens, nil
+}
+
+// Revoke revokes the token as long as it belongs to token.AccountID. It fails
+// with sql.ErrNoRows when there is no such active token.
+func (r *PersonalAccessTokenRepository) Revoke(token *entity.PersonalAccessTokenEntity) error {
+	fields := db.RevokePersonalAccessTokenParams{
+		ID:        token.ID,
+		AccountID: token.AccountID,
+	}
+
+	rows, err := r.db.RevokePersonalAccessToken(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao revogar token de acesso pessoal: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// RevokeAllByAccount revokes every token of the account that is still
+// active.
+func (r *PersonalAccessTokenRepository) RevokeAllByAccount(accountID uuid.UUID) error {
+	if err := r.db.RevokeAccountPersonalAccessTokens(context.Background(), accountID); err != nil {
+		return fmt.Errorf("erro ao revogar tokens de acesso pessoal da conta: %w", err)
+	}
+
+	return nil
+}
+
+// Touch records that the token is being used now. The write is skipped when
+// the last use was recorded after usedBefore, so busy tokens do not update the
+// row on every request.
+func (r *PersonalAccessTokenRepository) Touch(token *entity.PersonalAccessTokenEntity, usedBefore time.Time) error {
+	usedAt := time.Now().UTC()
+
+	fields := db.TouchPersonalAccessTokenParams{
+		ID:         token.ID,
+		UsedAt:     utils.TimeToPgTimestamp(&usedAt),
+		UsedBefore: utils.TimeToPgTimestamp(&usedBefore),
+	}
+
+	if err := r.db.TouchPersonalAccessToken(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao registrar uso do token de acesso pessoal: %w", err)
+	}
+
+	return nil
+}
+
+func toPersonalAccessTokenEntity(pat db.PersonalAccessToken, token string) entity.PersonalAccessTokenEntity {
+	return entity.PersonalAccessTokenEntity{
+		ID:         pat.ID,
+		AccountID:  pat.AccountID,
+		Name:       pat.Name,
+		Token:      token,
+		TokenHash:  pat.TokenHash,
+		Scopes:     pat.Scopes,
+		ExpiresAt:  pat.ExpiresAt.Time,
+		LastUsedAt: utils.PgTimestampToTime(pat.LastUsedAt),
+		RevokedAt:  utils.PgTimestampToTime(pat.RevokedAt),
+		CreatedAt:  pat.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPersonalAccessToken(t *testing.T) (*mocks.MockQuerier, *PersonalAccessTokenRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewPersonalAccessTokenRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestPersonalAccessTokenRepository_Create(t *testing.T) {
+	dbMock, repo := setupPersonalAccessToken(t)
+
+	expiresAt := time.Now().UTC().AddDate(0, 0, 30)
+	token := &entity.PersonalAccessTokenEntity{
+		AccountID: uuid.New(),
+		Name:      "ci",
+		Token:     "trl_pat_secret",
+		TokenHash: "hash",
+		Scopes:    []string{"read"},
+		ExpiresAt: expiresAt,
+	}
+
+	id := uuid.New()
+
+	dbMock.EXPECT().CreatePersonalAccessToken(context.Background(), db.CreatePersonalAccessTokenParams{
+		AccountID: token.AccountID,
+		Name:      token.Name,
+		TokenHash: token.TokenHash,
+		Scopes:    token.Scopes,
+		ExpiresAt: utils.TimeToPgTimestamp(&expiresAt),
+	}).Return(db.PersonalAccessToken{ID: id, AccountID: token.AccountID, Name: "ci", Scopes: []string{"read"}}, nil)
+
+	err := repo.Create(token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, token.ID)
+	assert.Equal(t, "trl_pat_secret", token.Token)
+}
+
+func TestPersonalAccessTokenRepository_Revoke(t *testing.T) {
+	dbMock, repo := setupPersonalAccessToken(t)
+
+	token := &entity.PersonalAccessTokenEntity{ID: uuid.New(), AccountID: uuid.New()}
+	fields := db.RevokePersonalAccessTokenParams{ID: token.ID, AccountID: token.AccountID}
+
+	t.Run("should revoke a token of the account", func(t *testing.T) {
+		dbMock.EXPECT().RevokePersonalAccessToken(context.Background(), fields).Return(int64(1), nil)
+
+		assert.NoError(t, repo.Revoke(token))
+	})
+
+	t.Run("should return sql.ErrNoRows for unknown or foreign tokens", func(t *testing.T) {
+		dbMock.EXPECT().RevokePersonalAccessToken(context.Background(), fields).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.Revoke(token), sql.ErrNoRows)
+	})
+}
+
+func TestPersonalAccessTokenRepository_RevokeAllByAccount(t *testing.T) {
+	dbMock, repo := setupPersonalAccessToken(t)
+
+	accountID := uuid.New()
+
+	dbMock.EXPECT().RevokeAccountPersonalAccessTokens(context.Background(), accountID).Return(nil)
+
+	assert.NoError(t, repo.RevokeAllByAccount(accountID))
+}
+
+func TestPersonalAccessTokenRepository_ListByAccount(t *testing.T) {
+	dbMock, repo := setupPersonalAccessToken(t)
+
+	accountID := uuid.New()
+
+	dbMock.EXPECT().ListAccountPersonalAccessTokens(context.Background(), accountID).Return([]db.PersonalAccessToken{
+		{ID: uuid.New(), AccountID: accountID, Name: "ci"},
+		{ID: uuid.New(), AccountID: accountID, Name: "backup"},
+	}, nil)
+
+	tokens, err := repo.ListByAccount(accountID)
+
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 2)
+	assert.Equal(t, "backup", tokens[1].Name)
+}
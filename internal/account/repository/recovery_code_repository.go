@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+type RecoveryCodeRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=recovery_code_repository.go -destination=../mocks/recovery_code_repository_mock.go -package=mocks
+
+type RecoveryCodeRepositoryInterface interface {
+	ReplaceAll(accountID uuid.UUID, codeHashes []string) error
+	Use(accountID uuid.UUID, codeHash string) (bool, error)
+	CountUnused(accountID uuid.UUID) (int64, error)
+	Summarize(accountID uuid.UUID) (entity.RecoveryCodesEntity, error)
+	DeleteAllByAccount(accountID uuid.UUID) error
+}
+
+func NewRecoveryCodeRepository(db db.Querier) *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{db: db}
+}
+
+// ReplaceAll discards every recovery code of the account and stores the given
+// hashes in their place.
+func (r *RecoveryCodeRepository) ReplaceAll(accountID uuid.UUID, codeHashes []string) error {
+	if err := r.DeleteAllByAccount(accountID); err != nil {
+		return err
+	}
+
+	fields := db.CreateRecoveryCodesParams{
+		AccountID:  accountID,
+		CodeHashes: codeHashes,
+	}
+
+	if err := r.db.CreateRecoveryCodes(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao registrar códigos de recuperação: %w", err)
+	}
+
+	return nil
+}
+
+// Use consumes the recovery code and reports whether it was still unused.
+func (r *RecoveryCodeRepository) Use(accountID uuid.UUID, codeHash string) (bool, error) {
+	fields := db.UseRecoveryCodeParams{
+		AccountID: accountID,
+		CodeHash:  codeHash,
+	}
+
+	rows, err := r.db.UseRecoveryCode(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao consumir código de recuperação: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RecoveryCodeRepository) CountUnused(accountID uuid.UUID) (int64, error) {
+	count, err := r.db.CountUnusedRecoveryCodes(context.Background(), accountID)
+
+	if err != nil {
+		return 0, fmt.Errorf("erro ao contar códigos de recuperação: %w", err)
+	}
+
+	return count, nil
+}
+
+// Summarize describes the recovery codes of the account, leaving their
+// hashes out.
+func (r *RecoveryCodeRepository) Summarize(accountID uuid.UUID) (entity.RecoveryCodesEntity, error) {
+	summary, err := r.db.SummarizeAccountRecoveryCodes(context.Background(), accountID)
+
+	if err != nil {
+		return entity.RecoveryCodesEntity{}, fmt.Errorf("erro ao resumir códigos de recuperação: %w", err)
+	}
+
+	return entity.RecoveryCodesEntity{
+		Count:     summary.Total,
+		Unused:    summary.Unused,
+		CreatedAt: utils.PgTimestampToTime(summary.CreatedAt),
+	}, nil
+}
+
+func (r *RecoveryCodeRepository) DeleteAllByAccount(accountID uuid.UUID) error {
+	if err := r.db.DeleteAccountRecoveryCodes(context.Background(), accountID); err != nil {
+		return fmt.Errorf("erro ao remover códigos de recuperação: %w", err)
+	}
+
+	return nil
+}
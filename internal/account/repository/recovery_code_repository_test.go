@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupRecoveryCode(t *testing.T) (*mocks.MockQuerier, *RecoveryCodeRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewRecoveryCodeRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestRecoveryCodeRepository_ReplaceAll(t *testing.T) {
+	dbMock, repo := setupRecoveryCode(t)
+
+	accountID := uuid.New()
+	hashes := []string{"hash-1", "hash-2"}
+
+	t.Run("should delete the previous codes before storing the new ones", func(t *testing.T) {
+		gomock.InOrder(
+			dbMock.EXPECT().DeleteAccountRecoveryCodes(context.Background(), accountID).Return(nil),
+			dbMock.EXPECT().CreateRecoveryCodes(context.Background(), db.CreateRecoveryCodesParams{
+				AccountID:  accountID,
+				CodeHashes: hashes,
+			}).Return(nil),
+		)
+
+		assert.NoError(t, repo.ReplaceAll(accountID, hashes))
+	})
+
+	t.Run("should not store the new codes when delete fails", func(t *testing.T) {
+		dbMock.EXPECT().DeleteAccountRecoveryCodes(context.Background(), accountID).Return(errors.New("database error"))
+
+		assert.Error(t, repo.ReplaceAll(accountID, hashes))
+	})
+}
+
+func TestRecoveryCodeRepository_Use(t *testing.T) {
+	dbMock, repo := setupRecoveryCode(t)
+
+	accountID := uuid.New()
+	fields := db.UseRecoveryCodeParams{AccountID: accountID, CodeHash: "hash"}
+
+	t.Run("should report an unused code", func(t *testing.T) {
+		dbMock.EXPECT().UseRecoveryCode(context.Background(), fields).Return(int64(1), nil)
+
+		used, err := repo.Use(accountID, "hash")
+
+		assert.NoError(t, err)
+		assert.True(t, used)
+	})
+
+	t.Run("should report a used or unknown code", func(t *testing.T) {
+		dbMock.EXPECT().UseRecoveryCode(context.Background(), fields).Return(int64(0), nil)
+
+		used, err := repo.Use(accountID, "hash")
+
+		assert.NoError(t, err)
+		assert.False(t, used)
+	})
+}
+
+func TestRecoveryCodeRepository_Summarize(t *testing.T) {
+	dbMock, repo := setupRecoveryCode(t)
+
+	accountID := uuid.New()
+
+	t.Run("should count the codes of the account", func(t *testing.T) {
+		dbMock.EXPECT().SummarizeAccountRecoveryCodes(context.Background(), accountID).
+			Return(db.SummarizeAccountRecoveryCodesRow{Total: 10, Unused: 8}, nil)
+
+		summary, err := repo.Summarize(accountID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), summary.Count)
+		assert.Equal(t, int64(8), summary.Unused)
+		assert.Nil(t, summary.CreatedAt)
+	})
+
+	t.Run("should wrap errors", func(t *testing.T) {
+		dbMock.EXPECT().SummarizeAccountRecoveryCodes(context.Background(), accountID).
+			Return(db.SummarizeAccountRecoveryCodesRow{}, errors.New("db down"))
+
+		_, err := repo.Summarize(accountID)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+type RefreshTokenRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=refresh_token_repository.go -destination=../mocks/refresh_token_repository_mock.go -package=mocks
+
+type RefreshTokenRepositoryInterface interface {
+	Create(token *entity.RefreshTokenEntity) error
+	FindByHash(token *entity.RefreshTokenEntity) error
+	Revoke(token *entity.RefreshTokenEntity) (bool, error)
+	RevokeAllByAccount(accountID uuid.UUID) error
+}
+
+func NewRefreshTokenRepository(db db.Querier) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+func (r *RefreshTokenRepository) Create(token *entity.RefreshTokenEntity) error {
+	fields := db.CreateRefreshTokenParams{
+		AccountID: token.AccountID,
+		SessionID: token.SessionID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: utils.TimeToPgTimestamp(&token.ExpiresAt),
+	}
+
+	rt, err := r.db.CreateRefreshToken(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar refresh token: %w", err)
+	}
+
+	token.ID = rt.ID
+	token.ExpiresAt = rt.ExpiresAt.Time
+	token.RevokedAt = utils.PgTimestampToTime(rt.RevokedAt)
+	token.CreatedAt = rt.CreatedAt.Time
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) FindByHash(token *entity.RefreshTokenEntity) error {
+	rt, err := r.db.FindRefreshTokenByHash(context.Background(), token.TokenHash)
+
+	if err != nil {
+		return err
+	}
+
+	*token = entity.RefreshTokenEntity{
+		ID:        rt.ID,
+		AccountID: rt.AccountID,
+		SessionID: rt.SessionID,
+		Token:     token.Token,
+		TokenHash: rt.TokenHash,
+		ExpiresAt: rt.ExpiresAt.Time,
+		RevokedAt: utils.PgTimestampToTime(rt.RevokedAt),
+		CreatedAt: rt.CreatedAt.Time,
+	}
+
+	return nil
+}
+
+// Revoke reports whether the token was still active, so concurrent rotations
+// of the same refresh token can only succeed once.
+func (r *RefreshTokenRepository) Revoke(token *entity.RefreshTokenEntity) (bool, error) {
+	rows, err := r.db.RevokeRefreshToken(context.Background(), token.ID)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao revogar refresh token: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RefreshTokenRepository) RevokeAllByAccount(accountID uuid.UUID) error {
+	if err := r.db.RevokeAccountRefreshTokens(context.Background(), accountID); err != nil {
+		return fmt.Errorf("erro ao revogar refresh tokens da conta: %w", err)
+	}
+
+	return nil
+}
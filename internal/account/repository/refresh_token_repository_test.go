@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupRefreshToken(t *testing.T) (*mocks.MockQuerier, *RefreshTokenRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewRefreshTokenRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestRefreshTokenRepository_Create(t *testing.T) {
+	dbMock, repo := setupRefreshToken(t)
+
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	token := &entity.RefreshTokenEntity{
+		AccountID: uuid.New(),
+		SessionID: uuid.New(),
+		TokenHash: "hash",
+		ExpiresAt: expiresAt,
+	}
+
+	t.Run("should persist the refresh token", func(t *testing.T) {
+		id := uuid.New()
+
+		dbMock.EXPECT().CreateRefreshToken(context.Background(), db.CreateRefreshTokenParams{
+			AccountID: token.AccountID,
+			SessionID: token.SessionID,
+			TokenHash: token.TokenHash,
+			ExpiresAt: utils.TimeToPgTimestamp(&expiresAt),
+		}).Return(db.RefreshToken{
+			ID:        id,
+			AccountID: token.AccountID,
+			SessionID: token.SessionID,
+			TokenHash: token.TokenHash,
+			ExpiresAt: utils.TimeToPgTimestamp(&expiresAt),
+		}, nil)
+
+		err := repo.Create(token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, token.ID)
+	})
+
+	t.Run("should return an error when insert fails", func(t *testing.T) {
+		dbMock.EXPECT().CreateRefreshToken(context.Background(), gomock.Any()).Return(db.RefreshToken{}, errors.New("database error"))
+
+		err := repo.Create(token)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRefreshTokenRepository_FindByHash(t *testing.T) {
+	dbMock, repo := setupRefreshToken(t)
+
+	revokedAt := time.Now()
+	expected := db.RefreshToken{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		TokenHash: "hash",
+		RevokedAt: utils.TimeToPgTimestamp(&revokedAt),
+	}
+
+	dbMock.EXPECT().FindRefreshTokenByHash(context.Background(), "hash").Return(expected, nil)
+
+	token := &entity.RefreshTokenEntity{TokenHash: "hash"}
+	err := repo.FindByHash(token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected.ID, token.ID)
+	assert.Equal(t, expected.AccountID, token.AccountID)
+	assert.Equal(t, &expected.RevokedAt.Time, token.RevokedAt)
+}
+
+func TestRefreshTokenRepository_Revoke(t *testing.T) {
+	dbMock, repo := setupRefreshToken(t)
+
+	token := &entity.RefreshTokenEntity{ID: uuid.New()}
+
+	t.Run("should report an active token as revoked", func(t *testing.T) {
+		dbMock.EXPECT().RevokeRefreshToken(context.Background(), token.ID).Return(int64(1), nil)
+
+		revoked, err := repo.Revoke(token)
+
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("should report an already revoked token", func(t *testing.T) {
+		dbMock.EXPECT().RevokeRefreshToken(context.Background(), token.ID).Return(int64(0), nil)
+
+		revoked, err := repo.Revoke(token)
+
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
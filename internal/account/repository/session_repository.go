@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+type SessionRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=session_repository.go -destination=../mocks/session_repository_mock.go -package=mocks
+
+type SessionRepositoryInterface interface {
+	Create(session *entity.SessionEntity) error
+	ListByAccount(accountID uuid.UUID) ([]entity.SessionEntity, error)
+	Touch(session *entity.SessionEntity) (bool, error)
+	Revoke(session *entity.SessionEntity) (bool, error)
+	RevokeAllByAccount(accountID, exceptID uuid.UUID) error
+}
+
+func NewSessionRepository(db db.Querier) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+func (r *SessionRepository) Create(session *entity.SessionEntity) error {
+	fields := db.CreateSessionParams{
+		AccountID: session.AccountID,
+		UserAgent: session.UserAgent,
+		IpAddress: session.IPAddress,
+		ExpiresAt: utils.TimeToPgTimestamp(&session.ExpiresAt),
+	}
+
+	created, err := r.db.CreateSession(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar sessão: %w", err)
+	}
+
+	*session = toSessionEntity(created)
+
+	return nil
+}
+
+// ListByAccount returns the active sessions of the account, most recently
+// used first.
+func (r *SessionRepository) ListByAccount(accountID uuid.UUID) ([]entity.SessionEntity, error) {
+	sessions, err := r.db.ListAccountSessions(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar sessões: %w", err)
+	}
+
+	result := make([]entity.SessionEntity, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, toSessionEntity(session))
+	}
+
+	return result, nil
+}
+
+// Touch records a refresh of the session from its current device, and
+// reports whether the session was still active.
+func (r *SessionRepository) Touch(session *entity.SessionEntity) (bool, error) {
+	fields := db.TouchSessionParams{
+		ID:        session.ID,
+		UserAgent: session.UserAgent,
+		IpAddress: session.IPAddress,
+		ExpiresAt: utils.TimeToPgTimestamp(&session.ExpiresAt),
+	}
+
+	rows, err := r.db.TouchSession(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao atualizar sessão: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// Revoke ends the session if it belongs to session.AccountID, and reports
+// whether it was still active.
+func (r *SessionRepository) Revoke(session *entity.SessionEntity) (bool, error) {
+	fields := db.RevokeSessionParams{
+		ID:        session.ID,
+		AccountID: session.AccountID,
+	}
+
+	rows, err := r.db.RevokeSession(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao revogar sessão: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// RevokeAllByAccount ends every session of the account but exceptID, which
+// may be uuid.Nil to end them all.
+func (r *SessionRepository) RevokeAllByAccount(accountID, exceptID uuid.UUID) error {
+	fields := db.RevokeAccountSessionsParams{
+		AccountID: accountID,
+		ExceptID:  exceptID,
+	}
+
+	if err := r.db.RevokeAccountSessions(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao revogar sessões da conta: %w", err)
+	}
+
+	return nil
+}
+
+func toSessionEntity(session db.Session) entity.SessionEntity {
+	return entity.SessionEntity{
+		ID:         session.ID,
+		AccountID:  session.AccountID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IpAddress,
+		ExpiresAt:  session.ExpiresAt.Time,
+		LastSeenAt: session.LastSeenAt.Time,
+		RevokedAt:  utils.PgTimestampToTime(session.RevokedAt),
+		CreatedAt:  session.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSession(t *testing.T) (*mocks.MockQuerier, *SessionRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewSessionRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestSessionRepository_Create(t *testing.T) {
+	dbMock, repo := setupSession(t)
+
+	expiresAt := time.Now().UTC().Add(time.Hour)
+
+	t.Run("should persist the session", func(t *testing.T) {
+		session := &entity.SessionEntity{
+			AccountID: uuid.New(),
+			UserAgent: "Mozilla/5.0",
+			IPAddress: "10.0.0.1",
+			ExpiresAt: expiresAt,
+		}
+		id := uuid.New()
+
+		dbMock.EXPECT().CreateSession(context.Background(), db.CreateSessionParams{
+			AccountID: session.AccountID,
+			UserAgent: session.UserAgent,
+			IpAddress: session.IPAddress,
+			ExpiresAt: utils.TimeToPgTimestamp(&expiresAt),
+		}).Return(db.Session{
+			ID:        id,
+			AccountID: session.AccountID,
+			UserAgent: session.UserAgent,
+			IpAddress: session.IPAddress,
+			ExpiresAt: utils.TimeToPgTimestamp(&expiresAt),
+		}, nil)
+
+		err := repo.Create(session)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, session.ID)
+		assert.Nil(t, session.RevokedAt)
+	})
+
+	t.Run("should return an error when insert fails", func(t *testing.T) {
+		dbMock.EXPECT().CreateSession(context.Background(), gomock.Any()).Return(db.Session{}, errors.New("database error"))
+
+		err := repo.Create(&entity.SessionEntity{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSessionRepository_ListByAccount(t *testing.T) {
+	dbMock, repo := setupSession(t)
+
+	accountID := uuid.New()
+
+	dbMock.EXPECT().ListAccountSessions(context.Background(), accountID).Return([]db.Session{
+		{ID: uuid.New(), AccountID: accountID, UserAgent: "Mozilla/5.0"},
+		{ID: uuid.New(), AccountID: accountID, UserAgent: "curl/8.0"},
+	}, nil)
+
+	sessions, err := repo.ListByAccount(accountID)
+
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, "curl/8.0", sessions[1].UserAgent)
+}
+
+func TestSessionRepository_Touch(t *testing.T) {
+	dbMock, repo := setupSession(t)
+
+	session := &entity.SessionEntity{ID: uuid.New(), UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.2"}
+
+	t.Run("should report an active session", func(t *testing.T) {
+		dbMock.EXPECT().TouchSession(context.Background(), gomock.Any()).Return(int64(1), nil)
+
+		active, err := repo.Touch(session)
+
+		assert.NoError(t, err)
+		assert.True(t, active)
+	})
+
+	t.Run("should report a revoked session", func(t *testing.T) {
+		dbMock.EXPECT().TouchSession(context.Background(), gomock.Any()).Return(int64(0), nil)
+
+		active, err := repo.Touch(session)
+
+		assert.NoError(t, err)
+		assert.False(t, active)
+	})
+}
+
+func TestSessionRepository_Revoke(t *testing.T) {
+	dbMock, repo := setupSession(t)
+
+	session := &entity.SessionEntity{ID: uuid.New(), AccountID: uuid.New()}
+
+	dbMock.EXPECT().RevokeSession(context.Background(), db.RevokeSessionParams{
+		ID:        session.ID,
+		AccountID: session.AccountID,
+	}).Return(int64(1), nil)
+
+	revoked, err := repo.Revoke(session)
+
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestSessionRepository_RevokeAllByAccount(t *testing.T) {
+	dbMock, repo := setupSession(t)
+
+	accountID := uuid.New()
+	exceptID := uuid.New()
+
+	dbMock.EXPECT().RevokeAccountSessions(context.Background(), db.RevokeAccountSessionsParams{
+		AccountID: accountID,
+		ExceptID:  exceptID,
+	}).Return(nil)
+
+	err := repo.RevokeAllByAccount(accountID, exceptID)
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"trilha-api/internal/account/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+)
+
+type SignInThrottleRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=sign_in_throttle_repository.go -destination=../mocks/sign_in_throttle_repository_mock.go -package=mocks
+
+type SignInThrottleRepositoryInterface interface {
+	Find(throttle *entity.SignInThrottleEntity) error
+	RecordFailure(throttle *entity.SignInThrottleEntity, resetBefore time.Time) error
+	Lock(throttle *entity.SignInThrottleEntity) error
+	Clear(throttle *entity.SignInThrottleEntity) (bool, error)
+}
+
+func NewSignInThrottleRepository(db db.Querier) *SignInThrottleRepository {
+	return &SignInThrottleRepository{db: db}
+}
+
+func (r *SignInThrottleRepository) Find(throttle *entity.SignInThrottleEntity) error {
+	fields := db.FindSignInThrottleParams{
+		Scope:   throttle.Scope,
+		Subject: throttle.Subject,
+	}
+
+	found, err := r.db.FindSignInThrottle(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*throttle = toSignInThrottleEntity(found)
+
+	return nil
+}
+
+// RecordFailure counts a failure at throttle.LastFailureAt. Failures older
+// than resetBefore are forgotten, as is an expired lockout.
+func (r *SignInThrottleRepository) RecordFailure(throttle *entity.SignInThrottleEntity, resetBefore time.Time) error {
+	fields := db.RecordSignInFailureParams{
+		Scope:       throttle.Scope,
+		Subject:     throttle.Subject,
+		FailedAt:    utils.TimeToPgTimestamp(&throttle.LastFailureAt),
+		ResetBefore: utils.TimeToPgTimestamp(&resetBefore),
+	}
+
+	recorded, err := r.db.RecordSignInFailure(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao registrar tentativa de login: %w", err)
+	}
+
+	*throttle = toSignInThrottleEntity(recorded)
+
+	return nil
+}
+
+func (r *SignInThrottleRepository) Lock(throttle *entity.SignInThrottleEntity) error {
+	fields := db.LockSignInThrottleParams{
+		Scope:       throttle.Scope,
+		Subject:     throttle.Subject,
+		LockedUntil: utils.TimeToPgTimestamp(throttle.LockedUntil),
+	}
+
+	if err := r.db.LockSignInThrottle(context.Background(), fields); err != nil {
+		return fmt.Errorf("erro ao bloquear login: %w", err)
+	}
+
+	return nil
+}
+
+// Clear forgets the failures of the subject and reports whether there were
+// any.
+func (r *SignInThrottleRepository) Clear(throttle *entity.SignInThrottleEntity) (bool, error) {
+	fields := db.ClearSignInThrottleParams{
+		Scope:   throttle.Scope,
+		Subject: throttle.Subject,
+	}
+
+	rows, err := r.db.ClearSignInThrottle(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao limpar tentativas de login: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func toSignInThrottleEntity(throttle db.SignInThrottle) entity.SignInThrottleEntity {
+	return entity.SignInThrottleEntity{
+		Scope:         throttle.Scope,
+		Subject:       throttle.Subject,
+		Failures:      int(throttle.Failures),
+		LastFailureAt: throttle.LastFailureAt.Time,
+		LockedUntil:   utils.PgTimestampToTime(throttle.LockedUntil),
+	}
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSignInThrottle(t *testing.T) (*mocks.MockQuerier, *SignInThrottleRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewSignInThrottleRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestSignInThrottleRepository_RecordFailure(t *testing.T) {
+	dbMock, repo := setupSignInThrottle(t)
+
+	failedAt := time.Now().UTC()
+	resetBefore := failedAt.Add(-15 * time.Minute)
+	throttle := &entity.SignInThrottleEntity{Scope: "account", Subject: "gandalf@lor.com.br", LastFailureAt: failedAt}
+
+	dbMock.EXPECT().RecordSignInFailure(context.Background(), db.RecordSignInFailureParams{
+		Scope:       "account",
+		Subject:     "gandalf@lor.com.br",
+		FailedAt:    utils.TimeToPgTimestamp(&failedAt),
+		ResetBefore: utils.TimeToPgTimestamp(&resetBefore),
+	}).Return(db.SignInThrottle{
+		Scope:         "account",
+		Subject:       "gandalf@lor.com.br",
+		Failures:      3,
+		LastFailureAt: utils.TimeToPgTimestamp(&failedAt),
+	}, nil)
+
+	err := repo.RecordFailure(throttle, resetBefore)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, throttle.Failures)
+	assert.Nil(t, throttle.LockedUntil)
+}
+
+func TestSignInThrottleRepository_Lock(t *testing.T) {
+	dbMock, repo := setupSignInThrottle(t)
+
+	lockedUntil := time.Now().UTC().Add(15 * time.Minute)
+	throttle := &entity.SignInThrottleEntity{Scope: "ip", Subject: "10.0.0.1", LockedUntil: &lockedUntil}
+
+	dbMock.EXPECT().LockSignInThrottle(context.Background(), db.LockSignInThrottleParams{
+		Scope:       "ip",
+		Subject:     "10.0.0.1",
+		LockedUntil: utils.TimeToPgTimestamp(&lockedUntil),
+	}).Return(nil)
+
+	assert.NoError(t, repo.Lock(throttle))
+}
+
+func TestSignInThrottleRepository_Clear(t *testing.T) {
+	dbMock, repo := setupSignInThrottle(t)
+
+	throttle := &entity.SignInThrottleEntity{Scope: "account", Subject: "gandalf@lor.com.br"}
+
+	dbMock.EXPECT().ClearSignInThrottle(context.Background(), db.ClearSignInThrottleParams{
+		Scope:   "account",
+		Subject: "gandalf@lor.com.br",
+	}).Return(int64(1), nil)
+
+	cleared, err := repo.Clear(throttle)
+
+	assert.NoError(t, err)
+	assert.True(t, cleared)
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/privacy"
+
+	"github.com/google/uuid"
+)
+
+// AccountDataUseCase takes part in data exports and erasures for what the
+// account module keeps: the account itself, its sessions, personal access
+// tokens, passkeys, linked identities, email change requests and recovery
+// codes. Secrets such as password hashes and the codes themselves are never
+// exported.
+type AccountDataUseCase struct {
+	accountRepo             repository.AccountRepositoryInterface
+	sessionRepo             repository.SessionRepositoryInterface
+	personalAccessTokenRepo repository.PersonalAccessTokenRepositoryInterface
+	passkeyRepo             repository.PasskeyRepositoryInterface
+	identityRepo            repository.AccountIdentityRepositoryInterface
+	emailChangeRepo         repository.EmailChangeRequestRepositoryInterface
+	recoveryCodeRepo        repository.RecoveryCodeRepositoryInterface
+	avatars                 AvatarUseCaseInterface
+}
+
+func NewAccountDataUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	sessionRepo repository.SessionRepositoryInterface,
+	personalAccessTokenRepo repository.PersonalAccessTokenRepositoryInterface,
+	passkeyRepo repository.PasskeyRepositoryInterface,
+	identityRepo repository.AccountIdentityRepositoryInterface,
+	emailChangeRepo repository.EmailChangeRequestRepositoryInterface,
+	recoveryCodeRepo repository.RecoveryCodeRepositoryInterface,
+	avatars AvatarUseCaseInterface,
+) *AccountDataUseCase {
+	return &AccountDataUseCase{
+		accountRepo:             accountRepo,
+		sessionRepo:             sessionRepo,
+		personalAccessTokenRepo: personalAccessTokenRepo,
+		passkeyRepo:             passkeyRepo,
+		identityRepo:            identityRepo,
+		emailChangeRepo:         emailChangeRepo,
+		recoveryCodeRepo:        recoveryCodeRepo,
+		avatars:                 avatars,
+	}
+}
+
+type accountExport struct {
+	ID               uuid.UUID         `json:"id"`
+	Name             string            `json:"name"`
+	Email            string            `json:"email"`
+	Avatar           map[string]string `json:"avatar"`
+	EmailVerifiedAt  *time.Time        `json:"email_verified_at"`
+	TwoFactorEnabled bool              `json:"two_factor_enabled"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	DeletedAt        *time.Time        `json:"deleted_at"`
+	SuspendedAt      *time.Time        `json:"suspended_at"`
+}
+
+type sessionExport struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type personalAccessTokenExport struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type passkeyExport struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type identityExport struct {
+	ID        uuid.UUID `json:"id"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type emailChangeRequestExport struct {
+	ID          uuid.UUID  `json:"id"`
+	NewEmail    string     `json:"new_email"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
+	CancelledAt *time.Time `json:"cancelled_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type recoveryCodesExport struct {
+	Count     int64      `json:"count"`
+	Unused    int64      `json:"unused"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (uc *AccountDataUseCase) ExportAccountData(accountID uuid.UUID) ([]privacy.Section, error) {
+	account := &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: accountID}}
+	if err := uc.accountRepo.FindForAdmin(account); err != nil {
+		return nil, err
+	}
+
+	sessions, err := uc.sessionRepo.ListByAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := uc.personalAccessTokenRepo.ListByAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := uc.passkeyRepo.ListByAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := uc.identityRepo.ListByAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	emailChanges, err := uc.emailChangeRepo.ListByAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := uc.recoveryCodeRepo.Summarize(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionsExport := make([]sessionExport, 0, len(sessions))
+	for _, session := range sessions {
+		sessionsExport = append(sessionsExport, sessionExport{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			LastSeenAt: session.LastSeenAt,
+			ExpiresAt:  session.ExpiresAt,
+			CreatedAt:  session.CreatedAt,
+		})
+	}
+
+	tokensExport := make([]personalAccessTokenExport, 0, len(tokens))
+	for _, token := range tokens {
+		tokensExport = append(tokensExport, personalAccessTokenExport{
+			ID:         token.ID,
+			Name:       token.Name,
+			Scopes:     token.Scopes,
+			ExpiresAt:  token.ExpiresAt,
+			LastUsedAt: token.LastUsedAt,
+			CreatedAt:  token.CreatedAt,
+		})
+	}
+
+	passkeysExport := make([]passkeyExport, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		passkeysExport = append(passkeysExport, passkeyExport{
+			ID:         passkey.ID,
+			Name:       passkey.Name,
+			LastUsedAt: passkey.LastUsedAt,
+			CreatedAt:  passkey.CreatedAt,
+		})
+	}
+
+	identitiesExport := make([]identityExport, 0, len(identities))
+	for _, identity := range identities {
+		identitiesExport = append(identitiesExport, identityExport{
+			ID:        identity.ID,
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	emailChangesExport := make([]emailChangeRequestExport, 0, len(emailChanges))
+	for _, request := range emailChanges {
+		emailChangesExport = append(emailChangesExport, emailChangeRequestExport{
+			ID:          request.ID,
+			NewEmail:    request.NewEmail,
+			ExpiresAt:   request.ExpiresAt,
+			ConfirmedAt: request.ConfirmedAt,
+			CancelledAt: request.CancelledAt,
+			CreatedAt:   request.CreatedAt,
+		})
+	}
+
+	return []privacy.Section{
+		{Name: "account", Data: accountExport{
+			ID:               account.ID,
+			Name:             account.Name,
+			Email:            account.Email,
+			Avatar:           uc.avatars.URLs(&account.AccountEntity),
+			EmailVerifiedAt:  account.EmailVerifiedAt,
+			TwoFactorEnabled: account.IsTwoFactorEnabled(),
+			CreatedAt:        account.CreatedAt,
+			UpdatedAt:        account.UpdatedAt,
+			DeletedAt:        account.DeletedAt,
+			SuspendedAt:      account.SuspendedAt,
+		}},
+		{Name: "sessions", Data: sessionsExport},
+		{Name: "personal_access_tokens", Data: tokensExport},
+		{Name: "passkeys", Data: passkeysExport},
+		{Name: "identities", Data: identitiesExport},
+		{Name: "email_change_requests", Data: emailChangesExport},
+		{Name: "recovery_codes", Data: recoveryCodesExport{
+			Count:     recoveryCodes.Count,
+			Unused:    recoveryCodes.Unused,
+			CreatedAt: recoveryCodes.CreatedAt,
+		}},
+	}, nil
+}
+
+// EraseAccountData anonymizes the account, removes its credentials and the
+// images of its avatar. The account row is kept, marked as deleted.
+func (uc *AccountDataUseCase) EraseAccountData(accountID uuid.UUID) error {
+	avatarKey, err := uc.accountRepo.Erase(&entity.AccountEntity{ID: accountID})
+	if err != nil {
+		return err
+	}
+
+	if avatarKey != "" {
+		uc.avatars.RemoveImages(avatarKey)
+	}
+
+	return nil
+}
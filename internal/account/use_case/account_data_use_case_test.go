@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type accountDataMocks struct {
+	accounts      *mocks.MockAccountRepositoryInterface
+	sessions      *mocks.MockSessionRepositoryInterface
+	tokens        *mocks.MockPersonalAccessTokenRepositoryInterface
+	passkeys      *mocks.MockPasskeyRepositoryInterface
+	identities    *mocks.MockAccountIdentityRepositoryInterface
+	emailChanges  *mocks.MockEmailChangeRequestRepositoryInterface
+	recoveryCodes *mocks.MockRecoveryCodeRepositoryInterface
+	avatars       *mocks.MockAvatarUseCaseInterface
+}
+
+func setupAccountData(t *testing.T) (*accountDataMocks, *usecase.AccountDataUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &accountDataMocks{
+		accounts:      mocks.NewMockAccountRepositoryInterface(ctrl),
+		sessions:      mocks.NewMockSessionRepositoryInterface(ctrl),
+		tokens:        mocks.NewMockPersonalAccessTokenRepositoryInterface(ctrl),
+		passkeys:      mocks.NewMockPasskeyRepositoryInterface(ctrl),
+		identities:    mocks.NewMockAccountIdentityRepositoryInterface(ctrl),
+		emailChanges:  mocks.NewMockEmailChangeRequestRepositoryInterface(ctrl),
+		recoveryCodes: mocks.NewMockRecoveryCodeRepositoryInterface(ctrl),
+		avatars:       mocks.NewMockAvatarUseCaseInterface(ctrl),
+	}
+
+	uc := usecase.NewAccountDataUseCase(m.accounts, m.sessions, m.tokens, m.passkeys, m.identities, m.emailChanges, m.recoveryCodes, m.avatars)
+
+	return m, uc
+}
+
+func TestAccountDataUseCase_ExportAccountData(t *testing.T) {
+	t.Run("should export the account without its secrets", func(t *testing.T) {
+		m, uc := setupAccountData(t)
+		accountID := uuid.New()
+
+		m.accounts.EXPECT().FindForAdmin(gomock.Any()).DoAndReturn(func(acc *entity.AdminAccountEntity) error {
+			acc.Name = "Gandalf"
+			acc.Email = "gandalf@lor.com.br"
+			acc.Password = "password-hash"
+			acc.TOTPSecret = "totp-secret"
+			return nil
+		})
+		m.sessions.EXPECT().ListByAccount(accountID).Return([]entity.SessionEntity{{ID: uuid.New(), IPAddress: "10.0.0.1"}}, nil)
+		m.tokens.EXPECT().ListByAccount(accountID).Return([]entity.PersonalAccessTokenEntity{{ID: uuid.New(), Name: "CI", TokenHash: "token-hash"}}, nil)
+		m.passkeys.EXPECT().ListByAccount(accountID).Return(nil, nil)
+		m.identities.EXPECT().ListByAccount(accountID).Return([]entity.AccountIdentityEntity{{ID: uuid.New(), Provider: "google", Email: "gandalf@gmail.com"}}, nil)
+		m.emailChanges.EXPECT().ListByAccount(accountID).Return([]entity.EmailChangeRequestEntity{{ID: uuid.New(), NewEmail: "mithrandir@lor.com.br", TokenHash: "change-hash", CancelTokenHash: "cancel-hash"}}, nil)
+		m.recoveryCodes.EXPECT().Summarize(accountID).Return(entity.RecoveryCodesEntity{Count: 10, Unused: 8}, nil)
+		m.avatars.EXPECT().URLs(gomock.Any()).Return(nil)
+
+		sections, err := uc.ExportAccountData(accountID)
+
+		require.NoError(t, err)
+		names := make([]string, 0, len(sections))
+		for _, section := range sections {
+			names = append(names, section.Name)
+		}
+		assert.Equal(t, []string{"account", "sessions", "personal_access_tokens", "passkeys", "identities", "email_change_requests", "recovery_codes"}, names)
+
+		exported, err := json.Marshal(sections)
+		require.NoError(t, err)
+		assert.Contains(t, string(exported), "gandalf@lor.com.br")
+		assert.Contains(t, string(exported), "10.0.0.1")
+		assert.Contains(t, string(exported), "gandalf@gmail.com")
+		assert.Contains(t, string(exported), "mithrandir@lor.com.br")
+		assert.Contains(t, string(exported), `"unused":8`)
+		assert.NotContains(t, string(exported), "password-hash")
+		assert.NotContains(t, string(exported), "totp-secret")
+		assert.NotContains(t, string(exported), "token-hash")
+		assert.NotContains(t, string(exported), "change-hash")
+		assert.NotContains(t, string(exported), "cancel-hash")
+	})
+
+	t.Run("should report an unknown account", func(t *testing.T) {
+		m, uc := setupAccountData(t)
+
+		m.accounts.EXPECT().FindForAdmin(gomock.Any()).Return(sql.ErrNoRows)
+
+		_, err := uc.ExportAccountData(uuid.New())
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestAccountDataUseCase_EraseAccountData(t *testing.T) {
+	t.Run("should anonymize the account and remove its avatar images", func(t *testing.T) {
+		m, uc := setupAccountData(t)
+		accountID := uuid.New()
+
+		m.accounts.EXPECT().Erase(&entity.AccountEntity{ID: accountID}).Return("avatars/1/key", nil)
+		m.avatars.EXPECT().RemoveImages("avatars/1/key")
+
+		assert.NoError(t, uc.EraseAccountData(accountID))
+	})
+
+	t.Run("should skip storage when the account had no avatar", func(t *testing.T) {
+		m, uc := setupAccountData(t)
+
+		m.accounts.EXPECT().Erase(gomock.Any()).Return("", nil)
+
+		assert.NoError(t, uc.EraseAccountData(uuid.New()))
+	})
+}
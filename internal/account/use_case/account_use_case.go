@@ -1,10 +1,21 @@
 package usecase
 
 import (
+	"database/sql"
+	"errors"
+	"log"
+	"sync"
+	"time"
 	"trilha-api/internal/account/entity"
 	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/password"
 
-	"golang.org/x/crypto/bcrypt"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrEmailNotVerified   = errors.New("email not verified")
 )
 
 //go:generate mockgen -source=account_use_case.go -destination=../mocks/account_use_case_mock.go -package=mocks
@@ -12,24 +23,106 @@ type AccountUseCaseInterface interface {
 	Register(account *entity.AccountEntity) error
 	Find(account *entity.AccountEntity) error
 	FindByEmail(account *entity.AccountEntity) error
+	SignIn(account *entity.AccountEntity, client entity.ClientEntity) (*entity.SignInEntity, error)
+	Update(account *entity.AccountEntity) error
+	ChangePassword(account *entity.AccountEntity, sessionID uuid.UUID, currentPassword, newPassword string) error
+	Delete(account *entity.AccountEntity) error
+	Restore(account *entity.AccountEntity) error
 }
 
 type AccountUseCase struct {
-	repo repository.AccountRepositoryInterface
+	repo         repository.AccountRepositoryInterface
+	tokenRepo    repository.PersonalAccessTokenRepositoryInterface
+	sessions     SessionUseCaseInterface
+	verification EmailVerificationUseCaseInterface
+	twoFactor    TwoFactorUseCaseInterface
+	throttle     SignInThrottleUseCaseInterface
+	hasher       password.Hasher
+	policy       *password.Policy
+	emails       *EmailNormalizer
+
+	// dummyHash is compared against when the email is unknown, so that
+	// sign-in takes the same time whether or not the account exists.
+	dummyHash     string
+	dummyHashOnce sync.Once
 }
 
-func New(repo repository.AccountRepositoryInterface) *AccountUseCase {
-	return &AccountUseCase{repo: repo}
+func New(
+	repo repository.AccountRepositoryInterface,
+	tokenRepo repository.PersonalAccessTokenRepositoryInterface,
+	sessions SessionUseCaseInterface,
+	verification EmailVerificationUseCaseInterface,
+	twoFactor TwoFactorUseCaseInterface,
+	throttle SignInThrottleUseCaseInterface,
+	hasher password.Hasher,
+	policy *password.Policy,
+	emails *EmailNormalizer,
+) *AccountUseCase {
+	return &AccountUseCase{
+		repo:         repo,
+		tokenRepo:    tokenRepo,
+		sessions:     sessions,
+		verification: verification,
+		twoFactor:    twoFactor,
+		throttle:     throttle,
+		hasher:       hasher,
+		policy:       policy,
+		emails:       emails,
+	}
 }
 
+// Register creates the account with its email normalized, returning a
+// *password.PolicyError when its password does not follow the password
+// policy.
 func (uc *AccountUseCase) Register(account *entity.AccountEntity) error {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(account.Password), bcrypt.DefaultCost)
+	if err := uc.create(account); err != nil {
+		return err
+	}
+
+	// The account is already created at this point; a failed email can be
+	// retried by the user through the resend endpoint.
+	if err := uc.verification.SendVerification(account); err != nil {
+		log.Printf("Erro ao enviar email de verificação para a conta %s: %v", account.ID, err)
+	}
+
+	return nil
+}
+
+// RegisterVerified creates the account like Register, with its email already
+// verified and no verification email sent. It is meant for callers that have
+// proved the email belongs to whoever registers, such as the link of a
+// workspace invitation sent to it.
+func (uc *AccountUseCase) RegisterVerified(account *entity.AccountEntity) error {
+	if err := uc.create(account); err != nil {
+		return err
+	}
+
+	if _, err := uc.repo.VerifyEmail(account); err != nil {
+		return err
+	}
+
+	verifiedAt := time.Now().UTC()
+	account.EmailVerifiedAt = &verifiedAt
+
+	return nil
+}
+
+// create stores the account with its email normalized and its password
+// hashed, once the password follows the password policy.
+func (uc *AccountUseCase) create(account *entity.AccountEntity) error {
+	account.Email = uc.emails.Normalize(account.Email)
+
+	if err := uc.policy.Check(account.Password, account.Name, account.Email); err != nil {
+		return err
+	}
+
+	hashedPassword, err := uc.hasher.Hash(account.Password)
 
 	if err != nil {
 		return err
 	}
 
-	account.Password = string(hashedPassword)
+	account.Password = hashedPassword
 
 	return uc.repo.Register(account)
 }
@@ -39,5 +132,163 @@ func (uc *AccountUseCase) Find(account *entity.AccountEntity) error {
 }
 
 func (uc *AccountUseCase) FindByEmail(account *entity.AccountEntity) error {
+	account.Email = uc.emails.Normalize(account.Email)
+
 	return uc.repo.FindByEmail(account)
 }
+
+// SignIn checks the email and password held by account and, on success,
+// fills account with the stored data and starts a session on the client's
+// device. Attempts from the client IP are throttled along with the attempts
+// on the email. A password hash of an older algorithm or weaker parameters is
+// replaced once the password is verified.
+func (uc *AccountUseCase) SignIn(account *entity.AccountEntity, client entity.ClientEntity) (*entity.SignInEntity, error) {
+	email := uc.emails.Normalize(account.Email)
+	password := account.Password
+	account.Email = email
+
+	if err := uc.throttle.Check(email, client.IP); err != nil {
+		return nil, err
+	}
+
+	err := uc.repo.FindByEmail(account)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			_ = uc.hasher.Compare(uc.dummyPasswordHash(), password)
+			return nil, uc.signInFailed(email, client.IP)
+		}
+		return nil, err
+	}
+
+	if account.DeletedAt != nil {
+		return nil, uc.signInFailed(email, client.IP)
+	}
+
+	if err := uc.hasher.Compare(account.Password, password); err != nil {
+		return nil, uc.signInFailed(email, client.IP)
+	}
+
+	uc.rehashPassword(account, password)
+
+	// The failures are only forgotten once the second factor is checked too,
+	// otherwise the password would reset the count of wrong codes.
+	if account.IsTwoFactorEnabled() {
+		challenge, err := uc.twoFactor.Challenge(account)
+		if err != nil {
+			return nil, err
+		}
+		return &entity.SignInEntity{Challenge: challenge}, nil
+	}
+
+	tokens, err := uc.sessions.Issue(account, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.throttle.RecordSuccess(email); err != nil {
+		log.Printf("Erro ao limpar tentativas de login da conta %s: %v", account.ID, err)
+	}
+
+	return &entity.SignInEntity{Tokens: tokens}, nil
+}
+
+// Update saves the profile fields of account, which must hold the current
+// state of the account. Accounts with an unverified email cannot be updated.
+func (uc *AccountUseCase) Update(account *entity.AccountEntity) error {
+	if !account.IsEmailVerified() {
+		return ErrEmailNotVerified
+	}
+
+	return uc.repo.Update(account)
+}
+
+// ChangePassword replaces the password of the account after checking the
+// current one, returning ErrInvalidCredentials when it does not match and a
+// *password.PolicyError when the new one does not follow the password policy.
+// Every session but sessionID, the one making the change, is signed out, and
+// every personal access token is revoked, as on a password reset.
+func (uc *AccountUseCase) ChangePassword(account *entity.AccountEntity, sessionID uuid.UUID, currentPassword, newPassword string) error {
+	if err := uc.repo.Find(account); err != nil {
+		return err
+	}
+
+	if err := uc.hasher.Compare(account.Password, currentPassword); err != nil {
+		return ErrInvalidCredentials
+	}
+
+	if err := uc.policy.Check(newPassword, account.Name, account.Email); err != nil {
+		return err
+	}
+
+	hashedPassword, err := uc.hasher.Hash(newPassword)
+
+	if err != nil {
+		return err
+	}
+
+	account.Password = hashedPassword
+
+	if err := uc.repo.UpdatePassword(account); err != nil {
+		return err
+	}
+
+	if err := uc.sessions.RevokeOthers(account, sessionID); err != nil {
+		return err
+	}
+
+	return uc.tokenRepo.RevokeAllByAccount(account.ID)
+}
+
+// Delete soft-deletes the account and revokes its refresh tokens, so the
+// account disappears from every lookup and cannot sign in anymore.
+func (uc *AccountUseCase) Delete(account *entity.AccountEntity) error {
+	if err := uc.repo.SoftDelete(account); err != nil {
+		return err
+	}
+
+	return uc.sessions.RevokeAll(account)
+}
+
+// signInFailed counts a failed attempt and returns ErrInvalidCredentials.
+func (uc *AccountUseCase) signInFailed(email, ip string) error {
+	if err := uc.throttle.RecordFailure(email, ip); err != nil {
+		return err
+	}
+
+	return ErrInvalidCredentials
+}
+
+// rehashPassword replaces the stored hash of account when the hasher no
+// longer deems it strong enough. The sign-in goes on if it fails, as the old
+// hash still verifies.
+func (uc *AccountUseCase) rehashPassword(account *entity.AccountEntity, plain string) {
+	if !uc.hasher.NeedsRehash(account.Password) {
+		return
+	}
+
+	hashedPassword, err := uc.hasher.Hash(plain)
+	if err != nil {
+		log.Printf("Erro ao recalcular hash da senha da conta %s: %v", account.ID, err)
+		return
+	}
+
+	oldHash := account.Password
+	account.Password = hashedPassword
+
+	if _, err := uc.repo.RehashPassword(account, oldHash); err != nil {
+		log.Printf("Erro ao recalcular hash da senha da conta %s: %v", account.ID, err)
+	}
+}
+
+// dummyPasswordHash hashes a throwaway password with the current hasher, so
+// that comparing against it costs as much as a real sign-in.
+func (uc *AccountUseCase) dummyPasswordHash() string {
+	uc.dummyHashOnce.Do(func() {
+		uc.dummyHash, _ = uc.hasher.Hash("dummy-password")
+	})
+	return uc.dummyHash
+}
+
+func (uc *AccountUseCase) Restore(account *entity.AccountEntity) error {
+	return uc.repo.Restore(account)
+}
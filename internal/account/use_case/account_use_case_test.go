@@ -1,13 +1,18 @@
 package usecase_test
 
 import (
+	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 	"trilha-api/internal/account/entity"
 	"trilha-api/internal/account/mocks"
+	"trilha-api/internal/account/repository"
 	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
 	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/password"
 	"trilha-api/internal/shared/utils"
 
 	"github.com/google/uuid"
@@ -16,40 +21,156 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-func setup(t *testing.T) (*mocks.MockAccountRepositoryInterface, *usecase.AccountUseCase) {
+// testHasher is the hasher of the application with parameters cheap enough
+// for tests.
+var testHasher = password.NewChain(
+	password.NewArgon2id(password.Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1}),
+	password.NewBcrypt(),
+)
+
+// testPolicy rejects passwords shorter than 8 characters, containing the
+// name or email of the account or breached, as "password123!" is.
+var testPolicy = newTestPolicy()
+
+func newTestPolicy() *password.Policy {
+	breached, _ := password.ReadBreachedList(strings.NewReader("ADDBD3AA5619F2932733104EB8CEEF08F6FD2693\n"))
+	return password.NewPolicy(config.PasswordConfig{MinLength: 8, MaxLength: 64}, breached)
+}
+
+func hashPassword(t *testing.T, plain string) string {
+	hashed, err := testHasher.Hash(plain)
+	assert.NoError(t, err)
+	return hashed
+}
+
+type accountDependencies struct {
+	tokens       *mocks.MockPersonalAccessTokenRepositoryInterface
+	sessions     *mocks.MockSessionUseCaseInterface
+	verification *mocks.MockEmailVerificationUseCaseInterface
+	twoFactor    *mocks.MockTwoFactorUseCaseInterface
+	throttle     *mocks.MockSignInThrottleUseCaseInterface
+}
+
+func setup(t *testing.T) (*mocks.MockAccountRepositoryInterface, *accountDependencies, *usecase.AccountUseCase) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mock := mocks.NewMockAccountRepositoryInterface(ctrl)
-	uc := usecase.New(mock)
+	deps := &accountDependencies{
+		tokens:       mocks.NewMockPersonalAccessTokenRepositoryInterface(ctrl),
+		sessions:     mocks.NewMockSessionUseCaseInterface(ctrl),
+		verification: mocks.NewMockEmailVerificationUseCaseInterface(ctrl),
+		twoFactor:    mocks.NewMockTwoFactorUseCaseInterface(ctrl),
+		throttle:     mocks.NewMockSignInThrottleUseCaseInterface(ctrl),
+	}
+	uc := usecase.New(mock, deps.tokens, deps.sessions, deps.verification, deps.twoFactor, deps.throttle, testHasher, testPolicy, testEmails)
 
-	return mock, uc
+	return mock, deps, uc
 }
 
 func TestAccountUseCase_Register(t *testing.T) {
-	mock, uc := setup(t)
+	mock, deps, uc := setup(t)
 
 	account := &entity.AccountEntity{
 		Name:     "Test User",
 		Email:    "test@example.com",
 		Password: "password123",
-		Avatar:   "test image",
 	}
 
 	mock.EXPECT().Register(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
-		assert.NotEqual(t, "password123", acc.Password)
-		err := bcrypt.CompareHashAndPassword([]byte(acc.Password), []byte("password123"))
-		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(acc.Password, "$argon2id$"))
+		assert.NoError(t, testHasher.Compare(acc.Password, "password123"))
 		return nil
 	})
+	deps.verification.EXPECT().SendVerification(account).Return(nil)
 
 	err := uc.Register(account)
 
 	assert.NoError(t, err)
 }
 
+func TestAccountUseCase_Register_NormalizesEmail(t *testing.T) {
+	mock, deps, uc := setup(t)
+
+	account := &entity.AccountEntity{Name: "Gandalf", Email: " Gandalf@LOR.com.br ", Password: "password123"}
+
+	mock.EXPECT().Register(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+		assert.Equal(t, "Gandalf@lor.com.br", acc.Email)
+		return nil
+	})
+	deps.verification.EXPECT().SendVerification(account).Return(nil)
+
+	assert.NoError(t, uc.Register(account))
+}
+
+func TestAccountUseCase_RegisterVerified(t *testing.T) {
+	t.Run("should verify the email of the account without sending a verification email", func(t *testing.T) {
+		mock, _, uc := setup(t)
+
+		account := &entity.AccountEntity{Name: "Gandalf", Email: "gandalf@lor.com.br", Password: "password123"}
+
+		gomock.InOrder(
+			mock.EXPECT().Register(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+				assert.NoError(t, testHasher.Compare(acc.Password, "password123"))
+				acc.ID = uuid.New()
+				return nil
+			}),
+			mock.EXPECT().VerifyEmail(account).Return(true, nil),
+		)
+
+		assert.NoError(t, uc.RegisterVerified(account))
+		assert.True(t, account.IsEmailVerified())
+	})
+
+	t.Run("should not verify an account that could not be registered", func(t *testing.T) {
+		mock, _, uc := setup(t)
+
+		mock.EXPECT().Register(gomock.Any()).Return(repository.ErrEmailAlreadyInUse)
+
+		err := uc.RegisterVerified(&entity.AccountEntity{Name: "Gandalf", Email: "gandalf@lor.com.br", Password: "password123"})
+
+		assert.ErrorIs(t, err, repository.ErrEmailAlreadyInUse)
+	})
+}
+
+func TestAccountUseCase_Register_PasswordPolicy(t *testing.T) {
+	_, _, uc := setup(t)
+
+	codes := func(err error) []string {
+		var policyErr *password.PolicyError
+		if !assert.ErrorAs(t, err, &policyErr) {
+			return nil
+		}
+		var codes []string
+		for _, violation := range policyErr.Violations {
+			codes = append(codes, violation.Code)
+		}
+		return codes
+	}
+
+	t.Run("should reject a short password without creating the account", func(t *testing.T) {
+		err := uc.Register(&entity.AccountEntity{Name: "Frodo", Email: "frodo@lor.com.br", Password: "ring"})
+
+		assert.Equal(t, []string{password.CodeTooShort}, codes(err))
+	})
+
+	t.Run("should reject a password containing the name or email", func(t *testing.T) {
+		err := uc.Register(&entity.AccountEntity{Name: "Frodo Baggins", Email: "ringbearer@lor.com.br", Password: "baggins-shire"})
+		assert.Equal(t, []string{password.CodePersonalInfo}, codes(err))
+
+		err = uc.Register(&entity.AccountEntity{Name: "Frodo Baggins", Email: "ringbearer@lor.com.br", Password: "the-ringbearer"})
+		assert.Equal(t, []string{password.CodePersonalInfo}, codes(err))
+	})
+
+	t.Run("should reject a breached password", func(t *testing.T) {
+		err := uc.Register(&entity.AccountEntity{Name: "Frodo", Email: "frodo@lor.com.br", Password: "password123!"})
+
+		assert.Equal(t, []string{password.CodeBreached}, codes(err))
+	})
+}
+
 func TestAccountUseCase_Find(t *testing.T) {
-	mock, uc := setup(t)
+	mock, _, uc := setup(t)
 
 	t.Run("Should find an account by id with success", func(t *testing.T) {
 
@@ -65,7 +186,7 @@ func TestAccountUseCase_Find(t *testing.T) {
 			Name:      "Gandalf",
 			Email:     "gandalf@lor.com.br",
 			Password:  "123mago",
-			Avatar:    "url",
+			AvatarKey: "avatars/1/key",
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
@@ -98,7 +219,7 @@ func TestAccountUseCase_Find(t *testing.T) {
 }
 
 func TestAccountUseCase_FindByEmail(t *testing.T) {
-	mock, uc := setup(t)
+	mock, _, uc := setup(t)
 
 	accountId := uuid.New()
 	now := time.Now()
@@ -111,7 +232,7 @@ func TestAccountUseCase_FindByEmail(t *testing.T) {
 		Email:     account.Email,
 		ID:        accountId,
 		Name:      "Gandalf",
-		Avatar:    utils.ToPgText("url"),
+		AvatarKey: utils.ToPgText("avatars/1/key"),
 		Password:  "gandalf123",
 		CreatedAt: utils.TimeToPgTimestamp(&now),
 		UpdatedAt: utils.TimeToPgTimestamp(&now),
@@ -123,7 +244,7 @@ func TestAccountUseCase_FindByEmail(t *testing.T) {
 				Email:     expectedAccount.Email,
 				ID:        expectedAccount.ID,
 				Name:      expectedAccount.Name,
-				Avatar:    expectedAccount.Avatar.String,
+				AvatarKey: expectedAccount.AvatarKey.String,
 				Password:  expectedAccount.Password,
 				CreatedAt: expectedAccount.CreatedAt.Time,
 				UpdatedAt: expectedAccount.UpdatedAt.Time,
@@ -135,7 +256,7 @@ func TestAccountUseCase_FindByEmail(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedAccount.Email, account.Email)
-		assert.Equal(t, expectedAccount.Avatar.String, account.Avatar)
+		assert.Equal(t, expectedAccount.AvatarKey.String, account.AvatarKey)
 		assert.Equal(t, expectedAccount.ID, account.ID)
 		assert.Equal(t, expectedAccount.Name, account.Name)
 		assert.Equal(t, expectedAccount.Password, account.Password)
@@ -151,3 +272,297 @@ func TestAccountUseCase_FindByEmail(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestAccountUseCase_SignIn(t *testing.T) {
+	mock, deps, uc := setup(t)
+
+	storedAccount := entity.AccountEntity{
+		ID:       uuid.New(),
+		Name:     "Gandalf",
+		Email:    "gandalf@lor.com.br",
+		Password: hashPassword(t, "password123"),
+	}
+
+	t.Run("should issue tokens when credentials are valid", func(t *testing.T) {
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "password123"}
+		expectedTokens := &entity.AuthTokensEntity{AccessToken: "access", RefreshToken: "refresh"}
+
+		mock.EXPECT().FindByEmail(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			*acc = storedAccount
+			return nil
+		})
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		deps.sessions.EXPECT().Issue(account, entity.ClientEntity{IP: "10.0.0.1"}).Return(expectedTokens, nil)
+		deps.throttle.EXPECT().RecordSuccess(storedAccount.Email).Return(nil)
+
+		result, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTokens, result.Tokens)
+		assert.Nil(t, result.Challenge)
+		assert.Equal(t, storedAccount.ID, account.ID)
+	})
+
+	t.Run("should throttle and look up the normalized email", func(t *testing.T) {
+		account := &entity.AccountEntity{Email: " gandalf@LOR.COM.BR", Password: "password123"}
+
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		mock.EXPECT().FindByEmail(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Equal(t, storedAccount.Email, acc.Email)
+			*acc = storedAccount
+			return nil
+		})
+		deps.sessions.EXPECT().Issue(account, gomock.Any()).Return(&entity.AuthTokensEntity{}, nil)
+		deps.throttle.EXPECT().RecordSuccess(storedAccount.Email).Return(nil)
+
+		_, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should rehash a bcrypt password once verified", func(t *testing.T) {
+		bcryptHash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "password123"}
+
+		mock.EXPECT().FindByEmail(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			*acc = storedAccount
+			acc.Password = string(bcryptHash)
+			return nil
+		})
+		mock.EXPECT().RehashPassword(account, string(bcryptHash)).DoAndReturn(func(acc *entity.AccountEntity, _ string) (bool, error) {
+			assert.True(t, strings.HasPrefix(acc.Password, "$argon2id$"))
+			assert.NoError(t, testHasher.Compare(acc.Password, "password123"))
+			return true, nil
+		})
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		deps.sessions.EXPECT().Issue(account, gomock.Any()).Return(&entity.AuthTokensEntity{}, nil)
+		deps.throttle.EXPECT().RecordSuccess(storedAccount.Email).Return(nil)
+
+		_, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should rehash an Argon2id password with weaker parameters", func(t *testing.T) {
+		weak, _ := password.NewArgon2id(password.Argon2idParams{Memory: 32, Iterations: 1, Parallelism: 1}).Hash("password123")
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "password123"}
+
+		mock.EXPECT().FindByEmail(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			*acc = storedAccount
+			acc.Password = weak
+			return nil
+		})
+		mock.EXPECT().RehashPassword(account, weak).DoAndReturn(func(acc *entity.AccountEntity, _ string) (bool, error) {
+			assert.False(t, testHasher.NeedsRehash(acc.Password))
+			return true, nil
+		})
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		deps.sessions.EXPECT().Issue(account, gomock.Any()).Return(&entity.AuthTokensEntity{}, nil)
+		deps.throttle.EXPECT().RecordSuccess(storedAccount.Email).Return(nil)
+
+		_, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should sign in even when the rehash fails", func(t *testing.T) {
+		bcryptHash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "password123"}
+
+		mock.EXPECT().FindByEmail(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			*acc = storedAccount
+			acc.Password = string(bcryptHash)
+			return nil
+		})
+		mock.EXPECT().RehashPassword(account, string(bcryptHash)).Return(false, errors.New("database error"))
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		deps.sessions.EXPECT().Issue(account, gomock.Any()).Return(&entity.AuthTokensEntity{}, nil)
+		deps.throttle.EXPECT().RecordSuccess(storedAccount.Email).Return(nil)
+
+		_, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not sign in to an account without password", func(t *testing.T) {
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "password123"}
+
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		mock.EXPECT().FindByEmail(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			*acc = storedAccount
+			acc.Password = ""
+			return nil
+		})
+		deps.throttle.EXPECT().RecordFailure(storedAccount.Email, "10.0.0.1").Return(nil)
+
+		_, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+	})
+
+	t.Run("should return a challenge when two-factor authentication is enabled", func(t *testing.T) {
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "password123"}
+		enabledAt := time.Now()
+		expectedChallenge := &entity.TwoFactorChallengeEntity{Token: "challenge"}
+
+		mock.EXPECT().FindByEmail(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			*acc = storedAccount
+			acc.TwoFactorEnabledAt = &enabledAt
+			return nil
+		})
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		deps.twoFactor.EXPECT().Challenge(account).Return(expectedChallenge, nil)
+
+		result, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedChallenge, result.Challenge)
+		assert.Nil(t, result.Tokens)
+	})
+
+	t.Run("should return invalid credentials when password does not match", func(t *testing.T) {
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "wrong-password"}
+
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		mock.EXPECT().FindByEmail(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			*acc = storedAccount
+			return nil
+		})
+		deps.throttle.EXPECT().RecordFailure(storedAccount.Email, "10.0.0.1").Return(nil)
+
+		result, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return invalid credentials when account does not exist", func(t *testing.T) {
+		account := &entity.AccountEntity{Email: "unknown@lor.com.br", Password: "password123"}
+
+		deps.throttle.EXPECT().Check("unknown@lor.com.br", "10.0.0.1").Return(nil)
+		mock.EXPECT().FindByEmail(account).Return(sql.ErrNoRows)
+		deps.throttle.EXPECT().RecordFailure("unknown@lor.com.br", "10.0.0.1").Return(nil)
+
+		result, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return the error when lookup fails", func(t *testing.T) {
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "password123"}
+
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(nil)
+		mock.EXPECT().FindByEmail(account).Return(errors.New("database error"))
+
+		_, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, usecase.ErrInvalidCredentials)
+	})
+
+	t.Run("should not check the password while sign-in is throttled", func(t *testing.T) {
+		account := &entity.AccountEntity{Email: storedAccount.Email, Password: "password123"}
+
+		deps.throttle.EXPECT().Check(storedAccount.Email, "10.0.0.1").Return(&usecase.SignInThrottledError{RetryAfter: time.Minute})
+
+		result, err := uc.SignIn(account, entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.ErrorIs(t, err, usecase.ErrTooManySignInAttempts)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAccountUseCase_ChangePassword(t *testing.T) {
+	mock, deps, uc := setup(t)
+
+	accountID := uuid.New()
+	sessionID := uuid.New()
+	hashedPassword := hashPassword(t, "password123")
+
+	t.Run("should rehash the new password, sign out the other sessions and revoke the tokens", func(t *testing.T) {
+		account := &entity.AccountEntity{ID: accountID}
+
+		mock.EXPECT().Find(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			acc.Password = hashedPassword
+			return nil
+		})
+		mock.EXPECT().UpdatePassword(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.NoError(t, testHasher.Compare(acc.Password, "new-password123"))
+			return nil
+		})
+		deps.sessions.EXPECT().RevokeOthers(account, sessionID).Return(nil)
+		deps.tokens.EXPECT().RevokeAllByAccount(accountID).Return(nil)
+
+		err := uc.ChangePassword(account, sessionID, "password123", "new-password123")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject a wrong current password", func(t *testing.T) {
+		account := &entity.AccountEntity{ID: accountID}
+
+		mock.EXPECT().Find(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			acc.Password = hashedPassword
+			return nil
+		})
+
+		err := uc.ChangePassword(account, sessionID, "wrong-password", "new-password123")
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+	})
+
+	t.Run("should reject a new password breaking the policy", func(t *testing.T) {
+		account := &entity.AccountEntity{ID: accountID}
+
+		mock.EXPECT().Find(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			acc.Name = "Gandalf"
+			acc.Email = "gandalf@lor.com.br"
+			acc.Password = hashedPassword
+			return nil
+		})
+
+		err := uc.ChangePassword(account, sessionID, "password123", "gandalf-the-white")
+
+		var policyErr *password.PolicyError
+		assert.ErrorAs(t, err, &policyErr)
+	})
+}
+
+func TestAccountUseCase_Delete(t *testing.T) {
+	mock, deps, uc := setup(t)
+
+	account := &entity.AccountEntity{ID: uuid.New()}
+
+	t.Run("should soft delete the account and revoke its sessions", func(t *testing.T) {
+		mock.EXPECT().SoftDelete(account).Return(nil)
+		deps.sessions.EXPECT().RevokeAll(account).Return(nil)
+
+		assert.NoError(t, uc.Delete(account))
+	})
+
+	t.Run("should not revoke sessions when the account does not exist", func(t *testing.T) {
+		mock.EXPECT().SoftDelete(account).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.Delete(account), sql.ErrNoRows)
+	})
+}
+
+func TestAccountUseCase_Update(t *testing.T) {
+	mock, _, uc := setup(t)
+
+	t.Run("should update a verified account", func(t *testing.T) {
+		verifiedAt := time.Now()
+		account := &entity.AccountEntity{ID: uuid.New(), Name: "Gandalf", EmailVerifiedAt: &verifiedAt}
+
+		mock.EXPECT().Update(account).Return(nil)
+
+		assert.NoError(t, uc.Update(account))
+	})
+
+	t.Run("should refuse to update an unverified account", func(t *testing.T) {
+		account := &entity.AccountEntity{ID: uuid.New(), Name: "Gandalf"}
+
+		assert.ErrorIs(t, uc.Update(account), usecase.ErrEmailNotVerified)
+	})
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"errors"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultAccountsPerPage = 20
+	MaxAccountsPerPage     = 100
+)
+
+var (
+	ErrAccountSuspended       = errors.New("account is suspended")
+	ErrAccountNotSuspended    = errors.New("account is not suspended")
+	ErrAccountDeleted         = errors.New("account is deleted")
+	ErrCannotManageOwnAccount = errors.New("cannot manage own account")
+)
+
+//go:generate mockgen -source=admin_account_use_case.go -destination=../mocks/admin_account_use_case_mock.go -package=mocks
+type AdminAccountUseCaseInterface interface {
+	List(filter *entity.AccountFilter) ([]entity.AdminAccountEntity, int64, error)
+	Find(account *entity.AdminAccountEntity) error
+	Suspend(adminID uuid.UUID, account *entity.AdminAccountEntity) error
+	Reactivate(account *entity.AdminAccountEntity) error
+	ForcePasswordReset(adminID uuid.UUID, account *entity.AdminAccountEntity) error
+	HardDelete(adminID uuid.UUID, account *entity.AccountEntity) error
+}
+
+type AdminAccountUseCase struct {
+	accountRepo   repository.AccountRepositoryInterface
+	tokenRepo     repository.PersonalAccessTokenRepositoryInterface
+	sessions      SessionUseCaseInterface
+	passwordReset PasswordResetUseCaseInterface
+	avatars       AvatarUseCaseInterface
+}
+
+func NewAdminAccountUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	tokenRepo repository.PersonalAccessTokenRepositoryInterface,
+	sessions SessionUseCaseInterface,
+	passwordReset PasswordResetUseCaseInterface,
+	avatars AvatarUseCaseInterface,
+) *AdminAccountUseCase {
+	return &AdminAccountUseCase{
+		accountRepo:   accountRepo,
+		tokenRepo:     tokenRepo,
+		sessions:      sessions,
+		passwordReset: passwordReset,
+		avatars:       avatars,
+	}
+}
+
+// List returns a page of the accounts matching the filter, in any state, and
+// how many match it overall. The page of the filter defaults to the first and
+// its size to DefaultAccountsPerPage, capped at MaxAccountsPerPage.
+func (uc *AdminAccountUseCase) List(filter *entity.AccountFilter) ([]entity.AdminAccountEntity, int64, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 {
+		filter.PerPage = DefaultAccountsPerPage
+	}
+	if filter.PerPage > MaxAccountsPerPage {
+		filter.PerPage = MaxAccountsPerPage
+	}
+
+	total, err := uc.accountRepo.Count(*filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	accounts, err := uc.accountRepo.List(*filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return accounts, total, nil
+}
+
+func (uc *AdminAccountUseCase) Find(account *entity.AdminAccountEntity) error {
+	return uc.accountRepo.FindForAdmin(account)
+}
+
+// Suspend keeps the account from signing in until it is reactivated, and
+// revokes its sessions. Personal access tokens stop working while the
+// account is suspended. A deleted account fails with ErrAccountDeleted and
+// one already suspended with ErrAccountSuspended.
+func (uc *AdminAccountUseCase) Suspend(adminID uuid.UUID, account *entity.AdminAccountEntity) error {
+	if account.ID == adminID {
+		return ErrCannotManageOwnAccount
+	}
+
+	suspended, err := uc.accountRepo.Suspend(&account.AccountEntity)
+	if err != nil {
+		return err
+	}
+
+	if !suspended {
+		if err := uc.accountRepo.FindForAdmin(account); err != nil {
+			return err
+		}
+		if account.DeletedAt != nil {
+			return ErrAccountDeleted
+		}
+		return ErrAccountSuspended
+	}
+
+	if err := uc.sessions.RevokeAll(&account.AccountEntity); err != nil {
+		return err
+	}
+
+	return uc.accountRepo.FindForAdmin(account)
+}
+
+// Reactivate lifts the suspension of the account, failing with
+// ErrAccountNotSuspended when it is not suspended.
+func (uc *AdminAccountUseCase) Reactivate(account *entity.AdminAccountEntity) error {
+	reactivated, err := uc.accountRepo.Reactivate(&account.AccountEntity)
+	if err != nil {
+		return err
+	}
+
+	if !reactivated {
+		if err := uc.accountRepo.FindForAdmin(account); err != nil {
+			return err
+		}
+		return ErrAccountNotSuspended
+	}
+
+	return uc.accountRepo.FindForAdmin(account)
+}
+
+// ForcePasswordReset clears the password of the account, revokes its
+// sessions and personal access tokens and emails the owner a reset link, so
+// the account can only sign in again with a password set through that link.
+// Only active accounts can be reset.
+func (uc *AdminAccountUseCase) ForcePasswordReset(adminID uuid.UUID, account *entity.AdminAccountEntity) error {
+	if account.ID == adminID {
+		return ErrCannotManageOwnAccount
+	}
+
+	if err := uc.accountRepo.FindForAdmin(account); err != nil {
+		return err
+	}
+
+	if account.DeletedAt != nil {
+		return ErrAccountDeleted
+	}
+	if account.IsSuspended() {
+		return ErrAccountSuspended
+	}
+
+	account.Password = ""
+	if err := uc.accountRepo.UpdatePassword(&account.AccountEntity); err != nil {
+		return err
+	}
+
+	if err := uc.sessions.RevokeAll(&account.AccountEntity); err != nil {
+		return err
+	}
+
+	if err := uc.tokenRepo.RevokeAllByAccount(account.ID); err != nil {
+		return err
+	}
+
+	return uc.passwordReset.ForgotPassword(account.Email)
+}
+
+// HardDelete removes the account for good, with everything referencing it
+// and the images of its avatar. Unlike soft deletion it cannot be undone.
+func (uc *AdminAccountUseCase) HardDelete(adminID uuid.UUID, account *entity.AccountEntity) error {
+	if account.ID == adminID {
+		return ErrCannotManageOwnAccount
+	}
+
+	avatarKey, err := uc.accountRepo.HardDelete(account)
+	if err != nil {
+		return err
+	}
+
+	if avatarKey != "" {
+		uc.avatars.RemoveImages(avatarKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type adminAccountMocks struct {
+	accounts      *mocks.MockAccountRepositoryInterface
+	tokens        *mocks.MockPersonalAccessTokenRepositoryInterface
+	sessions      *mocks.MockSessionUseCaseInterface
+	passwordReset *mocks.MockPasswordResetUseCaseInterface
+	avatars       *mocks.MockAvatarUseCaseInterface
+}
+
+func setupAdminAccount(t *testing.T) (*adminAccountMocks, *usecase.AdminAccountUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &adminAccountMocks{
+		accounts:      mocks.NewMockAccountRepositoryInterface(ctrl),
+		tokens:        mocks.NewMockPersonalAccessTokenRepositoryInterface(ctrl),
+		sessions:      mocks.NewMockSessionUseCaseInterface(ctrl),
+		passwordReset: mocks.NewMockPasswordResetUseCaseInterface(ctrl),
+		avatars:       mocks.NewMockAvatarUseCaseInterface(ctrl),
+	}
+
+	uc := usecase.NewAdminAccountUseCase(m.accounts, m.tokens, m.sessions, m.passwordReset, m.avatars)
+
+	return m, uc
+}
+
+func TestAdminAccountUseCase_List(t *testing.T) {
+	t.Run("should return the page and the total", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+		filter := entity.AccountFilter{Search: "gandalf", Status: entity.AccountStatusActive, Page: 2, PerPage: 10}
+		accounts := []entity.AdminAccountEntity{{AccountEntity: entity.AccountEntity{Name: "Gandalf"}}}
+
+		m.accounts.EXPECT().Count(filter).Return(int64(11), nil)
+		m.accounts.EXPECT().List(filter).Return(accounts, nil)
+
+		result, total, err := uc.List(&filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, accounts, result)
+		assert.Equal(t, int64(11), total)
+	})
+
+	t.Run("should default the page and cap its size", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+		expected := entity.AccountFilter{Page: 1, PerPage: usecase.MaxAccountsPerPage}
+
+		m.accounts.EXPECT().Count(expected).Return(int64(0), nil)
+		m.accounts.EXPECT().List(expected).Return(nil, nil)
+
+		filter := entity.AccountFilter{PerPage: 1000}
+		_, _, err := uc.List(&filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, filter)
+	})
+}
+
+func TestAdminAccountUseCase_Suspend(t *testing.T) {
+	adminID := uuid.New()
+
+	t.Run("should suspend the account and revoke its sessions", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+		account := &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}}
+
+		m.accounts.EXPECT().Suspend(&account.AccountEntity).Return(true, nil)
+		m.sessions.EXPECT().RevokeAll(&account.AccountEntity).Return(nil)
+		m.accounts.EXPECT().FindForAdmin(account).DoAndReturn(func(acc *entity.AdminAccountEntity) error {
+			now := time.Now().UTC()
+			acc.SuspendedAt = &now
+			return nil
+		})
+
+		err := uc.Suspend(adminID, account)
+
+		assert.NoError(t, err)
+		assert.True(t, account.IsSuspended())
+	})
+
+	t.Run("should refuse to suspend the own account", func(t *testing.T) {
+		_, uc := setupAdminAccount(t)
+
+		err := uc.Suspend(adminID, &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: adminID}})
+
+		assert.ErrorIs(t, err, usecase.ErrCannotManageOwnAccount)
+	})
+
+	t.Run("should report an account already suspended", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+		account := &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}}
+
+		m.accounts.EXPECT().Suspend(gomock.Any()).Return(false, nil)
+		m.accounts.EXPECT().FindForAdmin(account).DoAndReturn(func(acc *entity.AdminAccountEntity) error {
+			now := time.Now().UTC()
+			acc.SuspendedAt = &now
+			return nil
+		})
+
+		assert.ErrorIs(t, uc.Suspend(adminID, account), usecase.ErrAccountSuspended)
+	})
+
+	t.Run("should report a deleted account", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+		account := &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}}
+
+		m.accounts.EXPECT().Suspend(gomock.Any()).Return(false, nil)
+		m.accounts.EXPECT().FindForAdmin(account).DoAndReturn(func(acc *entity.AdminAccountEntity) error {
+			now := time.Now().UTC()
+			acc.DeletedAt = &now
+			return nil
+		})
+
+		assert.ErrorIs(t, uc.Suspend(adminID, account), usecase.ErrAccountDeleted)
+	})
+
+	t.Run("should report an unknown account", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+
+		m.accounts.EXPECT().Suspend(gomock.Any()).Return(false, nil)
+		m.accounts.EXPECT().FindForAdmin(gomock.Any()).Return(sql.ErrNoRows)
+
+		err := uc.Suspend(adminID, &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestAdminAccountUseCase_Reactivate(t *testing.T) {
+	t.Run("should lift the suspension", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+		account := &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}}
+
+		m.accounts.EXPECT().Reactivate(&account.AccountEntity).Return(true, nil)
+		m.accounts.EXPECT().FindForAdmin(account).Return(nil)
+
+		assert.NoError(t, uc.Reactivate(account))
+	})
+
+	t.Run("should report an account that is not suspended", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+
+		m.accounts.EXPECT().Reactivate(gomock.Any()).Return(false, nil)
+		m.accounts.EXPECT().FindForAdmin(gomock.Any()).Return(nil)
+
+		err := uc.Reactivate(&entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}})
+
+		assert.ErrorIs(t, err, usecase.ErrAccountNotSuspended)
+	})
+}
+
+func TestAdminAccountUseCase_ForcePasswordReset(t *testing.T) {
+	adminID := uuid.New()
+
+	t.Run("should clear the password, revoke sessions and tokens and send a reset link", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+		account := &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}}
+
+		m.accounts.EXPECT().FindForAdmin(account).DoAndReturn(func(acc *entity.AdminAccountEntity) error {
+			acc.Email = "gandalf@lor.com.br"
+			acc.Password = hashPassword(t, "password123")
+			return nil
+		})
+		m.accounts.EXPECT().UpdatePassword(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Empty(t, acc.Password)
+			return nil
+		})
+		m.sessions.EXPECT().RevokeAll(&account.AccountEntity).Return(nil)
+		m.tokens.EXPECT().RevokeAllByAccount(account.ID).Return(nil)
+		m.passwordReset.EXPECT().ForgotPassword("gandalf@lor.com.br").Return(nil)
+
+		assert.NoError(t, uc.ForcePasswordReset(adminID, account))
+	})
+
+	t.Run("should refuse a suspended account", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+
+		m.accounts.EXPECT().FindForAdmin(gomock.Any()).DoAndReturn(func(acc *entity.AdminAccountEntity) error {
+			now := time.Now().UTC()
+			acc.SuspendedAt = &now
+			return nil
+		})
+
+		err := uc.ForcePasswordReset(adminID, &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: uuid.New()}})
+
+		assert.ErrorIs(t, err, usecase.ErrAccountSuspended)
+	})
+
+	t.Run("should refuse the own account", func(t *testing.T) {
+		_, uc := setupAdminAccount(t)
+
+		err := uc.ForcePasswordReset(adminID, &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: adminID}})
+
+		assert.ErrorIs(t, err, usecase.ErrCannotManageOwnAccount)
+	})
+}
+
+func TestAdminAccountUseCase_HardDelete(t *testing.T) {
+	adminID := uuid.New()
+
+	t.Run("should remove the account and its avatar images", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+		account := &entity.AccountEntity{ID: uuid.New()}
+
+		m.accounts.EXPECT().HardDelete(account).Return("avatars/1/key", nil)
+		m.avatars.EXPECT().RemoveImages("avatars/1/key")
+
+		assert.NoError(t, uc.HardDelete(adminID, account))
+	})
+
+	t.Run("should skip storage when the account has no avatar", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+
+		m.accounts.EXPECT().HardDelete(gomock.Any()).Return("", nil)
+
+		assert.NoError(t, uc.HardDelete(adminID, &entity.AccountEntity{ID: uuid.New()}))
+	})
+
+	t.Run("should report an unknown account", func(t *testing.T) {
+		m, uc := setupAdminAccount(t)
+
+		m.accounts.EXPECT().HardDelete(gomock.Any()).Return("", sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.HardDelete(adminID, &entity.AccountEntity{ID: uuid.New()}), sql.ErrNoRows)
+	})
+
+	t.Run("should refuse the own account", func(t *testing.T) {
+		_, uc := setupAdminAccount(t)
+
+		err := uc.HardDelete(adminID, &entity.AccountEntity{ID: adminID})
+
+		assert.ErrorIs(t, err, usecase.ErrCannotManageOwnAccount)
+	})
+}
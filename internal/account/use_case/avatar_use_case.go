@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/imaging"
+	"trilha-api/internal/shared/storage"
+	"trilha-api/internal/shared/utils"
+)
+
+const (
+	avatarKeySize     = 16
+	avatarContentType = "image/jpeg"
+)
+
+var (
+	ErrUnsupportedAvatar = errors.New("unsupported avatar image format")
+	ErrInvalidAvatar     = errors.New("invalid avatar image")
+)
+
+//go:generate mockgen -source=avatar_use_case.go -destination=../mocks/avatar_use_case_mock.go -package=mocks
+type AvatarUseCaseInterface interface {
+	Upload(account *entity.AccountEntity, image []byte) error
+	Delete(account *entity.AccountEntity) error
+	URLs(account *entity.AccountEntity) map[string]string
+	RemoveImages(key string)
+}
+
+type AvatarUseCase struct {
+	accountRepo repository.AccountRepositoryInterface
+	storage     storage.Storage
+	sizes       []int
+}
+
+func NewAvatarUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	store storage.Storage,
+	avatarConfig config.AvatarConfig,
+) *AvatarUseCase {
+	return &AvatarUseCase{
+		accountRepo: accountRepo,
+		storage:     store,
+		sizes:       avatarConfig.Sizes,
+	}
+}
+
+// Upload replaces the avatar of the account with image, cropped to a square
+// and resized to every configured size. The images are re-encoded from their
+// pixels, which drops the metadata of the upload, such as EXIF. Each upload
+// gets a new key, so clients can cache avatar URLs for good.
+func (uc *AvatarUseCase) Upload(account *entity.AccountEntity, image []byte) error {
+	img, err := imaging.Decode(image)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupportedFormat) {
+			return ErrUnsupportedAvatar
+		}
+		return fmt.Errorf("%w: %w", ErrInvalidAvatar, err)
+	}
+
+	token, err := utils.GenerateRandomToken(avatarKeySize)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("avatars/%s/%s", account.ID, token)
+
+	for _, size := range uc.sizes {
+		encoded, err := imaging.EncodeJPEG(img.Square(size))
+		if err == nil {
+			err = uc.storage.Put(avatarImageKey(key, size), bytes.NewReader(encoded), int64(len(encoded)), avatarContentType)
+		}
+		if err != nil {
+			uc.RemoveImages(key)
+			return err
+		}
+	}
+
+	account.AvatarKey = key
+
+	if err := uc.replace(account); err != nil {
+		uc.RemoveImages(key)
+		return err
+	}
+
+	return nil
+}
+
+// Delete removes the avatar of the account.
+func (uc *AvatarUseCase) Delete(account *entity.AccountEntity) error {
+	account.AvatarKey = ""
+
+	return uc.replace(account)
+}
+
+// URLs returns the URL of the avatar of the account for each size, keyed by
+// the size in pixels, or nil when the account has no avatar.
+func (uc *AvatarUseCase) URLs(account *entity.AccountEntity) map[string]string {
+	if account.AvatarKey == "" {
+		return nil
+	}
+
+	urls := make(map[string]string, len(uc.sizes))
+	for _, size := range uc.sizes {
+		urls[strconv.Itoa(size)] = uc.storage.URL(avatarImageKey(account.AvatarKey, size))
+	}
+
+	return urls
+}
+
+// replace stores the avatar key of the account and reloads it, removing the
+// images of the avatar it replaced.
+func (uc *AvatarUseCase) replace(account *entity.AccountEntity) error {
+	previous, err := uc.accountRepo.ReplaceAvatar(account)
+	if err != nil {
+		return err
+	}
+
+	if previous != "" {
+		uc.RemoveImages(previous)
+	}
+
+	return uc.accountRepo.Find(account)
+}
+
+// RemoveImages deletes every size of the avatar at key. Failures are only
+// logged: the avatar is no longer referenced and the request should not fail
+// because of leftover files.
+func (uc *AvatarUseCase) RemoveImages(key string) {
+	for _, size := range uc.sizes {
+		if err := uc.storage.Delete(avatarImageKey(key, size)); err != nil {
+			log.Printf("Erro ao remover imagem de avatar %s: %v", key, err)
+		}
+	}
+}
+
+func avatarImageKey(key string, size int) string {
+	return fmt.Sprintf("%s/%d.jpg", key, size)
+}
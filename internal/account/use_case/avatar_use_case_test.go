@@ -0,0 +1,135 @@
+package usecase_test
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/storage"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupAvatar(t *testing.T) (*mocks.MockAccountRepositoryInterface, string, *usecase.AvatarUseCase) {
+	ctrl := gomock.NewController(t)
+	accounts := mocks.NewMockAccountRepositoryInterface(ctrl)
+	dir := t.TempDir()
+
+	uc := usecase.NewAvatarUseCase(
+		accounts,
+		storage.NewLocalStorage(dir, "http://trilha.test/storage"),
+		config.AvatarConfig{Sizes: []int{32, 128}},
+	)
+
+	return accounts, dir, uc
+}
+
+func pngImage(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func TestAvatarUseCase_Upload(t *testing.T) {
+	t.Run("should store every size and remove the previous avatar", func(t *testing.T) {
+		accounts, dir, uc := setupAvatar(t)
+		account := &entity.AccountEntity{ID: uuid.New()}
+		previous := "avatars/" + account.ID.String() + "/old"
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, previous), 0o755))
+		for _, name := range []string{"32.jpg", "128.jpg"} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, previous, name), []byte("old"), 0o644))
+		}
+
+		accounts.EXPECT().ReplaceAvatar(account).Return(previous, nil)
+		accounts.EXPECT().Find(account).Return(nil)
+
+		err := uc.Upload(account, pngImage(t, 300, 200))
+
+		require.NoError(t, err)
+		assert.NotEqual(t, previous, account.AvatarKey)
+		for _, size := range []int{32, 128} {
+			name := filepath.Join(dir, account.AvatarKey, fmt.Sprintf("%d.jpg", size))
+			data, err := os.ReadFile(name)
+			require.NoError(t, err)
+			cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+			require.NoError(t, err)
+			assert.Equal(t, size, cfg.Width)
+			assert.Equal(t, size, cfg.Height)
+		}
+		assert.NoFileExists(t, filepath.Join(dir, previous, "32.jpg"))
+		assert.NoFileExists(t, filepath.Join(dir, previous, "128.jpg"))
+	})
+
+	t.Run("should reject formats other than images", func(t *testing.T) {
+		_, _, uc := setupAvatar(t)
+
+		err := uc.Upload(&entity.AccountEntity{ID: uuid.New()}, []byte("%PDF-1.7"))
+
+		assert.ErrorIs(t, err, usecase.ErrUnsupportedAvatar)
+	})
+
+	t.Run("should reject a corrupt image", func(t *testing.T) {
+		_, _, uc := setupAvatar(t)
+		data := pngImage(t, 64, 64)
+
+		err := uc.Upload(&entity.AccountEntity{ID: uuid.New()}, data[:len(data)/2])
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidAvatar)
+	})
+
+	t.Run("should remove the new images when the account is missing", func(t *testing.T) {
+		accounts, dir, uc := setupAvatar(t)
+		account := &entity.AccountEntity{ID: uuid.New()}
+
+		accounts.EXPECT().ReplaceAvatar(account).Return("", sql.ErrNoRows)
+
+		err := uc.Upload(account, pngImage(t, 64, 64))
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.NoFileExists(t, filepath.Join(dir, account.AvatarKey, "32.jpg"))
+		assert.NoFileExists(t, filepath.Join(dir, account.AvatarKey, "128.jpg"))
+	})
+}
+
+func TestAvatarUseCase_Delete(t *testing.T) {
+	accounts, dir, uc := setupAvatar(t)
+	account := &entity.AccountEntity{ID: uuid.New(), AvatarKey: "avatars/1/key"}
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "avatars", "1", "key"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "avatars", "1", "key", "32.jpg"), []byte("old"), 0o644))
+
+	accounts.EXPECT().ReplaceAvatar(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) (string, error) {
+		assert.Empty(t, acc.AvatarKey)
+		return "avatars/1/key", nil
+	})
+	accounts.EXPECT().Find(account).Return(nil)
+
+	err := uc.Delete(account)
+
+	assert.NoError(t, err)
+	assert.Empty(t, account.AvatarKey)
+	assert.NoFileExists(t, filepath.Join(dir, "avatars", "1", "key", "32.jpg"))
+	assert.Nil(t, uc.URLs(account))
+}
+
+func TestAvatarUseCase_URLs(t *testing.T) {
+	_, _, uc := setupAvatar(t)
+
+	urls := uc.URLs(&entity.AccountEntity{AvatarKey: "avatars/1/key"})
+
+	assert.Equal(t, map[string]string{
+		"32":  "http://trilha.test/storage/avatars/1/key/32.jpg",
+		"128": "http://trilha.test/storage/avatars/1/key/128.jpg",
+	}, urls)
+}
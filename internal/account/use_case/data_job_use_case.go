@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/privacy"
+	"trilha-api/internal/shared/storage"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+const archiveKeySize = 24
+
+var ErrArchiveUnavailable = errors.New("archive unavailable")
+
+//go:generate mockgen -source=data_job_use_case.go -destination=../mocks/data_job_use_case_mock.go -package=mocks
+type DataJobUseCaseInterface interface {
+	RequestExport(job *entity.DataJobEntity) error
+	RequestErasure(job *entity.DataJobEntity) error
+	Find(job *entity.DataJobEntity) error
+	List(accountID uuid.UUID) ([]entity.DataJobEntity, error)
+	OpenArchive(job *entity.DataJobEntity) (io.ReadCloser, error)
+	Work() error
+}
+
+// DataJobUseCase queues data exports and erasures and runs them in the
+// background. Every module keeping personal data takes part through its
+// exporter and eraser. Erasers run in order, so the account module, whose
+// eraser anonymizes the account, comes last.
+type DataJobUseCase struct {
+	accountRepo repository.AccountRepositoryInterface
+	jobRepo     repository.DataJobRepositoryInterface
+	exporters   []privacy.Exporter
+	erasers     []privacy.Eraser
+	archives    storage.Private
+	exportTTL   time.Duration
+	jobTimeout  time.Duration
+}
+
+func NewDataJobUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	jobRepo repository.DataJobRepositoryInterface,
+	exporters []privacy.Exporter,
+	erasers []privacy.Eraser,
+	archives storage.Private,
+	privacyConfig config.PrivacyConfig,
+) *DataJobUseCase {
+	return &DataJobUseCase{
+		accountRepo: accountRepo,
+		jobRepo:     jobRepo,
+		exporters:   exporters,
+		erasers:     erasers,
+		archives:    archives,
+		exportTTL:   privacyConfig.ExportTTL,
+		jobTimeout:  privacyConfig.JobTimeout,
+	}
+}
+
+// RequestExport queues an export of the personal data of the account of
+// the job. It fails with repository.ErrDataJobInProgress while another
+// export of the account is unfinished.
+func (uc *DataJobUseCase) RequestExport(job *entity.DataJobEntity) error {
+	return uc.request(job, entity.DataJobKindExport)
+}
+
+// RequestErasure queues the erasure of the personal data of the account of
+// the job, which cannot be undone. It fails with
+// repository.ErrDataJobInProgress while another erasure of the account is
+// unfinished.
+func (uc *DataJobUseCase) RequestErasure(job *entity.DataJobEntity) error {
+	return uc.request(job, entity.DataJobKindErasure)
+}
+
+func (uc *DataJobUseCase) request(job *entity.DataJobEntity, kind string) error {
+	account := &entity.AdminAccountEntity{AccountEntity: entity.AccountEntity{ID: job.AccountID}}
+	if err := uc.accountRepo.FindForAdmin(account); err != nil {
+		return err
+	}
+
+	job.Kind = kind
+
+	return uc.jobRepo.Create(job)
+}
+
+// Find loads the job. When the job names an account, jobs of other accounts
+// are reported as missing.
+func (uc *DataJobUseCase) Find(job *entity.DataJobEntity) error {
+	accountID := job.AccountID
+
+	if err := uc.jobRepo.Find(job); err != nil {
+		return err
+	}
+
+	if accountID != uuid.Nil && job.AccountID != accountID {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (uc *DataJobUseCase) List(accountID uuid.UUID) ([]entity.DataJobEntity, error) {
+	return uc.jobRepo.ListByAccount(accountID)
+}
+
+// OpenArchive reads the archive of a completed export, failing with
+// ErrArchiveUnavailable for other jobs and once the archive expires.
+func (uc *DataJobUseCase) OpenArchive(job *entity.DataJobEntity) (io.ReadCloser, error) {
+	if err := uc.Find(job); err != nil {
+		return nil, err
+	}
+
+	if job.Kind != entity.DataJobKindExport || !job.HasArchive(time.Now().UTC()) {
+		return nil, ErrArchiveUnavailable
+	}
+
+	return uc.archives.Open(job.ArchiveKey)
+}
+
+// Work runs the pending jobs one at a time until none is left, then removes
+// the expired archives. It is the task of the background worker.
+func (uc *DataJobUseCase) Work() error {
+	for {
+		job := &entity.DataJobEntity{}
+
+		claimed, err := uc.jobRepo.Claim(time.Now().UTC().Add(-uc.jobTimeout), job)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			break
+		}
+
+		if err := uc.run(job); err != nil {
+			return err
+		}
+	}
+
+	keys, err := uc.jobRepo.ExpireArchives()
+	if err != nil {
+		return err
+	}
+
+	uc.removeArchives(keys)
+
+	return nil
+}
+
+// run runs the job and records its outcome. Only failing to record it is
+// returned; the failures of the job itself are kept in the job.
+func (uc *DataJobUseCase) run(job *entity.DataJobEntity) error {
+	var err error
+
+	switch job.Kind {
+	case entity.DataJobKindExport:
+		err = uc.export(job)
+	case entity.DataJobKindErasure:
+		err = uc.erase(job)
+	default:
+		err = fmt.Errorf("tipo de tarefa desconhecido: %s", job.Kind)
+	}
+
+	if err != nil {
+		log.Printf("Erro ao executar a tarefa de dados %s: %v", job.ID, err)
+		job.Error = err.Error()
+		return uc.jobRepo.Fail(job)
+	}
+
+	return uc.jobRepo.Complete(job)
+}
+
+// export gathers the sections of every module into a ZIP archive, kept in
+// the private storage, which is never served, under a key that cannot be
+// guessed.
+func (uc *DataJobUseCase) export(job *entity.DataJobEntity) error {
+	var sections []privacy.Section
+
+	for _, exporter := range uc.exporters {
+		exported, err := exporter.ExportAccountData(job.AccountID)
+		if err != nil {
+			return err
+		}
+		sections = append(sections, exported...)
+	}
+
+	var archive bytes.Buffer
+	if err := privacy.WriteArchive(&archive, sections); err != nil {
+		return err
+	}
+
+	name, err := utils.GenerateRandomToken(archiveKeySize)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("exports/%s/%s.zip", job.AccountID, name)
+	if err := uc.archives.Put(key, &archive, int64(archive.Len()), "application/zip"); err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().UTC().Add(uc.exportTTL)
+	job.ArchiveKey = key
+	job.ExpiresAt = &expiresAt
+
+	return nil
+}
+
+// erase runs every eraser, then removes the archives of earlier exports,
+// which hold the data just erased.
+func (uc *DataJobUseCase) erase(job *entity.DataJobEntity) error {
+	for _, eraser := range uc.erasers {
+		if err := eraser.EraseAccountData(job.AccountID); err != nil {
+			return err
+		}
+	}
+
+	keys, err := uc.jobRepo.ClearArchives(job.AccountID)
+	if err != nil {
+		return err
+	}
+
+	uc.removeArchives(keys)
+
+	return nil
+}
+
+// removeArchives deletes archives from the private storage. Failures are
+// only logged, as the archives are no longer referenced.
+func (uc *DataJobUseCase) removeArchives(keys []string) {
+	for _, key := range keys {
+		if err := uc.archives.Delete(key); err != nil {
+			log.Printf("Erro ao remover arquivo de exportação: %v", err)
+		}
+	}
+}
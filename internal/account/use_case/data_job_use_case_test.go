@@ -0,0 +1,277 @@
+package usecase_test
+
+import (
+	"archive/zip"
+	"database/sql"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	"trilha-api/internal/account/repository"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/privacy"
+	"trilha-api/internal/shared/storage"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeModuleData stands for a module taking part in exports and erasures.
+type fakeModuleData struct {
+	sections []privacy.Section
+	err      error
+	erased   []uuid.UUID
+}
+
+func (f *fakeModuleData) ExportAccountData(uuid.UUID) ([]privacy.Section, error) {
+	return f.sections, f.err
+}
+
+func (f *fakeModuleData) EraseAccountData(accountID uuid.UUID) error {
+	f.erased = append(f.erased, accountID)
+	return f.err
+}
+
+type dataJobMocks struct {
+	accounts *mocks.MockAccountRepositoryInterface
+	jobs     *mocks.MockDataJobRepositoryInterface
+	module   *fakeModuleData
+	archives *storage.LocalStorage
+	dir      string
+}
+
+func setupDataJob(t *testing.T) (*dataJobMocks, *usecase.DataJobUseCase) {
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+
+	m := &dataJobMocks{
+		accounts: mocks.NewMockAccountRepositoryInterface(ctrl),
+		jobs:     mocks.NewMockDataJobRepositoryInterface(ctrl),
+		module:   &fakeModuleData{},
+		archives: storage.NewLocalStorage(dir, ""),
+		dir:      dir,
+	}
+
+	uc := usecase.NewDataJobUseCase(
+		m.accounts,
+		m.jobs,
+		[]privacy.Exporter{m.module},
+		[]privacy.Eraser{m.module},
+		m.archives,
+		config.PrivacyConfig{ExportTTL: time.Hour, JobTimeout: 30 * time.Minute},
+	)
+
+	return m, uc
+}
+
+func TestDataJobUseCase_RequestExport(t *testing.T) {
+	t.Run("should queue an export of the account", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+		accountID := uuid.New()
+
+		m.accounts.EXPECT().FindForAdmin(gomock.Any()).Return(nil)
+		m.jobs.EXPECT().Create(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			assert.Equal(t, entity.DataJobKindExport, job.Kind)
+			assert.Equal(t, accountID, job.AccountID)
+			job.Status = entity.DataJobStatusPending
+			return nil
+		})
+
+		job := &entity.DataJobEntity{AccountID: accountID, RequestedBy: accountID}
+
+		assert.NoError(t, uc.RequestExport(job))
+		assert.Equal(t, entity.DataJobStatusPending, job.Status)
+	})
+
+	t.Run("should report an export already in progress", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+
+		m.accounts.EXPECT().FindForAdmin(gomock.Any()).Return(nil)
+		m.jobs.EXPECT().Create(gomock.Any()).Return(repository.ErrDataJobInProgress)
+
+		err := uc.RequestExport(&entity.DataJobEntity{AccountID: uuid.New()})
+
+		assert.ErrorIs(t, err, repository.ErrDataJobInProgress)
+	})
+
+	t.Run("should report an unknown account", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+
+		m.accounts.EXPECT().FindForAdmin(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.RequestErasure(&entity.DataJobEntity{AccountID: uuid.New()}), sql.ErrNoRows)
+	})
+}
+
+func TestDataJobUseCase_Find(t *testing.T) {
+	t.Run("should hide the jobs of other accounts", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+
+		m.jobs.EXPECT().Find(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			job.AccountID = uuid.New()
+			return nil
+		})
+
+		err := uc.Find(&entity.DataJobEntity{ID: uuid.New(), AccountID: uuid.New()})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("should find any job when no account is given", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+
+		m.jobs.EXPECT().Find(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			job.AccountID = uuid.New()
+			return nil
+		})
+
+		assert.NoError(t, uc.Find(&entity.DataJobEntity{ID: uuid.New()}))
+	})
+}
+
+func TestDataJobUseCase_OpenArchive(t *testing.T) {
+	t.Run("should refuse an expired archive", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+
+		m.jobs.EXPECT().Find(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			expiresAt := time.Now().UTC().Add(-time.Minute)
+			job.Kind = entity.DataJobKindExport
+			job.ArchiveKey = "exports/1/key.zip"
+			job.ExpiresAt = &expiresAt
+			return nil
+		})
+
+		_, err := uc.OpenArchive(&entity.DataJobEntity{ID: uuid.New()})
+
+		assert.ErrorIs(t, err, usecase.ErrArchiveUnavailable)
+	})
+
+	t.Run("should refuse an erasure", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+
+		m.jobs.EXPECT().Find(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			job.Kind = entity.DataJobKindErasure
+			return nil
+		})
+
+		_, err := uc.OpenArchive(&entity.DataJobEntity{ID: uuid.New()})
+
+		assert.ErrorIs(t, err, usecase.ErrArchiveUnavailable)
+	})
+}
+
+func TestDataJobUseCase_Work(t *testing.T) {
+	t.Run("should build the export archive and keep it until it expires", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+		accountID := uuid.New()
+		jobID := uuid.New()
+		m.module.sections = []privacy.Section{{Name: "account", Data: map[string]string{"name": "Gandalf"}}}
+
+		var archiveKey string
+
+		gomock.InOrder(
+			m.jobs.EXPECT().Claim(gomock.Any(), gomock.Any()).DoAndReturn(func(_ time.Time, job *entity.DataJobEntity) (bool, error) {
+				job.ID = jobID
+				job.AccountID = accountID
+				job.Kind = entity.DataJobKindExport
+				return true, nil
+			}),
+			m.jobs.EXPECT().Complete(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+				assert.Contains(t, job.ArchiveKey, "exports/"+accountID.String()+"/")
+				assert.WithinDuration(t, time.Now().UTC().Add(time.Hour), *job.ExpiresAt, time.Minute)
+				archiveKey = job.ArchiveKey
+				return nil
+			}),
+			m.jobs.EXPECT().Claim(gomock.Any(), gomock.Any()).Return(false, nil),
+			m.jobs.EXPECT().ExpireArchives().Return(nil, nil),
+		)
+
+		require.NoError(t, uc.Work())
+
+		archive, err := zip.OpenReader(filepath.Join(m.dir, filepath.FromSlash(archiveKey)))
+		require.NoError(t, err)
+		defer archive.Close()
+		require.Len(t, archive.File, 1)
+		assert.Equal(t, "account.json", archive.File[0].Name)
+
+		m.jobs.EXPECT().Find(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+			expiresAt := time.Now().UTC().Add(time.Hour)
+			job.AccountID = accountID
+			job.Kind = entity.DataJobKindExport
+			job.ArchiveKey = archiveKey
+			job.ExpiresAt = &expiresAt
+			return nil
+		})
+
+		file, err := uc.OpenArchive(&entity.DataJobEntity{ID: jobID, AccountID: accountID})
+		require.NoError(t, err)
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.NotEmpty(t, content)
+	})
+
+	t.Run("should erase the account and the archives of its exports", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+		accountID := uuid.New()
+		require.NoError(t, os.MkdirAll(filepath.Join(m.dir, "exports", "1"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(m.dir, "exports", "1", "key.zip"), []byte("archive"), 0o644))
+
+		gomock.InOrder(
+			m.jobs.EXPECT().Claim(gomock.Any(), gomock.Any()).DoAndReturn(func(_ time.Time, job *entity.DataJobEntity) (bool, error) {
+				job.AccountID = accountID
+				job.Kind = entity.DataJobKindErasure
+				return true, nil
+			}),
+			m.jobs.EXPECT().ClearArchives(accountID).Return([]string{"exports/1/key.zip"}, nil),
+			m.jobs.EXPECT().Complete(gomock.Any()).Return(nil),
+			m.jobs.EXPECT().Claim(gomock.Any(), gomock.Any()).Return(false, nil),
+			m.jobs.EXPECT().ExpireArchives().Return(nil, nil),
+		)
+
+		require.NoError(t, uc.Work())
+
+		assert.Equal(t, []uuid.UUID{accountID}, m.module.erased)
+		assert.NoFileExists(t, filepath.Join(m.dir, "exports", "1", "key.zip"))
+	})
+
+	t.Run("should record the failure of a job and go on", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+		m.module.err = errors.New("module unavailable")
+
+		gomock.InOrder(
+			m.jobs.EXPECT().Claim(gomock.Any(), gomock.Any()).DoAndReturn(func(_ time.Time, job *entity.DataJobEntity) (bool, error) {
+				job.Kind = entity.DataJobKindExport
+				return true, nil
+			}),
+			m.jobs.EXPECT().Fail(gomock.Any()).DoAndReturn(func(job *entity.DataJobEntity) error {
+				assert.Equal(t, "module unavailable", job.Error)
+				return nil
+			}),
+			m.jobs.EXPECT().Claim(gomock.Any(), gomock.Any()).Return(false, nil),
+			m.jobs.EXPECT().ExpireArchives().Return(nil, nil),
+		)
+
+		assert.NoError(t, uc.Work())
+	})
+
+	t.Run("should remove expired archives", func(t *testing.T) {
+		m, uc := setupDataJob(t)
+		require.NoError(t, os.MkdirAll(filepath.Join(m.dir, "exports", "2"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(m.dir, "exports", "2", "key.zip"), []byte("archive"), 0o644))
+
+		m.jobs.EXPECT().Claim(gomock.Any(), gomock.Any()).Return(false, nil)
+		m.jobs.EXPECT().ExpireArchives().Return([]string{"exports/2/key.zip"}, nil)
+
+		require.NoError(t, uc.Work())
+
+		assert.NoFileExists(t, filepath.Join(m.dir, "exports", "2", "key.zip"))
+	})
+}
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/utils"
+)
+
+const emailChangeTokenSize = 32
+
+var (
+	ErrInvalidEmailChangeToken = errors.New("invalid email change token")
+	ErrEmailUnchanged          = errors.New("new email is the current one")
+)
+
+//go:generate mockgen -source=email_change_use_case.go -destination=../mocks/email_change_use_case_mock.go -package=mocks
+type EmailChangeUseCaseInterface interface {
+	RequestChange(account *entity.AccountEntity, newEmail, currentPassword string) error
+	Confirm(token string) error
+	Cancel(token string) error
+}
+
+type EmailChangeUseCase struct {
+	accountRepo repository.AccountRepositoryInterface
+	requestRepo repository.EmailChangeRequestRepositoryInterface
+	mailer      mailer.Mailer
+	hasher      password.Hasher
+	emails      *EmailNormalizer
+	tokenTTL    time.Duration
+	appURL      string
+}
+
+func NewEmailChangeUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	requestRepo repository.EmailChangeRequestRepositoryInterface,
+	mail mailer.Mailer,
+	hasher password.Hasher,
+	emails *EmailNormalizer,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+) *EmailChangeUseCase {
+	return &EmailChangeUseCase{
+		accountRepo: accountRepo,
+		requestRepo: requestRepo,
+		mailer:      mail,
+		hasher:      hasher,
+		emails:      emails,
+		tokenTTL:    authConfig.EmailChangeTokenTTL,
+		appURL:      mailConfig.AppURL,
+	}
+}
+
+// RequestChange starts moving the account to newEmail once its current
+// password is checked. A confirmation link is sent to the new address and a
+// link to cancel the change to the current one; the email of the account only
+// changes when the first is followed. Earlier pending requests are cancelled.
+// An email already used by another account fails with
+// repository.ErrEmailAlreadyInUse, and the current one, in any case, with
+// ErrEmailUnchanged.
+func (uc *EmailChangeUseCase) RequestChange(account *entity.AccountEntity, newEmail, currentPassword string) error {
+	newEmail = uc.emails.Normalize(newEmail)
+
+	if err := uc.accountRepo.Find(account); err != nil {
+		return err
+	}
+
+	if err := uc.hasher.Compare(account.Password, currentPassword); err != nil {
+		return ErrInvalidCredentials
+	}
+
+	if strings.EqualFold(newEmail, account.Email) {
+		return ErrEmailUnchanged
+	}
+
+	err := uc.accountRepo.FindByEmail(&entity.AccountEntity{Email: newEmail})
+	if err == nil {
+		return repository.ErrEmailAlreadyInUse
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if err := uc.requestRepo.CancelAllByAccount(account.ID); err != nil {
+		return err
+	}
+
+	token, err := utils.GenerateRandomToken(emailChangeTokenSize)
+	if err != nil {
+		return err
+	}
+
+	cancelToken, err := utils.GenerateRandomToken(emailChangeTokenSize)
+	if err != nil {
+		return err
+	}
+
+	request := &entity.EmailChangeRequestEntity{
+		AccountID:       account.ID,
+		NewEmail:        newEmail,
+		Token:           token,
+		TokenHash:       utils.HashToken(token),
+		CancelToken:     cancelToken,
+		CancelTokenHash: utils.HashToken(cancelToken),
+		ExpiresAt:       time.Now().UTC().Add(uc.tokenTTL),
+	}
+
+	if err := uc.requestRepo.Create(request); err != nil {
+		return err
+	}
+
+	confirmLink := fmt.Sprintf("%s/confirm-email-change?token=%s", uc.appURL, url.QueryEscape(token))
+
+	if err := uc.mailer.Send(mailer.Message{
+		To:      newEmail,
+		Subject: "Confirme o seu novo email",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nPara passar a usar este endereço na sua conta, acesse o link abaixo:\n\n%s\n\nO link expira em %s. Se você não fez este pedido, ignore este email.\n",
+			account.Name, confirmLink, uc.tokenTTL,
+		),
+	}); err != nil {
+		return err
+	}
+
+	cancelLink := fmt.Sprintf("%s/cancel-email-change?token=%s", uc.appURL, url.QueryEscape(cancelToken))
+
+	return uc.mailer.Send(mailer.Message{
+		To:      account.Email,
+		Subject: "Pedido de troca de email",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nRecebemos um pedido para trocar o email da sua conta para %s. A troca só acontece quando o novo endereço for confirmado.\n\nSe você não fez este pedido, cancele a troca pelo link abaixo e troque a sua senha:\n\n%s\n",
+			account.Name, newEmail, cancelLink,
+		),
+	})
+}
+
+// Confirm moves the account of the request carried by token to its new email,
+// which counts as verified. A request already confirmed, cancelled or expired
+// fails with ErrInvalidEmailChangeToken, and an email taken by another
+// account since the request with repository.ErrEmailAlreadyInUse.
+func (uc *EmailChangeUseCase) Confirm(token string) error {
+	request := &entity.EmailChangeRequestEntity{
+		Token:     token,
+		TokenHash: utils.HashToken(token),
+	}
+
+	if err := uc.requestRepo.FindByTokenHash(request); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidEmailChangeToken
+		}
+		return err
+	}
+
+	if !request.IsPending(time.Now().UTC()) {
+		return ErrInvalidEmailChangeToken
+	}
+
+	confirmed, err := uc.requestRepo.Confirm(request)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return ErrInvalidEmailChangeToken
+	}
+
+	return nil
+}
+
+// Cancel cancels the request carried by the cancel token sent to the current
+// address. A request that was already confirmed or cancelled fails with
+// ErrInvalidEmailChangeToken.
+func (uc *EmailChangeUseCase) Cancel(token string) error {
+	request := &entity.EmailChangeRequestEntity{
+		CancelToken:     token,
+		CancelTokenHash: utils.HashToken(token),
+	}
+
+	if err := uc.requestRepo.FindByCancelTokenHash(request); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidEmailChangeToken
+		}
+		return err
+	}
+
+	cancelled, err := uc.requestRepo.Cancel(request)
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return ErrInvalidEmailChangeToken
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	"trilha-api/internal/account/repository"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type emailChangeMocks struct {
+	accounts *mocks.MockAccountRepositoryInterface
+	requests *mocks.MockEmailChangeRequestRepositoryInterface
+	mailer   *mailer.MemoryMailer
+}
+
+func setupEmailChange(t *testing.T) (*emailChangeMocks, *usecase.EmailChangeUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &emailChangeMocks{
+		accounts: mocks.NewMockAccountRepositoryInterface(ctrl),
+		requests: mocks.NewMockEmailChangeRequestRepositoryInterface(ctrl),
+		mailer:   mailer.NewMemoryMailer(),
+	}
+
+	uc := usecase.NewEmailChangeUseCase(
+		m.accounts,
+		m.requests,
+		m.mailer,
+		testHasher,
+		testEmails,
+		config.AuthConfig{EmailChangeTokenTTL: time.Hour},
+		config.MailConfig{AppURL: "http://trilha.test"},
+	)
+
+	return m, uc
+}
+
+func TestEmailChangeUseCase_RequestChange(t *testing.T) {
+	accountID := uuid.New()
+	hashed := hashPassword(t, "password123")
+
+	findAccount := func(m *emailChangeMocks) {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			acc.Name = "Gandalf"
+			acc.Email = "gandalf@lor.com.br"
+			acc.Password = hashed
+			return nil
+		})
+	}
+
+	t.Run("should store the request and email both addresses", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+		var stored *entity.EmailChangeRequestEntity
+
+		findAccount(m)
+		m.accounts.EXPECT().FindByEmail(&entity.AccountEntity{Email: "mithrandir@lor.com.br"}).Return(sql.ErrNoRows)
+		m.requests.EXPECT().CancelAllByAccount(accountID).Return(nil)
+		m.requests.EXPECT().Create(gomock.Any()).DoAndReturn(func(request *entity.EmailChangeRequestEntity) error {
+			assert.Equal(t, accountID, request.AccountID)
+			assert.Equal(t, "mithrandir@lor.com.br", request.NewEmail)
+			assert.Equal(t, utils.HashToken(request.Token), request.TokenHash)
+			assert.Equal(t, utils.HashToken(request.CancelToken), request.CancelTokenHash)
+			assert.NotEqual(t, request.TokenHash, request.CancelTokenHash)
+			stored = request
+			return nil
+		})
+
+		err := uc.RequestChange(&entity.AccountEntity{ID: accountID}, "mithrandir@lor.com.br", "password123")
+
+		assert.NoError(t, err)
+
+		messages := m.mailer.Messages()
+		assert.Len(t, messages, 2)
+		assert.Equal(t, "mithrandir@lor.com.br", messages[0].To)
+		assert.True(t, strings.Contains(messages[0].Body, "/confirm-email-change?token="+stored.Token))
+		assert.Equal(t, "gandalf@lor.com.br", messages[1].To)
+		assert.True(t, strings.Contains(messages[1].Body, "/cancel-email-change?token="+stored.CancelToken))
+		assert.False(t, strings.Contains(messages[1].Body, stored.Token))
+	})
+
+	t.Run("should reject a wrong current password", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+
+		findAccount(m)
+
+		err := uc.RequestChange(&entity.AccountEntity{ID: accountID}, "mithrandir@lor.com.br", "wrong")
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidCredentials)
+		assert.Empty(t, m.mailer.Messages())
+	})
+
+	t.Run("should reject the current email", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+
+		findAccount(m)
+
+		err := uc.RequestChange(&entity.AccountEntity{ID: accountID}, "gandalf@lor.com.br", "password123")
+
+		assert.ErrorIs(t, err, usecase.ErrEmailUnchanged)
+	})
+
+	t.Run("should reject the current email in another case", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+
+		findAccount(m)
+
+		err := uc.RequestChange(&entity.AccountEntity{ID: accountID}, " GANDALF@lor.com.br", "password123")
+
+		assert.ErrorIs(t, err, usecase.ErrEmailUnchanged)
+	})
+
+	t.Run("should reject an email used by another account", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+
+		findAccount(m)
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).Return(nil)
+
+		err := uc.RequestChange(&entity.AccountEntity{ID: accountID}, "saruman@lor.com.br", "password123")
+
+		assert.ErrorIs(t, err, repository.ErrEmailAlreadyInUse)
+		assert.Empty(t, m.mailer.Messages())
+	})
+}
+
+func TestEmailChangeUseCase_Confirm(t *testing.T) {
+	pending := func(m *emailChangeMocks, expiresAt time.Time) *entity.EmailChangeRequestEntity {
+		request := &entity.EmailChangeRequestEntity{ID: uuid.New(), NewEmail: "mithrandir@lor.com.br", ExpiresAt: expiresAt}
+
+		m.requests.EXPECT().FindByTokenHash(gomock.Any()).DoAndReturn(func(r *entity.EmailChangeRequestEntity) error {
+			assert.Equal(t, utils.HashToken("token"), r.TokenHash)
+			*r = *request
+			return nil
+		})
+
+		return request
+	}
+
+	t.Run("should confirm a pending request", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+		request := pending(m, time.Now().UTC().Add(time.Hour))
+
+		m.requests.EXPECT().Confirm(gomock.Any()).DoAndReturn(func(r *entity.EmailChangeRequestEntity) (bool, error) {
+			assert.Equal(t, request.ID, r.ID)
+			return true, nil
+		})
+
+		assert.NoError(t, uc.Confirm("token"))
+	})
+
+	t.Run("should reject an unknown token", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+
+		m.requests.EXPECT().FindByTokenHash(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.Confirm("token"), usecase.ErrInvalidEmailChangeToken)
+	})
+
+	t.Run("should reject an expired request", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+		pending(m, time.Now().UTC().Add(-time.Minute))
+
+		assert.ErrorIs(t, uc.Confirm("token"), usecase.ErrInvalidEmailChangeToken)
+	})
+
+	t.Run("should reject a request consumed concurrently", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+		pending(m, time.Now().UTC().Add(time.Hour))
+
+		m.requests.EXPECT().Confirm(gomock.Any()).Return(false, nil)
+
+		assert.ErrorIs(t, uc.Confirm("token"), usecase.ErrInvalidEmailChangeToken)
+	})
+
+	t.Run("should report an email taken since the request", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+		pending(m, time.Now().UTC().Add(time.Hour))
+
+		m.requests.EXPECT().Confirm(gomock.Any()).Return(false, repository.ErrEmailAlreadyInUse)
+
+		assert.ErrorIs(t, uc.Confirm("token"), repository.ErrEmailAlreadyInUse)
+	})
+}
+
+func TestEmailChangeUseCase_Cancel(t *testing.T) {
+	t.Run("should cancel an open request", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+		id := uuid.New()
+
+		m.requests.EXPECT().FindByCancelTokenHash(gomock.Any()).DoAndReturn(func(r *entity.EmailChangeRequestEntity) error {
+			assert.Equal(t, utils.HashToken("cancel"), r.CancelTokenHash)
+			r.ID = id
+			return nil
+		})
+		m.requests.EXPECT().Cancel(gomock.Any()).DoAndReturn(func(r *entity.EmailChangeRequestEntity) (bool, error) {
+			assert.Equal(t, id, r.ID)
+			return true, nil
+		})
+
+		assert.NoError(t, uc.Cancel("cancel"))
+	})
+
+	t.Run("should reject an unknown token", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+
+		m.requests.EXPECT().FindByCancelTokenHash(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.Cancel("cancel"), usecase.ErrInvalidEmailChangeToken)
+	})
+
+	t.Run("should reject a request already confirmed", func(t *testing.T) {
+		m, uc := setupEmailChange(t)
+
+		m.requests.EXPECT().FindByCancelTokenHash(gomock.Any()).Return(nil)
+		m.requests.EXPECT().Cancel(gomock.Any()).Return(false, nil)
+
+		assert.ErrorIs(t, uc.Cancel("cancel"), usecase.ErrInvalidEmailChangeToken)
+	})
+}
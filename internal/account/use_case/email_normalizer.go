@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"strings"
+	"trilha-api/internal/shared/config"
+)
+
+// EmailNormalizer puts emails in the form they are stored and looked up in.
+// Surrounding spaces are trimmed and the domain, which is case-insensitive,
+// is lowercased. The local part is kept as typed unless the configuration
+// asks for it to be lowercased too; the database compares emails
+// case-insensitively either way.
+type EmailNormalizer struct {
+	lowercaseLocalPart bool
+}
+
+func NewEmailNormalizer(authConfig config.AuthConfig) *EmailNormalizer {
+	return &EmailNormalizer{
+		lowercaseLocalPart: authConfig.EmailLocalPart == config.EmailLocalPartLowercase,
+	}
+}
+
+func (n *EmailNormalizer) Normalize(email string) string {
+	email = strings.TrimSpace(email)
+
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return email
+	}
+
+	local, domain := email[:at], strings.ToLower(email[at+1:])
+	if n.lowercaseLocalPart {
+		local = strings.ToLower(local)
+	}
+
+	return local + "@" + domain
+}
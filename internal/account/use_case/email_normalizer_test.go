@@ -0,0 +1,32 @@
+package usecase_test
+
+import (
+	"testing"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testEmails normalizes emails as configured by default.
+var testEmails = usecase.NewEmailNormalizer(config.AuthConfig{EmailLocalPart: config.EmailLocalPartPreserve})
+
+func TestEmailNormalizer(t *testing.T) {
+	t.Run("should trim and lowercase the domain only", func(t *testing.T) {
+		assert.Equal(t, "Gandalf.Grey@lor.com.br", testEmails.Normalize("  Gandalf.Grey@LOR.Com.BR "))
+	})
+
+	t.Run("should lowercase the local part when configured", func(t *testing.T) {
+		n := usecase.NewEmailNormalizer(config.AuthConfig{EmailLocalPart: config.EmailLocalPartLowercase})
+
+		assert.Equal(t, "gandalf.grey@lor.com.br", n.Normalize("Gandalf.Grey@LOR.com.br"))
+	})
+
+	t.Run("should split on the last at sign", func(t *testing.T) {
+		assert.Equal(t, `"a@B"@lor.com.br`, testEmails.Normalize(`"a@B"@LOR.com.br`))
+	})
+
+	t.Run("should only trim what is not an email", func(t *testing.T) {
+		assert.Equal(t, "Gandalf", testEmails.Normalize(" Gandalf "))
+	})
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+)
+
+var (
+	ErrInvalidVerificationToken = errors.New("invalid verification token")
+	ErrEmailAlreadyVerified     = errors.New("email already verified")
+	ErrVerificationThrottled    = errors.New("verification email sent too recently")
+)
+
+//go:generate mockgen -source=email_verification_use_case.go -destination=../mocks/email_verification_use_case_mock.go -package=mocks
+type EmailVerificationUseCaseInterface interface {
+	SendVerification(account *entity.AccountEntity) error
+	Resend(account *entity.AccountEntity) error
+	Verify(token string) error
+}
+
+type EmailVerificationUseCase struct {
+	repo           repository.AccountRepositoryInterface
+	tokens         auth.TokenManager
+	mailer         mailer.Mailer
+	tokenTTL       time.Duration
+	resendInterval time.Duration
+	appURL         string
+}
+
+func NewEmailVerificationUseCase(
+	repo repository.AccountRepositoryInterface,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+) *EmailVerificationUseCase {
+	return &EmailVerificationUseCase{
+		repo:           repo,
+		tokens:         tokens,
+		mailer:         mail,
+		tokenTTL:       authConfig.EmailVerificationTokenTTL,
+		resendInterval: authConfig.EmailVerificationResendInterval,
+		appURL:         mailConfig.AppURL,
+	}
+}
+
+// SendVerification emails a signed verification link to the account. At most
+// one email is sent per resend interval; earlier attempts fail with
+// ErrVerificationThrottled.
+func (uc *EmailVerificationUseCase) SendVerification(account *entity.AccountEntity) error {
+	if account.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	allowed, err := uc.repo.MarkVerificationSent(account, time.Now().UTC().Add(-uc.resendInterval))
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrVerificationThrottled
+	}
+
+	token, err := uc.tokens.GenerateActionToken(auth.ActionToken{
+		Purpose:   auth.PurposeEmailVerification,
+		AccountID: account.ID,
+		Email:     account.Email,
+	}, uc.tokenTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", uc.appURL, url.QueryEscape(token))
+
+	return uc.mailer.Send(mailer.Message{
+		To:      account.Email,
+		Subject: "Confirme o seu email",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nPara confirmar o seu endereço de email, acesse o link abaixo:\n\n%s\n\nO link expira em %s.\n",
+			account.Name, link, uc.tokenTTL,
+		),
+	})
+}
+
+func (uc *EmailVerificationUseCase) Resend(account *entity.AccountEntity) error {
+	if err := uc.repo.Find(account); err != nil {
+		return err
+	}
+
+	return uc.SendVerification(account)
+}
+
+// Verify confirms the email carried by token. The token is only honoured while
+// the account still holds that email, and verifying twice is not an error.
+func (uc *EmailVerificationUseCase) Verify(token string) error {
+	action, err := uc.tokens.ParseActionToken(auth.PurposeEmailVerification, token)
+	if err != nil {
+		return ErrInvalidVerificationToken
+	}
+
+	account := &entity.AccountEntity{ID: action.AccountID}
+
+	if err := uc.repo.Find(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidVerificationToken
+		}
+		return err
+	}
+
+	if account.Email != action.Email {
+		return ErrInvalidVerificationToken
+	}
+
+	if account.IsEmailVerified() {
+		return nil
+	}
+
+	verified, err := uc.repo.VerifyEmail(account)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return ErrInvalidVerificationToken
+	}
+
+	return nil
+}
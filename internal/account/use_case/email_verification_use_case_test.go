@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupEmailVerification(t *testing.T) (*mocks.MockAccountRepositoryInterface, *auth.JWTManager, *mailer.MemoryMailer, *usecase.EmailVerificationUseCase) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockAccountRepositoryInterface(ctrl)
+	tokens := auth.NewJWTManager(config.AuthConfig{JWTSecret: "test-secret", JWTIssuer: "trilha-api"})
+	mail := mailer.NewMemoryMailer()
+
+	uc := usecase.NewEmailVerificationUseCase(
+		repo,
+		tokens,
+		mail,
+		config.AuthConfig{EmailVerificationTokenTTL: time.Hour, EmailVerificationResendInterval: time.Minute},
+		config.MailConfig{AppURL: "http://trilha.test"},
+	)
+
+	return repo, tokens, mail, uc
+}
+
+func TestEmailVerificationUseCase_SendVerification(t *testing.T) {
+	repo, tokens, mail, uc := setupEmailVerification(t)
+
+	account := &entity.AccountEntity{ID: uuid.New(), Name: "Gandalf", Email: "gandalf@lor.com.br"}
+
+	t.Run("should email a signed verification link", func(t *testing.T) {
+		repo.EXPECT().MarkVerificationSent(account, gomock.Any()).DoAndReturn(func(_ *entity.AccountEntity, resendBefore time.Time) (bool, error) {
+			assert.WithinDuration(t, time.Now().UTC().Add(-time.Minute), resendBefore, time.Second)
+			return true, nil
+		})
+
+		err := uc.SendVerification(account)
+
+		assert.NoError(t, err)
+
+		msg, _ := mail.Last()
+		assert.Equal(t, account.Email, msg.To)
+
+		_, token, found := strings.Cut(msg.Body, "http://trilha.test/verify-email?token=")
+		assert.True(t, found)
+		token, _, _ = strings.Cut(token, "\n")
+
+		action, err := tokens.ParseActionToken(auth.PurposeEmailVerification, token)
+		assert.NoError(t, err)
+		assert.Equal(t, account.ID, action.AccountID)
+		assert.Equal(t, account.Email, action.Email)
+	})
+
+	t.Run("should throttle emails sent too often", func(t *testing.T) {
+		repo.EXPECT().MarkVerificationSent(account, gomock.Any()).Return(false, nil)
+
+		assert.ErrorIs(t, uc.SendVerification(account), usecase.ErrVerificationThrottled)
+	})
+
+	t.Run("should not send to a verified account", func(t *testing.T) {
+		verifiedAt := time.Now()
+		verified := &entity.AccountEntity{ID: uuid.New(), EmailVerifiedAt: &verifiedAt}
+
+		assert.ErrorIs(t, uc.SendVerification(verified), usecase.ErrEmailAlreadyVerified)
+	})
+}
+
+func TestEmailVerificationUseCase_Verify(t *testing.T) {
+	repo, tokens, _, uc := setupEmailVerification(t)
+
+	accountID := uuid.New()
+	token, _ := tokens.GenerateActionToken(auth.ActionToken{
+		Purpose:   auth.PurposeEmailVerification,
+		AccountID: accountID,
+		Email:     "gandalf@lor.com.br",
+	}, time.Hour)
+
+	t.Run("should verify the email of the account", func(t *testing.T) {
+		repo.EXPECT().Find(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Equal(t, accountID, acc.ID)
+			acc.Email = "gandalf@lor.com.br"
+			return nil
+		})
+		repo.EXPECT().VerifyEmail(gomock.Any()).Return(true, nil)
+
+		assert.NoError(t, uc.Verify(token))
+	})
+
+	t.Run("should reject a token for an email the account no longer holds", func(t *testing.T) {
+		repo.EXPECT().Find(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			acc.Email = "mithrandir@lor.com.br"
+			return nil
+		})
+
+		assert.ErrorIs(t, uc.Verify(token), usecase.ErrInvalidVerificationToken)
+	})
+
+	t.Run("should reject a token of a deleted account", func(t *testing.T) {
+		repo.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.Verify(token), usecase.ErrInvalidVerificationToken)
+	})
+
+	t.Run("should reject a malformed token", func(t *testing.T) {
+		assert.ErrorIs(t, uc.Verify("invalid"), usecase.ErrInvalidVerificationToken)
+	})
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+)
+
+var ErrImpersonationEnded = errors.New("impersonation has ended")
+
+//go:generate mockgen -source=impersonation_use_case.go -destination=../mocks/impersonation_use_case_mock.go -package=mocks
+type ImpersonationUseCaseInterface interface {
+	Start(impersonation *entity.ImpersonationEntity) error
+	Find(impersonation *entity.ImpersonationEntity) error
+	End(impersonation *entity.ImpersonationEntity) error
+	ListRequests(impersonation *entity.ImpersonationEntity) ([]entity.ImpersonationRequestEntity, error)
+	VerifyImpersonation(principal *auth.Principal) error
+	RecordImpersonatedRequest(request auth.ImpersonatedRequest) error
+}
+
+type ImpersonationUseCase struct {
+	accountRepo       repository.AccountRepositoryInterface
+	impersonationRepo repository.ImpersonationRepositoryInterface
+	tokens            auth.TokenManager
+	ttl               time.Duration
+}
+
+func NewImpersonationUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	impersonationRepo repository.ImpersonationRepositoryInterface,
+	tokens auth.TokenManager,
+	authConfig config.AuthConfig,
+) *ImpersonationUseCase {
+	return &ImpersonationUseCase{
+		accountRepo:       accountRepo,
+		impersonationRepo: impersonationRepo,
+		tokens:            tokens,
+		ttl:               authConfig.ImpersonationTTL,
+	}
+}
+
+// Start lets the actor of the impersonation act as its account, which must
+// be active, until the impersonation expires or is ended, and issues the
+// access token to do so. Administrators cannot impersonate themselves.
+func (uc *ImpersonationUseCase) Start(impersonation *entity.ImpersonationEntity) error {
+	if impersonation.AccountID == impersonation.ActorID {
+		return ErrCannotManageOwnAccount
+	}
+
+	account := &entity.AccountEntity{ID: impersonation.AccountID}
+	if err := uc.accountRepo.Find(account); err != nil {
+		return err
+	}
+
+	impersonation.UserAgent = truncateUserAgent(impersonation.UserAgent)
+	impersonation.ExpiresAt = time.Now().UTC().Add(uc.ttl)
+
+	if err := uc.impersonationRepo.Create(impersonation); err != nil {
+		return err
+	}
+
+	token, err := uc.tokens.GenerateImpersonationToken(auth.Principal{
+		AccountID:       account.ID,
+		Email:           account.Email,
+		Verified:        account.IsEmailVerified(),
+		ImpersonatorID:  impersonation.ActorID,
+		ImpersonationID: impersonation.ID,
+	}, impersonation.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	impersonation.Token = token
+
+	return nil
+}
+
+func (uc *ImpersonationUseCase) Find(impersonation *entity.ImpersonationEntity) error {
+	return uc.impersonationRepo.Find(impersonation)
+}
+
+// End stops the impersonation before it expires, failing with
+// ErrImpersonationEnded when it is no longer running.
+func (uc *ImpersonationUseCase) End(impersonation *entity.ImpersonationEntity) error {
+	ended, err := uc.impersonationRepo.End(impersonation)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.impersonationRepo.Find(impersonation); err != nil {
+		return err
+	}
+
+	if !ended {
+		return ErrImpersonationEnded
+	}
+
+	return nil
+}
+
+// ListRequests returns the audit trail of the impersonation, oldest first.
+func (uc *ImpersonationUseCase) ListRequests(impersonation *entity.ImpersonationEntity) ([]entity.ImpersonationRequestEntity, error) {
+	if err := uc.impersonationRepo.Find(impersonation); err != nil {
+		return nil, err
+	}
+
+	return uc.impersonationRepo.ListRequests(impersonation.ID)
+}
+
+// VerifyImpersonation checks that the impersonation the principal was issued
+// for is still running, for the same actor and account.
+func (uc *ImpersonationUseCase) VerifyImpersonation(principal *auth.Principal) error {
+	impersonation := &entity.ImpersonationEntity{ID: principal.ImpersonationID}
+
+	if err := uc.impersonationRepo.Find(impersonation); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.ErrInvalidToken
+		}
+		return err
+	}
+
+	if !impersonation.IsActive(time.Now().UTC()) ||
+		impersonation.ActorID != principal.ImpersonatorID ||
+		impersonation.AccountID != principal.AccountID {
+		return auth.ErrInvalidToken
+	}
+
+	return nil
+}
+
+func (uc *ImpersonationUseCase) RecordImpersonatedRequest(request auth.ImpersonatedRequest) error {
+	return uc.impersonationRepo.RecordRequest(&entity.ImpersonationRequestEntity{
+		ImpersonationID: request.ImpersonationID,
+		Method:          request.Method,
+		Path:            request.Path,
+		Status:          request.Status,
+		IPAddress:       request.IPAddress,
+		UserAgent:       truncateUserAgent(request.UserAgent),
+	})
+}
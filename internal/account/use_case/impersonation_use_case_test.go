@@ -0,0 +1,191 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupImpersonation(t *testing.T) (*mocks.MockAccountRepositoryInterface, *mocks.MockImpersonationRepositoryInterface, *auth.JWTManager, *usecase.ImpersonationUseCase) {
+	ctrl := gomock.NewController(t)
+
+	accountMock := mocks.NewMockAccountRepositoryInterface(ctrl)
+	impersonationMock := mocks.NewMockImpersonationRepositoryInterface(ctrl)
+	authConfig := config.AuthConfig{
+		JWTSecret:        "test-secret",
+		JWTIssuer:        "trilha-api",
+		AccessTokenTTL:   time.Minute,
+		ImpersonationTTL: 30 * time.Minute,
+	}
+	tokens := auth.NewJWTManager(authConfig)
+
+	uc := usecase.NewImpersonationUseCase(accountMock, impersonationMock, tokens, authConfig)
+
+	return accountMock, impersonationMock, tokens, uc
+}
+
+func TestImpersonationUseCase_Start(t *testing.T) {
+	adminID := uuid.New()
+
+	t.Run("should start the impersonation and issue a token carrying both accounts", func(t *testing.T) {
+		accountMock, impersonationMock, tokens, uc := setupImpersonation(t)
+		accountID := uuid.New()
+		impersonationID := uuid.New()
+
+		accountMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Equal(t, accountID, acc.ID)
+			acc.Email = "frodo@lor.com.br"
+			return nil
+		})
+		impersonationMock.EXPECT().Create(gomock.Any()).DoAndReturn(func(impersonation *entity.ImpersonationEntity) error {
+			assert.Equal(t, adminID, impersonation.ActorID)
+			assert.WithinDuration(t, time.Now().UTC().Add(30*time.Minute), impersonation.ExpiresAt, time.Minute)
+			impersonation.ID = impersonationID
+			return nil
+		})
+
+		impersonation := &entity.ImpersonationEntity{ActorID: adminID, AccountID: accountID, Reason: "Ticket 42"}
+		err := uc.Start(impersonation)
+
+		assert.NoError(t, err)
+
+		principal, err := tokens.ParseAccessToken(impersonation.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, accountID, principal.AccountID)
+		assert.Equal(t, "frodo@lor.com.br", principal.Email)
+		assert.Equal(t, adminID, principal.ImpersonatorID)
+		assert.Equal(t, impersonationID, principal.ImpersonationID)
+		assert.Equal(t, uuid.Nil, principal.SessionID)
+	})
+
+	t.Run("should refuse to impersonate the own account", func(t *testing.T) {
+		_, _, _, uc := setupImpersonation(t)
+
+		err := uc.Start(&entity.ImpersonationEntity{ActorID: adminID, AccountID: adminID})
+
+		assert.ErrorIs(t, err, usecase.ErrCannotManageOwnAccount)
+	})
+
+	t.Run("should report an account that is not active", func(t *testing.T) {
+		accountMock, _, _, uc := setupImpersonation(t)
+
+		accountMock.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		err := uc.Start(&entity.ImpersonationEntity{ActorID: adminID, AccountID: uuid.New()})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestImpersonationUseCase_End(t *testing.T) {
+	t.Run("should end a running impersonation", func(t *testing.T) {
+		_, impersonationMock, _, uc := setupImpersonation(t)
+		impersonation := &entity.ImpersonationEntity{ID: uuid.New()}
+
+		impersonationMock.EXPECT().End(impersonation).Return(true, nil)
+		impersonationMock.EXPECT().Find(impersonation).Return(nil)
+
+		assert.NoError(t, uc.End(impersonation))
+	})
+
+	t.Run("should report an impersonation already over", func(t *testing.T) {
+		_, impersonationMock, _, uc := setupImpersonation(t)
+
+		impersonationMock.EXPECT().End(gomock.Any()).Return(false, nil)
+		impersonationMock.EXPECT().Find(gomock.Any()).Return(nil)
+
+		assert.ErrorIs(t, uc.End(&entity.ImpersonationEntity{ID: uuid.New()}), usecase.ErrImpersonationEnded)
+	})
+
+	t.Run("should report an unknown impersonation", func(t *testing.T) {
+		_, impersonationMock, _, uc := setupImpersonation(t)
+
+		impersonationMock.EXPECT().End(gomock.Any()).Return(false, nil)
+		impersonationMock.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.End(&entity.ImpersonationEntity{ID: uuid.New()}), sql.ErrNoRows)
+	})
+}
+
+func TestImpersonationUseCase_VerifyImpersonation(t *testing.T) {
+	principal := &auth.Principal{AccountID: uuid.New(), ImpersonatorID: uuid.New(), ImpersonationID: uuid.New()}
+
+	running := func(impersonation *entity.ImpersonationEntity) error {
+		impersonation.ActorID = principal.ImpersonatorID
+		impersonation.AccountID = principal.AccountID
+		impersonation.ExpiresAt = time.Now().UTC().Add(time.Minute)
+		return nil
+	}
+
+	t.Run("should accept a running impersonation", func(t *testing.T) {
+		_, impersonationMock, _, uc := setupImpersonation(t)
+
+		impersonationMock.EXPECT().Find(gomock.Any()).DoAndReturn(running)
+
+		assert.NoError(t, uc.VerifyImpersonation(principal))
+	})
+
+	t.Run("should reject an ended impersonation", func(t *testing.T) {
+		_, impersonationMock, _, uc := setupImpersonation(t)
+
+		impersonationMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(impersonation *entity.ImpersonationEntity) error {
+			_ = running(impersonation)
+			now := time.Now().UTC()
+			impersonation.EndedAt = &now
+			return nil
+		})
+
+		assert.ErrorIs(t, uc.VerifyImpersonation(principal), auth.ErrInvalidToken)
+	})
+
+	t.Run("should reject an impersonation whose account was removed", func(t *testing.T) {
+		_, impersonationMock, _, uc := setupImpersonation(t)
+
+		impersonationMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(impersonation *entity.ImpersonationEntity) error {
+			_ = running(impersonation)
+			impersonation.AccountID = uuid.Nil
+			return nil
+		})
+
+		assert.ErrorIs(t, uc.VerifyImpersonation(principal), auth.ErrInvalidToken)
+	})
+
+	t.Run("should reject an unknown impersonation", func(t *testing.T) {
+		_, impersonationMock, _, uc := setupImpersonation(t)
+
+		impersonationMock.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.VerifyImpersonation(principal), auth.ErrInvalidToken)
+	})
+}
+
+func TestImpersonationUseCase_RecordImpersonatedRequest(t *testing.T) {
+	_, impersonationMock, _, uc := setupImpersonation(t)
+	impersonationID := uuid.New()
+
+	impersonationMock.EXPECT().RecordRequest(gomock.Any()).DoAndReturn(func(request *entity.ImpersonationRequestEntity) error {
+		assert.Equal(t, impersonationID, request.ImpersonationID)
+		assert.Equal(t, "DELETE", request.Method)
+		assert.Equal(t, "/api/v1/accounts/me", request.Path)
+		assert.Equal(t, 403, request.Status)
+		return nil
+	})
+
+	err := uc.RecordImpersonatedRequest(auth.ImpersonatedRequest{
+		ImpersonationID: impersonationID,
+		Method:          "DELETE",
+		Path:            "/api/v1/accounts/me",
+		Status:          403,
+	})
+
+	assert.NoError(t, err)
+}
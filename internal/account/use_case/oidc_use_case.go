@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/oidc"
+	"trilha-api/internal/shared/utils"
+)
+
+const oidcTokenSize = 32
+
+var (
+	ErrUnknownOIDCProvider  = errors.New("unknown identity provider")
+	ErrInvalidOIDCLogin     = errors.New("invalid identity provider login")
+	ErrOIDCEmailNotVerified = errors.New("identity provider email not verified")
+)
+
+//go:generate mockgen -source=oidc_use_case.go -destination=../mocks/oidc_use_case_mock.go -package=mocks
+type OIDCUseCaseInterface interface {
+	Authorize(provider string) (*entity.OIDCAuthorizationEntity, error)
+	SignIn(account *entity.AccountEntity, provider, state, code string, client entity.ClientEntity) (*entity.SignInEntity, error)
+}
+
+type OIDCUseCase struct {
+	accountRepo      repository.AccountRepositoryInterface
+	identityRepo     repository.AccountIdentityRepositoryInterface
+	loginRequestRepo repository.OIDCLoginRequestRepositoryInterface
+	tokenRepo        repository.PersonalAccessTokenRepositoryInterface
+	recoveryCodeRepo repository.RecoveryCodeRepositoryInterface
+	passkeyRepo      repository.PasskeyRepositoryInterface
+	challengeRepo    repository.PasskeyChallengeRepositoryInterface
+	sessions         SessionUseCaseInterface
+	twoFactor        TwoFactorUseCaseInterface
+	providers        oidc.Providers
+	emails           *EmailNormalizer
+	loginTTL         time.Duration
+}
+
+func NewOIDCUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	identityRepo repository.AccountIdentityRepositoryInterface,
+	loginRequestRepo repository.OIDCLoginRequestRepositoryInterface,
+	tokenRepo repository.PersonalAccessTokenRepositoryInterface,
+	recoveryCodeRepo repository.RecoveryCodeRepositoryInterface,
+	passkeyRepo repository.PasskeyRepositoryInterface,
+	challengeRepo repository.PasskeyChallengeRepositoryInterface,
+	sessions SessionUseCaseInterface,
+	twoFactor TwoFactorUseCaseInterface,
+	providers oidc.Providers,
+	emails *EmailNormalizer,
+	oidcConfig config.OIDCConfig,
+) *OIDCUseCase {
+	return &OIDCUseCase{
+		accountRepo:      accountRepo,
+		identityRepo:     identityRepo,
+		loginRequestRepo: loginRequestRepo,
+		tokenRepo:        tokenRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		passkeyRepo:      passkeyRepo,
+		challengeRepo:    challengeRepo,
+		sessions:         sessions,
+		twoFactor:        twoFactor,
+		providers:        providers,
+		emails:           emails,
+		loginTTL:         oidcConfig.LoginTTL,
+	}
+}
+
+// Authorize starts a login with the provider. The returned state must come
+// back with the callback, which is only accepted once and within the login
+// TTL.
+func (uc *OIDCUseCase) Authorize(providerName string) (*entity.OIDCAuthorizationEntity, error) {
+	provider, ok := uc.providers[providerName]
+	if !ok {
+		return nil, ErrUnknownOIDCProvider
+	}
+
+	state, err := utils.GenerateRandomToken(oidcTokenSize)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := utils.GenerateRandomToken(oidcTokenSize)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := utils.GenerateRandomToken(oidcTokenSize)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := provider.AuthCodeURL(state, nonce, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.loginRequestRepo.DeleteExpired(); err != nil {
+		log.Printf("Erro ao remover logins externos expirados: %v", err)
+	}
+
+	request := &entity.OIDCLoginRequestEntity{
+		StateHash:    utils.HashToken(state),
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    time.Now().UTC().Add(uc.loginTTL),
+	}
+
+	if err := uc.loginRequestRepo.Create(request); err != nil {
+		return nil, err
+	}
+
+	return &entity.OIDCAuthorizationEntity{
+		URL:       url,
+		State:     state,
+		ExpiresAt: request.ExpiresAt,
+	}, nil
+}
+
+// SignIn completes the login of state with the authorization code sent back
+// by the provider, filling account with the account of the identity. The
+// identity is linked on its first login, to the account holding its verified
+// email or to a new account. As with passwords, accounts with two-factor
+// authentication get a challenge instead of tokens.
+func (uc *OIDCUseCase) SignIn(account *entity.AccountEntity, providerName, state, code string, client entity.ClientEntity) (*entity.SignInEntity, error) {
+	provider, ok := uc.providers[providerName]
+	if !ok {
+		return nil, ErrUnknownOIDCProvider
+	}
+
+	request := &entity.OIDCLoginRequestEntity{StateHash: utils.HashToken(state)}
+
+	if err := uc.loginRequestRepo.Consume(request); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidOIDCLogin
+		}
+		return nil, err
+	}
+
+	if request.Provider != providerName || !time.Now().UTC().Before(request.ExpiresAt) {
+		return nil, ErrInvalidOIDCLogin
+	}
+
+	identity, err := provider.Exchange(code, request.CodeVerifier, request.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidIDToken) {
+			log.Printf("Login externo recusado pelo provedor %s: %v", providerName, err)
+			return nil, ErrInvalidOIDCLogin
+		}
+		return nil, err
+	}
+
+	if err := uc.resolveAccount(account, providerName, identity); err != nil {
+		return nil, err
+	}
+
+	if account.IsTwoFactorEnabled() {
+		challenge, err := uc.twoFactor.Challenge(account)
+		if err != nil {
+			return nil, err
+		}
+		return &entity.SignInEntity{Challenge: challenge}, nil
+	}
+
+	tokens, err := uc.sessions.Issue(account, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.SignInEntity{Tokens: tokens}, nil
+}
+
+// resolveAccount fills account with the account linked to identity, linking
+// one first when the identity signs in for the first time.
+func (uc *OIDCUseCase) resolveAccount(account *entity.AccountEntity, providerName string, identity *oidc.Identity) error {
+	link := &entity.AccountIdentityEntity{
+		Provider: providerName,
+		Subject:  identity.Subject,
+	}
+
+	err := uc.identityRepo.FindBySubject(link)
+	if err == nil {
+		account.ID = link.AccountID
+		if err := uc.accountRepo.Find(account); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrInvalidCredentials
+			}
+			return err
+		}
+		return nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	// Linking by email is only safe when the provider vouches for it.
+	if identity.Email == "" || !identity.EmailVerified {
+		return ErrOIDCEmailNotVerified
+	}
+
+	account.Email = uc.emails.Normalize(identity.Email)
+
+	err = uc.accountRepo.FindByEmail(account)
+	switch {
+	case err == nil:
+		err = uc.claimAccount(account)
+	case errors.Is(err, sql.ErrNoRows):
+		err = uc.registerAccount(account, identity)
+	}
+	if err != nil {
+		return err
+	}
+
+	link.AccountID = account.ID
+	link.Email = identity.Email
+
+	return uc.identityRepo.Create(link)
+}
+
+// claimAccount prepares an existing account to be linked. An account whose
+// email was never verified may have been registered by someone else than the
+// owner of the email, who the provider just vouched for: everything that
+// lets someone else in is dropped before handing it over, which is its
+// password, sessions, personal access tokens, second factor and passkeys.
+func (uc *OIDCUseCase) claimAccount(account *entity.AccountEntity) error {
+	if account.IsEmailVerified() {
+		return nil
+	}
+
+	account.Password = ""
+	if err := uc.accountRepo.UpdatePassword(account); err != nil {
+		return err
+	}
+
+	if err := uc.sessions.RevokeAll(account); err != nil {
+		return err
+	}
+
+	if err := uc.tokenRepo.RevokeAllByAccount(account.ID); err != nil {
+		return err
+	}
+
+	if err := uc.accountRepo.DisableTwoFactor(account); err != nil {
+		return err
+	}
+
+	if err := uc.recoveryCodeRepo.DeleteAllByAccount(account.ID); err != nil {
+		return err
+	}
+
+	if err := uc.passkeyRepo.DeleteAllByAccount(account.ID); err != nil {
+		return err
+	}
+
+	if err := uc.challengeRepo.DeleteAllByAccount(account.ID); err != nil {
+		return err
+	}
+
+	return uc.verifyEmail(account)
+}
+
+// registerAccount creates the account of an identity signing in for the
+// first time. It has no password until one is set through a password reset.
+func (uc *OIDCUseCase) registerAccount(account *entity.AccountEntity, identity *oidc.Identity) error {
+	account.Name = identity.Name
+	if account.Name == "" {
+		account.Name, _, _ = strings.Cut(identity.Email, "@")
+	}
+	account.Password = ""
+
+	if err := uc.accountRepo.Register(account); err != nil {
+		return err
+	}
+
+	return uc.verifyEmail(account)
+}
+
+func (uc *OIDCUseCase) verifyEmail(account *entity.AccountEntity) error {
+	if _, err := uc.accountRepo.VerifyEmail(account); err != nil {
+		return err
+	}
+
+	verifiedAt := time.Now().UTC()
+	account.EmailVerifiedAt = &verifiedAt
+
+	return nil
+}
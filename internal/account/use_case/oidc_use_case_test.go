@@ -0,0 +1,366 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/oidc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeProvider returns identity for code "valid-code" when redeemed with the
+// verifier and nonce of the authorization.
+type fakeProvider struct {
+	identity *oidc.Identity
+	verifier string
+	nonce    string
+}
+
+func (p *fakeProvider) AuthCodeURL(state, nonce, verifier string) (string, error) {
+	p.nonce = nonce
+	p.verifier = verifier
+	return "https://idp.example.com/authorize?state=" + state, nil
+}
+
+func (p *fakeProvider) Exchange(code, verifier, nonce string) (*oidc.Identity, error) {
+	if code != "valid-code" || verifier != p.verifier || nonce != p.nonce {
+		return nil, fmt.Errorf("%w: rejected", oidc.ErrInvalidIDToken)
+	}
+	return p.identity, nil
+}
+
+type oidcMocks struct {
+	accounts      *mocks.MockAccountRepositoryInterface
+	identities    *mocks.MockAccountIdentityRepositoryInterface
+	loginRequests *mocks.MockOIDCLoginRequestRepositoryInterface
+	tokens        *mocks.MockPersonalAccessTokenRepositoryInterface
+	recoveryCodes *mocks.MockRecoveryCodeRepositoryInterface
+	passkeys      *mocks.MockPasskeyRepositoryInterface
+	challenges    *mocks.MockPasskeyChallengeRepositoryInterface
+	sessions      *mocks.MockSessionUseCaseInterface
+	twoFactor     *mocks.MockTwoFactorUseCaseInterface
+	provider      *fakeProvider
+}
+
+func setupOIDC(t *testing.T) (*oidcMocks, *usecase.OIDCUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &oidcMocks{
+		accounts:      mocks.NewMockAccountRepositoryInterface(ctrl),
+		identities:    mocks.NewMockAccountIdentityRepositoryInterface(ctrl),
+		loginRequests: mocks.NewMockOIDCLoginRequestRepositoryInterface(ctrl),
+		tokens:        mocks.NewMockPersonalAccessTokenRepositoryInterface(ctrl),
+		recoveryCodes: mocks.NewMockRecoveryCodeRepositoryInterface(ctrl),
+		passkeys:      mocks.NewMockPasskeyRepositoryInterface(ctrl),
+		challenges:    mocks.NewMockPasskeyChallengeRepositoryInterface(ctrl),
+		sessions:      mocks.NewMockSessionUseCaseInterface(ctrl),
+		twoFactor:     mocks.NewMockTwoFactorUseCaseInterface(ctrl),
+		provider: &fakeProvider{identity: &oidc.Identity{
+			Subject:       "user-123",
+			Email:         "gandalf@lor.com.br",
+			EmailVerified: true,
+			Name:          "Gandalf",
+		}},
+	}
+
+	uc := usecase.NewOIDCUseCase(
+		m.accounts,
+		m.identities,
+		m.loginRequests,
+		m.tokens,
+		m.recoveryCodes,
+		m.passkeys,
+		m.challenges,
+		m.sessions,
+		m.twoFactor,
+		oidc.Providers{"keycloak": m.provider},
+		testEmails,
+		config.OIDCConfig{LoginTTL: 10 * time.Minute},
+	)
+
+	return m, uc
+}
+
+// authorize starts a login and makes the repository mock hand it back on
+// Consume, as the callback would find it.
+func authorize(t *testing.T, m *oidcMocks, uc *usecase.OIDCUseCase) string {
+	var stored entity.OIDCLoginRequestEntity
+
+	m.loginRequests.EXPECT().DeleteExpired().Return(nil)
+	m.loginRequests.EXPECT().Create(gomock.Any()).DoAndReturn(func(request *entity.OIDCLoginRequestEntity) error {
+		stored = *request
+		return nil
+	})
+
+	authorization, err := uc.Authorize("keycloak")
+	assert.NoError(t, err)
+
+	m.loginRequests.EXPECT().Consume(gomock.Any()).DoAndReturn(func(request *entity.OIDCLoginRequestEntity) error {
+		if request.StateHash != stored.StateHash {
+			return sql.ErrNoRows
+		}
+		*request = stored
+		return nil
+	})
+
+	return authorization.State
+}
+
+func TestOIDCUseCase_Authorize(t *testing.T) {
+	t.Run("should store the login under the hash of its state", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+
+		m.loginRequests.EXPECT().DeleteExpired().Return(nil)
+		m.loginRequests.EXPECT().Create(gomock.Any()).DoAndReturn(func(request *entity.OIDCLoginRequestEntity) error {
+			assert.Equal(t, "keycloak", request.Provider)
+			assert.Equal(t, m.provider.nonce, request.Nonce)
+			assert.Equal(t, m.provider.verifier, request.CodeVerifier)
+			assert.WithinDuration(t, time.Now().Add(10*time.Minute), request.ExpiresAt, time.Minute)
+			return nil
+		})
+
+		authorization, err := uc.Authorize("keycloak")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://idp.example.com/authorize?state="+authorization.State, authorization.URL)
+	})
+
+	t.Run("should reject an unknown provider", func(t *testing.T) {
+		_, uc := setupOIDC(t)
+
+		_, err := uc.Authorize("unknown")
+
+		assert.ErrorIs(t, err, usecase.ErrUnknownOIDCProvider)
+	})
+}
+
+func TestOIDCUseCase_SignIn(t *testing.T) {
+	client := entity.ClientEntity{IP: "10.0.0.1"}
+	expectedTokens := &entity.AuthTokensEntity{AccessToken: "access-token"}
+
+	t.Run("should sign in the account linked to the identity", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		state := authorize(t, m, uc)
+		accountID := uuid.New()
+
+		m.identities.EXPECT().FindBySubject(gomock.Any()).DoAndReturn(func(identity *entity.AccountIdentityEntity) error {
+			assert.Equal(t, "keycloak", identity.Provider)
+			assert.Equal(t, "user-123", identity.Subject)
+			identity.AccountID = accountID
+			return nil
+		})
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(entity.AccountEntity{ID: accountID, Email: "gandalf@lor.com.br"}))
+		m.sessions.EXPECT().Issue(gomock.Any(), client).Return(expectedTokens, nil)
+
+		account := &entity.AccountEntity{}
+		result, err := uc.SignIn(account, "keycloak", state, "valid-code", client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTokens, result.Tokens)
+		assert.Equal(t, accountID, account.ID)
+	})
+
+	t.Run("should link a verified account holding the email", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		state := authorize(t, m, uc)
+		verifiedAt := time.Now()
+		stored := entity.AccountEntity{ID: uuid.New(), Email: "gandalf@lor.com.br", Password: "hash", EmailVerifiedAt: &verifiedAt}
+
+		m.identities.EXPECT().FindBySubject(gomock.Any()).Return(sql.ErrNoRows)
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).DoAndReturn(findAccount(stored))
+		m.identities.EXPECT().Create(gomock.Any()).DoAndReturn(func(identity *entity.AccountIdentityEntity) error {
+			assert.Equal(t, stored.ID, identity.AccountID)
+			assert.Equal(t, "user-123", identity.Subject)
+			assert.Equal(t, "gandalf@lor.com.br", identity.Email)
+			return nil
+		})
+		m.sessions.EXPECT().Issue(gomock.Any(), client).Return(expectedTokens, nil)
+
+		account := &entity.AccountEntity{}
+		_, err := uc.SignIn(account, "keycloak", state, "valid-code", client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "hash", account.Password)
+	})
+
+	t.Run("should drop the password and sessions of an unverified account before linking it", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		state := authorize(t, m, uc)
+		stored := entity.AccountEntity{ID: uuid.New(), Email: "gandalf@lor.com.br", Password: "hash"}
+
+		m.identities.EXPECT().FindBySubject(gomock.Any()).Return(sql.ErrNoRows)
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).DoAndReturn(findAccount(stored))
+		m.accounts.EXPECT().UpdatePassword(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Empty(t, acc.Password)
+			return nil
+		})
+		m.sessions.EXPECT().RevokeAll(gomock.Any()).Return(nil)
+		m.tokens.EXPECT().RevokeAllByAccount(stored.ID).Return(nil)
+		m.accounts.EXPECT().DisableTwoFactor(gomock.Any()).Return(nil)
+		m.recoveryCodes.EXPECT().DeleteAllByAccount(stored.ID).Return(nil)
+		m.passkeys.EXPECT().DeleteAllByAccount(stored.ID).Return(nil)
+		m.challenges.EXPECT().DeleteAllByAccount(stored.ID).Return(nil)
+		m.accounts.EXPECT().VerifyEmail(gomock.Any()).Return(true, nil)
+		m.identities.EXPECT().Create(gomock.Any()).Return(nil)
+		m.sessions.EXPECT().Issue(gomock.Any(), client).Return(expectedTokens, nil)
+
+		account := &entity.AccountEntity{}
+		_, err := uc.SignIn(account, "keycloak", state, "valid-code", client)
+
+		assert.NoError(t, err)
+		assert.True(t, account.IsEmailVerified())
+	})
+
+	t.Run("should revoke the tokens, second factor and passkeys of an unverified account before linking it", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		state := authorize(t, m, uc)
+		enabledAt := time.Now().UTC()
+		stored := entity.AccountEntity{
+			ID:                 uuid.New(),
+			Email:              "gandalf@lor.com.br",
+			Password:           "hash",
+			TOTPSecret:         "JBSWY3DPEHPK3PXP",
+			TwoFactorEnabledAt: &enabledAt,
+		}
+
+		m.identities.EXPECT().FindBySubject(gomock.Any()).Return(sql.ErrNoRows)
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).DoAndReturn(findAccount(stored))
+		m.accounts.EXPECT().UpdatePassword(gomock.Any()).Return(nil)
+		m.sessions.EXPECT().RevokeAll(gomock.Any()).Return(nil)
+		m.tokens.EXPECT().RevokeAllByAccount(stored.ID).Return(nil)
+		m.accounts.EXPECT().DisableTwoFactor(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Equal(t, stored.ID, acc.ID)
+			acc.TOTPSecret = ""
+			acc.TwoFactorEnabledAt = nil
+			return nil
+		})
+		m.recoveryCodes.EXPECT().DeleteAllByAccount(stored.ID).Return(nil)
+		m.passkeys.EXPECT().DeleteAllByAccount(stored.ID).Return(nil)
+		m.challenges.EXPECT().DeleteAllByAccount(stored.ID).Return(nil)
+		m.accounts.EXPECT().VerifyEmail(gomock.Any()).Return(true, nil)
+		m.identities.EXPECT().Create(gomock.Any()).Return(nil)
+		m.sessions.EXPECT().Issue(gomock.Any(), client).Return(expectedTokens, nil)
+
+		account := &entity.AccountEntity{}
+		result, err := uc.SignIn(account, "keycloak", state, "valid-code", client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTokens, result.Tokens)
+		assert.Empty(t, account.TOTPSecret)
+		assert.False(t, account.IsTwoFactorEnabled())
+	})
+
+	t.Run("should create a verified account on the first login", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		state := authorize(t, m, uc)
+		accountID := uuid.New()
+
+		m.identities.EXPECT().FindBySubject(gomock.Any()).Return(sql.ErrNoRows)
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).Return(sql.ErrNoRows)
+		m.accounts.EXPECT().Register(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Equal(t, "Gandalf", acc.Name)
+			assert.Equal(t, "gandalf@lor.com.br", acc.Email)
+			assert.Empty(t, acc.Password)
+			acc.ID = accountID
+			return nil
+		})
+		m.accounts.EXPECT().VerifyEmail(gomock.Any()).Return(true, nil)
+		m.identities.EXPECT().Create(gomock.Any()).DoAndReturn(func(identity *entity.AccountIdentityEntity) error {
+			assert.Equal(t, accountID, identity.AccountID)
+			return nil
+		})
+		m.sessions.EXPECT().Issue(gomock.Any(), client).Return(expectedTokens, nil)
+
+		account := &entity.AccountEntity{}
+		result, err := uc.SignIn(account, "keycloak", state, "valid-code", client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTokens, result.Tokens)
+		assert.True(t, account.IsEmailVerified())
+	})
+
+	t.Run("should refuse to link an email the provider has not verified", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		m.provider.identity = &oidc.Identity{Subject: "user-123", Email: "gandalf@lor.com.br"}
+		state := authorize(t, m, uc)
+
+		m.identities.EXPECT().FindBySubject(gomock.Any()).Return(sql.ErrNoRows)
+
+		_, err := uc.SignIn(&entity.AccountEntity{}, "keycloak", state, "valid-code", client)
+
+		assert.ErrorIs(t, err, usecase.ErrOIDCEmailNotVerified)
+	})
+
+	t.Run("should return a challenge for accounts with two-factor authentication", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		state := authorize(t, m, uc)
+		enabledAt := time.Now()
+		challenge := &entity.TwoFactorChallengeEntity{Token: "challenge"}
+
+		m.identities.EXPECT().FindBySubject(gomock.Any()).Return(nil)
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(entity.AccountEntity{ID: uuid.New(), TwoFactorEnabledAt: &enabledAt}))
+		m.twoFactor.EXPECT().Challenge(gomock.Any()).Return(challenge, nil)
+
+		result, err := uc.SignIn(&entity.AccountEntity{}, "keycloak", state, "valid-code", client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, challenge, result.Challenge)
+		assert.Nil(t, result.Tokens)
+	})
+
+	t.Run("should reject an unknown state", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		authorize(t, m, uc)
+
+		_, err := uc.SignIn(&entity.AccountEntity{}, "keycloak", "forged-state", "valid-code", client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidOIDCLogin)
+	})
+
+	t.Run("should reject a state issued for another provider", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+
+		m.loginRequests.EXPECT().Consume(gomock.Any()).DoAndReturn(func(request *entity.OIDCLoginRequestEntity) error {
+			request.Provider = "google"
+			request.ExpiresAt = time.Now().Add(time.Minute)
+			return nil
+		})
+
+		_, err := uc.SignIn(&entity.AccountEntity{}, "keycloak", "state", "valid-code", client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidOIDCLogin)
+	})
+
+	t.Run("should reject an expired login", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+
+		m.loginRequests.EXPECT().Consume(gomock.Any()).DoAndReturn(func(request *entity.OIDCLoginRequestEntity) error {
+			assert.Equal(t, utils.HashToken("state"), request.StateHash)
+			request.Provider = "keycloak"
+			request.ExpiresAt = time.Now().Add(-time.Minute)
+			return nil
+		})
+
+		_, err := uc.SignIn(&entity.AccountEntity{}, "keycloak", "state", "valid-code", client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidOIDCLogin)
+	})
+
+	t.Run("should reject a code the provider refuses", func(t *testing.T) {
+		m, uc := setupOIDC(t)
+		state := authorize(t, m, uc)
+
+		_, err := uc.SignIn(&entity.AccountEntity{}, "keycloak", state, "invalid-code", client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidOIDCLogin)
+	})
+}
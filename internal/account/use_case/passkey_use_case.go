@@ -0,0 +1,375 @@
+package usecase
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/config"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidPasskeyChallenge = errors.New("invalid or expired passkey challenge")
+	ErrInvalidPasskey          = errors.New("invalid passkey")
+)
+
+//go:generate mockgen -source=passkey_use_case.go -destination=../mocks/passkey_use_case_mock.go -package=mocks
+type PasskeyUseCaseInterface interface {
+	BeginRegistration(account *entity.AccountEntity) (*entity.PasskeyOptionsEntity, error)
+	FinishRegistration(passkey *entity.PasskeyEntity, challengeID uuid.UUID, response []byte) error
+	BeginSignIn() (*entity.PasskeyOptionsEntity, error)
+	FinishSignIn(account *entity.AccountEntity, challengeID uuid.UUID, response []byte, client entity.ClientEntity) (*entity.SignInEntity, error)
+	List(account *entity.AccountEntity) ([]entity.PasskeyEntity, error)
+	Delete(passkey *entity.PasskeyEntity) error
+}
+
+type PasskeyUseCase struct {
+	accountRepo   repository.AccountRepositoryInterface
+	passkeyRepo   repository.PasskeyRepositoryInterface
+	challengeRepo repository.PasskeyChallengeRepositoryInterface
+	sessions      SessionUseCaseInterface
+	relyingParty  *webauthn.WebAuthn
+	challengeTTL  time.Duration
+}
+
+func NewPasskeyUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	passkeyRepo repository.PasskeyRepositoryInterface,
+	challengeRepo repository.PasskeyChallengeRepositoryInterface,
+	sessions SessionUseCaseInterface,
+	relyingParty *webauthn.WebAuthn,
+	webAuthnConfig config.WebAuthnConfig,
+) *PasskeyUseCase {
+	return &PasskeyUseCase{
+		accountRepo:   accountRepo,
+		passkeyRepo:   passkeyRepo,
+		challengeRepo: challengeRepo,
+		sessions:      sessions,
+		relyingParty:  relyingParty,
+		challengeTTL:  webAuthnConfig.ChallengeTTL,
+	}
+}
+
+// BeginRegistration returns the options to create a passkey for the account.
+// Passkeys must be discoverable, so that sign-in needs no email, and verify
+// the user, so that they stand in for the password. Only verified accounts
+// may register one, as an unverified account may still be claimed by the
+// owner of its email.
+func (uc *PasskeyUseCase) BeginRegistration(account *entity.AccountEntity) (*entity.PasskeyOptionsEntity, error) {
+	user, err := uc.loadUser(account)
+	if err != nil {
+		return nil, err
+	}
+
+	if !account.IsEmailVerified() {
+		return nil, ErrEmailNotVerified
+	}
+
+	exclusions := make([]protocol.CredentialDescriptor, 0, len(user.passkeys))
+	for _, credential := range user.WebAuthnCredentials() {
+		exclusions = append(exclusions, credential.Descriptor())
+	}
+
+	options, session, err := uc.relyingParty.BeginRegistration(user,
+		webauthn.WithExclusions(exclusions),
+		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			UserVerification:   protocol.VerificationRequired,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.createChallenge(&account.ID, entity.PasskeyPurposeRegistration, options, session)
+}
+
+// FinishRegistration checks the answer of the authenticator to the
+// registration challenge of passkey.AccountID and saves the passkey.
+func (uc *PasskeyUseCase) FinishRegistration(passkey *entity.PasskeyEntity, challengeID uuid.UUID, response []byte) error {
+	session, err := uc.consumeChallenge(challengeID, entity.PasskeyPurposeRegistration, &passkey.AccountID)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(response))
+	if err != nil {
+		return ErrInvalidPasskey
+	}
+
+	user, err := uc.loadUser(&entity.AccountEntity{ID: passkey.AccountID})
+	if err != nil {
+		return err
+	}
+
+	if !user.account.IsEmailVerified() {
+		return ErrEmailNotVerified
+	}
+
+	credential, err := uc.relyingParty.CreateCredential(user, *session, parsed)
+	if err != nil {
+		log.Printf("Registro de passkey recusado para a conta %s: %v", passkey.AccountID, err)
+		return ErrInvalidPasskey
+	}
+
+	transports := make([]string, 0, len(credential.Transport))
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	passkey.CredentialID = credential.ID
+	passkey.PublicKey = credential.PublicKey
+	passkey.AttestationType = credential.AttestationType
+	passkey.AAGUID = credential.Authenticator.AAGUID
+	passkey.SignCount = credential.Authenticator.SignCount
+	passkey.Transports = transports
+	passkey.BackupEligible = credential.Flags.BackupEligible
+	passkey.BackupState = credential.Flags.BackupState
+
+	return uc.passkeyRepo.Create(passkey)
+}
+
+// BeginSignIn returns the options to sign in with any passkey of the relying
+// party. The account is found from the answer of the authenticator.
+func (uc *PasskeyUseCase) BeginSignIn() (*entity.PasskeyOptionsEntity, error) {
+	options, session, err := uc.relyingParty.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.createChallenge(nil, entity.PasskeyPurposeSignIn, options, session)
+}
+
+// FinishSignIn checks the answer of the authenticator to the sign-in
+// challenge and, on success, fills account with the owner of the passkey and
+// starts a session on the client's device, like the password sign-in. A
+// passkey verifies the user on its own, so no second factor is asked for.
+func (uc *PasskeyUseCase) FinishSignIn(account *entity.AccountEntity, challengeID uuid.UUID, response []byte, client entity.ClientEntity) (*entity.SignInEntity, error) {
+	session, err := uc.consumeChallenge(challengeID, entity.PasskeyPurposeSignIn, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(response))
+	if err != nil {
+		return nil, ErrInvalidPasskey
+	}
+
+	var (
+		user      *passkeyUser
+		lookupErr error
+	)
+
+	findUser := func(_, userHandle []byte) (webauthn.User, error) {
+		accountID, err := uuid.FromBytes(userHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		user, lookupErr = uc.loadUser(&entity.AccountEntity{ID: accountID})
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+
+		return user, nil
+	}
+
+	credential, err := uc.relyingParty.ValidateDiscoverableLogin(findUser, *session, parsed)
+	if err != nil {
+		if lookupErr != nil && !errors.Is(lookupErr, sql.ErrNoRows) {
+			return nil, lookupErr
+		}
+		log.Printf("Login com passkey recusado: %v", err)
+		return nil, ErrInvalidPasskey
+	}
+
+	// A counter that did not move forward means the credential may have been
+	// copied out of the authenticator.
+	if credential.Authenticator.CloneWarning {
+		log.Printf("Login com passkey recusado para a conta %s: contador de assinaturas não avançou", user.account.ID)
+		return nil, ErrInvalidPasskey
+	}
+
+	passkey := user.passkey(credential.ID)
+	passkey.SignCount = credential.Authenticator.SignCount
+	passkey.BackupState = credential.Flags.BackupState
+
+	if err := uc.passkeyRepo.UpdateUsage(passkey); err != nil {
+		return nil, err
+	}
+
+	*account = *user.account
+
+	tokens, err := uc.sessions.Issue(account, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.SignInEntity{Tokens: tokens}, nil
+}
+
+func (uc *PasskeyUseCase) List(account *entity.AccountEntity) ([]entity.PasskeyEntity, error) {
+	return uc.passkeyRepo.ListByAccount(account.ID)
+}
+
+// Delete removes the passkey of passkey.AccountID, returning sql.ErrNoRows
+// when the account has no such passkey.
+func (uc *PasskeyUseCase) Delete(passkey *entity.PasskeyEntity) error {
+	deleted, err := uc.passkeyRepo.Delete(passkey)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// loadUser fills account and returns it along with its passkeys. Deleted
+// accounts are not found.
+func (uc *PasskeyUseCase) loadUser(account *entity.AccountEntity) (*passkeyUser, error) {
+	if err := uc.accountRepo.Find(account); err != nil {
+		return nil, err
+	}
+
+	passkeys, err := uc.passkeyRepo.ListByAccount(account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passkeyUser{account: account, passkeys: passkeys}, nil
+}
+
+func (uc *PasskeyUseCase) createChallenge(accountID *uuid.UUID, purpose string, options any, session *webauthn.SessionData) (*entity.PasskeyOptionsEntity, error) {
+	sessionData, err := json.Marshal(session)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.challengeRepo.DeleteExpired(); err != nil {
+		log.Printf("Erro ao remover desafios de passkey expirados: %v", err)
+	}
+
+	challenge := &entity.PasskeyChallengeEntity{
+		AccountID:   accountID,
+		Purpose:     purpose,
+		SessionData: sessionData,
+		ExpiresAt:   time.Now().UTC().Add(uc.challengeTTL),
+	}
+
+	if err := uc.challengeRepo.Create(challenge); err != nil {
+		return nil, err
+	}
+
+	return &entity.PasskeyOptionsEntity{
+		ChallengeID: challenge.ID,
+		Options:     options,
+		ExpiresAt:   challenge.ExpiresAt,
+	}, nil
+}
+
+// consumeChallenge uses up the challenge, which must have been issued for
+// purpose to accountID, or to no account when accountID is nil.
+func (uc *PasskeyUseCase) consumeChallenge(challengeID uuid.UUID, purpose string, accountID *uuid.UUID) (*webauthn.SessionData, error) {
+	challenge := &entity.PasskeyChallengeEntity{ID: challengeID, Purpose: purpose}
+
+	if err := uc.challengeRepo.Consume(challenge); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidPasskeyChallenge
+		}
+		return nil, err
+	}
+
+	if !time.Now().UTC().Before(challenge.ExpiresAt) {
+		return nil, ErrInvalidPasskeyChallenge
+	}
+
+	if (accountID == nil) != (challenge.AccountID == nil) || (accountID != nil && *accountID != *challenge.AccountID) {
+		return nil, ErrInvalidPasskeyChallenge
+	}
+
+	session := &webauthn.SessionData{}
+	if err := json.Unmarshal(challenge.SessionData, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// passkeyUser presents an account and its passkeys to the WebAuthn library.
+// The user handle stored by authenticators is the account ID.
+type passkeyUser struct {
+	account  *entity.AccountEntity
+	passkeys []entity.PasskeyEntity
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	id := u.account.ID
+	return id[:]
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.account.Email
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.account.Name
+}
+
+func (u *passkeyUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(u.passkeys))
+
+	for _, passkey := range u.passkeys {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(passkey.Transports))
+		for _, transport := range passkey.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+
+		credentials = append(credentials, webauthn.Credential{
+			ID:              passkey.CredentialID,
+			PublicKey:       passkey.PublicKey,
+			AttestationType: passkey.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				UserPresent:    true,
+				UserVerified:   true,
+				BackupEligible: passkey.BackupEligible,
+				BackupState:    passkey.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    passkey.AAGUID,
+				SignCount: passkey.SignCount,
+			},
+		})
+	}
+
+	return credentials
+}
+
+// passkey returns the passkey of the credential, which the library has
+// already matched against the passkeys of the user.
+func (u *passkeyUser) passkey(credentialID []byte) *entity.PasskeyEntity {
+	for i := range u.passkeys {
+		if bytes.Equal(u.passkeys[i].CredentialID, credentialID) {
+			return &u.passkeys[i]
+		}
+	}
+	return nil
+}
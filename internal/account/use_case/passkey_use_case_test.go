@@ -0,0 +1,454 @@
+package usecase_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	passkeyRPID   = "localhost"
+	passkeyOrigin = "http://localhost:3000"
+)
+
+// Flags of the authenticator data.
+const (
+	flagUserPresent    = 0x01
+	flagUserVerified   = 0x04
+	flagBackupEligible = 0x08
+	flagBackupState    = 0x10
+	flagAttestedData   = 0x40
+)
+
+// softAuthenticator is a passkey authenticator holding a single ES256 key,
+// answering ceremonies the way a browser would serialize them.
+type softAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+	origin       string
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	credentialID := make([]byte, 16)
+	_, err = rand.Read(credentialID)
+	require.NoError(t, err)
+
+	return &softAuthenticator{key: key, credentialID: credentialID, origin: passkeyOrigin}
+}
+
+func (a *softAuthenticator) clientData(ceremony string, challenge protocol.URLEncodedBase64) []byte {
+	clientData, _ := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": challenge.String(),
+		"origin":    a.origin,
+	})
+	return clientData
+}
+
+func (a *softAuthenticator) authData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(passkeyRPID))
+
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags|flagUserPresent|flagUserVerified|flagBackupEligible)
+	return binary.BigEndian.AppendUint32(data, a.signCount)
+}
+
+// create answers the registration options with a "none" attestation.
+func (a *softAuthenticator) create(t *testing.T, options any) []byte {
+	creation, ok := options.(*protocol.CredentialCreation)
+	require.True(t, ok)
+
+	a.userHandle = creation.Response.User.ID.(protocol.URLEncodedBase64)
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1,
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	authData := a.authData(flagAttestedData)
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, publicKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	require.NoError(t, err)
+
+	return a.respond(t, map[string]any{
+		"clientDataJSON":    a.encode(a.clientData("webauthn.create", creation.Response.Challenge)),
+		"attestationObject": a.encode(attestation),
+		"transports":        []string{"internal", "hybrid"},
+	})
+}
+
+// get answers the sign-in options, counting one more signature.
+func (a *softAuthenticator) get(t *testing.T, options any) []byte {
+	assertion, ok := options.(*protocol.CredentialAssertion)
+	require.True(t, ok)
+
+	a.signCount++
+
+	clientData := a.clientData("webauthn.get", assertion.Response.Challenge)
+	authData := a.authData(flagBackupState)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return a.respond(t, map[string]any{
+		"clientDataJSON":    a.encode(clientData),
+		"authenticatorData": a.encode(authData),
+		"signature":         a.encode(signature),
+		"userHandle":        a.encode(a.userHandle),
+	})
+}
+
+func (a *softAuthenticator) respond(t *testing.T, response map[string]any) []byte {
+	body, err := json.Marshal(map[string]any{
+		"id":       a.encode(a.credentialID),
+		"rawId":    a.encode(a.credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	require.NoError(t, err)
+	return body
+}
+
+func (a *softAuthenticator) encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+type passkeyMocks struct {
+	accounts   *mocks.MockAccountRepositoryInterface
+	passkeys   *mocks.MockPasskeyRepositoryInterface
+	challenges *mocks.MockPasskeyChallengeRepositoryInterface
+	sessions   *mocks.MockSessionUseCaseInterface
+}
+
+func setupPasskey(t *testing.T) (*passkeyMocks, *usecase.PasskeyUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &passkeyMocks{
+		accounts:   mocks.NewMockAccountRepositoryInterface(ctrl),
+		passkeys:   mocks.NewMockPasskeyRepositoryInterface(ctrl),
+		challenges: mocks.NewMockPasskeyChallengeRepositoryInterface(ctrl),
+		sessions:   mocks.NewMockSessionUseCaseInterface(ctrl),
+	}
+
+	relyingParty, err := webauthn.New(&webauthn.Config{
+		RPID:          passkeyRPID,
+		RPDisplayName: "Trilha",
+		RPOrigins:     []string{passkeyOrigin},
+	})
+	require.NoError(t, err)
+
+	uc := usecase.NewPasskeyUseCase(
+		m.accounts,
+		m.passkeys,
+		m.challenges,
+		m.sessions,
+		relyingParty,
+		config.WebAuthnConfig{ChallengeTTL: 5 * time.Minute},
+	)
+
+	return m, uc
+}
+
+// storeChallenge makes the repository mock keep the next challenge created
+// and hand it back once on Consume.
+func storeChallenge(m *passkeyMocks) {
+	var stored entity.PasskeyChallengeEntity
+
+	m.challenges.EXPECT().DeleteExpired().Return(nil)
+	m.challenges.EXPECT().Create(gomock.Any()).DoAndReturn(func(challenge *entity.PasskeyChallengeEntity) error {
+		challenge.ID = uuid.New()
+		stored = *challenge
+		return nil
+	})
+	m.challenges.EXPECT().Consume(gomock.Any()).DoAndReturn(func(challenge *entity.PasskeyChallengeEntity) error {
+		if challenge.ID != stored.ID || challenge.Purpose != stored.Purpose {
+			return sql.ErrNoRows
+		}
+		*challenge = stored
+		return nil
+	}).MaxTimes(1)
+}
+
+// registerPasskey runs the registration ceremony of account with
+// authenticator and returns the saved passkey.
+func registerPasskey(t *testing.T, m *passkeyMocks, uc *usecase.PasskeyUseCase, account entity.AccountEntity, authenticator *softAuthenticator) entity.PasskeyEntity {
+	storeChallenge(m)
+	m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account)).Times(2)
+	m.passkeys.EXPECT().ListByAccount(account.ID).Return(nil, nil).Times(2)
+
+	var saved entity.PasskeyEntity
+	m.passkeys.EXPECT().Create(gomock.Any()).DoAndReturn(func(passkey *entity.PasskeyEntity) error {
+		passkey.ID = uuid.New()
+		saved = *passkey
+		return nil
+	})
+
+	options, err := uc.BeginRegistration(&entity.AccountEntity{ID: account.ID})
+	require.NoError(t, err)
+
+	passkey := &entity.PasskeyEntity{AccountID: account.ID, Name: "Laptop"}
+	err = uc.FinishRegistration(passkey, options.ChallengeID, authenticator.create(t, options.Options))
+	require.NoError(t, err)
+
+	return saved
+}
+
+func TestPasskeyUseCase_Registration(t *testing.T) {
+	verifiedAt := time.Now().UTC()
+	account := entity.AccountEntity{ID: uuid.New(), Name: "Gandalf", Email: "gandalf@lor.com.br", EmailVerifiedAt: &verifiedAt}
+
+	t.Run("should save the passkey created by the authenticator", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		authenticator := newSoftAuthenticator(t)
+
+		saved := registerPasskey(t, m, uc, account, authenticator)
+
+		assert.Equal(t, account.ID, saved.AccountID)
+		assert.Equal(t, "Laptop", saved.Name)
+		assert.Equal(t, authenticator.credentialID, saved.CredentialID)
+		assert.NotEmpty(t, saved.PublicKey)
+		assert.Equal(t, []string{"internal", "hybrid"}, saved.Transports)
+		assert.True(t, saved.BackupEligible)
+		assert.Equal(t, account.ID[:], authenticator.userHandle)
+	})
+
+	t.Run("should require resident keys and user verification", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+
+		m.challenges.EXPECT().DeleteExpired().Return(nil)
+		m.challenges.EXPECT().Create(gomock.Any()).DoAndReturn(func(challenge *entity.PasskeyChallengeEntity) error {
+			assert.Equal(t, &account.ID, challenge.AccountID)
+			assert.Equal(t, entity.PasskeyPurposeRegistration, challenge.Purpose)
+			assert.WithinDuration(t, time.Now().Add(5*time.Minute), challenge.ExpiresAt, time.Minute)
+			return nil
+		})
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+		m.passkeys.EXPECT().ListByAccount(account.ID).Return([]entity.PasskeyEntity{{CredentialID: []byte("existing")}}, nil)
+
+		options, err := uc.BeginRegistration(&entity.AccountEntity{ID: account.ID})
+
+		require.NoError(t, err)
+		selection := options.Options.(*protocol.CredentialCreation).Response.AuthenticatorSelection
+		assert.Equal(t, protocol.ResidentKeyRequirementRequired, selection.ResidentKey)
+		assert.Equal(t, protocol.VerificationRequired, selection.UserVerification)
+
+		exclusions := options.Options.(*protocol.CredentialCreation).Response.CredentialExcludeList
+		assert.Len(t, exclusions, 1)
+		assert.Equal(t, protocol.URLEncodedBase64("existing"), exclusions[0].CredentialID)
+	})
+
+	t.Run("should refuse an account whose email is not verified", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		unverified := account
+		unverified.EmailVerifiedAt = nil
+
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(unverified))
+		m.passkeys.EXPECT().ListByAccount(account.ID).Return(nil, nil)
+
+		_, err := uc.BeginRegistration(&entity.AccountEntity{ID: account.ID})
+
+		assert.ErrorIs(t, err, usecase.ErrEmailNotVerified)
+	})
+
+	t.Run("should reject a challenge issued to another account", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		storeChallenge(m)
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+		m.passkeys.EXPECT().ListByAccount(account.ID).Return(nil, nil)
+
+		options, err := uc.BeginRegistration(&entity.AccountEntity{ID: account.ID})
+		require.NoError(t, err)
+
+		passkey := &entity.PasskeyEntity{AccountID: uuid.New(), Name: "Laptop"}
+		err = uc.FinishRegistration(passkey, options.ChallengeID, newSoftAuthenticator(t).create(t, options.Options))
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasskeyChallenge)
+	})
+
+	t.Run("should reject an answer from another origin", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		storeChallenge(m)
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account)).Times(2)
+		m.passkeys.EXPECT().ListByAccount(account.ID).Return(nil, nil).Times(2)
+
+		options, err := uc.BeginRegistration(&entity.AccountEntity{ID: account.ID})
+		require.NoError(t, err)
+
+		authenticator := newSoftAuthenticator(t)
+		authenticator.origin = "https://evil.example.com"
+
+		passkey := &entity.PasskeyEntity{AccountID: account.ID, Name: "Laptop"}
+		err = uc.FinishRegistration(passkey, options.ChallengeID, authenticator.create(t, options.Options))
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasskey)
+	})
+
+	t.Run("should reject an unknown challenge", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		m.challenges.EXPECT().Consume(gomock.Any()).Return(sql.ErrNoRows)
+
+		err := uc.FinishRegistration(&entity.PasskeyEntity{AccountID: account.ID}, uuid.New(), []byte("{}"))
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasskeyChallenge)
+	})
+}
+
+func TestPasskeyUseCase_SignIn(t *testing.T) {
+	verifiedAt := time.Now().UTC()
+	account := entity.AccountEntity{ID: uuid.New(), Name: "Gandalf", Email: "gandalf@lor.com.br", EmailVerifiedAt: &verifiedAt}
+	client := entity.ClientEntity{IP: "10.0.0.1"}
+	expectedTokens := &entity.AuthTokensEntity{AccessToken: "access-token"}
+
+	t.Run("should issue tokens for the owner of the passkey", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		authenticator := newSoftAuthenticator(t)
+		saved := registerPasskey(t, m, uc, account, authenticator)
+
+		storeChallenge(m)
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+		m.passkeys.EXPECT().ListByAccount(account.ID).Return([]entity.PasskeyEntity{saved}, nil)
+		m.passkeys.EXPECT().UpdateUsage(gomock.Any()).DoAndReturn(func(passkey *entity.PasskeyEntity) error {
+			assert.Equal(t, saved.ID, passkey.ID)
+			assert.Equal(t, uint32(1), passkey.SignCount)
+			assert.True(t, passkey.BackupState)
+			return nil
+		})
+		m.sessions.EXPECT().Issue(gomock.Any(), client).Return(expectedTokens, nil)
+
+		options, err := uc.BeginSignIn()
+		require.NoError(t, err)
+
+		signedIn := &entity.AccountEntity{}
+		result, err := uc.FinishSignIn(signedIn, options.ChallengeID, authenticator.get(t, options.Options), client)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTokens, result.Tokens)
+		assert.Equal(t, account.ID, signedIn.ID)
+	})
+
+	t.Run("should reject a signature from another key", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		authenticator := newSoftAuthenticator(t)
+		saved := registerPasskey(t, m, uc, account, authenticator)
+
+		storeChallenge(m)
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+		m.passkeys.EXPECT().ListByAccount(account.ID).Return([]entity.PasskeyEntity{saved}, nil)
+
+		options, err := uc.BeginSignIn()
+		require.NoError(t, err)
+
+		authenticator.key, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+		_, err = uc.FinishSignIn(&entity.AccountEntity{}, options.ChallengeID, authenticator.get(t, options.Options), client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasskey)
+	})
+
+	t.Run("should reject a counter that did not move forward", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		authenticator := newSoftAuthenticator(t)
+		saved := registerPasskey(t, m, uc, account, authenticator)
+		saved.SignCount = 5
+
+		storeChallenge(m)
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+		m.passkeys.EXPECT().ListByAccount(account.ID).Return([]entity.PasskeyEntity{saved}, nil)
+
+		options, err := uc.BeginSignIn()
+		require.NoError(t, err)
+
+		_, err = uc.FinishSignIn(&entity.AccountEntity{}, options.ChallengeID, authenticator.get(t, options.Options), client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasskey)
+	})
+
+	t.Run("should reject the passkey of a deleted account", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		authenticator := newSoftAuthenticator(t)
+		registerPasskey(t, m, uc, account, authenticator)
+
+		storeChallenge(m)
+		m.accounts.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		options, err := uc.BeginSignIn()
+		require.NoError(t, err)
+
+		_, err = uc.FinishSignIn(&entity.AccountEntity{}, options.ChallengeID, authenticator.get(t, options.Options), client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasskey)
+	})
+
+	t.Run("should not accept a registration challenge", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		m.challenges.EXPECT().Consume(gomock.Any()).DoAndReturn(func(challenge *entity.PasskeyChallengeEntity) error {
+			assert.Equal(t, entity.PasskeyPurposeSignIn, challenge.Purpose)
+			return sql.ErrNoRows
+		})
+
+		_, err := uc.FinishSignIn(&entity.AccountEntity{}, uuid.New(), []byte("{}"), client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasskeyChallenge)
+	})
+}
+
+func TestPasskeyUseCase_Delete(t *testing.T) {
+	t.Run("should delete the passkey of the account", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		passkey := &entity.PasskeyEntity{ID: uuid.New(), AccountID: uuid.New()}
+
+		m.passkeys.EXPECT().Delete(passkey).Return(true, nil)
+
+		assert.NoError(t, uc.Delete(passkey))
+	})
+
+	t.Run("should return sql.ErrNoRows for a passkey of another account", func(t *testing.T) {
+		m, uc := setupPasskey(t)
+		passkey := &entity.PasskeyEntity{ID: uuid.New(), AccountID: uuid.New()}
+
+		m.passkeys.EXPECT().Delete(passkey).Return(false, nil)
+
+		assert.ErrorIs(t, uc.Delete(passkey), sql.ErrNoRows)
+	})
+}
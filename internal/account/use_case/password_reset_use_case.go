@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/utils"
+)
+
+const passwordResetTokenSize = 32
+
+var ErrInvalidPasswordResetToken = errors.New("invalid password reset token")
+
+//go:generate mockgen -source=password_reset_use_case.go -destination=../mocks/password_reset_use_case_mock.go -package=mocks
+type PasswordResetUseCaseInterface interface {
+	ForgotPassword(email string) error
+	ResetPassword(token, newPassword string) error
+}
+
+type PasswordResetUseCase struct {
+	accountRepo    repository.AccountRepositoryInterface
+	resetTokenRepo repository.PasswordResetTokenRepositoryInterface
+	tokenRepo      repository.PersonalAccessTokenRepositoryInterface
+	sessions       SessionUseCaseInterface
+	mailer         mailer.Mailer
+	hasher         password.Hasher
+	policy         *password.Policy
+	emails         *EmailNormalizer
+	tokenTTL       time.Duration
+	appURL         string
+}
+
+func NewPasswordResetUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	resetTokenRepo repository.PasswordResetTokenRepositoryInterface,
+	tokenRepo repository.PersonalAccessTokenRepositoryInterface,
+	sessions SessionUseCaseInterface,
+	mail mailer.Mailer,
+	hasher password.Hasher,
+	policy *password.Policy,
+	emails *EmailNormalizer,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+) *PasswordResetUseCase {
+	return &PasswordResetUseCase{
+		accountRepo:    accountRepo,
+		resetTokenRepo: resetTokenRepo,
+		tokenRepo:      tokenRepo,
+		sessions:       sessions,
+		mailer:         mail,
+		hasher:         hasher,
+		policy:         policy,
+		emails:         emails,
+		tokenTTL:       authConfig.PasswordResetTokenTTL,
+		appURL:         mailConfig.AppURL,
+	}
+}
+
+// ForgotPassword emails a reset link to the account owning email. Unknown
+// emails are silently ignored so the endpoint cannot be used to find out
+// which addresses are registered.
+func (uc *PasswordResetUseCase) ForgotPassword(email string) error {
+	account := &entity.AccountEntity{Email: uc.emails.Normalize(email)}
+
+	if err := uc.accountRepo.FindByEmail(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if err := uc.resetTokenRepo.InvalidateAllByAccount(account.ID); err != nil {
+		return err
+	}
+
+	token, err := utils.GenerateRandomToken(passwordResetTokenSize)
+	if err != nil {
+		return err
+	}
+
+	resetToken := &entity.PasswordResetTokenEntity{
+		AccountID: account.ID,
+		Token:     token,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: time.Now().UTC().Add(uc.tokenTTL),
+	}
+
+	if err := uc.resetTokenRepo.Create(resetToken); err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", uc.appURL, url.QueryEscape(token))
+
+	return uc.mailer.Send(mailer.Message{
+		To:      account.Email,
+		Subject: "Redefinição de senha",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nRecebemos um pedido para redefinir a sua senha. Para continuar, acesse o link abaixo:\n\n%s\n\nO link expira em %s. Se você não fez este pedido, ignore este email.\n",
+			account.Name, link, uc.tokenTTL,
+		),
+	})
+}
+
+// ResetPassword consumes the token and replaces the password of its account.
+// Every session and personal access token of the account is revoked
+// afterwards. A new password breaking the password policy is rejected with a
+// *password.PolicyError before the token is consumed, so it can be retried
+// with another one.
+func (uc *PasswordResetUseCase) ResetPassword(token, newPassword string) error {
+	resetToken := &entity.PasswordResetTokenEntity{
+		Token:     token,
+		TokenHash: utils.HashToken(token),
+	}
+
+	if err := uc.resetTokenRepo.FindByHash(resetToken); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidPasswordResetToken
+		}
+		return err
+	}
+
+	if resetToken.UsedAt != nil || !time.Now().UTC().Before(resetToken.ExpiresAt) {
+		return ErrInvalidPasswordResetToken
+	}
+
+	account := &entity.AccountEntity{ID: resetToken.AccountID}
+
+	if err := uc.accountRepo.Find(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidPasswordResetToken
+		}
+		return err
+	}
+
+	if err := uc.policy.Check(newPassword, account.Name, account.Email); err != nil {
+		return err
+	}
+
+	used, err := uc.resetTokenRepo.MarkUsed(resetToken)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidPasswordResetToken
+	}
+
+	hashedPassword, err := uc.hasher.Hash(newPassword)
+	if err != nil {
+		return err
+	}
+
+	account.Password = hashedPassword
+
+	if err := uc.accountRepo.UpdatePassword(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidPasswordResetToken
+		}
+		return err
+	}
+
+	if err := uc.sessions.RevokeAll(account); err != nil {
+		return err
+	}
+
+	return uc.tokenRepo.RevokeAllByAccount(account.ID)
+}
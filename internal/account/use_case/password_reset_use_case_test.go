@@ -0,0 +1,193 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type passwordResetMocks struct {
+	accounts    *mocks.MockAccountRepositoryInterface
+	resetTokens *mocks.MockPasswordResetTokenRepositoryInterface
+	tokens      *mocks.MockPersonalAccessTokenRepositoryInterface
+	sessions    *mocks.MockSessionUseCaseInterface
+	mailer      *mailer.MemoryMailer
+}
+
+func setupPasswordReset(t *testing.T) (*passwordResetMocks, *usecase.PasswordResetUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &passwordResetMocks{
+		accounts:    mocks.NewMockAccountRepositoryInterface(ctrl),
+		resetTokens: mocks.NewMockPasswordResetTokenRepositoryInterface(ctrl),
+		tokens:      mocks.NewMockPersonalAccessTokenRepositoryInterface(ctrl),
+		sessions:    mocks.NewMockSessionUseCaseInterface(ctrl),
+		mailer:      mailer.NewMemoryMailer(),
+	}
+
+	uc := usecase.NewPasswordResetUseCase(
+		m.accounts,
+		m.resetTokens,
+		m.tokens,
+		m.sessions,
+		m.mailer,
+		testHasher,
+		testPolicy,
+		testEmails,
+		config.AuthConfig{PasswordResetTokenTTL: time.Hour},
+		config.MailConfig{AppURL: "http://trilha.test"},
+	)
+
+	return m, uc
+}
+
+func TestPasswordResetUseCase_ForgotPassword(t *testing.T) {
+	m, uc := setupPasswordReset(t)
+
+	t.Run("should store a hashed token and email the reset link", func(t *testing.T) {
+		accountID := uuid.New()
+		var storedHash string
+
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			acc.ID = accountID
+			acc.Name = "Gandalf"
+			return nil
+		})
+		m.resetTokens.EXPECT().InvalidateAllByAccount(accountID).Return(nil)
+		m.resetTokens.EXPECT().Create(gomock.Any()).DoAndReturn(func(token *entity.PasswordResetTokenEntity) error {
+			assert.Equal(t, accountID, token.AccountID)
+			assert.NotEqual(t, token.Token, token.TokenHash)
+			assert.True(t, token.ExpiresAt.After(time.Now().UTC()))
+			storedHash = token.TokenHash
+			return nil
+		})
+
+		err := uc.ForgotPassword("gandalf@lor.com.br")
+
+		assert.NoError(t, err)
+
+		msg, ok := m.mailer.Last()
+		assert.True(t, ok)
+		assert.Equal(t, "gandalf@lor.com.br", msg.To)
+
+		_, token, found := strings.Cut(msg.Body, "http://trilha.test/reset-password?token=")
+		assert.True(t, found)
+		token, _, _ = strings.Cut(token, "\n")
+		assert.Equal(t, storedHash, utils.HashToken(token))
+	})
+
+	t.Run("should silently ignore unknown emails", func(t *testing.T) {
+		sent := len(m.mailer.Messages())
+
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).Return(sql.ErrNoRows)
+
+		err := uc.ForgotPassword("unknown@lor.com.br")
+
+		assert.NoError(t, err)
+		assert.Len(t, m.mailer.Messages(), sent)
+	})
+}
+
+func TestPasswordResetUseCase_ResetPassword(t *testing.T) {
+	m, uc := setupPasswordReset(t)
+
+	accountID := uuid.New()
+
+	findAccount := func() {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Equal(t, accountID, acc.ID)
+			acc.Name = "Gandalf"
+			acc.Email = "gandalf@lor.com.br"
+			return nil
+		})
+	}
+
+	t.Run("should consume the token, update the password and revoke sessions and tokens", func(t *testing.T) {
+		m.resetTokens.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(token *entity.PasswordResetTokenEntity) error {
+			assert.Equal(t, utils.HashToken("reset-token"), token.TokenHash)
+			token.AccountID = accountID
+			token.ExpiresAt = time.Now().UTC().Add(time.Hour)
+			return nil
+		})
+		findAccount()
+		m.resetTokens.EXPECT().MarkUsed(gomock.Any()).Return(true, nil)
+		m.accounts.EXPECT().UpdatePassword(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Equal(t, accountID, acc.ID)
+			assert.NoError(t, testHasher.Compare(acc.Password, "new-password123"))
+			return nil
+		})
+		m.sessions.EXPECT().RevokeAll(gomock.Any()).Return(nil)
+		m.tokens.EXPECT().RevokeAllByAccount(accountID).Return(nil)
+
+		err := uc.ResetPassword("reset-token", "new-password123")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject a token that was already used", func(t *testing.T) {
+		usedAt := time.Now()
+
+		m.resetTokens.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(token *entity.PasswordResetTokenEntity) error {
+			token.AccountID = accountID
+			token.ExpiresAt = time.Now().UTC().Add(time.Hour)
+			token.UsedAt = &usedAt
+			return nil
+		})
+
+		err := uc.ResetPassword("reset-token", "new-password123")
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasswordResetToken)
+	})
+
+	t.Run("should reject an expired token", func(t *testing.T) {
+		m.resetTokens.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(token *entity.PasswordResetTokenEntity) error {
+			token.AccountID = accountID
+			token.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+			return nil
+		})
+
+		err := uc.ResetPassword("reset-token", "new-password123")
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasswordResetToken)
+	})
+
+	t.Run("should reject a token consumed concurrently", func(t *testing.T) {
+		m.resetTokens.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(token *entity.PasswordResetTokenEntity) error {
+			token.AccountID = accountID
+			token.ExpiresAt = time.Now().UTC().Add(time.Hour)
+			return nil
+		})
+		findAccount()
+		m.resetTokens.EXPECT().MarkUsed(gomock.Any()).Return(false, nil)
+
+		err := uc.ResetPassword("reset-token", "new-password123")
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidPasswordResetToken)
+	})
+
+	t.Run("should reject a password breaking the policy without consuming the token", func(t *testing.T) {
+		m.resetTokens.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(token *entity.PasswordResetTokenEntity) error {
+			token.AccountID = accountID
+			token.ExpiresAt = time.Now().UTC().Add(time.Hour)
+			return nil
+		})
+		findAccount()
+
+		err := uc.ResetPassword("reset-token", "gandalf-the-grey")
+
+		var policyErr *password.PolicyError
+		assert.ErrorAs(t, err, &policyErr)
+	})
+}
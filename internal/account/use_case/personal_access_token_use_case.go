@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	personalAccessTokenSize = 32
+
+	// personalAccessTokenTouchInterval bounds how often the last-used time of
+	// a token is written.
+	personalAccessTokenTouchInterval = time.Minute
+)
+
+//go:generate mockgen -source=personal_access_token_use_case.go -destination=../mocks/personal_access_token_use_case_mock.go -package=mocks
+type PersonalAccessTokenUseCaseInterface interface {
+	Create(token *entity.PersonalAccessTokenEntity) error
+	List(accountID uuid.UUID) ([]entity.PersonalAccessTokenEntity, error)
+	Revoke(token *entity.PersonalAccessTokenEntity) error
+	AuthenticatePersonalAccessToken(token string) (*auth.Principal, error)
+}
+
+type PersonalAccessTokenUseCase struct {
+	tokenRepo   repository.PersonalAccessTokenRepositoryInterface
+	accountRepo repository.AccountRepositoryInterface
+}
+
+func NewPersonalAccessTokenUseCase(
+	tokenRepo repository.PersonalAccessTokenRepositoryInterface,
+	accountRepo repository.AccountRepositoryInterface,
+) *PersonalAccessTokenUseCase {
+	return &PersonalAccessTokenUseCase{tokenRepo: tokenRepo, accountRepo: accountRepo}
+}
+
+// Create generates the secret of token, which must hold the account, name,
+// scopes and expiry. The plain token is only available on the returned
+// entity; just its hash is stored.
+func (uc *PersonalAccessTokenUseCase) Create(token *entity.PersonalAccessTokenEntity) error {
+	secret, err := utils.GenerateRandomToken(personalAccessTokenSize)
+	if err != nil {
+		return err
+	}
+
+	token.Token = auth.PersonalAccessTokenPrefix + secret
+	token.TokenHash = utils.HashToken(token.Token)
+
+	return uc.tokenRepo.Create(token)
+}
+
+func (uc *PersonalAccessTokenUseCase) List(accountID uuid.UUID) ([]entity.PersonalAccessTokenEntity, error) {
+	return uc.tokenRepo.ListByAccount(accountID)
+}
+
+func (uc *PersonalAccessTokenUseCase) Revoke(token *entity.PersonalAccessTokenEntity) error {
+	return uc.tokenRepo.Revoke(token)
+}
+
+// AuthenticatePersonalAccessToken implements auth.PersonalAccessTokenAuthenticator.
+func (uc *PersonalAccessTokenUseCase) AuthenticatePersonalAccessToken(token string) (*auth.Principal, error) {
+	pat := &entity.PersonalAccessTokenEntity{
+		Token:     token,
+		TokenHash: utils.HashToken(token),
+	}
+
+	if err := uc.tokenRepo.FindByHash(pat); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, auth.ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	if pat.RevokedAt != nil || !now.Before(pat.ExpiresAt) {
+		return nil, auth.ErrInvalidToken
+	}
+
+	account := &entity.AccountEntity{ID: pat.AccountID}
+	if err := uc.accountRepo.Find(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, auth.ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	// Failing to record the last use must not fail the request itself.
+	if err := uc.tokenRepo.Touch(pat, now.Add(-personalAccessTokenTouchInterval)); err != nil {
+		log.Printf("Erro ao registrar uso do token de acesso pessoal %s: %v", pat.ID, err)
+	}
+
+	return &auth.Principal{
+		AccountID:             account.ID,
+		Email:                 account.Email,
+		Verified:              account.IsEmailVerified(),
+		PersonalAccessTokenID: pat.ID,
+		Scopes:                pat.Scopes,
+	}, nil
+}
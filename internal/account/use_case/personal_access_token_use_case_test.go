@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupPersonalAccessToken(t *testing.T) (*mocks.MockPersonalAccessTokenRepositoryInterface, *mocks.MockAccountRepositoryInterface, *usecase.PersonalAccessTokenUseCase) {
+	ctrl := gomock.NewController(t)
+
+	tokenRepo := mocks.NewMockPersonalAccessTokenRepositoryInterface(ctrl)
+	accountRepo := mocks.NewMockAccountRepositoryInterface(ctrl)
+
+	return tokenRepo, accountRepo, usecase.NewPersonalAccessTokenUseCase(tokenRepo, accountRepo)
+}
+
+func TestPersonalAccessTokenUseCase_Create(t *testing.T) {
+	tokenRepo, _, uc := setupPersonalAccessToken(t)
+
+	token := &entity.PersonalAccessTokenEntity{AccountID: uuid.New(), Name: "ci", Scopes: []string{auth.ScopeRead}}
+
+	tokenRepo.EXPECT().Create(token).Return(nil)
+
+	err := uc.Create(token)
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token.Token, auth.PersonalAccessTokenPrefix))
+	assert.Equal(t, utils.HashToken(token.Token), token.TokenHash)
+}
+
+func TestPersonalAccessTokenUseCase_AuthenticatePersonalAccessToken(t *testing.T) {
+	tokenRepo, accountRepo, uc := setupPersonalAccessToken(t)
+
+	verifiedAt := time.Now()
+	account := entity.AccountEntity{ID: uuid.New(), Email: "gandalf@lor.com.br", EmailVerifiedAt: &verifiedAt}
+	stored := entity.PersonalAccessTokenEntity{
+		ID:        uuid.New(),
+		AccountID: account.ID,
+		Scopes:    []string{auth.ScopeRead},
+		ExpiresAt: time.Now().UTC().Add(time.Hour),
+	}
+
+	findToken := func(stored entity.PersonalAccessTokenEntity) func(*entity.PersonalAccessTokenEntity) error {
+		return func(pat *entity.PersonalAccessTokenEntity) error {
+			assert.Equal(t, utils.HashToken("trl_pat_secret"), pat.TokenHash)
+			*pat = stored
+			return nil
+		}
+	}
+
+	t.Run("should return the principal of the account with the token scopes", func(t *testing.T) {
+		tokenRepo.EXPECT().FindByHash(gomock.Any()).DoAndReturn(findToken(stored))
+		accountRepo.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+		tokenRepo.EXPECT().Touch(gomock.Any(), gomock.Any()).Return(nil)
+
+		principal, err := uc.AuthenticatePersonalAccessToken("trl_pat_secret")
+
+		assert.NoError(t, err)
+		assert.Equal(t, account.ID, principal.AccountID)
+		assert.True(t, principal.Verified)
+		assert.Equal(t, stored.ID, principal.PersonalAccessTokenID)
+		assert.Equal(t, []string{auth.ScopeRead}, principal.Scopes)
+	})
+
+	t.Run("should not fail the request when the last use cannot be recorded", func(t *testing.T) {
+		tokenRepo.EXPECT().FindByHash(gomock.Any()).DoAndReturn(findToken(stored))
+		accountRepo.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+		tokenRepo.EXPECT().Touch(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+
+		_, err := uc.AuthenticatePersonalAccessToken("trl_pat_secret")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject an expired token", func(t *testing.T) {
+		expired := stored
+		expired.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+
+		tokenRepo.EXPECT().FindByHash(gomock.Any()).DoAndReturn(findToken(expired))
+
+		_, err := uc.AuthenticatePersonalAccessToken("trl_pat_secret")
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("should reject a revoked token", func(t *testing.T) {
+		revokedAt := time.Now()
+		revoked := stored
+		revoked.RevokedAt = &revokedAt
+
+		tokenRepo.EXPECT().FindByHash(gomock.Any()).DoAndReturn(findToken(revoked))
+
+		_, err := uc.AuthenticatePersonalAccessToken("trl_pat_secret")
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("should reject an unknown token", func(t *testing.T) {
+		tokenRepo.EXPECT().FindByHash(gomock.Any()).Return(sql.ErrNoRows)
+
+		_, err := uc.AuthenticatePersonalAccessToken("trl_pat_secret")
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("should reject a token of a deleted account", func(t *testing.T) {
+		tokenRepo.EXPECT().FindByHash(gomock.Any()).DoAndReturn(findToken(stored))
+		accountRepo.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		_, err := uc.AuthenticatePersonalAccessToken("trl_pat_secret")
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+}
@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	refreshTokenSize = 32
+	maxUserAgentSize = 512
+)
+
+var ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+//go:generate mockgen -source=session_use_case.go -destination=../mocks/session_use_case_mock.go -package=mocks
+type SessionUseCaseInterface interface {
+	Issue(account *entity.AccountEntity, client entity.ClientEntity) (*entity.AuthTokensEntity, error)
+	Refresh(refreshToken string, client entity.ClientEntity) (*entity.AuthTokensEntity, error)
+	Revoke(refreshToken string) error
+	RevokeAll(account *entity.AccountEntity) error
+	RevokeOthers(account *entity.AccountEntity, sessionID uuid.UUID) error
+	List(account *entity.AccountEntity) ([]entity.SessionEntity, error)
+	RevokeSession(session *entity.SessionEntity) error
+}
+
+type SessionUseCase struct {
+	accountRepo      repository.AccountRepositoryInterface
+	refreshTokenRepo repository.RefreshTokenRepositoryInterface
+	sessionRepo      repository.SessionRepositoryInterface
+	tokens           auth.TokenManager
+}
+
+func NewSessionUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	refreshTokenRepo repository.RefreshTokenRepositoryInterface,
+	sessionRepo repository.SessionRepositoryInterface,
+	tokens auth.TokenManager,
+) *SessionUseCase {
+	return &SessionUseCase{
+		accountRepo:      accountRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		sessionRepo:      sessionRepo,
+		tokens:           tokens,
+	}
+}
+
+// Issue starts a new session on the client's device and issues its first
+// token pair.
+func (uc *SessionUseCase) Issue(account *entity.AccountEntity, client entity.ClientEntity) (*entity.AuthTokensEntity, error) {
+	session := &entity.SessionEntity{
+		AccountID: account.ID,
+		UserAgent: truncateUserAgent(client.UserAgent),
+		IPAddress: client.IP,
+		ExpiresAt: time.Now().UTC().Add(uc.tokens.RefreshTokenTTL()),
+	}
+
+	if err := uc.sessionRepo.Create(session); err != nil {
+		return nil, err
+	}
+
+	return uc.issueTokens(account, session)
+}
+
+func (uc *SessionUseCase) issueTokens(account *entity.AccountEntity, session *entity.SessionEntity) (*entity.AuthTokensEntity, error) {
+	accessToken, accessTokenExpiresAt, err := uc.tokens.GenerateAccessToken(auth.Principal{
+		AccountID: account.ID,
+		Email:     account.Email,
+		Verified:  account.IsEmailVerified(),
+		SessionID: session.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := utils.GenerateRandomToken(refreshTokenSize)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &entity.RefreshTokenEntity{
+		AccountID: account.ID,
+		SessionID: session.ID,
+		Token:     refreshToken,
+		TokenHash: utils.HashToken(refreshToken),
+		ExpiresAt: session.ExpiresAt,
+	}
+
+	if err := uc.refreshTokenRepo.Create(rt); err != nil {
+		return nil, err
+	}
+
+	return &entity.AuthTokensEntity{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: rt.ExpiresAt,
+	}, nil
+}
+
+// Refresh rotates the refresh token: the presented token is revoked and a new
+// pair is issued within the same session, which records the client as last
+// seen. Presenting an already revoked token is treated as token theft and
+// revokes every session of the account.
+func (uc *SessionUseCase) Refresh(refreshToken string, client entity.ClientEntity) (*entity.AuthTokensEntity, error) {
+	rt, err := uc.findRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if rt.RevokedAt != nil {
+		if err := uc.RevokeAll(&entity.AccountEntity{ID: rt.AccountID}); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if !time.Now().UTC().Before(rt.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	revoked, err := uc.refreshTokenRepo.Revoke(rt)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	account := &entity.AccountEntity{ID: rt.AccountID}
+	if err := uc.accountRepo.Find(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	session := &entity.SessionEntity{
+		ID:        rt.SessionID,
+		AccountID: rt.AccountID,
+		UserAgent: truncateUserAgent(client.UserAgent),
+		IPAddress: client.IP,
+		ExpiresAt: time.Now().UTC().Add(uc.tokens.RefreshTokenTTL()),
+	}
+
+	active, err := uc.sessionRepo.Touch(session)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	return uc.issueTokens(account, session)
+}
+
+// Revoke signs out of the session of the refresh token.
+func (uc *SessionUseCase) Revoke(refreshToken string) error {
+	rt, err := uc.findRefreshToken(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uc.refreshTokenRepo.Revoke(rt); err != nil {
+		return err
+	}
+
+	_, err = uc.sessionRepo.Revoke(&entity.SessionEntity{ID: rt.SessionID, AccountID: rt.AccountID})
+	return err
+}
+
+func (uc *SessionUseCase) findRefreshToken(refreshToken string) (*entity.RefreshTokenEntity, error) {
+	rt := &entity.RefreshTokenEntity{
+		Token:     refreshToken,
+		TokenHash: utils.HashToken(refreshToken),
+	}
+
+	if err := uc.refreshTokenRepo.FindByHash(rt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+// RevokeAll signs the account out everywhere. Access tokens already issued
+// stay valid until they expire.
+func (uc *SessionUseCase) RevokeAll(account *entity.AccountEntity) error {
+	if err := uc.sessionRepo.RevokeAllByAccount(account.ID, uuid.Nil); err != nil {
+		return err
+	}
+
+	return uc.refreshTokenRepo.RevokeAllByAccount(account.ID)
+}
+
+// RevokeOthers signs the account out of every session but sessionID. The
+// refresh tokens of the revoked sessions are refused from then on.
+func (uc *SessionUseCase) RevokeOthers(account *entity.AccountEntity, sessionID uuid.UUID) error {
+	return uc.sessionRepo.RevokeAllByAccount(account.ID, sessionID)
+}
+
+func (uc *SessionUseCase) List(account *entity.AccountEntity) ([]entity.SessionEntity, error) {
+	return uc.sessionRepo.ListByAccount(account.ID)
+}
+
+// RevokeSession ends a session of session.AccountID, failing with
+// sql.ErrNoRows when there is no such active session.
+func (uc *SessionUseCase) RevokeSession(session *entity.SessionEntity) error {
+	revoked, err := uc.sessionRepo.Revoke(session)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) <= maxUserAgentSize {
+		return userAgent
+	}
+	return strings.ToValidUTF8(userAgent[:maxUserAgentSize], "")
+}
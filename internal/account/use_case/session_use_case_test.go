@@ -0,0 +1,212 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSession(t *testing.T) (*mocks.MockAccountRepositoryInterface, *mocks.MockRefreshTokenRepositoryInterface, *mocks.MockSessionRepositoryInterface, *auth.JWTManager, *usecase.SessionUseCase) {
+	ctrl := gomock.NewController(t)
+
+	accountMock := mocks.NewMockAccountRepositoryInterface(ctrl)
+	refreshTokenMock := mocks.NewMockRefreshTokenRepositoryInterface(ctrl)
+	sessionMock := mocks.NewMockSessionRepositoryInterface(ctrl)
+	tokens := auth.NewJWTManager(config.AuthConfig{
+		JWTSecret:       "test-secret",
+		JWTIssuer:       "trilha-api",
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+	})
+
+	uc := usecase.NewSessionUseCase(accountMock, refreshTokenMock, sessionMock, tokens)
+
+	return accountMock, refreshTokenMock, sessionMock, tokens, uc
+}
+
+func TestSessionUseCase_Issue(t *testing.T) {
+	_, refreshTokenMock, sessionMock, tokens, uc := setupSession(t)
+
+	account := &entity.AccountEntity{ID: uuid.New(), Email: "gandalf@lor.com.br"}
+	sessionID := uuid.New()
+
+	sessionMock.EXPECT().Create(gomock.Any()).DoAndReturn(func(session *entity.SessionEntity) error {
+		assert.Equal(t, account.ID, session.AccountID)
+		assert.Equal(t, "Mozilla/5.0", session.UserAgent)
+		assert.Equal(t, "10.0.0.1", session.IPAddress)
+		session.ID = sessionID
+		return nil
+	})
+	refreshTokenMock.EXPECT().Create(gomock.Any()).DoAndReturn(func(rt *entity.RefreshTokenEntity) error {
+		assert.Equal(t, account.ID, rt.AccountID)
+		assert.Equal(t, sessionID, rt.SessionID)
+		assert.Equal(t, utils.HashToken(rt.Token), rt.TokenHash)
+		return nil
+	})
+
+	result, err := uc.Issue(account, entity.ClientEntity{UserAgent: "Mozilla/5.0", IP: "10.0.0.1"})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.RefreshToken)
+
+	principal, err := tokens.ParseAccessToken(result.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, account.ID, principal.AccountID)
+	assert.Equal(t, account.Email, principal.Email)
+	assert.Equal(t, sessionID, principal.SessionID)
+}
+
+func TestSessionUseCase_Refresh(t *testing.T) {
+	accountMock, refreshTokenMock, sessionMock, _, uc := setupSession(t)
+
+	accountID := uuid.New()
+	sessionID := uuid.New()
+	client := entity.ClientEntity{UserAgent: "Mozilla/5.0", IP: "10.0.0.2"}
+
+	t.Run("should rotate the refresh token", func(t *testing.T) {
+		refreshTokenMock.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(rt *entity.RefreshTokenEntity) error {
+			assert.Equal(t, utils.HashToken("old-token"), rt.TokenHash)
+			rt.ID = uuid.New()
+			rt.AccountID = accountID
+			rt.SessionID = sessionID
+			rt.ExpiresAt = time.Now().UTC().Add(time.Hour)
+			return nil
+		})
+		refreshTokenMock.EXPECT().Revoke(gomock.Any()).Return(true, nil)
+		accountMock.EXPECT().Find(gomock.Any()).DoAndReturn(func(acc *entity.AccountEntity) error {
+			acc.Email = "gandalf@lor.com.br"
+			return nil
+		})
+		sessionMock.EXPECT().Touch(gomock.Any()).DoAndReturn(func(session *entity.SessionEntity) (bool, error) {
+			assert.Equal(t, sessionID, session.ID)
+			assert.Equal(t, client.IP, session.IPAddress)
+			return true, nil
+		})
+		refreshTokenMock.EXPECT().Create(gomock.Any()).DoAndReturn(func(rt *entity.RefreshTokenEntity) error {
+			assert.Equal(t, sessionID, rt.SessionID)
+			return nil
+		})
+
+		result, err := uc.Refresh("old-token", client)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, "old-token", result.RefreshToken)
+	})
+
+	t.Run("should revoke every token of the account when a revoked token is reused", func(t *testing.T) {
+		revokedAt := time.Now()
+
+		refreshTokenMock.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(rt *entity.RefreshTokenEntity) error {
+			rt.AccountID = accountID
+			rt.ExpiresAt = time.Now().UTC().Add(time.Hour)
+			rt.RevokedAt = &revokedAt
+			return nil
+		})
+		sessionMock.EXPECT().RevokeAllByAccount(accountID, uuid.Nil).Return(nil)
+		refreshTokenMock.EXPECT().RevokeAllByAccount(accountID).Return(nil)
+
+		_, err := uc.Refresh("stolen-token", client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+	})
+
+	t.Run("should reject a token whose session was revoked", func(t *testing.T) {
+		refreshTokenMock.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(rt *entity.RefreshTokenEntity) error {
+			rt.AccountID = accountID
+			rt.SessionID = sessionID
+			rt.ExpiresAt = time.Now().UTC().Add(time.Hour)
+			return nil
+		})
+		refreshTokenMock.EXPECT().Revoke(gomock.Any()).Return(true, nil)
+		accountMock.EXPECT().Find(gomock.Any()).Return(nil)
+		sessionMock.EXPECT().Touch(gomock.Any()).Return(false, nil)
+
+		_, err := uc.Refresh("old-token", client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+	})
+
+	t.Run("should reject an expired token", func(t *testing.T) {
+		refreshTokenMock.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(rt *entity.RefreshTokenEntity) error {
+			rt.AccountID = accountID
+			rt.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+			return nil
+		})
+
+		_, err := uc.Refresh("expired-token", client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+	})
+
+	t.Run("should reject an unknown token", func(t *testing.T) {
+		refreshTokenMock.EXPECT().FindByHash(gomock.Any()).Return(sql.ErrNoRows)
+
+		_, err := uc.Refresh("unknown-token", client)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidRefreshToken)
+	})
+}
+
+func TestSessionUseCase_Revoke(t *testing.T) {
+	_, refreshTokenMock, sessionMock, _, uc := setupSession(t)
+
+	accountID := uuid.New()
+	sessionID := uuid.New()
+
+	refreshTokenMock.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(rt *entity.RefreshTokenEntity) error {
+		rt.AccountID = accountID
+		rt.SessionID = sessionID
+		return nil
+	})
+	refreshTokenMock.EXPECT().Revoke(gomock.Any()).Return(true, nil)
+	sessionMock.EXPECT().Revoke(&entity.SessionEntity{ID: sessionID, AccountID: accountID}).Return(true, nil)
+
+	err := uc.Revoke("refresh-token")
+
+	assert.NoError(t, err)
+}
+
+func TestSessionUseCase_RevokeOthers(t *testing.T) {
+	_, _, sessionMock, _, uc := setupSession(t)
+
+	account := &entity.AccountEntity{ID: uuid.New()}
+	sessionID := uuid.New()
+
+	sessionMock.EXPECT().RevokeAllByAccount(account.ID, sessionID).Return(nil)
+
+	err := uc.RevokeOthers(account, sessionID)
+
+	assert.NoError(t, err)
+}
+
+func TestSessionUseCase_RevokeSession(t *testing.T) {
+	_, _, sessionMock, _, uc := setupSession(t)
+
+	session := &entity.SessionEntity{ID: uuid.New(), AccountID: uuid.New()}
+
+	t.Run("should revoke an active session", func(t *testing.T) {
+		sessionMock.EXPECT().Revoke(session).Return(true, nil)
+
+		err := uc.RevokeSession(session)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return sql.ErrNoRows when the session is not active", func(t *testing.T) {
+		sessionMock.EXPECT().Revoke(session).Return(false, nil)
+
+		err := uc.RevokeSession(session)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
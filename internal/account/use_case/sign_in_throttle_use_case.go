@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+)
+
+const (
+	throttleScopeAccount = "account"
+	throttleScopeIP      = "ip"
+)
+
+var (
+	ErrTooManySignInAttempts = errors.New("too many sign-in attempts")
+	ErrInvalidUnlockToken    = errors.New("invalid unlock token")
+)
+
+// SignInThrottledError is returned while sign-in is delayed or locked, and
+// tells how long the caller must wait. It matches ErrTooManySignInAttempts.
+type SignInThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *SignInThrottledError) Error() string {
+	return ErrTooManySignInAttempts.Error()
+}
+
+func (e *SignInThrottledError) Unwrap() error {
+	return ErrTooManySignInAttempts
+}
+
+//go:generate mockgen -source=sign_in_throttle_use_case.go -destination=../mocks/sign_in_throttle_use_case_mock.go -package=mocks
+type SignInThrottleUseCaseInterface interface {
+	Check(email, ip string) error
+	RecordFailure(email, ip string) error
+	RecordSuccess(email string) error
+	Unlock(token string) error
+	Clear(account *entity.AccountEntity) error
+}
+
+type SignInThrottleUseCase struct {
+	repo            repository.SignInThrottleRepositoryInterface
+	accountRepo     repository.AccountRepositoryInterface
+	tokens          auth.TokenManager
+	mailer          mailer.Mailer
+	emails          *EmailNormalizer
+	maxFailures     int
+	ipMaxFailures   int
+	failureWindow   time.Duration
+	lockoutDuration time.Duration
+	delayBase       time.Duration
+	delayMax        time.Duration
+	appURL          string
+}
+
+func NewSignInThrottleUseCase(
+	repo repository.SignInThrottleRepositoryInterface,
+	accountRepo repository.AccountRepositoryInterface,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	emails *EmailNormalizer,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+) *SignInThrottleUseCase {
+	return &SignInThrottleUseCase{
+		repo:            repo,
+		accountRepo:     accountRepo,
+		tokens:          tokens,
+		mailer:          mail,
+		emails:          emails,
+		maxFailures:     authConfig.SignInMaxFailures,
+		ipMaxFailures:   authConfig.SignInIPMaxFailures,
+		failureWindow:   authConfig.SignInFailureWindow,
+		lockoutDuration: authConfig.SignInLockoutDuration,
+		delayBase:       authConfig.SignInDelayBase,
+		delayMax:        authConfig.SignInDelayMax,
+		appURL:          mailConfig.AppURL,
+	}
+}
+
+// Check returns a *SignInThrottledError when the account or the source IP
+// must wait before trying to sign in again. Accounts are tracked by email, so
+// unknown emails are throttled like existing ones.
+func (uc *SignInThrottleUseCase) Check(email, ip string) error {
+	now := time.Now().UTC()
+
+	var wait time.Duration
+	for _, throttle := range uc.subjects(email, ip) {
+		if err := uc.repo.Find(throttle); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return err
+		}
+
+		wait = max(wait, uc.wait(throttle, now))
+	}
+
+	if wait > 0 {
+		return &SignInThrottledError{RetryAfter: wait}
+	}
+
+	return nil
+}
+
+// RecordFailure counts a failed attempt for the account and the source IP,
+// locking them once they reach their maximum number of failures. The owner
+// of a locked account receives an email to unlock it.
+func (uc *SignInThrottleUseCase) RecordFailure(email, ip string) error {
+	now := time.Now().UTC()
+
+	for _, throttle := range uc.subjects(email, ip) {
+		throttle.LastFailureAt = now
+
+		if err := uc.repo.RecordFailure(throttle, now.Add(-uc.failureWindow)); err != nil {
+			return err
+		}
+
+		if throttle.IsLocked(now) || throttle.Failures < uc.maxFailuresOf(throttle.Scope) {
+			continue
+		}
+
+		lockedUntil := now.Add(uc.lockoutDuration)
+		throttle.LockedUntil = &lockedUntil
+
+		if err := uc.repo.Lock(throttle); err != nil {
+			return err
+		}
+
+		if throttle.Scope == throttleScopeAccount {
+			if err := uc.sendUnlock(email); err != nil {
+				log.Printf("Erro ao enviar email de desbloqueio: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RecordSuccess forgets the failures of the account. Failures of the source
+// IP are kept, so one valid account cannot be used to reset them.
+func (uc *SignInThrottleUseCase) RecordSuccess(email string) error {
+	_, err := uc.repo.Clear(&entity.SignInThrottleEntity{Scope: throttleScopeAccount, Subject: uc.accountSubject(email)})
+	return err
+}
+
+// Unlock clears the lockout of the account named by an unlock token. The
+// token is only honoured while the account still holds the email it was sent
+// to.
+func (uc *SignInThrottleUseCase) Unlock(token string) error {
+	action, err := uc.tokens.ParseActionToken(auth.PurposeAccountUnlock, token)
+	if err != nil {
+		return ErrInvalidUnlockToken
+	}
+
+	account := &entity.AccountEntity{ID: action.AccountID}
+
+	if err := uc.accountRepo.Find(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidUnlockToken
+		}
+		return err
+	}
+
+	if account.Email != action.Email {
+		return ErrInvalidUnlockToken
+	}
+
+	return uc.RecordSuccess(account.Email)
+}
+
+// Clear lifts the lockout of the account, which is looked up by ID.
+func (uc *SignInThrottleUseCase) Clear(account *entity.AccountEntity) error {
+	if err := uc.accountRepo.Find(account); err != nil {
+		return err
+	}
+
+	return uc.RecordSuccess(account.Email)
+}
+
+func (uc *SignInThrottleUseCase) subjects(email, ip string) []*entity.SignInThrottleEntity {
+	subjects := []*entity.SignInThrottleEntity{
+		{Scope: throttleScopeAccount, Subject: uc.accountSubject(email)},
+	}
+
+	if ip != "" {
+		subjects = append(subjects, &entity.SignInThrottleEntity{Scope: throttleScopeIP, Subject: ip})
+	}
+
+	return subjects
+}
+
+func (uc *SignInThrottleUseCase) maxFailuresOf(scope string) int {
+	if scope == throttleScopeIP {
+		return uc.ipMaxFailures
+	}
+	return uc.maxFailures
+}
+
+// wait returns how long the subject must wait at now: until the end of its
+// lockout, or for a delay that doubles with every recent failure.
+func (uc *SignInThrottleUseCase) wait(throttle *entity.SignInThrottleEntity, now time.Time) time.Duration {
+	if throttle.IsLocked(now) {
+		return throttle.LockedUntil.Sub(now)
+	}
+
+	if throttle.LockedUntil != nil || throttle.Failures == 0 || !throttle.LastFailureAt.After(now.Add(-uc.failureWindow)) {
+		return 0
+	}
+
+	delay := uc.delayBase
+	for i := 1; i < throttle.Failures && delay < uc.delayMax; i++ {
+		delay *= 2
+	}
+	delay = min(delay, uc.delayMax)
+
+	return max(throttle.LastFailureAt.Add(delay).Sub(now), 0)
+}
+
+func (uc *SignInThrottleUseCase) sendUnlock(email string) error {
+	account := &entity.AccountEntity{Email: email}
+
+	if err := uc.accountRepo.FindByEmail(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := uc.tokens.GenerateActionToken(auth.ActionToken{
+		Purpose:   auth.PurposeAccountUnlock,
+		AccountID: account.ID,
+		Email:     account.Email,
+	}, uc.lockoutDuration)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/unlock-account?token=%s", uc.appURL, url.QueryEscape(token))
+
+	return uc.mailer.Send(mailer.Message{
+		To:      account.Email,
+		Subject: "Sua conta foi bloqueada temporariamente",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nDetectamos várias tentativas de login sem sucesso na sua conta, que foi bloqueada por %s.\n\nSe foi você, acesse o link abaixo para desbloqueá-la agora:\n\n%s\n\nSe não foi você, recomendamos alterar a sua senha.\n",
+			account.Name, uc.lockoutDuration, link,
+		),
+	})
+}
+
+// accountSubject is the subject the failures of the account with email are
+// kept under. It is the stored form of the email, lowercased as the database
+// compares emails, so every casing of one address shares a throttle.
+func (uc *SignInThrottleUseCase) accountSubject(email string) string {
+	return strings.ToLower(uc.emails.Normalize(email))
+}
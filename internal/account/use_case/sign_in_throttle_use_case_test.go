@@ -0,0 +1,242 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type signInThrottleMocks struct {
+	throttles *mocks.MockSignInThrottleRepositoryInterface
+	accounts  *mocks.MockAccountRepositoryInterface
+	tokens    *auth.JWTManager
+	mail      *mailer.MemoryMailer
+}
+
+func setupSignInThrottle(t *testing.T) (*signInThrottleMocks, *usecase.SignInThrottleUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &signInThrottleMocks{
+		throttles: mocks.NewMockSignInThrottleRepositoryInterface(ctrl),
+		accounts:  mocks.NewMockAccountRepositoryInterface(ctrl),
+		tokens:    auth.NewJWTManager(config.AuthConfig{JWTSecret: "test-secret", JWTIssuer: "trilha-api"}),
+		mail:      mailer.NewMemoryMailer(),
+	}
+
+	uc := usecase.NewSignInThrottleUseCase(
+		m.throttles,
+		m.accounts,
+		m.tokens,
+		m.mail,
+		testEmails,
+		config.AuthConfig{
+			SignInMaxFailures:     3,
+			SignInIPMaxFailures:   10,
+			SignInFailureWindow:   15 * time.Minute,
+			SignInLockoutDuration: 15 * time.Minute,
+			SignInDelayBase:       time.Second,
+			SignInDelayMax:        4 * time.Second,
+		},
+		config.MailConfig{AppURL: "http://trilha.test"},
+	)
+
+	return m, uc
+}
+
+// findThrottles answers Find with the stored throttle of each scope.
+func findThrottles(stored map[string]entity.SignInThrottleEntity) func(*entity.SignInThrottleEntity) error {
+	return func(throttle *entity.SignInThrottleEntity) error {
+		found, ok := stored[throttle.Scope]
+		if !ok {
+			return sql.ErrNoRows
+		}
+		*throttle = found
+		return nil
+	}
+}
+
+func TestSignInThrottleUseCase_Check(t *testing.T) {
+	m, uc := setupSignInThrottle(t)
+
+	now := time.Now().UTC()
+
+	t.Run("should allow subjects without failures", func(t *testing.T) {
+		m.throttles.EXPECT().Find(gomock.Any()).DoAndReturn(findThrottles(nil)).Times(2)
+
+		assert.NoError(t, uc.Check("gandalf@lor.com.br", "10.0.0.1"))
+	})
+
+	t.Run("should track accounts by normalized email", func(t *testing.T) {
+		m.throttles.EXPECT().Find(&entity.SignInThrottleEntity{Scope: "account", Subject: "gandalf@lor.com.br"}).Return(sql.ErrNoRows)
+
+		assert.NoError(t, uc.Check(" Gandalf@LOR.com.br ", ""))
+	})
+
+	t.Run("should double the delay with every failure", func(t *testing.T) {
+		m.throttles.EXPECT().Find(gomock.Any()).DoAndReturn(findThrottles(map[string]entity.SignInThrottleEntity{
+			"account": {Scope: "account", Failures: 2, LastFailureAt: now},
+		})).Times(2)
+
+		err := uc.Check("gandalf@lor.com.br", "10.0.0.1")
+
+		var throttled *usecase.SignInThrottledError
+		assert.True(t, errors.As(err, &throttled))
+		assert.InDelta(t, 2*time.Second, throttled.RetryAfter, float64(time.Second))
+	})
+
+	t.Run("should cap the delay", func(t *testing.T) {
+		m.throttles.EXPECT().Find(gomock.Any()).DoAndReturn(findThrottles(map[string]entity.SignInThrottleEntity{
+			"ip": {Scope: "ip", Failures: 9, LastFailureAt: now},
+		})).Times(2)
+
+		err := uc.Check("gandalf@lor.com.br", "10.0.0.1")
+
+		var throttled *usecase.SignInThrottledError
+		assert.True(t, errors.As(err, &throttled))
+		assert.LessOrEqual(t, throttled.RetryAfter, 4*time.Second)
+	})
+
+	t.Run("should wait until the end of a lockout", func(t *testing.T) {
+		lockedUntil := now.Add(10 * time.Minute)
+
+		m.throttles.EXPECT().Find(gomock.Any()).DoAndReturn(findThrottles(map[string]entity.SignInThrottleEntity{
+			"account": {Scope: "account", Failures: 3, LastFailureAt: now, LockedUntil: &lockedUntil},
+		})).Times(2)
+
+		err := uc.Check("gandalf@lor.com.br", "10.0.0.1")
+
+		var throttled *usecase.SignInThrottledError
+		assert.ErrorIs(t, err, usecase.ErrTooManySignInAttempts)
+		assert.True(t, errors.As(err, &throttled))
+		assert.InDelta(t, 10*time.Minute, throttled.RetryAfter, float64(time.Second))
+	})
+
+	t.Run("should forget failures outside the window and expired lockouts", func(t *testing.T) {
+		lockedUntil := now.Add(-time.Minute)
+
+		m.throttles.EXPECT().Find(gomock.Any()).DoAndReturn(findThrottles(map[string]entity.SignInThrottleEntity{
+			"account": {Scope: "account", Failures: 3, LastFailureAt: now, LockedUntil: &lockedUntil},
+			"ip":      {Scope: "ip", Failures: 5, LastFailureAt: now.Add(-time.Hour)},
+		})).Times(2)
+
+		assert.NoError(t, uc.Check("gandalf@lor.com.br", "10.0.0.1"))
+	})
+}
+
+func TestSignInThrottleUseCase_RecordFailure(t *testing.T) {
+	m, uc := setupSignInThrottle(t)
+
+	account := entity.AccountEntity{ID: uuid.New(), Name: "Gandalf", Email: "gandalf@lor.com.br"}
+
+	t.Run("should count failures below the maximum without locking", func(t *testing.T) {
+		m.throttles.EXPECT().RecordFailure(gomock.Any(), gomock.Any()).DoAndReturn(func(throttle *entity.SignInThrottleEntity, resetBefore time.Time) error {
+			assert.WithinDuration(t, throttle.LastFailureAt.Add(-15*time.Minute), resetBefore, time.Second)
+			throttle.Failures = 1
+			return nil
+		}).Times(2)
+
+		assert.NoError(t, uc.RecordFailure(account.Email, "10.0.0.1"))
+	})
+
+	t.Run("should lock the account and email an unlock link", func(t *testing.T) {
+		m.throttles.EXPECT().RecordFailure(gomock.Any(), gomock.Any()).DoAndReturn(func(throttle *entity.SignInThrottleEntity, _ time.Time) error {
+			throttle.Failures = 3
+			return nil
+		}).Times(2)
+		m.throttles.EXPECT().Lock(gomock.Any()).DoAndReturn(func(throttle *entity.SignInThrottleEntity) error {
+			assert.Equal(t, "account", throttle.Scope)
+			assert.WithinDuration(t, time.Now().Add(15*time.Minute), *throttle.LockedUntil, time.Second)
+			return nil
+		})
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).DoAndReturn(findAccount(account))
+
+		err := uc.RecordFailure(account.Email, "10.0.0.1")
+
+		assert.NoError(t, err)
+
+		msg, _ := m.mail.Last()
+		assert.Equal(t, account.Email, msg.To)
+
+		_, token, found := strings.Cut(msg.Body, "http://trilha.test/unlock-account?token=")
+		assert.True(t, found)
+		token, _, _ = strings.Cut(token, "\n")
+
+		action, err := m.tokens.ParseActionToken(auth.PurposeAccountUnlock, token)
+		assert.NoError(t, err)
+		assert.Equal(t, account.ID, action.AccountID)
+	})
+
+	t.Run("should lock unknown emails without sending any email", func(t *testing.T) {
+		sent := len(m.mail.Messages())
+
+		m.throttles.EXPECT().RecordFailure(gomock.Any(), gomock.Any()).DoAndReturn(func(throttle *entity.SignInThrottleEntity, _ time.Time) error {
+			throttle.Failures = 3
+			return nil
+		})
+		m.throttles.EXPECT().Lock(gomock.Any()).Return(nil)
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.NoError(t, uc.RecordFailure("unknown@lor.com.br", ""))
+		assert.Len(t, m.mail.Messages(), sent)
+	})
+}
+
+func TestSignInThrottleUseCase_Unlock(t *testing.T) {
+	m, uc := setupSignInThrottle(t)
+
+	account := entity.AccountEntity{ID: uuid.New(), Email: "gandalf@lor.com.br"}
+	token, _ := m.tokens.GenerateActionToken(auth.ActionToken{
+		Purpose:   auth.PurposeAccountUnlock,
+		AccountID: account.ID,
+		Email:     account.Email,
+	}, time.Hour)
+
+	t.Run("should clear the failures of the account", func(t *testing.T) {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+		m.throttles.EXPECT().Clear(&entity.SignInThrottleEntity{Scope: "account", Subject: account.Email}).Return(true, nil)
+
+		assert.NoError(t, uc.Unlock(token))
+	})
+
+	t.Run("should reject a token sent to a previous email", func(t *testing.T) {
+		changed := account
+		changed.Email = "mithrandir@lor.com.br"
+
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(changed))
+
+		assert.ErrorIs(t, uc.Unlock(token), usecase.ErrInvalidUnlockToken)
+	})
+
+	t.Run("should reject tokens issued for another purpose", func(t *testing.T) {
+		other, _ := m.tokens.GenerateActionToken(auth.ActionToken{
+			Purpose:   auth.PurposeEmailVerification,
+			AccountID: account.ID,
+			Email:     account.Email,
+		}, time.Hour)
+
+		assert.ErrorIs(t, uc.Unlock(other), usecase.ErrInvalidUnlockToken)
+	})
+}
+
+func TestSignInThrottleUseCase_Clear(t *testing.T) {
+	m, uc := setupSignInThrottle(t)
+
+	account := entity.AccountEntity{ID: uuid.New(), Email: "Gandalf@lor.com.br"}
+
+	m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(account))
+	m.throttles.EXPECT().Clear(&entity.SignInThrottleEntity{Scope: "account", Subject: "gandalf@lor.com.br"}).Return(true, nil)
+
+	assert.NoError(t, uc.Clear(&entity.AccountEntity{ID: account.ID}))
+}
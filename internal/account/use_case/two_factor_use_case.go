@@ -0,0 +1,312 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"log"
+	"strings"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/repository"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/totp"
+	"trilha-api/internal/shared/utils"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled   = errors.New("two-factor authentication already enabled")
+	ErrTwoFactorNotEnabled       = errors.New("two-factor authentication not enabled")
+	ErrTwoFactorNotEnrolled      = errors.New("two-factor enrollment not started")
+	ErrInvalidTwoFactorCode      = errors.New("invalid two-factor code")
+	ErrInvalidTwoFactorChallenge = errors.New("invalid two-factor challenge")
+)
+
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+//go:generate mockgen -source=two_factor_use_case.go -destination=../mocks/two_factor_use_case_mock.go -package=mocks
+type TwoFactorUseCaseInterface interface {
+	Enroll(account *entity.AccountEntity) (*entity.TwoFactorEnrollmentEntity, error)
+	Confirm(account *entity.AccountEntity, code string) ([]string, error)
+	Disable(account *entity.AccountEntity, code string) error
+	RegenerateRecoveryCodes(account *entity.AccountEntity, code string) ([]string, error)
+	Challenge(account *entity.AccountEntity) (*entity.TwoFactorChallengeEntity, error)
+	CompleteSignIn(account *entity.AccountEntity, challengeToken, code string, client entity.ClientEntity) (*entity.AuthTokensEntity, error)
+}
+
+type TwoFactorUseCase struct {
+	accountRepo      repository.AccountRepositoryInterface
+	recoveryCodeRepo repository.RecoveryCodeRepositoryInterface
+	sessions         SessionUseCaseInterface
+	throttle         SignInThrottleUseCaseInterface
+	tokens           auth.TokenManager
+	issuer           string
+	challengeTTL     time.Duration
+}
+
+func NewTwoFactorUseCase(
+	accountRepo repository.AccountRepositoryInterface,
+	recoveryCodeRepo repository.RecoveryCodeRepositoryInterface,
+	sessions SessionUseCaseInterface,
+	throttle SignInThrottleUseCaseInterface,
+	tokens auth.TokenManager,
+	authConfig config.AuthConfig,
+) *TwoFactorUseCase {
+	return &TwoFactorUseCase{
+		accountRepo:      accountRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		sessions:         sessions,
+		throttle:         throttle,
+		tokens:           tokens,
+		issuer:           authConfig.TwoFactorIssuer,
+		challengeTTL:     authConfig.TwoFactorChallengeTTL,
+	}
+}
+
+// Enroll generates a new TOTP secret for the account. The secret stays
+// pending, and sign-in unchanged, until it is confirmed with a code.
+func (uc *TwoFactorUseCase) Enroll(account *entity.AccountEntity) (*entity.TwoFactorEnrollmentEntity, error) {
+	if err := uc.accountRepo.Find(account); err != nil {
+		return nil, err
+	}
+
+	if account.IsTwoFactorEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	account.TOTPSecret = secret
+
+	if err := uc.accountRepo.SetTOTPSecret(account); err != nil {
+		return nil, err
+	}
+
+	return &entity.TwoFactorEnrollmentEntity{
+		Secret: secret,
+		URI:    totp.URI(uc.issuer, account.Email, secret),
+	}, nil
+}
+
+// Confirm enables two-factor authentication once code matches the pending
+// secret, and returns the recovery codes of the account in plain text. They
+// are only stored hashed and cannot be shown again.
+func (uc *TwoFactorUseCase) Confirm(account *entity.AccountEntity, code string) ([]string, error) {
+	if err := uc.accountRepo.Find(account); err != nil {
+		return nil, err
+	}
+
+	if account.IsTwoFactorEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	if account.TOTPSecret == "" {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+
+	if err := uc.verifyTOTP(account, code); err != nil {
+		return nil, err
+	}
+
+	enabled, err := uc.accountRepo.EnableTwoFactor(account)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	return uc.replaceRecoveryCodes(account)
+}
+
+// Disable turns off two-factor authentication after checking a TOTP or
+// recovery code, and discards the secret and the recovery codes.
+func (uc *TwoFactorUseCase) Disable(account *entity.AccountEntity, code string) error {
+	if err := uc.findEnabled(account); err != nil {
+		return err
+	}
+
+	if err := uc.verifyCode(account, code); err != nil {
+		return err
+	}
+
+	if err := uc.accountRepo.DisableTwoFactor(account); err != nil {
+		return err
+	}
+
+	return uc.recoveryCodeRepo.DeleteAllByAccount(account.ID)
+}
+
+// RegenerateRecoveryCodes replaces every recovery code of the account, used
+// or not, after checking a TOTP or recovery code.
+func (uc *TwoFactorUseCase) RegenerateRecoveryCodes(account *entity.AccountEntity, code string) ([]string, error) {
+	if err := uc.findEnabled(account); err != nil {
+		return nil, err
+	}
+
+	if err := uc.verifyCode(account, code); err != nil {
+		return nil, err
+	}
+
+	return uc.replaceRecoveryCodes(account)
+}
+
+// Challenge issues the short-lived token that proves the password step of a
+// sign-in succeeded. It is exchanged for a token pair by CompleteSignIn.
+func (uc *TwoFactorUseCase) Challenge(account *entity.AccountEntity) (*entity.TwoFactorChallengeEntity, error) {
+	token, err := uc.tokens.GenerateActionToken(auth.ActionToken{
+		Purpose:   auth.PurposeTwoFactorSignIn,
+		AccountID: account.ID,
+		Email:     account.Email,
+	}, uc.challengeTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.TwoFactorChallengeEntity{
+		Token:     token,
+		ExpiresAt: time.Now().UTC().Add(uc.challengeTTL),
+	}, nil
+}
+
+// CompleteSignIn checks the code against the account of the challenge and,
+// on success, fills account and starts a session on the client's device.
+// Wrong codes count as failed sign-in attempts.
+func (uc *TwoFactorUseCase) CompleteSignIn(account *entity.AccountEntity, challengeToken, code string, client entity.ClientEntity) (*entity.AuthTokensEntity, error) {
+	action, err := uc.tokens.ParseActionToken(auth.PurposeTwoFactorSignIn, challengeToken)
+	if err != nil {
+		return nil, ErrInvalidTwoFactorChallenge
+	}
+
+	account.ID = action.AccountID
+
+	if err := uc.accountRepo.Find(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidTwoFactorChallenge
+		}
+		return nil, err
+	}
+
+	if account.Email != action.Email || !account.IsTwoFactorEnabled() {
+		return nil, ErrInvalidTwoFactorChallenge
+	}
+
+	if err := uc.throttle.Check(account.Email, client.IP); err != nil {
+		return nil, err
+	}
+
+	if err := uc.verifyCode(account, code); err != nil {
+		if errors.Is(err, ErrInvalidTwoFactorCode) {
+			if err := uc.throttle.RecordFailure(account.Email, client.IP); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
+	tokens, err := uc.sessions.Issue(account, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.throttle.RecordSuccess(account.Email); err != nil {
+		log.Printf("Erro ao limpar tentativas de login da conta %s: %v", account.ID, err)
+	}
+
+	return tokens, nil
+}
+
+func (uc *TwoFactorUseCase) findEnabled(account *entity.AccountEntity) error {
+	if err := uc.accountRepo.Find(account); err != nil {
+		return err
+	}
+
+	if !account.IsTwoFactorEnabled() {
+		return ErrTwoFactorNotEnabled
+	}
+
+	return nil
+}
+
+// verifyCode accepts either a TOTP code or an unused recovery code.
+func (uc *TwoFactorUseCase) verifyCode(account *entity.AccountEntity, code string) error {
+	code = normalizeCode(code)
+
+	if len(code) == totp.Digits {
+		return uc.verifyTOTP(account, code)
+	}
+
+	used, err := uc.recoveryCodeRepo.Use(account.ID, utils.HashToken(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// verifyTOTP accepts each time step once, so an observed code cannot be
+// replayed while it is still valid.
+func (uc *TwoFactorUseCase) verifyTOTP(account *entity.AccountEntity, code string) error {
+	step, ok := totp.Validate(account.TOTPSecret, normalizeCode(code), time.Now())
+	if !ok {
+		return ErrInvalidTwoFactorCode
+	}
+
+	used, err := uc.accountRepo.UseTOTPStep(account, step)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+func (uc *TwoFactorUseCase) replaceRecoveryCodes(account *entity.AccountEntity) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+
+		half := recoveryCodeLength / 2
+		codes[i] = code[:half] + "-" + code[half:]
+		hashes[i] = utils.HashToken(code)
+	}
+
+	if err := uc.recoveryCodeRepo.ReplaceAll(account.ID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return recoveryCodeEncoding.EncodeToString(b)[:recoveryCodeLength], nil
+}
+
+// normalizeCode lets users type codes with spaces, dashes or in upper case.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
@@ -0,0 +1,252 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+	"trilha-api/internal/account/entity"
+	"trilha-api/internal/account/mocks"
+	usecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/totp"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type twoFactorMocks struct {
+	accounts      *mocks.MockAccountRepositoryInterface
+	recoveryCodes *mocks.MockRecoveryCodeRepositoryInterface
+	sessions      *mocks.MockSessionUseCaseInterface
+	throttle      *mocks.MockSignInThrottleUseCaseInterface
+	tokens        *auth.JWTManager
+}
+
+func setupTwoFactor(t *testing.T) (*twoFactorMocks, *usecase.TwoFactorUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &twoFactorMocks{
+		accounts:      mocks.NewMockAccountRepositoryInterface(ctrl),
+		recoveryCodes: mocks.NewMockRecoveryCodeRepositoryInterface(ctrl),
+		sessions:      mocks.NewMockSessionUseCaseInterface(ctrl),
+		throttle:      mocks.NewMockSignInThrottleUseCaseInterface(ctrl),
+		tokens:        auth.NewJWTManager(config.AuthConfig{JWTSecret: "test-secret", JWTIssuer: "trilha-api"}),
+	}
+
+	uc := usecase.NewTwoFactorUseCase(
+		m.accounts,
+		m.recoveryCodes,
+		m.sessions,
+		m.throttle,
+		m.tokens,
+		config.AuthConfig{TwoFactorIssuer: "Trilha", TwoFactorChallengeTTL: 5 * time.Minute},
+	)
+
+	return m, uc
+}
+
+// findAccount makes the repository mock return stored on Find.
+func findAccount(stored entity.AccountEntity) func(*entity.AccountEntity) error {
+	return func(acc *entity.AccountEntity) error {
+		*acc = stored
+		return nil
+	}
+}
+
+func currentCode(t *testing.T, secret string) string {
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	assert.NoError(t, err)
+	return code
+}
+
+func TestTwoFactorUseCase_Enroll(t *testing.T) {
+	m, uc := setupTwoFactor(t)
+
+	stored := entity.AccountEntity{ID: uuid.New(), Email: "gandalf@lor.com.br"}
+
+	t.Run("should store a pending secret and return its otpauth uri", func(t *testing.T) {
+		account := &entity.AccountEntity{ID: stored.ID}
+
+		m.accounts.EXPECT().Find(account).DoAndReturn(findAccount(stored))
+		m.accounts.EXPECT().SetTOTPSecret(account).Return(nil)
+
+		enrollment, err := uc.Enroll(account)
+
+		assert.NoError(t, err)
+		assert.Equal(t, account.TOTPSecret, enrollment.Secret)
+		assert.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/Trilha:gandalf@lor.com.br?"))
+	})
+
+	t.Run("should refuse when two-factor authentication is already enabled", func(t *testing.T) {
+		enabledAt := time.Now()
+		enabled := stored
+		enabled.TwoFactorEnabledAt = &enabledAt
+
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(enabled))
+
+		_, err := uc.Enroll(&entity.AccountEntity{ID: stored.ID})
+
+		assert.ErrorIs(t, err, usecase.ErrTwoFactorAlreadyEnabled)
+	})
+}
+
+func TestTwoFactorUseCase_Confirm(t *testing.T) {
+	m, uc := setupTwoFactor(t)
+
+	secret, _ := totp.GenerateSecret()
+	pending := entity.AccountEntity{ID: uuid.New(), TOTPSecret: secret}
+
+	t.Run("should enable two-factor authentication and store hashed recovery codes", func(t *testing.T) {
+		account := &entity.AccountEntity{ID: pending.ID}
+
+		m.accounts.EXPECT().Find(account).DoAndReturn(findAccount(pending))
+		m.accounts.EXPECT().UseTOTPStep(account, totp.Step(time.Now())).Return(true, nil)
+		m.accounts.EXPECT().EnableTwoFactor(account).Return(true, nil)
+
+		var stored []string
+		m.recoveryCodes.EXPECT().ReplaceAll(pending.ID, gomock.Any()).DoAndReturn(func(_ uuid.UUID, hashes []string) error {
+			stored = hashes
+			return nil
+		})
+
+		codes, err := uc.Confirm(account, currentCode(t, secret))
+
+		assert.NoError(t, err)
+		assert.Len(t, codes, 10)
+		assert.Len(t, stored, 10)
+		assert.NotContains(t, stored, codes[0])
+		assert.Equal(t, utils.HashToken(strings.ReplaceAll(codes[0], "-", "")), stored[0])
+	})
+
+	t.Run("should reject a wrong code", func(t *testing.T) {
+		expired, _ := totp.Code(secret, totp.Step(time.Now())-5)
+
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(pending))
+
+		_, err := uc.Confirm(&entity.AccountEntity{ID: pending.ID}, expired)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("should reject a code already used", func(t *testing.T) {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(pending))
+		m.accounts.EXPECT().UseTOTPStep(gomock.Any(), gomock.Any()).Return(false, nil)
+
+		_, err := uc.Confirm(&entity.AccountEntity{ID: pending.ID}, currentCode(t, secret))
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("should refuse when enrollment was not started", func(t *testing.T) {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(entity.AccountEntity{ID: pending.ID}))
+
+		_, err := uc.Confirm(&entity.AccountEntity{ID: pending.ID}, "123456")
+
+		assert.ErrorIs(t, err, usecase.ErrTwoFactorNotEnrolled)
+	})
+}
+
+func TestTwoFactorUseCase_Disable(t *testing.T) {
+	m, uc := setupTwoFactor(t)
+
+	secret, _ := totp.GenerateSecret()
+	enabledAt := time.Now()
+	enabled := entity.AccountEntity{ID: uuid.New(), TOTPSecret: secret, TwoFactorEnabledAt: &enabledAt}
+
+	t.Run("should disable with a recovery code", func(t *testing.T) {
+		account := &entity.AccountEntity{ID: enabled.ID}
+
+		m.accounts.EXPECT().Find(account).DoAndReturn(findAccount(enabled))
+		m.recoveryCodes.EXPECT().Use(enabled.ID, utils.HashToken("abcdefghij")).Return(true, nil)
+		m.accounts.EXPECT().DisableTwoFactor(account).Return(nil)
+		m.recoveryCodes.EXPECT().DeleteAllByAccount(enabled.ID).Return(nil)
+
+		assert.NoError(t, uc.Disable(account, "ABCDE-FGHIJ"))
+	})
+
+	t.Run("should refuse when two-factor authentication is not enabled", func(t *testing.T) {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(entity.AccountEntity{ID: enabled.ID}))
+
+		assert.ErrorIs(t, uc.Disable(&entity.AccountEntity{ID: enabled.ID}, "123456"), usecase.ErrTwoFactorNotEnabled)
+	})
+}
+
+func TestTwoFactorUseCase_CompleteSignIn(t *testing.T) {
+	m, uc := setupTwoFactor(t)
+
+	secret, _ := totp.GenerateSecret()
+	enabledAt := time.Now()
+	stored := entity.AccountEntity{
+		ID:                 uuid.New(),
+		Email:              "gandalf@lor.com.br",
+		TOTPSecret:         secret,
+		TwoFactorEnabledAt: &enabledAt,
+	}
+
+	challenge, err := uc.Challenge(&stored)
+	assert.NoError(t, err)
+
+	t.Run("should issue tokens for a valid code", func(t *testing.T) {
+		account := &entity.AccountEntity{}
+		expectedTokens := &entity.AuthTokensEntity{AccessToken: "access"}
+
+		m.accounts.EXPECT().Find(account).DoAndReturn(func(acc *entity.AccountEntity) error {
+			assert.Equal(t, stored.ID, acc.ID)
+			*acc = stored
+			return nil
+		})
+		m.throttle.EXPECT().Check(stored.Email, "10.0.0.1").Return(nil)
+		m.accounts.EXPECT().UseTOTPStep(account, gomock.Any()).Return(true, nil)
+		m.sessions.EXPECT().Issue(account, entity.ClientEntity{IP: "10.0.0.1"}).Return(expectedTokens, nil)
+		m.throttle.EXPECT().RecordSuccess(stored.Email).Return(nil)
+
+		tokens, err := uc.CompleteSignIn(account, challenge.Token, currentCode(t, secret), entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTokens, tokens)
+	})
+
+	t.Run("should reject an unknown recovery code", func(t *testing.T) {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(stored))
+		m.throttle.EXPECT().Check(stored.Email, "10.0.0.1").Return(nil)
+		m.recoveryCodes.EXPECT().Use(stored.ID, gomock.Any()).Return(false, nil)
+		m.throttle.EXPECT().RecordFailure(stored.Email, "10.0.0.1").Return(nil)
+
+		_, err := uc.CompleteSignIn(&entity.AccountEntity{}, challenge.Token, "abcde-fghij", entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("should not check the code while sign-in is throttled", func(t *testing.T) {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(findAccount(stored))
+		m.throttle.EXPECT().Check(stored.Email, "10.0.0.1").Return(&usecase.SignInThrottledError{RetryAfter: time.Minute})
+
+		_, err := uc.CompleteSignIn(&entity.AccountEntity{}, challenge.Token, currentCode(t, secret), entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.ErrorIs(t, err, usecase.ErrTooManySignInAttempts)
+	})
+
+	t.Run("should reject a challenge of a deleted account", func(t *testing.T) {
+		m.accounts.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		_, err := uc.CompleteSignIn(&entity.AccountEntity{}, challenge.Token, "123456", entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidTwoFactorChallenge)
+	})
+
+	t.Run("should reject tokens issued for another purpose", func(t *testing.T) {
+		token, _ := m.tokens.GenerateActionToken(auth.ActionToken{
+			Purpose:   auth.PurposeEmailVerification,
+			AccountID: stored.ID,
+			Email:     stored.Email,
+		}, time.Hour)
+
+		_, err := uc.CompleteSignIn(&entity.AccountEntity{}, token, "123456", entity.ClientEntity{IP: "10.0.0.1"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidTwoFactorChallenge)
+	})
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"time"
+	"trilha-api/internal/shared/dto"
+
+	"github.com/google/uuid"
+)
+
+// ProjectResponse is a project, with its dates as YYYY-MM-DD. OwnerID is
+// null once the account that created the project is gone.
+type ProjectResponse struct {
+	dto.Default
+	WorkspaceID uuid.UUID  `json:"workspace_id"`
+	OwnerID     *uuid.UUID `json:"owner_id"`
+	Name        string     `json:"name"`
+	Key         string     `json:"key"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	StartDate   *string    `json:"start_date"`
+	TargetDate  *string    `json:"target_date"`
+}
+
+// CreateProjectRequest creates a project. Dates are given as YYYY-MM-DD.
+type CreateProjectRequest struct {
+	Name        string  `json:"name" binding:"required,max=200"`
+	Key         string  `json:"key" binding:"required"`
+	Description string  `json:"description" binding:"max=5000"`
+	Status      string  `json:"status" binding:"omitempty,oneof=planned active on_hold completed cancelled"`
+	StartDate   *string `json:"start_date"`
+	TargetDate  *string `json:"target_date"`
+}
+
+// UpdateProjectRequest holds the fields of a PATCH request; fields left out
+// of the body are kept unchanged, and an empty date clears it.
+type UpdateProjectRequest struct {
+	Name        *string `json:"name" binding:"omitempty,min=1,max=200"`
+	Key         *string `json:"key"`
+	Description *string `json:"description" binding:"omitempty,max=5000"`
+	Status      *string `json:"status" binding:"omitempty,oneof=planned active on_hold completed cancelled"`
+	StartDate   *string `json:"start_date"`
+	TargetDate  *string `json:"target_date"`
+}
+
+// ListProjectsQuery holds the query string of the project listing.
+type ListProjectsQuery struct {
+	Search  string `form:"search"`
+	Status  string `form:"status" binding:"omitempty,oneof=planned active on_hold completed cancelled"`
+	Page    int    `form:"page" binding:"omitempty,min=1"`
+	PerPage int    `form:"per_page" binding:"omitempty,min=1"`
+}
+
+type ProjectMemberResponse struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+// SetProjectMemberRequest gives the role of a member, one of project_admin,
+// project_editor, project_commenter and project_viewer.
+type SetProjectMemberRequest struct {
+	Role string `json:"role" binding:"required"`
+}
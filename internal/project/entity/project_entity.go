@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ProjectStatusPlanned   = "planned"
+	ProjectStatusActive    = "active"
+	ProjectStatusOnHold    = "on_hold"
+	ProjectStatusCompleted = "completed"
+	ProjectStatusCancelled = "cancelled"
+)
+
+// ResourceType is the resource type of the roles held on a project.
+const ResourceType = "project"
+
+// Roles a member may hold on a project. Admins also manage its members,
+// editors change it, commenters comment on it and viewers only read it.
+const (
+	ProjectRoleAdmin     = "project_admin"
+	ProjectRoleEditor    = "project_editor"
+	ProjectRoleCommenter = "project_commenter"
+	ProjectRoleViewer    = "project_viewer"
+)
+
+// ProjectEntity is a project of the workspace WorkspaceID, created by the
+// account OwnerID, which is uuid.Nil once that account is gone. Key is a
+// short code naming the project, unique among the projects of the workspace
+// that are not deleted. StartDate and TargetDate are calendar dates, kept at
+// midnight UTC.
+type ProjectEntity struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	OwnerID     uuid.UUID
+	Name        string
+	Key         string
+	Description string
+	Status      string
+	StartDate   *time.Time
+	TargetDate  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+// ProjectMemberEntity is an account holding Role on the project ProjectID
+// since JoinedAt.
+type ProjectMemberEntity struct {
+	WorkspaceID uuid.UUID
+	ProjectID   uuid.UUID
+	AccountID   uuid.UUID
+	Name        string
+	Email       string
+	Role        string
+	JoinedAt    time.Time
+}
+
+// ProjectFilter narrows and pages the projects of a workspace that ViewerID
+// can read. An empty Search or Status does not filter.
+type ProjectFilter struct {
+	WorkspaceID uuid.UUID
+	ViewerID    uuid.UUID
+	Search      string
+	Status      string
+	Page        int
+	PerPage     int
+}
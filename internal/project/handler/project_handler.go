@@ -0,0 +1,465 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+	"trilha-api/internal/project/dto"
+	"trilha-api/internal/project/entity"
+	"trilha-api/internal/project/repository"
+	usecase "trilha-api/internal/project/use_case"
+	"trilha-api/internal/shared/auth"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ProjectHandler struct {
+	usecase usecase.ProjectUseCaseInterface
+}
+
+func New(uc usecase.ProjectUseCaseInterface) *ProjectHandler {
+	return &ProjectHandler{usecase: uc}
+}
+
+// List returns a page of the projects of the workspace the caller can read.
+func (h *ProjectHandler) List(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	query := dto.ListProjectsQuery{}
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	filter := &entity.ProjectFilter{
+		WorkspaceID: workspaceID,
+		ViewerID:    principal.AccountID,
+		Search:      query.Search,
+		Status:      query.Status,
+		Page:        query.Page,
+		PerPage:     query.PerPage,
+	}
+
+	projects, total, err := h.usecase.List(filter)
+
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	items := make([]dto.ProjectResponse, 0, len(projects))
+	for i := range projects {
+		items = append(items, toProjectResponse(&projects[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[sharedDto.Page[dto.ProjectResponse]]{
+		Status: http.StatusOK,
+		Data: sharedDto.Page[dto.ProjectResponse]{
+			Items:   items,
+			Page:    filter.Page,
+			PerPage: filter.PerPage,
+			Total:   total,
+		},
+	})
+}
+
+func (h *ProjectHandler) Create(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.CreateProjectRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	project := &entity.ProjectEntity{
+		WorkspaceID: workspaceID,
+		OwnerID:     principal.AccountID,
+		Name:        req.Name,
+		Key:         req.Key,
+		Description: req.Description,
+		Status:      req.Status,
+	}
+
+	if !parseDate(c, "start_date", req.StartDate, &project.StartDate) ||
+		!parseDate(c, "target_date", req.TargetDate, &project.TargetDate) {
+		return
+	}
+
+	if err := h.usecase.Create(project); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.ProjectResponse]{
+		Status: http.StatusCreated,
+		Data:   toProjectResponse(project),
+	})
+}
+
+func (h *ProjectHandler) Find(c *gin.Context) {
+	project, ok := parseProject(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Find(project); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.ProjectResponse]{
+		Status: http.StatusOK,
+		Data:   toProjectResponse(project),
+	})
+}
+
+func (h *ProjectHandler) Update(c *gin.Context) {
+	project, ok := parseProject(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.UpdateProjectRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.usecase.Find(project); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	if req.Name != nil {
+		project.Name = *req.Name
+	}
+	if req.Key != nil {
+		project.Key = *req.Key
+	}
+	if req.Description != nil {
+		project.Description = *req.Description
+	}
+	if req.Status != nil {
+		project.Status = *req.Status
+	}
+
+	if !parseDate(c, "start_date", req.StartDate, &project.StartDate) ||
+		!parseDate(c, "target_date", req.TargetDate, &project.TargetDate) {
+		return
+	}
+
+	if err := h.usecase.Update(project); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.ProjectResponse]{
+		Status: http.StatusOK,
+		Data:   toProjectResponse(project),
+	})
+}
+
+func (h *ProjectHandler) Delete(c *gin.Context) {
+	project, ok := parseProject(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Delete(project); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ProjectHandler) Restore(c *gin.Context) {
+	project, ok := parseProject(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Restore(project); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.ProjectResponse]{
+		Status: http.StatusOK,
+		Data:   toProjectResponse(project),
+	})
+}
+
+func (h *ProjectHandler) ListMembers(c *gin.Context) {
+	project, ok := parseProject(c)
+
+	if !ok {
+		return
+	}
+
+	members, err := h.usecase.ListMembers(project)
+
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	res := make([]dto.ProjectMemberResponse, 0, len(members))
+	for i := range members {
+		res = append(res, toProjectMemberResponse(&members[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.ProjectMemberResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+// SetMember adds a member of the workspace to the project, or changes its
+// role on the project.
+func (h *ProjectHandler) SetMember(c *gin.Context) {
+	member, ok := parseMember(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.SetProjectMemberRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	member.Role = req.Role
+
+	if err := h.usecase.SetMember(member); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.ProjectMemberResponse]{
+		Status: http.StatusOK,
+		Data:   toProjectMemberResponse(member),
+	})
+}
+
+func (h *ProjectHandler) RemoveMember(c *gin.Context) {
+	member, ok := parseMember(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.RemoveMember(member); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// requirePrincipal returns the authenticated caller, answering 401 when the
+// route was reached without one.
+func requirePrincipal(c *gin.Context) (*auth.Principal, bool) {
+	principal, ok := auth.CurrentPrincipal(c)
+
+	if !ok {
+		c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+			Status:  http.StatusUnauthorized,
+			Message: "Authentication required",
+		})
+	}
+
+	return principal, ok
+}
+
+// parseWorkspace reads the ID of the workspace the projects belong to from
+// the path.
+func parseWorkspace(c *gin.Context) (uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(c.Param("ws"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid workspace ID")
+		return uuid.Nil, false
+	}
+
+	return workspaceID, true
+}
+
+// parseProject reads the workspace and project IDs from the path, scoping
+// the project to its workspace.
+func parseProject(c *gin.Context) (*entity.ProjectEntity, bool) {
+	workspaceID, ok := parseWorkspace(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	projectId, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid project ID")
+		return nil, false
+	}
+
+	return &entity.ProjectEntity{ID: projectId, WorkspaceID: workspaceID}, true
+}
+
+func parseMember(c *gin.Context) (*entity.ProjectMemberEntity, bool) {
+	project, ok := parseProject(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	accountID, err := uuid.Parse(c.Param("account_id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid account ID")
+		return nil, false
+	}
+
+	return &entity.ProjectMemberEntity{WorkspaceID: project.WorkspaceID, ProjectID: project.ID, AccountID: accountID}, true
+}
+
+// parseDate sets *date from value, a YYYY-MM-DD date, leaving it unchanged
+// when value is nil and clearing it when value is empty. It answers 400 and
+// reports false when value is not a date.
+func parseDate(c *gin.Context, field string, value *string, date **time.Time) bool {
+	if value == nil {
+		return true
+	}
+
+	if *value == "" {
+		*date = nil
+		return true
+	}
+
+	parsed, err := time.Parse(time.DateOnly, *value)
+
+	if err != nil {
+		respondBadRequest(c, field+" must be a date as YYYY-MM-DD")
+		return false
+	}
+
+	*date = &parsed
+
+	return true
+}
+
+func respondBadRequest(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+		Status:  http.StatusBadRequest,
+		Message: message,
+	})
+}
+
+func respondProjectError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Project not found",
+		})
+	case errors.Is(err, repository.ErrNotWorkspaceMember):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Account is not a member of the workspace",
+		})
+	case errors.Is(err, repository.ErrProjectKeyInUse):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Project key already in use",
+		})
+	case errors.Is(err, usecase.ErrInvalidProjectKey):
+		respondBadRequest(c, "key must have 2 to 10 letters and digits, starting with a letter")
+	case errors.Is(err, usecase.ErrInvalidProjectDates):
+		respondBadRequest(c, "target_date cannot be before start_date")
+	case errors.Is(err, usecase.ErrUnknownProjectRole):
+		respondBadRequest(c, "role must be one of project_admin, project_editor, project_commenter and project_viewer")
+	default:
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
+
+func toProjectResponse(project *entity.ProjectEntity) dto.ProjectResponse {
+	return dto.ProjectResponse{
+		Default: sharedDto.Default{
+			ID:        project.ID,
+			CreatedAt: project.CreatedAt,
+			UpdatedAt: project.UpdatedAt,
+			DeletedAt: project.DeletedAt,
+		},
+		WorkspaceID: project.WorkspaceID,
+		OwnerID:     ownerID(project.OwnerID),
+		Name:        project.Name,
+		Key:         project.Key,
+		Description: project.Description,
+		Status:      project.Status,
+		StartDate:   formatDate(project.StartDate),
+		TargetDate:  formatDate(project.TargetDate),
+	}
+}
+
+func toProjectMemberResponse(member *entity.ProjectMemberEntity) dto.ProjectMemberResponse {
+	return dto.ProjectMemberResponse{
+		AccountID: member.AccountID,
+		Name:      member.Name,
+		Email:     member.Email,
+		Role:      member.Role,
+		JoinedAt:  member.JoinedAt,
+	}
+}
+
+// ownerID returns nil for the projects whose owner is gone.
+func ownerID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+
+	return &id
+}
+
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+
+	formatted := date.Format(time.DateOnly)
+	return &formatted
+}
@@ -0,0 +1,411 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trilha-api/internal/project/dto"
+	"trilha-api/internal/project/entity"
+	"trilha-api/internal/project/handler"
+	"trilha-api/internal/project/mocks"
+	"trilha-api/internal/project/repository"
+	usecase "trilha-api/internal/project/use_case"
+	"trilha-api/internal/shared/auth"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	workspaceID  = uuid.New()
+	projectsPath = "/api/v1/workspaces/" + workspaceID.String() + "/projects"
+)
+
+func setup(t *testing.T) (*gin.Engine, *mocks.MockProjectUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockProjectUseCaseInterface(ctrl)
+	h := handler.New(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.GET("/api/v1/workspaces/:ws/projects", h.List)
+	router.POST("/api/v1/workspaces/:ws/projects", h.Create)
+	router.GET("/api/v1/workspaces/:ws/projects/:id", h.Find)
+	router.PATCH("/api/v1/workspaces/:ws/projects/:id", h.Update)
+	router.DELETE("/api/v1/workspaces/:ws/projects/:id", h.Delete)
+	router.POST("/api/v1/workspaces/:ws/projects/:id/restore", h.Restore)
+	router.GET("/api/v1/workspaces/:ws/projects/:id/members", h.ListMembers)
+	router.PUT("/api/v1/workspaces/:ws/projects/:id/members/:account_id", h.SetMember)
+	router.DELETE("/api/v1/workspaces/:ws/projects/:id/members/:account_id", h.RemoveMember)
+
+	return router, mock
+}
+
+// fakeAuthentication authenticates requests as the account in the
+// X-Account-ID header, standing in for the auth middleware.
+func fakeAuthentication() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if accountID, err := uuid.Parse(c.GetHeader("X-Account-ID")); err == nil {
+			auth.SetPrincipal(c, &auth.Principal{AccountID: accountID, Verified: true})
+		}
+		c.Next()
+	}
+}
+
+func send(router *gin.Engine, method, path string, accountID uuid.UUID, body any) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(method, path, &buf)
+	r.Header.Set("Content-Type", "application/json")
+	if accountID != uuid.Nil {
+		r.Header.Set("X-Account-ID", accountID.String())
+	}
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func TestProjectHandler_Create(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	ownerID := uuid.New()
+
+	t.Run("should return status 201 and the created project", func(t *testing.T) {
+		startDate := "2026-01-05"
+		targetDate := "2026-03-31"
+
+		mockUseCase.EXPECT().Create(gomock.Any()).DoAndReturn(func(project *entity.ProjectEntity) error {
+			assert.Equal(t, workspaceID, project.WorkspaceID)
+			assert.Equal(t, ownerID, project.OwnerID)
+			assert.Equal(t, "Trilha", project.Name)
+			assert.Equal(t, "tri", project.Key)
+			assert.Equal(t, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), *project.StartDate)
+			project.ID = uuid.New()
+			project.Key = "TRI"
+			project.Status = entity.ProjectStatusActive
+			return nil
+		})
+
+		w := send(router, http.MethodPost, projectsPath, ownerID, dto.CreateProjectRequest{
+			Name:       "Trilha",
+			Key:        "tri",
+			StartDate:  &startDate,
+			TargetDate: &targetDate,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.ProjectResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "TRI", responseBody.Data.Key)
+		assert.Equal(t, entity.ProjectStatusActive, responseBody.Data.Status)
+		assert.Equal(t, &startDate, responseBody.Data.StartDate)
+		assert.Equal(t, &targetDate, responseBody.Data.TargetDate)
+	})
+
+	t.Run("should return status 400 for a date in another format", func(t *testing.T) {
+		startDate := "05/01/2026"
+
+		w := send(router, http.MethodPost, projectsPath, ownerID, dto.CreateProjectRequest{
+			Name:      "Trilha",
+			Key:       "TRI",
+			StartDate: &startDate,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 for an unknown status", func(t *testing.T) {
+		w := send(router, http.MethodPost, projectsPath, ownerID, dto.CreateProjectRequest{
+			Name:   "Trilha",
+			Key:    "TRI",
+			Status: "paused",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid key", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).Return(usecase.ErrInvalidProjectKey)
+
+		w := send(router, http.MethodPost, projectsPath, ownerID, dto.CreateProjectRequest{Name: "Trilha", Key: "1-A"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 409 when the key is in use", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).Return(repository.ErrProjectKeyInUse)
+
+		w := send(router, http.MethodPost, projectsPath, ownerID, dto.CreateProjectRequest{Name: "Trilha", Key: "TRI"})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := send(router, http.MethodPost, projectsPath, uuid.Nil, dto.CreateProjectRequest{Name: "Trilha", Key: "TRI"})
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestProjectHandler_List(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	ownerID := uuid.New()
+
+	t.Run("should return the page of projects of the workspace the caller can read", func(t *testing.T) {
+		mockUseCase.EXPECT().List(gomock.Any()).DoAndReturn(func(filter *entity.ProjectFilter) ([]entity.ProjectEntity, int64, error) {
+			assert.Equal(t, workspaceID, filter.WorkspaceID)
+			assert.Equal(t, ownerID, filter.ViewerID)
+			assert.Equal(t, "tri", filter.Search)
+			assert.Equal(t, entity.ProjectStatusActive, filter.Status)
+			filter.Page = 1
+			filter.PerPage = 20
+			return []entity.ProjectEntity{{ID: uuid.New(), Name: "Trilha", Key: "TRI"}}, 1, nil
+		})
+
+		w := send(router, http.MethodGet, projectsPath+"?search=tri&status=active", ownerID, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[sharedDto.Page[dto.ProjectResponse]]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Len(t, responseBody.Data.Items, 1)
+		assert.Equal(t, int64(1), responseBody.Data.Total)
+		assert.Equal(t, 20, responseBody.Data.PerPage)
+	})
+
+	t.Run("should return status 400 for an invalid page", func(t *testing.T) {
+		w := send(router, http.MethodGet, projectsPath+"?page=-1", ownerID, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid workspace ID", func(t *testing.T) {
+		w := send(router, http.MethodGet, "/api/v1/workspaces/shire/projects", ownerID, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestProjectHandler_Find(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	ownerID := uuid.New()
+	projectID := uuid.New()
+
+	t.Run("should look the project up among those of the workspace", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(&entity.ProjectEntity{ID: projectID, WorkspaceID: workspaceID}).Return(nil)
+
+		w := send(router, http.MethodGet, projectsPath+"/"+projectID.String(), ownerID, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 404 when the project is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodGet, projectsPath+"/"+projectID.String(), ownerID, nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid ID", func(t *testing.T) {
+		w := send(router, http.MethodGet, projectsPath+"/frodo", ownerID, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestProjectHandler_Update(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	ownerID := uuid.New()
+	projectID := uuid.New()
+
+	t.Run("should change only the fields sent", func(t *testing.T) {
+		startDate := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+		status := entity.ProjectStatusOnHold
+		cleared := ""
+
+		gomock.InOrder(
+			mockUseCase.EXPECT().Find(gomock.Any()).DoAndReturn(func(project *entity.ProjectEntity) error {
+				project.Name = "Trilha"
+				project.Key = "TRI"
+				project.Status = entity.ProjectStatusActive
+				project.StartDate = &startDate
+				return nil
+			}),
+			mockUseCase.EXPECT().Update(gomock.Any()).DoAndReturn(func(project *entity.ProjectEntity) error {
+				assert.Equal(t, "Trilha", project.Name)
+				assert.Equal(t, "TRI", project.Key)
+				assert.Equal(t, entity.ProjectStatusOnHold, project.Status)
+				assert.Nil(t, project.StartDate)
+				return nil
+			}),
+		)
+
+		w := send(router, http.MethodPatch, projectsPath+"/"+projectID.String(), ownerID, dto.UpdateProjectRequest{
+			Status:    &status,
+			StartDate: &cleared,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 400 when the target date is before the start date", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).Return(nil)
+		mockUseCase.EXPECT().Update(gomock.Any()).Return(usecase.ErrInvalidProjectDates)
+
+		w := send(router, http.MethodPatch, projectsPath+"/"+projectID.String(), ownerID, dto.UpdateProjectRequest{})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 404 when the project is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodPatch, projectsPath+"/"+projectID.String(), ownerID, dto.UpdateProjectRequest{})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestProjectHandler_Delete(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	ownerID := uuid.New()
+	projectID := uuid.New()
+
+	t.Run("should return status 204 on success", func(t *testing.T) {
+		mockUseCase.EXPECT().Delete(&entity.ProjectEntity{ID: projectID, WorkspaceID: workspaceID}).Return(nil)
+
+		w := send(router, http.MethodDelete, projectsPath+"/"+projectID.String(), ownerID, nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when the project is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Delete(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodDelete, projectsPath+"/"+projectID.String(), ownerID, nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestProjectHandler_Restore(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	ownerID := uuid.New()
+	projectID := uuid.New()
+
+	t.Run("should return status 200 and the restored project", func(t *testing.T) {
+		mockUseCase.EXPECT().Restore(gomock.Any()).Return(nil)
+
+		w := send(router, http.MethodPost, projectsPath+"/"+projectID.String()+"/restore", ownerID, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 409 when its key was taken meanwhile", func(t *testing.T) {
+		mockUseCase.EXPECT().Restore(gomock.Any()).Return(repository.ErrProjectKeyInUse)
+
+		w := send(router, http.MethodPost, projectsPath+"/"+projectID.String()+"/restore", ownerID, nil)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestProjectHandler_SetMember(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	callerID := uuid.New()
+	projectID := uuid.New()
+	accountID := uuid.New()
+	path := projectsPath + "/" + projectID.String() + "/members/" + accountID.String()
+
+	t.Run("should return status 200 and the member", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).DoAndReturn(func(member *entity.ProjectMemberEntity) error {
+			assert.Equal(t, workspaceID, member.WorkspaceID)
+			assert.Equal(t, projectID, member.ProjectID)
+			assert.Equal(t, accountID, member.AccountID)
+			assert.Equal(t, entity.ProjectRoleCommenter, member.Role)
+			member.Name = "Ana"
+			return nil
+		})
+
+		w := send(router, http.MethodPut, path, callerID, dto.SetProjectMemberRequest{Role: entity.ProjectRoleCommenter})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.ProjectMemberResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Ana", responseBody.Data.Name)
+		assert.Equal(t, entity.ProjectRoleCommenter, responseBody.Data.Role)
+	})
+
+	t.Run("should return status 404 for accounts out of the workspace", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).Return(repository.ErrNotWorkspaceMember)
+
+		w := send(router, http.MethodPut, path, callerID, dto.SetProjectMemberRequest{Role: entity.ProjectRoleViewer})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an unknown role", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).Return(usecase.ErrUnknownProjectRole)
+
+		w := send(router, http.MethodPut, path, callerID, dto.SetProjectMemberRequest{Role: "guest"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid account ID", func(t *testing.T) {
+		w := send(router, http.MethodPut, projectsPath+"/"+projectID.String()+"/members/abc", callerID, dto.SetProjectMemberRequest{Role: entity.ProjectRoleViewer})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestProjectHandler_RemoveMember(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	projectID := uuid.New()
+	accountID := uuid.New()
+	path := projectsPath + "/" + projectID.String() + "/members/" + accountID.String()
+
+	t.Run("should return status 204", func(t *testing.T) {
+		mockUseCase.EXPECT().RemoveMember(&entity.ProjectMemberEntity{
+			WorkspaceID: workspaceID,
+			ProjectID:   projectID,
+			AccountID:   accountID,
+		}).Return(nil)
+
+		w := send(router, http.MethodDelete, path, uuid.New(), nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when the account is not a member", func(t *testing.T) {
+		mockUseCase.EXPECT().RemoveMember(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodDelete, path, uuid.New(), nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,214 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project_repository.go -destination=../mocks/project_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/project/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectRepositoryInterface is a mock of ProjectRepositoryInterface interface.
+type MockProjectRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectRepositoryInterfaceMockRecorder is the mock recorder for MockProjectRepositoryInterface.
+type MockProjectRepositoryInterfaceMockRecorder struct {
+	mock *MockProjectRepositoryInterface
+}
+
+// NewMockProjectRepositoryInterface creates a new mock instance.
+func NewMockProjectRepositoryInterface(ctrl *gomock.Controller) *MockProjectRepositoryInterface {
+	mock := &MockProjectRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockProjectRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectRepositoryInterface) EXPECT() *MockProjectRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockProjectRepositoryInterface) Count(filter entity.ProjectFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) Count(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).Count), filter)
+}
+
+// Create mocks base method.
+func (m *MockProjectRepositoryInterface) Create(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) Create(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).Create), project)
+}
+
+// Find mocks base method.
+func (m *MockProjectRepositoryInterface) Find(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) Find(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).Find), project)
+}
+
+// FindMember mocks base method.
+func (m *MockProjectRepositoryInterface) FindMember(member *entity.ProjectMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindMember indicates an expected call of FindMember.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) FindMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMember", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).FindMember), member)
+}
+
+// List mocks base method.
+func (m *MockProjectRepositoryInterface) List(filter entity.ProjectFilter) ([]entity.ProjectEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", filter)
+	ret0, _ := ret[0].([]entity.ProjectEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) List(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).List), filter)
+}
+
+// ListByOwner mocks base method.
+func (m *MockProjectRepositoryInterface) ListByOwner(workspaceID, ownerID uuid.UUID) ([]entity.ProjectEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOwner", workspaceID, ownerID)
+	ret0, _ := ret[0].([]entity.ProjectEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOwner indicates an expected call of ListByOwner.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) ListByOwner(workspaceID, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwner", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).ListByOwner), workspaceID, ownerID)
+}
+
+// ListMembers mocks base method.
+func (m *MockProjectRepositoryInterface) ListMembers(workspaceID, projectID uuid.UUID) ([]entity.ProjectMemberEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", workspaceID, projectID)
+	ret0, _ := ret[0].([]entity.ProjectMemberEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) ListMembers(workspaceID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).ListMembers), workspaceID, projectID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockProjectRepositoryInterface) RemoveMember(member *entity.ProjectMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) RemoveMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).RemoveMember), member)
+}
+
+// Restore mocks base method.
+func (m *MockProjectRepositoryInterface) Restore(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) Restore(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).Restore), project)
+}
+
+// SetMember mocks base method.
+func (m *MockProjectRepositoryInterface) SetMember(member *entity.ProjectMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) SetMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).SetMember), member)
+}
+
+// SoftDelete mocks base method.
+func (m *MockProjectRepositoryInterface) SoftDelete(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) SoftDelete(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).SoftDelete), project)
+}
+
+// Update mocks base method.
+func (m *MockProjectRepositoryInterface) Update(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectRepositoryInterfaceMockRecorder) Update(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectRepositoryInterface)(nil).Update), project)
+}
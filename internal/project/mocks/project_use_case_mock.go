@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project_use_case.go -destination=../mocks/project_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/project/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectUseCaseInterface is a mock of ProjectUseCaseInterface interface.
+type MockProjectUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectUseCaseInterfaceMockRecorder is the mock recorder for MockProjectUseCaseInterface.
+type MockProjectUseCaseInterfaceMockRecorder struct {
+	mock *MockProjectUseCaseInterface
+}
+
+// NewMockProjectUseCaseInterface creates a new mock instance.
+func NewMockProjectUseCaseInterface(ctrl *gomock.Controller) *MockProjectUseCaseInterface {
+	mock := &MockProjectUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockProjectUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectUseCaseInterface) EXPECT() *MockProjectUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProjectUseCaseInterface) Create(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) Create(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).Create), project)
+}
+
+// Delete mocks base method.
+func (m *MockProjectUseCaseInterface) Delete(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) Delete(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).Delete), project)
+}
+
+// Find mocks base method.
+func (m *MockProjectUseCaseInterface) Find(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) Find(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).Find), project)
+}
+
+// List mocks base method.
+func (m *MockProjectUseCaseInterface) List(filter *entity.ProjectFilter) ([]entity.ProjectEntity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", filter)
+	ret0, _ := ret[0].([]entity.ProjectEntity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) List(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).List), filter)
+}
+
+// ListMembers mocks base method.
+func (m *MockProjectUseCaseInterface) ListMembers(project *entity.ProjectEntity) ([]entity.ProjectMemberEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", project)
+	ret0, _ := ret[0].([]entity.ProjectMemberEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) ListMembers(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).ListMembers), project)
+}
+
+// RemoveMember mocks base method.
+func (m *MockProjectUseCaseInterface) RemoveMember(member *entity.ProjectMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) RemoveMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).RemoveMember), member)
+}
+
+// Restore mocks base method.
+func (m *MockProjectUseCaseInterface) Restore(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) Restore(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).Restore), project)
+}
+
+// SetMember mocks base method.
+func (m *MockProjectUseCaseInterface) SetMember(member *entity.ProjectMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) SetMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).SetMember), member)
+}
+
+// Update mocks base method.
+func (m *MockProjectUseCaseInterface) Update(project *entity.ProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectUseCaseInterfaceMockRecorder) Update(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectUseCaseInterface)(nil).Update), project)
+}
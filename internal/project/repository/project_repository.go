@@ -0,0 +1,410 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"trilha-api/internal/project/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/tenant"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolationCode is the Postgres SQLSTATE for unique constraint violations.
+const uniqueViolationCode = "23505"
+
+var (
+	ErrProjectKeyInUse    = errors.New("project key already in use")
+	ErrNotWorkspaceMember = errors.New("account not a member of the workspace")
+)
+
+// ProjectRepository reaches projects only through the scope of their
+// workspace, so row-level security keeps every query inside it.
+type ProjectRepository struct {
+	scope tenant.Scope
+}
+
+//go:generate mockgen -source=project_repository.go -destination=../mocks/project_repository_mock.go -package=mocks
+
+type ProjectRepositoryInterface interface {
+	Create(project *entity.ProjectEntity) error
+	Find(project *entity.ProjectEntity) error
+	List(filter entity.ProjectFilter) ([]entity.ProjectEntity, error)
+	Count(filter entity.ProjectFilter) (int64, error)
+	ListByOwner(workspaceID, ownerID uuid.UUID) ([]entity.ProjectEntity, error)
+	Update(project *entity.ProjectEntity) error
+	SoftDelete(project *entity.ProjectEntity) error
+	Restore(project *entity.ProjectEntity) error
+	ListMembers(workspaceID, projectID uuid.UUID) ([]entity.ProjectMemberEntity, error)
+	FindMember(member *entity.ProjectMemberEntity) error
+	SetMember(member *entity.ProjectMemberEntity) error
+	RemoveMember(member *entity.ProjectMemberEntity) error
+}
+
+func New(scope tenant.Scope) *ProjectRepository {
+	return &ProjectRepository{scope: scope}
+}
+
+// Create stores the project, making its owner its admin. It fails with
+// ErrProjectKeyInUse when another project holds its key.
+func (r *ProjectRepository) Create(project *entity.ProjectEntity) error {
+	fields := db.CreateProjectParams{
+		WorkspaceID:    project.WorkspaceID,
+		OwnerAccountID: utils.UUIDToPgUUID(&project.OwnerID),
+		Name:           project.Name,
+		Key:            project.Key,
+		Description:    project.Description,
+		Status:         project.Status,
+		StartDate:      utils.TimeToPgDate(project.StartDate),
+		TargetDate:     utils.TimeToPgDate(project.TargetDate),
+	}
+
+	var created db.CreateProjectRow
+	err := r.scope.Run(project.WorkspaceID, func(q db.Querier) error {
+		var err error
+		created, err = q.CreateProject(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrProjectKeyInUse
+		}
+		return fmt.Errorf("erro ao criar projeto: %w", err)
+	}
+
+	*project = toProjectEntity(db.Project(created))
+
+	return nil
+}
+
+// Find loads the project of project.WorkspaceID with project.ID, unless it
+// is deleted.
+func (r *ProjectRepository) Find(project *entity.ProjectEntity) error {
+	fields := db.FindProjectParams{
+		ID:          project.ID,
+		WorkspaceID: project.WorkspaceID,
+	}
+
+	var found db.Project
+	err := r.scope.Run(project.WorkspaceID, func(q db.Querier) error {
+		var err error
+		found, err = q.FindProject(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	*project = toProjectEntity(found)
+
+	return nil
+}
+
+func (r *ProjectRepository) List(filter entity.ProjectFilter) ([]entity.ProjectEntity, error) {
+	fields := db.ListProjectsParams{
+		ViewerID:    filter.ViewerID,
+		WorkspaceID: filter.WorkspaceID,
+		Search:      utils.ToPgText(filter.Search),
+		Status:      utils.ToPgText(filter.Status),
+		RowLimit:    int32(filter.PerPage),
+		RowOffset:   int32((filter.Page - 1) * filter.PerPage),
+	}
+
+	var rows []db.Project
+	err := r.scope.Run(filter.WorkspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.ListProjects(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar projetos: %w", err)
+	}
+
+	return toProjectEntities(rows), nil
+}
+
+// Count returns how many projects match the filter, regardless of its page.
+func (r *ProjectRepository) Count(filter entity.ProjectFilter) (int64, error) {
+	fields := db.CountProjectsParams{
+		ViewerID:    filter.ViewerID,
+		WorkspaceID: filter.WorkspaceID,
+		Search:      utils.ToPgText(filter.Search),
+		Status:      utils.ToPgText(filter.Status),
+	}
+
+	var total int64
+	err := r.scope.Run(filter.WorkspaceID, func(q db.Querier) error {
+		var err error
+		total, err = q.CountProjects(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("erro ao contar projetos: %w", err)
+	}
+
+	return total, nil
+}
+
+// ListByOwner returns every project the account created in the workspace,
+// deleted ones included.
+func (r *ProjectRepository) ListByOwner(workspaceID, ownerID uuid.UUID) ([]entity.ProjectEntity, error) {
+	fields := db.ListAccountProjectsParams{
+		WorkspaceID:    workspaceID,
+		OwnerAccountID: utils.UUIDToPgUUID(&ownerID),
+	}
+
+	var rows []db.Project
+	err := r.scope.Run(workspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.ListAccountProjects(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar projetos da conta: %w", err)
+	}
+
+	return toProjectEntities(rows), nil
+}
+
+// Update saves the fields of the project, failing with ErrProjectKeyInUse
+// when its new key is held by another project.
+func (r *ProjectRepository) Update(project *entity.ProjectEntity) error {
+	fields := db.UpdateProjectParams{
+		ID:          project.ID,
+		WorkspaceID: project.WorkspaceID,
+		Name:        project.Name,
+		Key:         project.Key,
+		Description: project.Description,
+		Status:      project.Status,
+		StartDate:   utils.TimeToPgDate(project.StartDate),
+		TargetDate:  utils.TimeToPgDate(project.TargetDate),
+	}
+
+	var updated db.Project
+	err := r.scope.Run(project.WorkspaceID, func(q db.Querier) error {
+		var err error
+		updated, err = q.UpdateProject(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrProjectKeyInUse
+		}
+		return err
+	}
+
+	*project = toProjectEntity(updated)
+
+	return nil
+}
+
+func (r *ProjectRepository) SoftDelete(project *entity.ProjectEntity) error {
+	fields := db.SoftDeleteProjectParams{
+		ID:          project.ID,
+		WorkspaceID: project.WorkspaceID,
+	}
+
+	var rows int64
+	err := r.scope.Run(project.WorkspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.SoftDeleteProject(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("erro ao remover projeto: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Restore undoes the removal of the project, failing with ErrProjectKeyInUse
+// when its key was taken meanwhile.
+func (r *ProjectRepository) Restore(project *entity.ProjectEntity) error {
+	fields := db.RestoreProjectParams{
+		ID:          project.ID,
+		WorkspaceID: project.WorkspaceID,
+	}
+
+	var restored db.Project
+	err := r.scope.Run(project.WorkspaceID, func(q db.Querier) error {
+		var err error
+		restored, err = q.RestoreProject(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrProjectKeyInUse
+		}
+		return err
+	}
+
+	*project = toProjectEntity(restored)
+
+	return nil
+}
+
+func (r *ProjectRepository) ListMembers(workspaceID, projectID uuid.UUID) ([]entity.ProjectMemberEntity, error) {
+	fields := db.ListProjectMembersParams{
+		ProjectID:   projectID,
+		WorkspaceID: workspaceID,
+	}
+
+	var rows []db.ListProjectMembersRow
+	err := r.scope.Run(workspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.ListProjectMembers(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar membros do projeto: %w", err)
+	}
+
+	members := make([]entity.ProjectMemberEntity, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, toProjectMemberEntity(workspaceID, projectID, db.FindProjectMemberRow(row)))
+	}
+
+	return members, nil
+}
+
+// FindMember loads the membership of member.AccountID in member.ProjectID.
+func (r *ProjectRepository) FindMember(member *entity.ProjectMemberEntity) error {
+	fields := db.FindProjectMemberParams{
+		ProjectID:   member.ProjectID,
+		WorkspaceID: member.WorkspaceID,
+		AccountID:   member.AccountID,
+	}
+
+	var found db.FindProjectMemberRow
+	err := r.scope.Run(member.WorkspaceID, func(q db.Querier) error {
+		var err error
+		found, err = q.FindProjectMember(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	*member = toProjectMemberEntity(member.WorkspaceID, member.ProjectID, found)
+
+	return nil
+}
+
+// SetMember adds the account to the project with member.Role, or changes its
+// role when it is a member already. It fails with ErrNotWorkspaceMember
+// when the account is not a member of the workspace of the project.
+func (r *ProjectRepository) SetMember(member *entity.ProjectMemberEntity) error {
+	fields := db.SetProjectMemberParams{
+		AccountID:   member.AccountID,
+		ProjectID:   member.ProjectID,
+		WorkspaceID: member.WorkspaceID,
+		RoleName:    member.Role,
+	}
+
+	var rows int64
+	err := r.scope.Run(member.WorkspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.SetProjectMember(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("erro ao adicionar membro ao projeto: %w", err)
+	}
+
+	if rows == 0 {
+		return ErrNotWorkspaceMember
+	}
+
+	return nil
+}
+
+func (r *ProjectRepository) RemoveMember(member *entity.ProjectMemberEntity) error {
+	fields := db.RemoveProjectMemberParams{
+		ProjectID:   member.ProjectID,
+		WorkspaceID: member.WorkspaceID,
+		AccountID:   member.AccountID,
+	}
+
+	var rows int64
+	err := r.scope.Run(member.WorkspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.RemoveProjectMember(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("erro ao remover membro do projeto: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func toProjectEntity(project db.Project) entity.ProjectEntity {
+	ownerID := uuid.Nil
+	if owner := utils.PgUUIDToUUID(project.OwnerAccountID); owner != nil {
+		ownerID = *owner
+	}
+
+	return entity.ProjectEntity{
+		ID:          project.ID,
+		WorkspaceID: project.WorkspaceID,
+		OwnerID:     ownerID,
+		Name:        project.Name,
+		Key:         project.Key,
+		Description: project.Description,
+		Status:      project.Status,
+		StartDate:   utils.PgDateToTime(project.StartDate),
+		TargetDate:  utils.PgDateToTime(project.TargetDate),
+		CreatedAt:   project.CreatedAt.Time,
+		UpdatedAt:   project.UpdatedAt.Time,
+		DeletedAt:   utils.PgTimestampToTime(project.DeletedAt),
+	}
+}
+
+func toProjectEntities(rows []db.Project) []entity.ProjectEntity {
+	projects := make([]entity.ProjectEntity, 0, len(rows))
+	for _, row := range rows {
+		projects = append(projects, toProjectEntity(row))
+	}
+	return projects
+}
+
+func toProjectMemberEntity(workspaceID, projectID uuid.UUID, member db.FindProjectMemberRow) entity.ProjectMemberEntity {
+	return entity.ProjectMemberEntity{
+		WorkspaceID: workspaceID,
+		ProjectID:   projectID,
+		AccountID:   member.AccountID,
+		Name:        member.Name,
+		Email:       member.Email,
+		Role:        member.RoleName,
+		JoinedAt:    member.CreatedAt.Time,
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
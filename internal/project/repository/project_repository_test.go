@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"trilha-api/internal/project/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// scopeStub runs the queries on the mocked querier, remembering the
+// workspace they were scoped to.
+type scopeStub struct {
+	querier   db.Querier
+	workspace uuid.UUID
+}
+
+func (s *scopeStub) Run(workspaceID uuid.UUID, fn func(q db.Querier) error) error {
+	s.workspace = workspaceID
+	return fn(s.querier)
+}
+
+func setup(t *testing.T) (*mocks.MockQuerier, *scopeStub, *ProjectRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	scope := &scopeStub{querier: dbMock}
+	repo := New(scope)
+
+	return dbMock, scope, repo
+}
+
+func TestProjectRepository_Create(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	workspaceID := uuid.New()
+	ownerID := uuid.New()
+	startDate := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should create the project in its workspace", func(t *testing.T) {
+		project := &entity.ProjectEntity{
+			WorkspaceID: workspaceID,
+			OwnerID:     ownerID,
+			Name:        "Trilha",
+			Key:         "TRI",
+			Status:      entity.ProjectStatusActive,
+			StartDate:   &startDate,
+		}
+
+		params := db.CreateProjectParams{
+			WorkspaceID:    workspaceID,
+			OwnerAccountID: pgtype.UUID{Bytes: ownerID, Valid: true},
+			Name:           "Trilha",
+			Key:            "TRI",
+			Status:         entity.ProjectStatusActive,
+			StartDate:      pgtype.Date{Time: startDate, Valid: true},
+		}
+
+		created := db.CreateProjectRow{
+			ID:             uuid.New(),
+			WorkspaceID:    workspaceID,
+			OwnerAccountID: pgtype.UUID{Bytes: ownerID, Valid: true},
+			Name:           "Trilha",
+			Key:            "TRI",
+			Status:         entity.ProjectStatusActive,
+			StartDate:      pgtype.Date{Time: startDate, Valid: true},
+			CreatedAt:      pgtype.Timestamp{Time: time.Now(), Valid: true},
+		}
+
+		dbMock.EXPECT().CreateProject(context.Background(), params).Return(created, nil)
+
+		err := repo.Create(project)
+
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, scope.workspace)
+		assert.Equal(t, created.ID, project.ID)
+		assert.Equal(t, ownerID, project.OwnerID)
+		assert.Equal(t, startDate, *project.StartDate)
+		assert.Nil(t, project.TargetDate)
+		assert.Nil(t, project.DeletedAt)
+	})
+
+	t.Run("should report a key in use", func(t *testing.T) {
+		dbMock.EXPECT().CreateProject(context.Background(), gomock.Any()).Return(db.CreateProjectRow{}, &pgconn.PgError{Code: "23505"})
+
+		err := repo.Create(&entity.ProjectEntity{WorkspaceID: workspaceID, OwnerID: ownerID, Key: "TRI"})
+
+		assert.ErrorIs(t, err, ErrProjectKeyInUse)
+	})
+
+	t.Run("should wrap other errors", func(t *testing.T) {
+		dbMock.EXPECT().CreateProject(context.Background(), gomock.Any()).Return(db.CreateProjectRow{}, errors.New("database error"))
+
+		err := repo.Create(&entity.ProjectEntity{WorkspaceID: workspaceID, OwnerID: ownerID, Key: "TRI"})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrProjectKeyInUse)
+	})
+}
+
+func TestProjectRepository_Find(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	workspaceID := uuid.New()
+	projectID := uuid.New()
+
+	t.Run("should find the project among those of the workspace", func(t *testing.T) {
+		params := db.FindProjectParams{ID: projectID, WorkspaceID: workspaceID}
+
+		dbMock.EXPECT().FindProject(context.Background(), params).Return(db.Project{ID: projectID, WorkspaceID: workspaceID, Name: "Trilha"}, nil)
+
+		project := &entity.ProjectEntity{ID: projectID, WorkspaceID: workspaceID}
+
+		assert.NoError(t, repo.Find(project))
+		assert.Equal(t, workspaceID, scope.workspace)
+		assert.Equal(t, "Trilha", project.Name)
+		assert.Equal(t, uuid.Nil, project.OwnerID)
+	})
+
+	t.Run("should return no rows when the project is not found", func(t *testing.T) {
+		dbMock.EXPECT().FindProject(context.Background(), gomock.Any()).Return(db.Project{}, sql.ErrNoRows)
+
+		err := repo.Find(&entity.ProjectEntity{ID: projectID, WorkspaceID: workspaceID})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestProjectRepository_List(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	workspaceID := uuid.New()
+	viewerID := uuid.New()
+
+	t.Run("should page the projects of the workspace the viewer can read", func(t *testing.T) {
+		params := db.ListProjectsParams{
+			ViewerID:    viewerID,
+			WorkspaceID: workspaceID,
+			Search:      pgtype.Text{String: "tri", Valid: true},
+			RowLimit:    20,
+			RowOffset:   40,
+		}
+
+		dbMock.EXPECT().ListProjects(context.Background(), params).Return([]db.Project{{ID: uuid.New()}, {ID: uuid.New()}}, nil)
+
+		projects, err := repo.List(entity.ProjectFilter{WorkspaceID: workspaceID, ViewerID: viewerID, Search: "tri", Page: 3, PerPage: 20})
+
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, scope.workspace)
+		assert.Len(t, projects, 2)
+	})
+}
+
+func TestProjectRepository_ListByOwner(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	workspaceID := uuid.New()
+	ownerID := uuid.New()
+
+	t.Run("should list the projects of the owner in the workspace", func(t *testing.T) {
+		params := db.ListAccountProjectsParams{
+			WorkspaceID:    workspaceID,
+			OwnerAccountID: pgtype.UUID{Bytes: ownerID, Valid: true},
+		}
+
+		dbMock.EXPECT().ListAccountProjects(context.Background(), params).Return([]db.Project{{ID: uuid.New()}}, nil)
+
+		projects, err := repo.ListByOwner(workspaceID, ownerID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, scope.workspace)
+		assert.Len(t, projects, 1)
+	})
+}
+
+func TestProjectRepository_SoftDelete(t *testing.T) {
+	dbMock, _, repo := setup(t)
+
+	project := &entity.ProjectEntity{ID: uuid.New(), WorkspaceID: uuid.New()}
+	params := db.SoftDeleteProjectParams{ID: project.ID, WorkspaceID: project.WorkspaceID}
+
+	t.Run("should delete the project", func(t *testing.T) {
+		dbMock.EXPECT().SoftDeleteProject(context.Background(), params).Return(int64(1), nil)
+
+		assert.NoError(t, repo.SoftDelete(project))
+	})
+
+	t.Run("should return no rows when nothing was deleted", func(t *testing.T) {
+		dbMock.EXPECT().SoftDeleteProject(context.Background(), params).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.SoftDelete(project), sql.ErrNoRows)
+	})
+}
+
+func TestProjectRepository_Restore(t *testing.T) {
+	dbMock, _, repo := setup(t)
+
+	project := &entity.ProjectEntity{ID: uuid.New(), WorkspaceID: uuid.New()}
+
+	t.Run("should report a key taken while the project was deleted", func(t *testing.T) {
+		dbMock.EXPECT().RestoreProject(context.Background(), gomock.Any()).Return(db.Project{}, &pgconn.PgError{Code: "23505"})
+
+		assert.ErrorIs(t, repo.Restore(project), ErrProjectKeyInUse)
+	})
+
+	t.Run("should return no rows when the project is not deleted", func(t *testing.T) {
+		dbMock.EXPECT().RestoreProject(context.Background(), gomock.Any()).Return(db.Project{}, sql.ErrNoRows)
+
+		assert.ErrorIs(t, repo.Restore(project), sql.ErrNoRows)
+	})
+}
+
+func TestProjectRepository_SetMember(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	member := &entity.ProjectMemberEntity{
+		WorkspaceID: uuid.New(),
+		ProjectID:   uuid.New(),
+		AccountID:   uuid.New(),
+		Role:        entity.ProjectRoleViewer,
+	}
+	params := db.SetProjectMemberParams{
+		AccountID:   member.AccountID,
+		ProjectID:   member.ProjectID,
+		WorkspaceID: member.WorkspaceID,
+		RoleName:    entity.ProjectRoleViewer,
+	}
+
+	t.Run("should add the member of the workspace within its scope", func(t *testing.T) {
+		dbMock.EXPECT().SetProjectMember(context.Background(), params).Return(int64(1), nil)
+
+		assert.NoError(t, repo.SetMember(member))
+		assert.Equal(t, member.WorkspaceID, scope.workspace)
+	})
+
+	t.Run("should refuse accounts out of the workspace", func(t *testing.T) {
+		dbMock.EXPECT().SetProjectMember(context.Background(), params).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.SetMember(member), ErrNotWorkspaceMember)
+	})
+}
+
+func TestProjectRepository_RemoveMember(t *testing.T) {
+	dbMock, _, repo := setup(t)
+
+	member := &entity.ProjectMemberEntity{WorkspaceID: uuid.New(), ProjectID: uuid.New(), AccountID: uuid.New()}
+	params := db.RemoveProjectMemberParams{ProjectID: member.ProjectID, WorkspaceID: member.WorkspaceID, AccountID: member.AccountID}
+
+	t.Run("should remove the member", func(t *testing.T) {
+		dbMock.EXPECT().RemoveProjectMember(context.Background(), params).Return(int64(1), nil)
+
+		assert.NoError(t, repo.RemoveMember(member))
+	})
+
+	t.Run("should return no rows when the account is not a member", func(t *testing.T) {
+		dbMock.EXPECT().RemoveProjectMember(context.Background(), params).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.RemoveMember(member), sql.ErrNoRows)
+	})
+}
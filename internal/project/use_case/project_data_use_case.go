@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"time"
+	"trilha-api/internal/project/entity"
+	"trilha-api/internal/project/repository"
+	"trilha-api/internal/shared/privacy"
+
+	"github.com/google/uuid"
+)
+
+// AccountWorkspaces lists the workspaces an account belongs to.
+type AccountWorkspaces interface {
+	ListWorkspaceIDs(accountID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// ProjectDataUseCase takes part in data exports with the projects the
+// account created in the workspaces it belongs to. Projects are not erased
+// with the account: they belong to their workspace.
+type ProjectDataUseCase struct {
+	repo       repository.ProjectRepositoryInterface
+	workspaces AccountWorkspaces
+}
+
+func NewProjectDataUseCase(repo repository.ProjectRepositoryInterface, workspaces AccountWorkspaces) *ProjectDataUseCase {
+	return &ProjectDataUseCase{repo: repo, workspaces: workspaces}
+}
+
+type projectExport struct {
+	ID          uuid.UUID  `json:"id"`
+	WorkspaceID uuid.UUID  `json:"workspace_id"`
+	Name        string     `json:"name"`
+	Key         string     `json:"key"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	StartDate   *string    `json:"start_date"`
+	TargetDate  *string    `json:"target_date"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+}
+
+func (uc *ProjectDataUseCase) ExportAccountData(accountID uuid.UUID) ([]privacy.Section, error) {
+	workspaceIDs, err := uc.workspaces.ListWorkspaceIDs(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := make([]projectExport, 0)
+	for _, workspaceID := range workspaceIDs {
+		projects, err := uc.repo.ListByOwner(workspaceID, accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range projects {
+			exported = append(exported, toProjectExport(project))
+		}
+	}
+
+	return []privacy.Section{{Name: "projects", Data: exported}}, nil
+}
+
+func toProjectExport(project entity.ProjectEntity) projectExport {
+	return projectExport{
+		ID:          project.ID,
+		WorkspaceID: project.WorkspaceID,
+		Name:        project.Name,
+		Key:         project.Key,
+		Description: project.Description,
+		Status:      project.Status,
+		StartDate:   formatDate(project.StartDate),
+		TargetDate:  formatDate(project.TargetDate),
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+		DeletedAt:   project.DeletedAt,
+	}
+}
+
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+
+	formatted := date.Format(time.DateOnly)
+	return &formatted
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"trilha-api/internal/project/entity"
+	"trilha-api/internal/project/repository"
+)
+
+const (
+	DefaultProjectsPerPage = 20
+	MaxProjectsPerPage     = 100
+)
+
+var (
+	ErrInvalidProjectKey   = errors.New("invalid project key")
+	ErrInvalidProjectDates = errors.New("target date before start date")
+	ErrUnknownProjectRole  = errors.New("unknown project role")
+)
+
+// projectRoles are the roles a member may hold on a project.
+var projectRoles = map[string]bool{
+	entity.ProjectRoleAdmin:     true,
+	entity.ProjectRoleEditor:    true,
+	entity.ProjectRoleCommenter: true,
+	entity.ProjectRoleViewer:    true,
+}
+
+// projectKeyPattern accepts keys of 2 to 10 uppercase letters and digits,
+// starting with a letter.
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+//go:generate mockgen -source=project_use_case.go -destination=../mocks/project_use_case_mock.go -package=mocks
+type ProjectUseCaseInterface interface {
+	Create(project *entity.ProjectEntity) error
+	Find(project *entity.ProjectEntity) error
+	List(filter *entity.ProjectFilter) ([]entity.ProjectEntity, int64, error)
+	Update(project *entity.ProjectEntity) error
+	Delete(project *entity.ProjectEntity) error
+	Restore(project *entity.ProjectEntity) error
+	ListMembers(project *entity.ProjectEntity) ([]entity.ProjectMemberEntity, error)
+	SetMember(member *entity.ProjectMemberEntity) error
+	RemoveMember(member *entity.ProjectMemberEntity) error
+}
+
+type ProjectUseCase struct {
+	repo repository.ProjectRepositoryInterface
+}
+
+func New(repo repository.ProjectRepositoryInterface) *ProjectUseCase {
+	return &ProjectUseCase{repo: repo}
+}
+
+// Create stores a new project of project.WorkspaceID, created by
+// project.OwnerID, who becomes its admin. The key is uppercased and the
+// status defaults to active.
+func (uc *ProjectUseCase) Create(project *entity.ProjectEntity) error {
+	if project.Status == "" {
+		project.Status = entity.ProjectStatusActive
+	}
+
+	if err := normalize(project); err != nil {
+		return err
+	}
+
+	return uc.repo.Create(project)
+}
+
+func (uc *ProjectUseCase) Find(project *entity.ProjectEntity) error {
+	return uc.repo.Find(project)
+}
+
+// List returns a page of the projects of filter.WorkspaceID that
+// filter.ViewerID can read and how many match the filter overall. The page
+// defaults to the first and its size to DefaultProjectsPerPage, capped at
+// MaxProjectsPerPage.
+func (uc *ProjectUseCase) List(filter *entity.ProjectFilter) ([]entity.ProjectEntity, int64, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 {
+		filter.PerPage = DefaultProjectsPerPage
+	}
+	if filter.PerPage > MaxProjectsPerPage {
+		filter.PerPage = MaxProjectsPerPage
+	}
+
+	total, err := uc.repo.Count(*filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	projects, err := uc.repo.List(*filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return projects, total, nil
+}
+
+func (uc *ProjectUseCase) Update(project *entity.ProjectEntity) error {
+	if err := normalize(project); err != nil {
+		return err
+	}
+
+	return uc.repo.Update(project)
+}
+
+// Delete removes the project until it is restored.
+func (uc *ProjectUseCase) Delete(project *entity.ProjectEntity) error {
+	return uc.repo.SoftDelete(project)
+}
+
+// Restore brings back a deleted project, failing with sql.ErrNoRows when the
+// project is not deleted.
+func (uc *ProjectUseCase) Restore(project *entity.ProjectEntity) error {
+	return uc.repo.Restore(project)
+}
+
+// ListMembers returns the members of the project, failing with sql.ErrNoRows
+// when the workspace has no such project.
+func (uc *ProjectUseCase) ListMembers(project *entity.ProjectEntity) ([]entity.ProjectMemberEntity, error) {
+	if err := uc.repo.Find(project); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListMembers(project.WorkspaceID, project.ID)
+}
+
+// SetMember adds a member of the workspace to the project with member.Role,
+// or changes its role when it is in the project already.
+func (uc *ProjectUseCase) SetMember(member *entity.ProjectMemberEntity) error {
+	if !projectRoles[member.Role] {
+		return ErrUnknownProjectRole
+	}
+
+	if err := uc.repo.Find(&entity.ProjectEntity{ID: member.ProjectID, WorkspaceID: member.WorkspaceID}); err != nil {
+		return err
+	}
+
+	if err := uc.repo.SetMember(member); err != nil {
+		return err
+	}
+
+	return uc.repo.FindMember(member)
+}
+
+func (uc *ProjectUseCase) RemoveMember(member *entity.ProjectMemberEntity) error {
+	return uc.repo.RemoveMember(member)
+}
+
+// normalize trims the fields of the project and checks its key and dates.
+func normalize(project *entity.ProjectEntity) error {
+	project.Name = strings.TrimSpace(project.Name)
+	project.Description = strings.TrimSpace(project.Description)
+	project.Key = strings.ToUpper(strings.TrimSpace(project.Key))
+
+	if !projectKeyPattern.MatchString(project.Key) {
+		return ErrInvalidProjectKey
+	}
+
+	if project.StartDate != nil && project.TargetDate != nil && project.TargetDate.Before(*project.StartDate) {
+		return ErrInvalidProjectDates
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+	"trilha-api/internal/project/entity"
+	"trilha-api/internal/project/mocks"
+	usecase "trilha-api/internal/project/use_case"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*mocks.MockProjectRepositoryInterface, *usecase.ProjectUseCase) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockProjectRepositoryInterface(ctrl)
+
+	return repo, usecase.New(repo)
+}
+
+func date(year int, month time.Month, day int) *time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &d
+}
+
+func TestProjectUseCase_Create(t *testing.T) {
+	repo, uc := setup(t)
+
+	t.Run("should uppercase the key and default the status to active", func(t *testing.T) {
+		project := &entity.ProjectEntity{OwnerID: uuid.New(), Name: "  Trilha ", Key: " tri2 "}
+
+		repo.EXPECT().Create(project).Return(nil)
+
+		assert.NoError(t, uc.Create(project))
+		assert.Equal(t, "Trilha", project.Name)
+		assert.Equal(t, "TRI2", project.Key)
+		assert.Equal(t, entity.ProjectStatusActive, project.Status)
+	})
+
+	t.Run("should keep the status given", func(t *testing.T) {
+		project := &entity.ProjectEntity{Name: "Trilha", Key: "TRI", Status: entity.ProjectStatusPlanned}
+
+		repo.EXPECT().Create(project).Return(nil)
+
+		assert.NoError(t, uc.Create(project))
+		assert.Equal(t, entity.ProjectStatusPlanned, project.Status)
+	})
+
+	t.Run("should refuse invalid keys", func(t *testing.T) {
+		for _, key := range []string{"T", "1TRI", "TRI-1", "TRILHAPROJETO", ""} {
+			err := uc.Create(&entity.ProjectEntity{Name: "Trilha", Key: key})
+
+			assert.ErrorIs(t, err, usecase.ErrInvalidProjectKey, key)
+		}
+	})
+
+	t.Run("should refuse a target date before the start date", func(t *testing.T) {
+		project := &entity.ProjectEntity{
+			Name:       "Trilha",
+			Key:        "TRI",
+			StartDate:  date(2026, 3, 1),
+			TargetDate: date(2026, 2, 1),
+		}
+
+		assert.ErrorIs(t, uc.Create(project), usecase.ErrInvalidProjectDates)
+	})
+
+	t.Run("should accept a project finishing the day it starts", func(t *testing.T) {
+		project := &entity.ProjectEntity{
+			Name:       "Trilha",
+			Key:        "TRI",
+			StartDate:  date(2026, 3, 1),
+			TargetDate: date(2026, 3, 1),
+		}
+
+		repo.EXPECT().Create(project).Return(nil)
+
+		assert.NoError(t, uc.Create(project))
+	})
+}
+
+func TestProjectUseCase_List(t *testing.T) {
+	repo, uc := setup(t)
+
+	t.Run("should default the page and cap its size", func(t *testing.T) {
+		filter := &entity.ProjectFilter{WorkspaceID: uuid.New(), PerPage: 1000}
+
+		repo.EXPECT().Count(gomock.Any()).Return(int64(3), nil)
+		repo.EXPECT().List(gomock.Any()).DoAndReturn(func(f entity.ProjectFilter) ([]entity.ProjectEntity, error) {
+			assert.Equal(t, 1, f.Page)
+			assert.Equal(t, usecase.MaxProjectsPerPage, f.PerPage)
+			return []entity.ProjectEntity{{}, {}, {}}, nil
+		})
+
+		projects, total, err := uc.List(filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, projects, 3)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("should use the default page size", func(t *testing.T) {
+		filter := &entity.ProjectFilter{}
+
+		repo.EXPECT().Count(gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().List(gomock.Any()).Return(nil, nil)
+
+		_, _, err := uc.List(filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, usecase.DefaultProjectsPerPage, filter.PerPage)
+	})
+}
+
+func TestProjectUseCase_Update(t *testing.T) {
+	repo, uc := setup(t)
+
+	t.Run("should validate the project before saving it", func(t *testing.T) {
+		err := uc.Update(&entity.ProjectEntity{Name: "Trilha", Key: "T"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidProjectKey)
+	})
+
+	t.Run("should save the normalized project", func(t *testing.T) {
+		project := &entity.ProjectEntity{ID: uuid.New(), Name: "Trilha", Key: "tri"}
+
+		repo.EXPECT().Update(project).Return(nil)
+
+		assert.NoError(t, uc.Update(project))
+		assert.Equal(t, "TRI", project.Key)
+	})
+
+	t.Run("should report a missing project", func(t *testing.T) {
+		repo.EXPECT().Update(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.Update(&entity.ProjectEntity{Name: "Trilha", Key: "TRI"}), sql.ErrNoRows)
+	})
+}
+
+// workspacesStub lists the same workspaces for any account.
+type workspacesStub []uuid.UUID
+
+func (s workspacesStub) ListWorkspaceIDs(accountID uuid.UUID) ([]uuid.UUID, error) {
+	return s, nil
+}
+
+func TestProjectDataUseCase_ExportAccountData(t *testing.T) {
+	t.Run("should export the projects of the account in each of its workspaces", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockProjectRepositoryInterface(ctrl)
+		first, second := uuid.New(), uuid.New()
+		uc := usecase.NewProjectDataUseCase(repo, workspacesStub{first, second})
+		accountID := uuid.New()
+
+		repo.EXPECT().ListByOwner(first, accountID).Return([]entity.ProjectEntity{
+			{ID: uuid.New(), WorkspaceID: first, Name: "Trilha", Key: "TRI", StartDate: date(2026, 1, 5)},
+		}, nil)
+		repo.EXPECT().ListByOwner(second, accountID).Return([]entity.ProjectEntity{
+			{ID: uuid.New(), WorkspaceID: second, Name: "Trilha", Key: "TRI"},
+		}, nil)
+
+		sections, err := uc.ExportAccountData(accountID)
+
+		require.NoError(t, err)
+		require.Len(t, sections, 1)
+		assert.Equal(t, "projects", sections[0].Name)
+
+		exported, err := json.Marshal(sections[0].Data)
+		require.NoError(t, err)
+		assert.Contains(t, string(exported), `"key":"TRI"`)
+		assert.Contains(t, string(exported), `"start_date":"2026-01-05"`)
+		assert.Contains(t, string(exported), `"workspace_id":"`+second.String()+`"`)
+	})
+}
+
+func TestProjectUseCase_SetMember(t *testing.T) {
+	repo, uc := setup(t)
+
+	member := &entity.ProjectMemberEntity{WorkspaceID: uuid.New(), ProjectID: uuid.New(), AccountID: uuid.New()}
+	project := &entity.ProjectEntity{ID: member.ProjectID, WorkspaceID: member.WorkspaceID}
+
+	t.Run("should set the member and load it back", func(t *testing.T) {
+		member.Role = entity.ProjectRoleEditor
+
+		repo.EXPECT().Find(project).Return(nil)
+		repo.EXPECT().SetMember(member).Return(nil)
+		repo.EXPECT().FindMember(member).Return(nil)
+
+		assert.NoError(t, uc.SetMember(member))
+	})
+
+	t.Run("should refuse roles other than the project roles", func(t *testing.T) {
+		for _, role := range []string{"member", "guest", "workspace_owner", "team_lead", ""} {
+			member.Role = role
+
+			assert.ErrorIs(t, uc.SetMember(member), usecase.ErrUnknownProjectRole, role)
+		}
+	})
+
+	t.Run("should fail when the workspace has no such project", func(t *testing.T) {
+		member.Role = entity.ProjectRoleViewer
+
+		repo.EXPECT().Find(project).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.SetMember(member), sql.ErrNoRows)
+	})
+}
+
+func TestProjectUseCase_ListMembers(t *testing.T) {
+	repo, uc := setup(t)
+
+	project := &entity.ProjectEntity{ID: uuid.New(), WorkspaceID: uuid.New()}
+
+	t.Run("should list the members of the project", func(t *testing.T) {
+		members := []entity.ProjectMemberEntity{{AccountID: uuid.New(), Role: entity.ProjectRoleAdmin}}
+
+		repo.EXPECT().Find(project).Return(nil)
+		repo.EXPECT().ListMembers(project.WorkspaceID, project.ID).Return(members, nil)
+
+		found, err := uc.ListMembers(project)
+
+		assert.NoError(t, err)
+		assert.Equal(t, members, found)
+	})
+
+	t.Run("should fail when the workspace has no such project", func(t *testing.T) {
+		repo.EXPECT().Find(project).Return(sql.ErrNoRows)
+
+		_, err := uc.ListMembers(project)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
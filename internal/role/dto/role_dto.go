@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoleResponse struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type PermissionResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type AccountRoleResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	Role         string     `json:"role"`
+	ResourceType string     `json:"resource_type"`
+	ResourceID   *uuid.UUID `json:"resource_id"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// AccountRoleRequest identifies a role grant. Without resource_type the role
+// is granted system-wide.
+type AccountRoleRequest struct {
+	Role         string     `json:"role" binding:"required"`
+	ResourceType string     `json:"resource_type"`
+	ResourceID   *uuid.UUID `json:"resource_id"`
+}
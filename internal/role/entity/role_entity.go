@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoleEntity struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	Permissions []string
+	CreatedAt   time.Time
+}
+
+type PermissionEntity struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+// AccountRoleEntity is a role granted to an account, either system-wide or on
+// a single resource.
+type AccountRoleEntity struct {
+	ID           uuid.UUID
+	AccountID    uuid.UUID
+	RoleID       uuid.UUID
+	Role         string
+	ResourceType string
+	ResourceID   *uuid.UUID
+	CreatedAt    time.Time
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"trilha-api/internal/role/dto"
+	"trilha-api/internal/role/entity"
+	"trilha-api/internal/role/repository"
+	usecase "trilha-api/internal/role/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RoleHandler struct {
+	usecase usecase.RoleUseCaseInterface
+}
+
+func New(uc usecase.RoleUseCaseInterface) *RoleHandler {
+	return &RoleHandler{usecase: uc}
+}
+
+func (h *RoleHandler) ListRoles(c *gin.Context) {
+	roles, err := h.usecase.ListRoles()
+
+	if err != nil {
+		respondInternalError(c)
+		return
+	}
+
+	res := make([]dto.RoleResponse, 0, len(roles))
+	for _, role := range roles {
+		permissions := role.Permissions
+		if permissions == nil {
+			permissions = []string{}
+		}
+
+		res = append(res, dto.RoleResponse{
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: permissions,
+		})
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.RoleResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func (h *RoleHandler) ListPermissions(c *gin.Context) {
+	permissions, err := h.usecase.ListPermissions()
+
+	if err != nil {
+		respondInternalError(c)
+		return
+	}
+
+	res := make([]dto.PermissionResponse, 0, len(permissions))
+	for _, permission := range permissions {
+		res = append(res, dto.PermissionResponse{
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.PermissionResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func (h *RoleHandler) ListAccountRoles(c *gin.Context) {
+	accountID, ok := parseAccountID(c)
+
+	if !ok {
+		return
+	}
+
+	roles, err := h.usecase.ListAccountRoles(accountID)
+
+	if err != nil {
+		respondInternalError(c)
+		return
+	}
+
+	res := make([]dto.AccountRoleResponse, 0, len(roles))
+	for i := range roles {
+		res = append(res, toAccountRoleResponse(&roles[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.AccountRoleResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func (h *RoleHandler) Assign(c *gin.Context) {
+	accountRole, ok := bindAccountRole(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Assign(accountRole); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrAccountNotFound):
+			c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+				Status:  http.StatusNotFound,
+				Message: "Account not found",
+			})
+		case errors.Is(err, usecase.ErrRoleAlreadyAssigned):
+			c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+				Status:  http.StatusConflict,
+				Message: "Role already assigned",
+			})
+		default:
+			respondRoleError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[any]{
+		Status:  http.StatusCreated,
+		Message: "Role assigned",
+	})
+}
+
+func (h *RoleHandler) Revoke(c *gin.Context) {
+	accountRole, ok := bindAccountRole(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Revoke(accountRole); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+				Status:  http.StatusNotFound,
+				Message: "Role assignment not found",
+			})
+			return
+		}
+
+		respondRoleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseAccountID(c *gin.Context) (uuid.UUID, bool) {
+	accountID, err := uuid.Parse(c.Param("account_id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Invalid account ID",
+		})
+		return uuid.Nil, false
+	}
+
+	return accountID, true
+}
+
+func bindAccountRole(c *gin.Context) (*entity.AccountRoleEntity, bool) {
+	accountID, ok := parseAccountID(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	req := dto.AccountRoleRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return nil, false
+	}
+
+	return &entity.AccountRoleEntity{
+		AccountID:    accountID,
+		Role:         req.Role,
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceID,
+	}, true
+}
+
+// respondRoleError maps the validation errors shared by Assign and Revoke.
+func respondRoleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrUnknownRole):
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "Unknown role",
+		})
+	case errors.Is(err, usecase.ErrInvalidResource):
+		c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+			Status:  http.StatusBadRequest,
+			Message: "resource_id is required for resource roles and must be empty for system roles",
+		})
+	default:
+		respondInternalError(c)
+	}
+}
+
+func respondInternalError(c *gin.Context) {
+	c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+		Status:  http.StatusInternalServerError,
+		Message: "Internal server error",
+	})
+}
+
+func toAccountRoleResponse(accountRole *entity.AccountRoleEntity) dto.AccountRoleResponse {
+	return dto.AccountRoleResponse{
+		ID:           accountRole.ID,
+		Role:         accountRole.Role,
+		ResourceType: accountRole.ResourceType,
+		ResourceID:   accountRole.ResourceID,
+		CreatedAt:    accountRole.CreatedAt,
+	}
+}
@@ -0,0 +1,172 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/role/dto"
+	"trilha-api/internal/role/entity"
+	"trilha-api/internal/role/handler"
+	"trilha-api/internal/role/mocks"
+	"trilha-api/internal/role/repository"
+	usecase "trilha-api/internal/role/use_case"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*gin.Engine, *mocks.MockRoleUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockRoleUseCaseInterface(ctrl)
+	h := handler.New(mock)
+	router := gin.Default()
+
+	router.GET("/api/v1/roles", h.ListRoles)
+	router.GET("/api/v1/roles/accounts/:account_id", h.ListAccountRoles)
+	router.POST("/api/v1/roles/accounts/:account_id", h.Assign)
+	router.DELETE("/api/v1/roles/accounts/:account_id", h.Revoke)
+
+	return router, mock
+}
+
+func sendRole(router *gin.Engine, method, path string, req dto.AccountRoleRequest) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(req)
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(method, path, bytes.NewBuffer(body))
+	r.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func TestRoleHandler_ListRoles(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	mockUseCase.EXPECT().ListRoles().Return([]entity.RoleEntity{
+		{Name: "system_admin", Permissions: []string{"roles:read"}},
+		{Name: "guest"},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/roles", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var responseBody sharedDto.APIResponse[[]dto.RoleResponse]
+	err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"roles:read"}, responseBody.Data[0].Permissions)
+	assert.Equal(t, []string{}, responseBody.Data[1].Permissions)
+}
+
+func TestRoleHandler_ListAccountRoles(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return status 200 and the roles of the account", func(t *testing.T) {
+		mockUseCase.EXPECT().ListAccountRoles(accountID).Return([]entity.AccountRoleEntity{
+			{ID: uuid.New(), AccountID: accountID, Role: "system_admin", ResourceType: "system"},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/roles/accounts/"+accountID.String(), nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[[]dto.AccountRoleResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "system_admin", responseBody.Data[0].Role)
+	})
+
+	t.Run("should return status 400 for an invalid account ID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/roles/accounts/invalid", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestRoleHandler_Assign(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+	workspaceID := uuid.New()
+	path := "/api/v1/roles/accounts/" + accountID.String()
+
+	t.Run("should return status 201 when the role is assigned", func(t *testing.T) {
+		mockUseCase.EXPECT().Assign(gomock.Any()).DoAndReturn(func(accountRole *entity.AccountRoleEntity) error {
+			assert.Equal(t, accountID, accountRole.AccountID)
+			assert.Equal(t, "member", accountRole.Role)
+			assert.Equal(t, "workspace", accountRole.ResourceType)
+			assert.Equal(t, &workspaceID, accountRole.ResourceID)
+			return nil
+		})
+
+		w := sendRole(router, http.MethodPost, path, dto.AccountRoleRequest{Role: "member", ResourceType: "workspace", ResourceID: &workspaceID})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return status 400 without a role", func(t *testing.T) {
+		w := sendRole(router, http.MethodPost, path, dto.AccountRoleRequest{})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 for an unknown role", func(t *testing.T) {
+		mockUseCase.EXPECT().Assign(gomock.Any()).Return(usecase.ErrUnknownRole)
+
+		w := sendRole(router, http.MethodPost, path, dto.AccountRoleRequest{Role: "superuser"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 404 for an unknown account", func(t *testing.T) {
+		mockUseCase.EXPECT().Assign(gomock.Any()).Return(repository.ErrAccountNotFound)
+
+		w := sendRole(router, http.MethodPost, path, dto.AccountRoleRequest{Role: "system_admin"})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 409 when the role is already assigned", func(t *testing.T) {
+		mockUseCase.EXPECT().Assign(gomock.Any()).Return(usecase.ErrRoleAlreadyAssigned)
+
+		w := sendRole(router, http.MethodPost, path, dto.AccountRoleRequest{Role: "system_admin"})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestRoleHandler_Revoke(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	path := "/api/v1/roles/accounts/" + uuid.NewString()
+
+	t.Run("should return status 204 when the role is revoked", func(t *testing.T) {
+		mockUseCase.EXPECT().Revoke(gomock.Any()).Return(nil)
+
+		w := sendRole(router, http.MethodDelete, path, dto.AccountRoleRequest{Role: "system_admin"})
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when the account does not hold the role", func(t *testing.T) {
+		mockUseCase.EXPECT().Revoke(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := sendRole(router, http.MethodDelete, path, dto.AccountRoleRequest{Role: "system_admin"})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: role_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=role_repository.go -destination=../mocks/role_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/role/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleRepositoryInterface is a mock of RoleRepositoryInterface interface.
+type MockRoleRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleRepositoryInterfaceMockRecorder is the mock recorder for MockRoleRepositoryInterface.
+type MockRoleRepositoryInterfaceMockRecorder struct {
+	mock *MockRoleRepositoryInterface
+}
+
+// NewMockRoleRepositoryInterface creates a new mock instance.
+func NewMockRoleRepositoryInterface(ctrl *gomock.Controller) *MockRoleRepositoryInterface {
+	mock := &MockRoleRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockRoleRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleRepositoryInterface) EXPECT() *MockRoleRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockRoleRepositoryInterface) Assign(accountRole *entity.AccountRoleEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", accountRole)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) Assign(accountRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).Assign), accountRole)
+}
+
+// FindByName mocks base method.
+func (m *MockRoleRepositoryInterface) FindByName(role *entity.RoleEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) FindByName(role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).FindByName), role)
+}
+
+// HasPermission mocks base method.
+func (m *MockRoleRepositoryInterface) HasPermission(accountID uuid.UUID, permission, resourceType string, resourceID *uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", accountID, permission, resourceType, resourceID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) HasPermission(accountID, permission, resourceType, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).HasPermission), accountID, permission, resourceType, resourceID)
+}
+
+// ListAccountRoles mocks base method.
+func (m *MockRoleRepositoryInterface) ListAccountRoles(accountID uuid.UUID) ([]entity.AccountRoleEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountRoles", accountID)
+	ret0, _ := ret[0].([]entity.AccountRoleEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountRoles indicates an expected call of ListAccountRoles.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) ListAccountRoles(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountRoles", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).ListAccountRoles), accountID)
+}
+
+// ListPermissions mocks base method.
+func (m *MockRoleRepositoryInterface) ListPermissions() ([]entity.PermissionEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions")
+	ret0, _ := ret[0].([]entity.PermissionEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) ListPermissions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).ListPermissions))
+}
+
+// ListRoles mocks base method.
+func (m *MockRoleRepositoryInterface) ListRoles() ([]entity.RoleEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles")
+	ret0, _ := ret[0].([]entity.RoleEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) ListRoles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).ListRoles))
+}
+
+// Revoke mocks base method.
+func (m *MockRoleRepositoryInterface) Revoke(accountRole *entity.AccountRoleEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", accountRole)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRoleRepositoryInterfaceMockRecorder) Revoke(accountRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRoleRepositoryInterface)(nil).Revoke), accountRole)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: role_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=role_use_case.go -destination=../mocks/role_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/role/entity"
+	authz "trilha-api/internal/shared/authz"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleUseCaseInterface is a mock of RoleUseCaseInterface interface.
+type MockRoleUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleUseCaseInterfaceMockRecorder is the mock recorder for MockRoleUseCaseInterface.
+type MockRoleUseCaseInterfaceMockRecorder struct {
+	mock *MockRoleUseCaseInterface
+}
+
+// NewMockRoleUseCaseInterface creates a new mock instance.
+func NewMockRoleUseCaseInterface(ctrl *gomock.Controller) *MockRoleUseCaseInterface {
+	mock := &MockRoleUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockRoleUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleUseCaseInterface) EXPECT() *MockRoleUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockRoleUseCaseInterface) Assign(accountRole *entity.AccountRoleEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", accountRole)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockRoleUseCaseInterfaceMockRecorder) Assign(accountRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockRoleUseCaseInterface)(nil).Assign), accountRole)
+}
+
+// HasPermission mocks base method.
+func (m *MockRoleUseCaseInterface) HasPermission(accountID uuid.UUID, permission authz.Permission, resource authz.Resource) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", accountID, permission, resource)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockRoleUseCaseInterfaceMockRecorder) HasPermission(accountID, permission, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockRoleUseCaseInterface)(nil).HasPermission), accountID, permission, resource)
+}
+
+// ListAccountRoles mocks base method.
+func (m *MockRoleUseCaseInterface) ListAccountRoles(accountID uuid.UUID) ([]entity.AccountRoleEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountRoles", accountID)
+	ret0, _ := ret[0].([]entity.AccountRoleEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountRoles indicates an expected call of ListAccountRoles.
+func (mr *MockRoleUseCaseInterfaceMockRecorder) ListAccountRoles(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountRoles", reflect.TypeOf((*MockRoleUseCaseInterface)(nil).ListAccountRoles), accountID)
+}
+
+// ListPermissions mocks base method.
+func (m *MockRoleUseCaseInterface) ListPermissions() ([]entity.PermissionEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions")
+	ret0, _ := ret[0].([]entity.PermissionEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockRoleUseCaseInterfaceMockRecorder) ListPermissions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockRoleUseCaseInterface)(nil).ListPermissions))
+}
+
+// ListRoles mocks base method.
+func (m *MockRoleUseCaseInterface) ListRoles() ([]entity.RoleEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles")
+	ret0, _ := ret[0].([]entity.RoleEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockRoleUseCaseInterfaceMockRecorder) ListRoles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRoleUseCaseInterface)(nil).ListRoles))
+}
+
+// Revoke mocks base method.
+func (m *MockRoleUseCaseInterface) Revoke(accountRole *entity.AccountRoleEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", accountRole)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockRoleUseCaseInterfaceMockRecorder) Revoke(accountRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRoleUseCaseInterface)(nil).Revoke), accountRole)
+}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"trilha-api/internal/role/entity"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// foreignKeyViolationCode is the Postgres SQLSTATE for foreign key violations.
+const foreignKeyViolationCode = "23503"
+
+var ErrAccountNotFound = errors.New("account not found")
+
+type RoleRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=role_repository.go -destination=../mocks/role_repository_mock.go -package=mocks
+
+type RoleRepositoryInterface interface {
+	ListRoles() ([]entity.RoleEntity, error)
+	ListPermissions() ([]entity.PermissionEntity, error)
+	FindByName(role *entity.RoleEntity) error
+	HasPermission(accountID uuid.UUID, permission, resourceType string, resourceID *uuid.UUID) (bool, error)
+	ListAccountRoles(accountID uuid.UUID) ([]entity.AccountRoleEntity, error)
+	Assign(accountRole *entity.AccountRoleEntity) (bool, error)
+	Revoke(accountRole *entity.AccountRoleEntity) (bool, error)
+}
+
+func New(db db.Querier) *RoleRepository {
+	return &RoleRepository{db: db}
+}
+
+// ListRoles returns every role together with the names of its permissions.
+func (r *RoleRepository) ListRoles() ([]entity.RoleEntity, error) {
+	roles, err := r.db.ListRoles(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar papéis: %w", err)
+	}
+
+	grants, err := r.db.ListRolePermissions(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar permissões dos papéis: %w", err)
+	}
+
+	permissions := map[string][]string{}
+	for _, grant := range grants {
+		permissions[grant.RoleName] = append(permissions[grant.RoleName], grant.PermissionName)
+	}
+
+	result := make([]entity.RoleEntity, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, entity.RoleEntity{
+			ID:          role.ID,
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: permissions[role.Name],
+			CreatedAt:   role.CreatedAt.Time,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *RoleRepository) ListPermissions() ([]entity.PermissionEntity, error) {
+	permissions, err := r.db.ListPermissions(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar permissões: %w", err)
+	}
+
+	result := make([]entity.PermissionEntity, 0, len(permissions))
+	for _, permission := range permissions {
+		result = append(result, entity.PermissionEntity{
+			ID:          permission.ID,
+			Name:        permission.Name,
+			Description: permission.Description,
+			CreatedAt:   permission.CreatedAt.Time,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *RoleRepository) FindByName(role *entity.RoleEntity) error {
+	found, err := r.db.FindRoleByName(context.Background(), role.Name)
+
+	if err != nil {
+		return err
+	}
+
+	*role = entity.RoleEntity{
+		ID:          found.ID,
+		Name:        found.Name,
+		Description: found.Description,
+		CreatedAt:   found.CreatedAt.Time,
+	}
+
+	return nil
+}
+
+// HasPermission reports whether a role granted to the account, system-wide or
+// on the given resource, holds permission.
+func (r *RoleRepository) HasPermission(accountID uuid.UUID, permission, resourceType string, resourceID *uuid.UUID) (bool, error) {
+	fields := db.AccountHasPermissionParams{
+		AccountID:    accountID,
+		Permission:   permission,
+		ResourceType: resourceType,
+		ResourceID:   utils.UUIDToPgUUID(resourceID),
+	}
+
+	allowed, err := r.db.AccountHasPermission(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao verificar permissão: %w", err)
+	}
+
+	return allowed, nil
+}
+
+func (r *RoleRepository) ListAccountRoles(accountID uuid.UUID) ([]entity.AccountRoleEntity, error) {
+	rows, err := r.db.ListAccountRoles(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar papéis da conta: %w", err)
+	}
+
+	result := make([]entity.AccountRoleEntity, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, entity.AccountRoleEntity{
+			ID:           row.ID,
+			AccountID:    row.AccountID,
+			Role:         row.RoleName,
+			ResourceType: row.ResourceType,
+			ResourceID:   utils.PgUUIDToUUID(row.ResourceID),
+			CreatedAt:    row.CreatedAt.Time,
+		})
+	}
+
+	return result, nil
+}
+
+// Assign grants the role and reports whether it was not granted yet. It fails
+// with ErrAccountNotFound when the account does not exist.
+func (r *RoleRepository) Assign(accountRole *entity.AccountRoleEntity) (bool, error) {
+	fields := db.AssignAccountRoleParams{
+		AccountID:    accountRole.AccountID,
+		RoleID:       accountRole.RoleID,
+		ResourceType: accountRole.ResourceType,
+		ResourceID:   utils.UUIDToPgUUID(accountRole.ResourceID),
+	}
+
+	rows, err := r.db.AssignAccountRole(context.Background(), fields)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return false, ErrAccountNotFound
+		}
+		return false, fmt.Errorf("erro ao atribuir papel: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// Revoke removes the role grant and reports whether it existed.
+func (r *RoleRepository) Revoke(accountRole *entity.AccountRoleEntity) (bool, error) {
+	fields := db.RevokeAccountRoleParams{
+		AccountID:    accountRole.AccountID,
+		RoleID:       accountRole.RoleID,
+		ResourceType: accountRole.ResourceType,
+		ResourceID:   utils.UUIDToPgUUID(accountRole.ResourceID),
+	}
+
+	rows, err := r.db.RevokeAccountRole(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao remover papel: %w", err)
+	}
+
+	return rows > 0, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"trilha-api/internal/role/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*mocks.MockQuerier, *RoleRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := New(dbMock)
+
+	return dbMock, repo
+}
+
+func TestRoleRepository_ListRoles(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	dbMock.EXPECT().ListRoles(context.Background()).Return([]db.Role{
+		{ID: uuid.New(), Name: "system_admin"},
+		{ID: uuid.New(), Name: "guest"},
+	}, nil)
+	dbMock.EXPECT().ListRolePermissions(context.Background()).Return([]db.ListRolePermissionsRow{
+		{RoleName: "system_admin", PermissionName: "accounts:restore"},
+		{RoleName: "system_admin", PermissionName: "roles:read"},
+	}, nil)
+
+	roles, err := repo.ListRoles()
+
+	assert.NoError(t, err)
+	assert.Len(t, roles, 2)
+	assert.Equal(t, []string{"accounts:restore", "roles:read"}, roles[0].Permissions)
+	assert.Empty(t, roles[1].Permissions)
+}
+
+func TestRoleRepository_HasPermission(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	accountID := uuid.New()
+	resourceID := uuid.New()
+
+	t.Run("should look up a system permission with a null resource ID", func(t *testing.T) {
+		dbMock.EXPECT().AccountHasPermission(context.Background(), db.AccountHasPermissionParams{
+			AccountID:    accountID,
+			Permission:   "roles:read",
+			ResourceType: "system",
+		}).Return(true, nil)
+
+		allowed, err := repo.HasPermission(accountID, "roles:read", "system", nil)
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("should pass the resource ID of a resource permission", func(t *testing.T) {
+		dbMock.EXPECT().AccountHasPermission(context.Background(), db.AccountHasPermissionParams{
+			AccountID:    accountID,
+			Permission:   "accounts:restore",
+			ResourceType: "account",
+			ResourceID:   utils.UUIDToPgUUID(&resourceID),
+		}).Return(false, nil)
+
+		allowed, err := repo.HasPermission(accountID, "accounts:restore", "account", &resourceID)
+
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
+
+func TestRoleRepository_ListAccountRoles(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	accountID := uuid.New()
+	resourceID := uuid.New()
+
+	dbMock.EXPECT().ListAccountRoles(context.Background(), accountID).Return([]db.ListAccountRolesRow{
+		{ID: uuid.New(), AccountID: accountID, RoleName: "system_admin", ResourceType: "system"},
+		{ID: uuid.New(), AccountID: accountID, RoleName: "member", ResourceType: "workspace", ResourceID: pgtype.UUID{Bytes: resourceID, Valid: true}},
+	}, nil)
+
+	roles, err := repo.ListAccountRoles(accountID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, roles[0].ResourceID)
+	assert.Equal(t, &resourceID, roles[1].ResourceID)
+}
+
+func TestRoleRepository_Assign(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	accountRole := &entity.AccountRoleEntity{AccountID: uuid.New(), RoleID: uuid.New(), ResourceType: "system"}
+	params := db.AssignAccountRoleParams{
+		AccountID:    accountRole.AccountID,
+		RoleID:       accountRole.RoleID,
+		ResourceType: "system",
+	}
+
+	t.Run("should report a new assignment", func(t *testing.T) {
+		dbMock.EXPECT().AssignAccountRole(context.Background(), params).Return(int64(1), nil)
+
+		assigned, err := repo.Assign(accountRole)
+
+		assert.NoError(t, err)
+		assert.True(t, assigned)
+	})
+
+	t.Run("should report an existing assignment", func(t *testing.T) {
+		dbMock.EXPECT().AssignAccountRole(context.Background(), params).Return(int64(0), nil)
+
+		assigned, err := repo.Assign(accountRole)
+
+		assert.NoError(t, err)
+		assert.False(t, assigned)
+	})
+
+	t.Run("should return ErrAccountNotFound on a foreign key violation", func(t *testing.T) {
+		dbMock.EXPECT().AssignAccountRole(context.Background(), params).Return(int64(0), &pgconn.PgError{Code: foreignKeyViolationCode})
+
+		_, err := repo.Assign(accountRole)
+
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+	})
+
+	t.Run("should wrap other errors", func(t *testing.T) {
+		dbMock.EXPECT().AssignAccountRole(context.Background(), params).Return(int64(0), errors.New("db down"))
+
+		_, err := repo.Assign(accountRole)
+
+		assert.ErrorContains(t, err, "erro ao atribuir papel")
+	})
+}
+
+func TestRoleRepository_Revoke(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	resourceID := uuid.New()
+	accountRole := &entity.AccountRoleEntity{AccountID: uuid.New(), RoleID: uuid.New(), ResourceType: "workspace", ResourceID: &resourceID}
+
+	dbMock.EXPECT().RevokeAccountRole(context.Background(), db.RevokeAccountRoleParams{
+		AccountID:    accountRole.AccountID,
+		RoleID:       accountRole.RoleID,
+		ResourceType: "workspace",
+		ResourceID:   utils.UUIDToPgUUID(&resourceID),
+	}).Return(int64(0), nil)
+
+	revoked, err := repo.Revoke(accountRole)
+
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+}
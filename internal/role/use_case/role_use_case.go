@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"trilha-api/internal/role/entity"
+	"trilha-api/internal/role/repository"
+	"trilha-api/internal/shared/authz"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownRole         = errors.New("unknown role")
+	ErrInvalidResource     = errors.New("invalid resource")
+	ErrRoleAlreadyAssigned = errors.New("role already assigned")
+)
+
+//go:generate mockgen -source=role_use_case.go -destination=../mocks/role_use_case_mock.go -package=mocks
+type RoleUseCaseInterface interface {
+	ListRoles() ([]entity.RoleEntity, error)
+	ListPermissions() ([]entity.PermissionEntity, error)
+	ListAccountRoles(accountID uuid.UUID) ([]entity.AccountRoleEntity, error)
+	Assign(accountRole *entity.AccountRoleEntity) error
+	Revoke(accountRole *entity.AccountRoleEntity) error
+	HasPermission(accountID uuid.UUID, permission authz.Permission, resource authz.Resource) (bool, error)
+}
+
+type RoleUseCase struct {
+	repo repository.RoleRepositoryInterface
+}
+
+func New(repo repository.RoleRepositoryInterface) *RoleUseCase {
+	return &RoleUseCase{repo: repo}
+}
+
+func (uc *RoleUseCase) ListRoles() ([]entity.RoleEntity, error) {
+	return uc.repo.ListRoles()
+}
+
+func (uc *RoleUseCase) ListPermissions() ([]entity.PermissionEntity, error) {
+	return uc.repo.ListPermissions()
+}
+
+func (uc *RoleUseCase) ListAccountRoles(accountID uuid.UUID) ([]entity.AccountRoleEntity, error) {
+	return uc.repo.ListAccountRoles(accountID)
+}
+
+// Assign grants the role named by accountRole.Role. An empty resource type
+// grants it system-wide.
+func (uc *RoleUseCase) Assign(accountRole *entity.AccountRoleEntity) error {
+	if err := uc.resolve(accountRole); err != nil {
+		return err
+	}
+
+	assigned, err := uc.repo.Assign(accountRole)
+	if err != nil {
+		return err
+	}
+	if !assigned {
+		return ErrRoleAlreadyAssigned
+	}
+
+	return nil
+}
+
+// Revoke removes a role grant, failing with sql.ErrNoRows when the account
+// does not hold it.
+func (uc *RoleUseCase) Revoke(accountRole *entity.AccountRoleEntity) error {
+	if err := uc.resolve(accountRole); err != nil {
+		return err
+	}
+
+	revoked, err := uc.repo.Revoke(accountRole)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// HasPermission implements authz.PermissionChecker.
+func (uc *RoleUseCase) HasPermission(accountID uuid.UUID, permission authz.Permission, resource authz.Resource) (bool, error) {
+	var resourceID *uuid.UUID
+	if !resource.IsSystem() {
+		resourceID = &resource.ID
+	}
+
+	return uc.repo.HasPermission(accountID, string(permission), resource.Type, resourceID)
+}
+
+// resolve fills the role ID of accountRole and checks that system grants have
+// no resource ID while resource grants do.
+func (uc *RoleUseCase) resolve(accountRole *entity.AccountRoleEntity) error {
+	if accountRole.ResourceType == "" {
+		accountRole.ResourceType = authz.ResourceTypeSystem
+	}
+
+	if (accountRole.ResourceType == authz.ResourceTypeSystem) != (accountRole.ResourceID == nil) {
+		return ErrInvalidResource
+	}
+
+	role := &entity.RoleEntity{Name: accountRole.Role}
+
+	if err := uc.repo.FindByName(role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUnknownRole
+		}
+		return err
+	}
+
+	accountRole.RoleID = role.ID
+
+	return nil
+}
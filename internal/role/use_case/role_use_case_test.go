@@ -0,0 +1,127 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"testing"
+	"trilha-api/internal/role/entity"
+	"trilha-api/internal/role/mocks"
+	usecase "trilha-api/internal/role/use_case"
+	"trilha-api/internal/shared/authz"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*mocks.MockRoleRepositoryInterface, *usecase.RoleUseCase) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockRoleRepositoryInterface(ctrl)
+
+	return repo, usecase.New(repo)
+}
+
+func expectRole(repo *mocks.MockRoleRepositoryInterface, name string, id uuid.UUID) {
+	repo.EXPECT().FindByName(gomock.Any()).DoAndReturn(func(role *entity.RoleEntity) error {
+		if role.Name != name {
+			return sql.ErrNoRows
+		}
+		role.ID = id
+		return nil
+	})
+}
+
+func TestRoleUseCase_Assign(t *testing.T) {
+	repo, uc := setup(t)
+
+	roleID := uuid.New()
+	workspaceID := uuid.New()
+
+	t.Run("should grant a system role when no resource is given", func(t *testing.T) {
+		accountRole := &entity.AccountRoleEntity{AccountID: uuid.New(), Role: "system_admin"}
+
+		expectRole(repo, "system_admin", roleID)
+		repo.EXPECT().Assign(accountRole).Return(true, nil)
+
+		err := uc.Assign(accountRole)
+
+		assert.NoError(t, err)
+		assert.Equal(t, roleID, accountRole.RoleID)
+		assert.Equal(t, authz.ResourceTypeSystem, accountRole.ResourceType)
+	})
+
+	t.Run("should grant a role on a resource", func(t *testing.T) {
+		accountRole := &entity.AccountRoleEntity{AccountID: uuid.New(), Role: "member", ResourceType: "workspace", ResourceID: &workspaceID}
+
+		expectRole(repo, "member", roleID)
+		repo.EXPECT().Assign(accountRole).Return(true, nil)
+
+		assert.NoError(t, uc.Assign(accountRole))
+	})
+
+	t.Run("should return ErrRoleAlreadyAssigned when the grant exists", func(t *testing.T) {
+		accountRole := &entity.AccountRoleEntity{AccountID: uuid.New(), Role: "member", ResourceType: "workspace", ResourceID: &workspaceID}
+
+		expectRole(repo, "member", roleID)
+		repo.EXPECT().Assign(accountRole).Return(false, nil)
+
+		assert.ErrorIs(t, uc.Assign(accountRole), usecase.ErrRoleAlreadyAssigned)
+	})
+
+	t.Run("should return ErrUnknownRole for roles outside the catalogue", func(t *testing.T) {
+		expectRole(repo, "member", roleID)
+
+		err := uc.Assign(&entity.AccountRoleEntity{AccountID: uuid.New(), Role: "superuser"})
+
+		assert.ErrorIs(t, err, usecase.ErrUnknownRole)
+	})
+
+	t.Run("should return ErrInvalidResource for a resource role without ID", func(t *testing.T) {
+		err := uc.Assign(&entity.AccountRoleEntity{AccountID: uuid.New(), Role: "member", ResourceType: "workspace"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidResource)
+	})
+
+	t.Run("should return ErrInvalidResource for a system role with ID", func(t *testing.T) {
+		err := uc.Assign(&entity.AccountRoleEntity{AccountID: uuid.New(), Role: "system_admin", ResourceID: &workspaceID})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidResource)
+	})
+}
+
+func TestRoleUseCase_Revoke(t *testing.T) {
+	repo, uc := setup(t)
+
+	roleID := uuid.New()
+	accountRole := &entity.AccountRoleEntity{AccountID: uuid.New(), Role: "system_admin"}
+
+	expectRole(repo, "system_admin", roleID)
+	repo.EXPECT().Revoke(accountRole).Return(false, nil)
+
+	assert.ErrorIs(t, uc.Revoke(accountRole), sql.ErrNoRows)
+}
+
+func TestRoleUseCase_HasPermission(t *testing.T) {
+	repo, uc := setup(t)
+
+	accountID := uuid.New()
+	resourceID := uuid.New()
+
+	t.Run("should check system permissions without resource ID", func(t *testing.T) {
+		repo.EXPECT().HasPermission(accountID, "roles:read", authz.ResourceTypeSystem, (*uuid.UUID)(nil)).Return(true, nil)
+
+		allowed, err := uc.HasPermission(accountID, authz.PermissionRolesRead, authz.System())
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("should check resource permissions with the resource ID", func(t *testing.T) {
+		repo.EXPECT().HasPermission(accountID, "accounts:restore", "account", &resourceID).Return(false, nil)
+
+		allowed, err := uc.HasPermission(accountID, authz.PermissionAccountsRestore, authz.NewResource("account", resourceID))
+
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
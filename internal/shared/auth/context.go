@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+type principalContextKey struct{}
+
+const principalKey = "auth.principal"
+
+// SetPrincipal stores the authenticated principal on both the gin context and
+// the underlying request context, so it is reachable from handlers and from
+// code that only receives a context.Context.
+func SetPrincipal(c *gin.Context, principal *Principal) {
+	c.Set(principalKey, principal)
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), principalContextKey{}, principal))
+}
+
+func CurrentPrincipal(c *gin.Context) (*Principal, bool) {
+	value, ok := c.Get(principalKey)
+	if !ok {
+		return nil, false
+	}
+
+	principal, ok := value.(*Principal)
+	return principal, ok && principal != nil
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,24 @@
+package auth
+
+import "github.com/google/uuid"
+
+// ImpersonatedRequest is a request made while an administrator acted as
+// another account, as kept in the audit trail of the impersonation.
+type ImpersonatedRequest struct {
+	ImpersonationID uuid.UUID
+	ActorID         uuid.UUID
+	AccountID       uuid.UUID
+	Method          string
+	Path            string
+	Status          int
+	IPAddress       string
+	UserAgent       string
+}
+
+// ImpersonationTracker checks that the impersonation an access token was
+// issued for is still running and keeps the audit trail of its requests.
+// Ended and expired impersonations fail with ErrInvalidToken.
+type ImpersonationTracker interface {
+	VerifyImpersonation(principal *Principal) error
+	RecordImpersonatedRequest(request ImpersonatedRequest) error
+}
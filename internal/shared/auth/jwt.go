@@ -0,0 +1,253 @@
+package auth
+
+import (
+	"errors"
+	"slices"
+	"time"
+	"trilha-api/internal/shared/config"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// accessTokenAudience tells access tokens apart from the action tokens signed
+// with the same secret, which use their purpose as audience.
+const accessTokenAudience = "access"
+
+const (
+	PurposeEmailVerification = "email_verification"
+	PurposeTwoFactorSignIn   = "two_factor_sign_in"
+	PurposeAccountUnlock     = "account_unlock"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type Principal struct {
+	AccountID uuid.UUID
+	Email     string
+	Verified  bool
+
+	// SessionID is the session the access token was issued for.
+	SessionID uuid.UUID
+
+	// PersonalAccessTokenID and Scopes are only set when the request was
+	// authenticated with a personal access token.
+	PersonalAccessTokenID uuid.UUID
+	Scopes                []string
+
+	// ImpersonatorID and ImpersonationID are only set while an administrator
+	// acts as the account: ImpersonatorID is the administrator, the real
+	// actor behind the request.
+	ImpersonatorID  uuid.UUID
+	ImpersonationID uuid.UUID
+}
+
+func (p *Principal) IsPersonalAccessToken() bool {
+	return p.PersonalAccessTokenID != uuid.Nil
+}
+
+func (p *Principal) IsImpersonated() bool {
+	return p.ImpersonationID != uuid.Nil
+}
+
+// ActorID returns the account really making the request: the administrator
+// during an impersonation, the account itself otherwise.
+func (p *Principal) ActorID() uuid.UUID {
+	if p.IsImpersonated() {
+		return p.ImpersonatorID
+	}
+	return p.AccountID
+}
+
+// HasScope reports whether the principal may act within scope. Sessions are
+// not scoped and hold every scope.
+func (p *Principal) HasScope(scope string) bool {
+	return !p.IsPersonalAccessToken() || slices.Contains(p.Scopes, scope)
+}
+
+// ActionToken is a signed, self-contained token sent to users to confirm a
+// single action, such as verifying an email address.
+type ActionToken struct {
+	Purpose   string
+	AccountID uuid.UUID
+	Email     string
+}
+
+type TokenManager interface {
+	GenerateAccessToken(principal Principal) (string, time.Time, error)
+	GenerateImpersonationToken(principal Principal, expiresAt time.Time) (string, error)
+	ParseAccessToken(token string) (*Principal, error)
+	GenerateActionToken(action ActionToken, ttl time.Duration) (string, error)
+	ParseActionToken(purpose, token string) (*ActionToken, error)
+	RefreshTokenTTL() time.Duration
+}
+
+type accessTokenClaims struct {
+	Email     string       `json:"email"`
+	Verified  bool         `json:"evf,omitempty"`
+	SessionID uuid.UUID    `json:"sid"`
+	Actor     *actorClaims `json:"act,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// actorClaims names the administrator acting as the subject of an access
+// token, after the actor claim of RFC 8693.
+type actorClaims struct {
+	Subject         uuid.UUID `json:"sub"`
+	ImpersonationID uuid.UUID `json:"imp"`
+}
+
+type actionTokenClaims struct {
+	Email string `json:"email,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type JWTManager struct {
+	secret          []byte
+	issuer          string
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+}
+
+func NewJWTManager(cfg config.AuthConfig) *JWTManager {
+	return &JWTManager{
+		secret:          []byte(cfg.JWTSecret),
+		issuer:          cfg.JWTIssuer,
+		accessTokenTTL:  cfg.AccessTokenTTL,
+		refreshTokenTTL: cfg.RefreshTokenTTL,
+	}
+}
+
+func (m *JWTManager) GenerateAccessToken(principal Principal) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.accessTokenTTL)
+
+	claims := accessTokenClaims{
+		Email:            principal.Email,
+		Verified:         principal.Verified,
+		SessionID:        principal.SessionID,
+		RegisteredClaims: m.registeredClaims(principal.AccountID, accessTokenAudience, now, expiresAt),
+	}
+
+	token, err := m.sign(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiresAt, nil
+}
+
+// GenerateImpersonationToken issues an access token for principal, which
+// must name its impersonator and impersonation, lasting until expiresAt.
+// Impersonations get no refresh token and are not tied to a session.
+func (m *JWTManager) GenerateImpersonationToken(principal Principal, expiresAt time.Time) (string, error) {
+	if !principal.IsImpersonated() {
+		return "", errors.New("principal is not impersonated")
+	}
+
+	claims := accessTokenClaims{
+		Email:    principal.Email,
+		Verified: principal.Verified,
+		Actor: &actorClaims{
+			Subject:         principal.ImpersonatorID,
+			ImpersonationID: principal.ImpersonationID,
+		},
+		RegisteredClaims: m.registeredClaims(principal.AccountID, accessTokenAudience, time.Now(), expiresAt),
+	}
+
+	return m.sign(claims)
+}
+
+func (m *JWTManager) ParseAccessToken(token string) (*Principal, error) {
+	claims := &accessTokenClaims{}
+
+	accountID, err := m.parse(token, accessTokenAudience, claims, &claims.RegisteredClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	principal := &Principal{
+		AccountID: accountID,
+		Email:     claims.Email,
+		Verified:  claims.Verified,
+		SessionID: claims.SessionID,
+	}
+
+	if claims.Actor != nil {
+		if claims.Actor.Subject == uuid.Nil || claims.Actor.ImpersonationID == uuid.Nil {
+			return nil, ErrInvalidToken
+		}
+		principal.ImpersonatorID = claims.Actor.Subject
+		principal.ImpersonationID = claims.Actor.ImpersonationID
+	}
+
+	return principal, nil
+}
+
+func (m *JWTManager) GenerateActionToken(action ActionToken, ttl time.Duration) (string, error) {
+	now := time.Now()
+
+	claims := actionTokenClaims{
+		Email:            action.Email,
+		RegisteredClaims: m.registeredClaims(action.AccountID, action.Purpose, now, now.Add(ttl)),
+	}
+
+	return m.sign(claims)
+}
+
+func (m *JWTManager) ParseActionToken(purpose, token string) (*ActionToken, error) {
+	claims := &actionTokenClaims{}
+
+	accountID, err := m.parse(token, purpose, claims, &claims.RegisteredClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ActionToken{
+		Purpose:   purpose,
+		AccountID: accountID,
+		Email:     claims.Email,
+	}, nil
+}
+
+func (m *JWTManager) RefreshTokenTTL() time.Duration {
+	return m.refreshTokenTTL
+}
+
+func (m *JWTManager) registeredClaims(subject uuid.UUID, audience string, issuedAt, expiresAt time.Time) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Issuer:    m.issuer,
+		Subject:   subject.String(),
+		Audience:  jwt.ClaimStrings{audience},
+		ID:        uuid.NewString(),
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		NotBefore: jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+}
+
+func (m *JWTManager) sign(claims jwt.Claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+}
+
+// parse validates token for the given audience and returns its subject.
+func (m *JWTManager) parse(token, audience string, claims jwt.Claims, registered *jwt.RegisteredClaims) (uuid.UUID, error) {
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return uuid.Nil, ErrInvalidToken
+	}
+
+	subject, err := uuid.Parse(registered.Subject)
+	if err != nil {
+		return uuid.Nil, ErrInvalidToken
+	}
+
+	return subject, nil
+}
@@ -0,0 +1,128 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newManager(secret string, ttl time.Duration) *auth.JWTManager {
+	return auth.NewJWTManager(config.AuthConfig{
+		JWTSecret:       secret,
+		JWTIssuer:       "trilha-api",
+		AccessTokenTTL:  ttl,
+		RefreshTokenTTL: time.Hour,
+	})
+}
+
+func TestJWTManager_AccessToken(t *testing.T) {
+	manager := newManager("test-secret", time.Minute)
+
+	t.Run("should parse a token it generated", func(t *testing.T) {
+		principal := auth.Principal{AccountID: uuid.New(), Email: "gandalf@lor.com.br", SessionID: uuid.New()}
+
+		token, expiresAt, err := manager.GenerateAccessToken(principal)
+		assert.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), expiresAt, time.Second)
+
+		parsed, err := manager.ParseAccessToken(token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, principal, *parsed)
+	})
+
+	t.Run("should reject a token signed with another secret", func(t *testing.T) {
+		token, _, _ := newManager("other-secret", time.Minute).GenerateAccessToken(auth.Principal{AccountID: uuid.New()})
+
+		_, err := manager.ParseAccessToken(token)
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("should reject an expired token", func(t *testing.T) {
+		token, _, _ := newManager("test-secret", -time.Minute).GenerateAccessToken(auth.Principal{AccountID: uuid.New()})
+
+		_, err := manager.ParseAccessToken(token)
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+}
+
+func TestJWTManager_ImpersonationToken(t *testing.T) {
+	manager := newManager("test-secret", time.Minute)
+
+	t.Run("should carry both identities until the given expiry", func(t *testing.T) {
+		principal := auth.Principal{
+			AccountID:       uuid.New(),
+			Email:           "frodo@lor.com.br",
+			Verified:        true,
+			ImpersonatorID:  uuid.New(),
+			ImpersonationID: uuid.New(),
+		}
+
+		token, err := manager.GenerateImpersonationToken(principal, time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+
+		parsed, err := manager.ParseAccessToken(token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, principal, *parsed)
+		assert.True(t, parsed.IsImpersonated())
+		assert.Equal(t, principal.ImpersonatorID, parsed.ActorID())
+	})
+
+	t.Run("should refuse a principal without impersonator", func(t *testing.T) {
+		_, err := manager.GenerateImpersonationToken(auth.Principal{AccountID: uuid.New()}, time.Now().Add(time.Hour))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject an expired impersonation", func(t *testing.T) {
+		principal := auth.Principal{AccountID: uuid.New(), ImpersonatorID: uuid.New(), ImpersonationID: uuid.New()}
+		token, _ := manager.GenerateImpersonationToken(principal, time.Now().Add(-time.Minute))
+
+		_, err := manager.ParseAccessToken(token)
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+}
+
+func TestJWTManager_ActionToken(t *testing.T) {
+	manager := newManager("test-secret", time.Minute)
+
+	action := auth.ActionToken{
+		Purpose:   auth.PurposeEmailVerification,
+		AccountID: uuid.New(),
+		Email:     "gandalf@lor.com.br",
+	}
+
+	t.Run("should parse a token for the same purpose", func(t *testing.T) {
+		token, err := manager.GenerateActionToken(action, time.Hour)
+		assert.NoError(t, err)
+
+		parsed, err := manager.ParseActionToken(auth.PurposeEmailVerification, token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, action, *parsed)
+	})
+
+	t.Run("should reject a token issued for another purpose", func(t *testing.T) {
+		token, _ := manager.GenerateActionToken(action, time.Hour)
+
+		_, err := manager.ParseActionToken("other_purpose", token)
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("should not accept an action token as access token", func(t *testing.T) {
+		token, _ := manager.GenerateActionToken(action, time.Hour)
+
+		_, err := manager.ParseAccessToken(token)
+
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+}
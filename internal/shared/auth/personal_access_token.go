@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+)
+
+// PersonalAccessTokenPrefix starts every personal access token, so they can be
+// told apart from JWTs without a lookup and found by secret scanners.
+const PersonalAccessTokenPrefix = "trl_pat_"
+
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+// PersonalAccessTokenAuthenticator resolves a personal access token into the
+// principal of its account. Unknown, expired and revoked tokens fail with
+// ErrInvalidToken.
+type PersonalAccessTokenAuthenticator interface {
+	AuthenticatePersonalAccessToken(token string) (*Principal, error)
+}
+
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
+
+// ScopeForMethod returns the scope a personal access token needs to make a
+// request with the given HTTP method.
+func ScopeForMethod(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ScopeRead
+	default:
+		return ScopeWrite
+	}
+}
@@ -0,0 +1,106 @@
+// Package authz decides whether a principal may act on a resource, based on
+// the roles granted to its account and the permissions of those roles.
+package authz
+
+import (
+	"errors"
+	"trilha-api/internal/shared/auth"
+
+	"github.com/google/uuid"
+)
+
+// Permission names an action in the permission catalogue stored in the
+// permissions table. New permissions must be added there by a migration.
+type Permission string
+
+const (
+	PermissionAccountsRestore       Permission = "accounts:restore"
+	PermissionAccountsUnlock        Permission = "accounts:unlock"
+	PermissionAccountsRead          Permission = "accounts:read"
+	PermissionAccountsSuspend       Permission = "accounts:suspend"
+	PermissionAccountsResetPassword Permission = "accounts:reset_password"
+	PermissionAccountsDelete        Permission = "accounts:delete"
+	PermissionAccountsImpersonate   Permission = "accounts:impersonate"
+	PermissionAccountsExport        Permission = "accounts:export"
+	PermissionAccountsErase         Permission = "accounts:erase"
+	PermissionRolesRead             Permission = "roles:read"
+	PermissionRolesAssign           Permission = "roles:assign"
+	PermissionWorkspacesRead        Permission = "workspaces:read"
+	PermissionWorkspacesUpdate      Permission = "workspaces:update"
+	PermissionWorkspacesDelete      Permission = "workspaces:delete"
+	PermissionWorkspacesMembers     Permission = "workspaces:manage_members"
+	PermissionProjectsRead          Permission = "projects:read"
+	PermissionProjectsWrite         Permission = "projects:write"
+	PermissionProjectsComment       Permission = "projects:comment"
+	PermissionProjectsMembers       Permission = "projects:manage_members"
+	PermissionTeamsRead             Permission = "teams:read"
+	PermissionTeamsManage           Permission = "teams:manage"
+	PermissionTeamsMembers          Permission = "teams:manage_members"
+)
+
+// ResourceTypeSystem is the resource of roles granted on the whole platform.
+const ResourceTypeSystem = "system"
+
+var ErrForbidden = errors.New("forbidden")
+
+// Resource is the target of an action. Roles granted on the system resource
+// apply to every resource, and roles granted on the parent of a resource,
+// such as the workspace of a project, apply to it too.
+type Resource struct {
+	Type   string
+	ID     uuid.UUID
+	Parent *Resource
+}
+
+func System() Resource {
+	return Resource{Type: ResourceTypeSystem}
+}
+
+func NewResource(resourceType string, id uuid.UUID) Resource {
+	return Resource{Type: resourceType, ID: id}
+}
+
+// Within returns the resource as a child of parent.
+func (r Resource) Within(parent Resource) Resource {
+	r.Parent = &parent
+	return r
+}
+
+func (r Resource) IsSystem() bool {
+	return r.Type == ResourceTypeSystem
+}
+
+// PermissionChecker looks up whether the roles of an account grant a
+// permission on a resource.
+type PermissionChecker interface {
+	HasPermission(accountID uuid.UUID, permission Permission, resource Resource) (bool, error)
+}
+
+type Policy struct {
+	checker PermissionChecker
+}
+
+func NewPolicy(checker PermissionChecker) *Policy {
+	return &Policy{checker: checker}
+}
+
+// Authorize returns ErrForbidden unless principal may perform permission on
+// resource or on one of its parents.
+func (p *Policy) Authorize(principal *auth.Principal, permission Permission, resource Resource) error {
+	if principal == nil {
+		return ErrForbidden
+	}
+
+	for target := &resource; target != nil; target = target.Parent {
+		allowed, err := p.checker.HasPermission(principal.AccountID, permission, *target)
+		if err != nil {
+			return err
+		}
+
+		if allowed {
+			return nil
+		}
+	}
+
+	return ErrForbidden
+}
@@ -0,0 +1,84 @@
+package authz_test
+
+import (
+	"errors"
+	"testing"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/authz"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type checkerFunc func(uuid.UUID, authz.Permission, authz.Resource) (bool, error)
+
+func (f checkerFunc) HasPermission(accountID uuid.UUID, permission authz.Permission, resource authz.Resource) (bool, error) {
+	return f(accountID, permission, resource)
+}
+
+func TestPolicy_Authorize(t *testing.T) {
+	principal := &auth.Principal{AccountID: uuid.New()}
+	resource := authz.NewResource("account", uuid.New())
+
+	t.Run("should allow when the checker grants the permission", func(t *testing.T) {
+		policy := authz.NewPolicy(checkerFunc(func(accountID uuid.UUID, permission authz.Permission, r authz.Resource) (bool, error) {
+			assert.Equal(t, principal.AccountID, accountID)
+			assert.Equal(t, authz.PermissionAccountsRestore, permission)
+			assert.Equal(t, resource, r)
+			return true, nil
+		}))
+
+		assert.NoError(t, policy.Authorize(principal, authz.PermissionAccountsRestore, resource))
+	})
+
+	t.Run("should forbid when the checker denies the permission", func(t *testing.T) {
+		policy := authz.NewPolicy(checkerFunc(func(uuid.UUID, authz.Permission, authz.Resource) (bool, error) {
+			return false, nil
+		}))
+
+		assert.ErrorIs(t, policy.Authorize(principal, authz.PermissionAccountsRestore, resource), authz.ErrForbidden)
+	})
+
+	t.Run("should allow when the checker grants the permission on a parent", func(t *testing.T) {
+		workspace := authz.NewResource("workspace", uuid.New())
+		project := authz.NewResource("project", uuid.New()).Within(workspace)
+		var checked []string
+
+		policy := authz.NewPolicy(checkerFunc(func(_ uuid.UUID, _ authz.Permission, r authz.Resource) (bool, error) {
+			checked = append(checked, r.Type)
+			return r.Type == "workspace" && r.ID == workspace.ID, nil
+		}))
+
+		assert.NoError(t, policy.Authorize(principal, authz.PermissionProjectsWrite, project))
+		assert.Equal(t, []string{"project", "workspace"}, checked)
+	})
+
+	t.Run("should forbid when neither the resource nor its parents grant the permission", func(t *testing.T) {
+		project := authz.NewResource("project", uuid.New()).Within(authz.NewResource("workspace", uuid.New()))
+
+		policy := authz.NewPolicy(checkerFunc(func(uuid.UUID, authz.Permission, authz.Resource) (bool, error) {
+			return false, nil
+		}))
+
+		assert.ErrorIs(t, policy.Authorize(principal, authz.PermissionProjectsWrite, project), authz.ErrForbidden)
+	})
+
+	t.Run("should forbid anonymous callers", func(t *testing.T) {
+		policy := authz.NewPolicy(checkerFunc(func(uuid.UUID, authz.Permission, authz.Resource) (bool, error) {
+			return true, nil
+		}))
+
+		assert.ErrorIs(t, policy.Authorize(nil, authz.PermissionAccountsRestore, resource), authz.ErrForbidden)
+	})
+
+	t.Run("should return lookup errors", func(t *testing.T) {
+		policy := authz.NewPolicy(checkerFunc(func(uuid.UUID, authz.Permission, authz.Resource) (bool, error) {
+			return false, errors.New("database error")
+		}))
+
+		err := policy.Authorize(principal, authz.PermissionAccountsRestore, resource)
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, authz.ErrForbidden)
+	})
+}
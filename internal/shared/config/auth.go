@@ -0,0 +1,95 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// Ways to handle the local part of emails, before the @, when normalizing
+// them. The domain is always lowercased.
+const (
+	EmailLocalPartPreserve  = "preserve"
+	EmailLocalPartLowercase = "lowercase"
+)
+
+type AuthConfig struct {
+	JWTSecret       string
+	JWTIssuer       string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+
+	PasswordResetTokenTTL time.Duration
+
+	EmailVerificationTokenTTL       time.Duration
+	EmailVerificationResendInterval time.Duration
+
+	EmailChangeTokenTTL time.Duration
+
+	// EmailLocalPart is how the local part of emails is stored: as typed
+	// (EmailLocalPartPreserve) or lowercased (EmailLocalPartLowercase).
+	// Either way, emails are compared case-insensitively.
+	EmailLocalPart string
+
+	TwoFactorIssuer       string
+	TwoFactorChallengeTTL time.Duration
+
+	// Sign-in throttling: every failure delays the next attempt, doubling
+	// from SignInDelayBase up to SignInDelayMax, and reaching the maximum
+	// number of failures within SignInFailureWindow locks sign-in for
+	// SignInLockoutDuration.
+	SignInMaxFailures     int
+	SignInIPMaxFailures   int
+	SignInFailureWindow   time.Duration
+	SignInLockoutDuration time.Duration
+	SignInDelayBase       time.Duration
+	SignInDelayMax        time.Duration
+
+	// Impersonation: administrators may act as another account for up to
+	// ImpersonationTTL. While ImpersonationReadOnly is set, requests that
+	// change data are refused during an impersonation.
+	ImpersonationTTL      time.Duration
+	ImpersonationReadOnly bool
+}
+
+var Auth AuthConfig
+
+func LoadAuthConfig() {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		log.Fatal("JWT_SECRET não configurado")
+	}
+
+	Auth = AuthConfig{
+		JWTSecret:       secret,
+		JWTIssuer:       getEnv("JWT_ISSUER", "trilha-api"),
+		AccessTokenTTL:  getEnvDuration("JWT_ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL: getEnvDuration("JWT_REFRESH_TOKEN_TTL", 30*24*time.Hour),
+
+		PasswordResetTokenTTL: getEnvDuration("PASSWORD_RESET_TOKEN_TTL", time.Hour),
+
+		EmailVerificationTokenTTL:       getEnvDuration("EMAIL_VERIFICATION_TOKEN_TTL", 24*time.Hour),
+		EmailVerificationResendInterval: getEnvDuration("EMAIL_VERIFICATION_RESEND_INTERVAL", time.Minute),
+
+		EmailChangeTokenTTL: getEnvDuration("EMAIL_CHANGE_TOKEN_TTL", 24*time.Hour),
+
+		EmailLocalPart: getEnv("EMAIL_LOCAL_PART", EmailLocalPartPreserve),
+
+		TwoFactorIssuer:       getEnv("TWO_FACTOR_ISSUER", "Trilha"),
+		TwoFactorChallengeTTL: getEnvDuration("TWO_FACTOR_CHALLENGE_TTL", 5*time.Minute),
+
+		SignInMaxFailures:     getEnvInt("SIGN_IN_MAX_FAILURES", 5),
+		SignInIPMaxFailures:   getEnvInt("SIGN_IN_IP_MAX_FAILURES", 20),
+		SignInFailureWindow:   getEnvDuration("SIGN_IN_FAILURE_WINDOW", 15*time.Minute),
+		SignInLockoutDuration: getEnvDuration("SIGN_IN_LOCKOUT_DURATION", 15*time.Minute),
+		SignInDelayBase:       getEnvDuration("SIGN_IN_DELAY_BASE", time.Second),
+		SignInDelayMax:        getEnvDuration("SIGN_IN_DELAY_MAX", 30*time.Second),
+
+		ImpersonationTTL:      getEnvDuration("IMPERSONATION_TTL", 30*time.Minute),
+		ImpersonationReadOnly: getEnvBool("IMPERSONATION_READ_ONLY", true),
+	}
+
+	if Auth.EmailLocalPart != EmailLocalPartPreserve && Auth.EmailLocalPart != EmailLocalPartLowercase {
+		log.Fatalf("EMAIL_LOCAL_PART inválido: %s (use %s ou %s)", Auth.EmailLocalPart, EmailLocalPartPreserve, EmailLocalPartLowercase)
+	}
+}
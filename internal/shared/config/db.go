@@ -12,6 +12,10 @@ import (
 
 var DB *db.Queries
 
+// Pool is the connection pool behind DB, for the queries that must share a
+// transaction.
+var Pool *pgxpool.Pool
+
 func ConnectDatabase() {
 
 	host := os.Getenv("DB_HOST")
@@ -29,5 +33,6 @@ func ConnectDatabase() {
 		log.Fatalf("Erro ao conectar no banco: %v", err)
 	}
 
+	Pool = pool
 	DB = db.New(pool)
 }
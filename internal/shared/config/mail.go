@@ -0,0 +1,25 @@
+package config
+
+type MailConfig struct {
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+	// AppURL is the base URL of the web client, used to build the links
+	// sent by email.
+	AppURL string
+}
+
+var Mail MailConfig
+
+func LoadMailConfig() {
+	Mail = MailConfig{
+		SMTPHost:     getEnv("SMTP_HOST", ""),
+		SMTPPort:     getEnv("SMTP_PORT", "587"),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		From:         getEnv("SMTP_FROM", "Trilha <no-reply@trilha.app>"),
+		AppURL:       getEnv("APP_URL", "http://localhost:3000"),
+	}
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"log"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var providerNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// OIDCProviderConfig describes an OpenID Connect provider accounts can sign
+// in with. IssuerURL is used for discovery, and RedirectURL must point to
+// the callback route of the provider.
+type OIDCProviderConfig struct {
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+type OIDCConfig struct {
+	Providers []OIDCProviderConfig
+	// LoginTTL bounds the time between starting a login with a provider
+	// and its callback.
+	LoginTTL time.Duration
+}
+
+var OIDC OIDCConfig
+
+// LoadOIDCConfig reads the providers listed in OIDC_PROVIDERS. The settings
+// of a provider named keycloak are read from OIDC_KEYCLOAK_ISSUER_URL,
+// OIDC_KEYCLOAK_CLIENT_ID and so on.
+func LoadOIDCConfig() {
+	OIDC = OIDCConfig{
+		LoginTTL: getEnvDuration("OIDC_LOGIN_TTL", 10*time.Minute),
+	}
+
+	for _, name := range getEnvList("OIDC_PROVIDERS") {
+		if !providerNamePattern.MatchString(name) {
+			log.Fatalf("Nome de provedor OIDC inválido: %s", name)
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+
+		provider := OIDCProviderConfig{
+			Name:         name,
+			IssuerURL:    getEnv(prefix+"ISSUER_URL", ""),
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  getEnv(prefix+"REDIRECT_URL", ""),
+			Scopes:       getEnvList(prefix + "SCOPES"),
+		}
+
+		if provider.IssuerURL == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			log.Fatalf("Provedor OIDC %s exige %sISSUER_URL, %sCLIENT_ID e %sREDIRECT_URL", name, prefix, prefix, prefix)
+		}
+
+		if len(provider.Scopes) == 0 {
+			provider.Scopes = []string{"openid", "email", "profile"}
+		}
+
+		OIDC.Providers = append(OIDC.Providers, provider)
+	}
+}
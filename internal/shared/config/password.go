@@ -0,0 +1,49 @@
+package config
+
+import (
+	"log"
+	"slices"
+)
+
+// PasswordClasses are the character classes a password policy can require.
+var PasswordClasses = []string{"lower", "upper", "digit", "symbol"}
+
+// PasswordConfig holds the Argon2id parameters of new password hashes and
+// the policy new passwords must follow. Raising the Argon2id parameters makes
+// stored hashes be recomputed on the next sign-in.
+type PasswordConfig struct {
+	Argon2Memory      uint32 // in KiB
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+
+	MinLength       int
+	MaxLength       int
+	RequiredClasses []string
+	// BreachedList is the path of a list of SHA-1 hashes of breached
+	// passwords. Without one, passwords are not checked against breaches.
+	BreachedList string
+}
+
+var Password PasswordConfig
+
+func LoadPasswordConfig() {
+	Password = PasswordConfig{
+		Argon2Memory:      uint32(getEnvInt("PASSWORD_ARGON2_MEMORY", 64*1024)),
+		Argon2Iterations:  uint32(getEnvInt("PASSWORD_ARGON2_ITERATIONS", 3)),
+		Argon2Parallelism: uint8(getEnvInt("PASSWORD_ARGON2_PARALLELISM", 4)),
+		MinLength:         getEnvInt("PASSWORD_MIN_LENGTH", 8),
+		MaxLength:         getEnvInt("PASSWORD_MAX_LENGTH", 128),
+		RequiredClasses:   getEnvList("PASSWORD_REQUIRED_CLASSES"),
+		BreachedList:      getEnv("PASSWORD_BREACHED_LIST", ""),
+	}
+
+	if Password.MinLength < 1 || Password.MaxLength < Password.MinLength {
+		log.Fatalf("PASSWORD_MAX_LENGTH deve ser maior ou igual a PASSWORD_MIN_LENGTH, que deve ser positivo")
+	}
+
+	for _, class := range Password.RequiredClasses {
+		if !slices.Contains(PasswordClasses, class) {
+			log.Fatalf("Classe de caracteres inválida em PASSWORD_REQUIRED_CLASSES: %s", class)
+		}
+	}
+}
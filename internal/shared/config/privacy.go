@@ -0,0 +1,75 @@
+package config
+
+import (
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// PrivacyConfig tunes the background jobs exporting and erasing the personal
+// data of accounts.
+type PrivacyConfig struct {
+	// ExportTTL is how long the archive of an export can be downloaded.
+	ExportTTL time.Duration
+	// JobInterval is how often the server looks for pending jobs.
+	JobInterval time.Duration
+	// JobTimeout is how long a job may run before it is taken as interrupted
+	// and run again.
+	JobTimeout time.Duration
+	// Archives is where export archives are kept. Unlike Storage, it is
+	// never served: archives are only read back by the download endpoint.
+	Archives StorageConfig
+}
+
+var Privacy PrivacyConfig
+
+// LoadPrivacyConfig must run after LoadStorageConfig, as the archives may not
+// share the public storage of uploaded files.
+func LoadPrivacyConfig() {
+	Privacy = PrivacyConfig{
+		ExportTTL:   getEnvDuration("DATA_EXPORT_TTL", 7*24*time.Hour),
+		JobInterval: getEnvDuration("DATA_JOB_INTERVAL", 10*time.Second),
+		JobTimeout:  getEnvDuration("DATA_JOB_TIMEOUT", 30*time.Minute),
+		Archives: StorageConfig{
+			Driver:            getEnv("DATA_EXPORT_STORAGE_DRIVER", "local"),
+			LocalDir:          getEnv("DATA_EXPORT_LOCAL_DIR", "./private/exports"),
+			S3Endpoint:        getEnv("DATA_EXPORT_S3_ENDPOINT", getEnv("S3_ENDPOINT", "")),
+			S3Region:          getEnv("DATA_EXPORT_S3_REGION", getEnv("S3_REGION", "us-east-1")),
+			S3Bucket:          getEnv("DATA_EXPORT_S3_BUCKET", ""),
+			S3AccessKeyID:     getEnv("DATA_EXPORT_S3_ACCESS_KEY_ID", getEnv("S3_ACCESS_KEY_ID", "")),
+			S3SecretAccessKey: getEnv("DATA_EXPORT_S3_SECRET_ACCESS_KEY", getEnv("S3_SECRET_ACCESS_KEY", "")),
+		},
+	}
+
+	archives := Privacy.Archives
+
+	switch archives.Driver {
+	case "local":
+		if Storage.Driver == "local" && isWithin(archives.LocalDir, Storage.LocalDir) {
+			log.Fatalf("DATA_EXPORT_LOCAL_DIR não pode ficar dentro de STORAGE_LOCAL_DIR, que é público")
+		}
+	case "s3":
+		if archives.S3Endpoint == "" || archives.S3Bucket == "" {
+			log.Fatalf("DATA_EXPORT_STORAGE_DRIVER=s3 exige DATA_EXPORT_S3_BUCKET e um endpoint S3")
+		}
+		if Storage.Driver == "s3" && archives.S3Bucket == Storage.S3Bucket &&
+			strings.TrimSuffix(archives.S3Endpoint, "/") == strings.TrimSuffix(Storage.S3Endpoint, "/") {
+			log.Fatalf("DATA_EXPORT_S3_BUCKET não pode ser o bucket público S3_BUCKET")
+		}
+	default:
+		log.Fatalf("DATA_EXPORT_STORAGE_DRIVER inválido: %s", archives.Driver)
+	}
+}
+
+// isWithin reports whether dir is parent or a directory under it.
+func isWithin(dir, parent string) bool {
+	dir, errDir := filepath.Abs(dir)
+	parent, errParent := filepath.Abs(parent)
+	if errDir != nil || errParent != nil {
+		return false
+	}
+
+	rel, err := filepath.Rel(parent, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
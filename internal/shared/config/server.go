@@ -0,0 +1,16 @@
+package config
+
+type ServerConfig struct {
+	// TrustedProxies lists the addresses allowed to set X-Forwarded-For.
+	// Without it the client IP, used to throttle sign-in, is the address of
+	// the connection.
+	TrustedProxies []string
+}
+
+var Server ServerConfig
+
+func LoadServerConfig() {
+	Server = ServerConfig{
+		TrustedProxies: getEnvList("TRUSTED_PROXIES"),
+	}
+}
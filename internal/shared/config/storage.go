@@ -0,0 +1,80 @@
+package config
+
+import (
+	"log"
+	"strconv"
+	"strings"
+)
+
+// StorageConfig selects where uploaded files are kept: on the local disk,
+// served by the API itself, or in an S3-compatible bucket.
+type StorageConfig struct {
+	Driver string // "local" or "s3"
+	// PublicURL is the base URL files are served from, such as a CDN in
+	// front of the bucket.
+	PublicURL string
+
+	LocalDir string
+
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+}
+
+// AvatarConfig bounds avatar uploads and lists the square sizes, in pixels,
+// each avatar is resized to.
+type AvatarConfig struct {
+	MaxBytes int64
+	Sizes    []int
+}
+
+var (
+	Storage StorageConfig
+	Avatar  AvatarConfig
+)
+
+func LoadStorageConfig() {
+	Storage = StorageConfig{
+		Driver:            getEnv("STORAGE_DRIVER", "local"),
+		PublicURL:         strings.TrimSuffix(getEnv("STORAGE_PUBLIC_URL", ""), "/"),
+		LocalDir:          getEnv("STORAGE_LOCAL_DIR", "./storage"),
+		S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+		S3Region:          getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:          getEnv("S3_BUCKET", ""),
+		S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+		S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+	}
+
+	switch Storage.Driver {
+	case "local":
+		if Storage.PublicURL == "" {
+			Storage.PublicURL = "http://localhost:" + getEnv("APP_PORT", "8080") + "/storage"
+		}
+	case "s3":
+		if Storage.S3Endpoint == "" || Storage.S3Bucket == "" {
+			log.Fatalf("STORAGE_DRIVER=s3 exige S3_ENDPOINT e S3_BUCKET")
+		}
+		if Storage.PublicURL == "" {
+			Storage.PublicURL = strings.TrimSuffix(Storage.S3Endpoint, "/") + "/" + Storage.S3Bucket
+		}
+	default:
+		log.Fatalf("STORAGE_DRIVER inválido: %s", Storage.Driver)
+	}
+
+	Avatar = AvatarConfig{
+		MaxBytes: int64(getEnvInt("AVATAR_MAX_BYTES", 5<<20)),
+	}
+
+	for _, item := range getEnvList("AVATAR_SIZES") {
+		size, err := strconv.Atoi(item)
+		if err != nil || size < 1 {
+			log.Fatalf("Tamanho inválido em AVATAR_SIZES: %s", item)
+		}
+		Avatar.Sizes = append(Avatar.Sizes, size)
+	}
+	if len(Avatar.Sizes) == 0 {
+		Avatar.Sizes = []int{32, 128, 512}
+	}
+}
@@ -0,0 +1,29 @@
+package config
+
+import "time"
+
+// WebAuthnConfig describes the relying party passkeys are bound to. RPID is
+// the domain of the web client and RPOrigins the origins it is served from.
+type WebAuthnConfig struct {
+	RPID          string
+	RPDisplayName string
+	RPOrigins     []string
+	// ChallengeTTL bounds the time to answer a registration or sign-in
+	// challenge.
+	ChallengeTTL time.Duration
+}
+
+var WebAuthn WebAuthnConfig
+
+func LoadWebAuthnConfig() {
+	WebAuthn = WebAuthnConfig{
+		RPID:          getEnv("WEBAUTHN_RP_ID", "localhost"),
+		RPDisplayName: getEnv("WEBAUTHN_RP_NAME", "Trilha"),
+		RPOrigins:     getEnvList("WEBAUTHN_RP_ORIGINS"),
+		ChallengeTTL:  getEnvDuration("WEBAUTHN_CHALLENGE_TTL", 5*time.Minute),
+	}
+
+	if len(WebAuthn.RPOrigins) == 0 {
+		WebAuthn.RPOrigins = []string{getEnv("APP_URL", "http://localhost:3000")}
+	}
+}
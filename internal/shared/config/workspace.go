@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+// WorkspaceConfig tunes the collaboration on workspaces.
+type WorkspaceConfig struct {
+	// InvitationTTL is how long the link of an invitation to a workspace can
+	// be used, counted from when it was last sent.
+	InvitationTTL time.Duration
+}
+
+var Workspace WorkspaceConfig
+
+func LoadWorkspaceConfig() {
+	Workspace = WorkspaceConfig{
+		InvitationTTL: getEnvDuration("WORKSPACE_INVITATION_TTL", 7*24*time.Hour),
+	}
+}
@@ -15,6 +15,7 @@ import (
 	db "trilha-api/internal/shared/database/sqlc"
 
 	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +43,289 @@ func (m *MockQuerier) EXPECT() *MockQuerierMockRecorder {
 	return m.recorder
 }
 
+// AcceptWorkspaceInvitation mocks base method.
+func (m *MockQuerier) AcceptWorkspaceInvitation(ctx context.Context, arg db.AcceptWorkspaceInvitationParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptWorkspaceInvitation", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptWorkspaceInvitation indicates an expected call of AcceptWorkspaceInvitation.
+func (mr *MockQuerierMockRecorder) AcceptWorkspaceInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptWorkspaceInvitation", reflect.TypeOf((*MockQuerier)(nil).AcceptWorkspaceInvitation), ctx, arg)
+}
+
+// AccountHasPermission mocks base method.
+func (m *MockQuerier) AccountHasPermission(ctx context.Context, arg db.AccountHasPermissionParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountHasPermission", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountHasPermission indicates an expected call of AccountHasPermission.
+func (mr *MockQuerierMockRecorder) AccountHasPermission(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountHasPermission", reflect.TypeOf((*MockQuerier)(nil).AccountHasPermission), ctx, arg)
+}
+
+// AssignAccountRole mocks base method.
+func (m *MockQuerier) AssignAccountRole(ctx context.Context, arg db.AssignAccountRoleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignAccountRole", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignAccountRole indicates an expected call of AssignAccountRole.
+func (mr *MockQuerierMockRecorder) AssignAccountRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignAccountRole", reflect.TypeOf((*MockQuerier)(nil).AssignAccountRole), ctx, arg)
+}
+
+// AssignTask mocks base method.
+func (m *MockQuerier) AssignTask(ctx context.Context, arg db.AssignTaskParams) (db.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignTask", ctx, arg)
+	ret0, _ := ret[0].(db.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignTask indicates an expected call of AssignTask.
+func (mr *MockQuerierMockRecorder) AssignTask(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTask", reflect.TypeOf((*MockQuerier)(nil).AssignTask), ctx, arg)
+}
+
+// AssignTeamProject mocks base method.
+func (m *MockQuerier) AssignTeamProject(ctx context.Context, arg db.AssignTeamProjectParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignTeamProject", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignTeamProject indicates an expected call of AssignTeamProject.
+func (mr *MockQuerierMockRecorder) AssignTeamProject(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTeamProject", reflect.TypeOf((*MockQuerier)(nil).AssignTeamProject), ctx, arg)
+}
+
+// CancelAccountEmailChangeRequests mocks base method.
+func (m *MockQuerier) CancelAccountEmailChangeRequests(ctx context.Context, arg uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAccountEmailChangeRequests", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAccountEmailChangeRequests indicates an expected call of CancelAccountEmailChangeRequests.
+func (mr *MockQuerierMockRecorder) CancelAccountEmailChangeRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAccountEmailChangeRequests", reflect.TypeOf((*MockQuerier)(nil).CancelAccountEmailChangeRequests), ctx, arg)
+}
+
+// CancelEmailChangeRequest mocks base method.
+func (m *MockQuerier) CancelEmailChangeRequest(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEmailChangeRequest", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEmailChangeRequest indicates an expected call of CancelEmailChangeRequest.
+func (mr *MockQuerierMockRecorder) CancelEmailChangeRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEmailChangeRequest", reflect.TypeOf((*MockQuerier)(nil).CancelEmailChangeRequest), ctx, arg)
+}
+
+// ChangeWorkspaceMemberRole mocks base method.
+func (m *MockQuerier) ChangeWorkspaceMemberRole(ctx context.Context, arg db.ChangeWorkspaceMemberRoleParams) (db.ChangeWorkspaceMemberRoleRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeWorkspaceMemberRole", ctx, arg)
+	ret0, _ := ret[0].(db.ChangeWorkspaceMemberRoleRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeWorkspaceMemberRole indicates an expected call of ChangeWorkspaceMemberRole.
+func (mr *MockQuerierMockRecorder) ChangeWorkspaceMemberRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeWorkspaceMemberRole", reflect.TypeOf((*MockQuerier)(nil).ChangeWorkspaceMemberRole), ctx, arg)
+}
+
+// ClaimDataJob mocks base method.
+func (m *MockQuerier) ClaimDataJob(ctx context.Context, arg pgtype.Timestamp) (db.DataJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDataJob", ctx, arg)
+	ret0, _ := ret[0].(db.DataJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDataJob indicates an expected call of ClaimDataJob.
+func (mr *MockQuerierMockRecorder) ClaimDataJob(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDataJob", reflect.TypeOf((*MockQuerier)(nil).ClaimDataJob), ctx, arg)
+}
+
+// ClearAccountDataJobArchives mocks base method.
+func (m *MockQuerier) ClearAccountDataJobArchives(ctx context.Context, arg uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearAccountDataJobArchives", ctx, arg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearAccountDataJobArchives indicates an expected call of ClearAccountDataJobArchives.
+func (mr *MockQuerierMockRecorder) ClearAccountDataJobArchives(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearAccountDataJobArchives", reflect.TypeOf((*MockQuerier)(nil).ClearAccountDataJobArchives), ctx, arg)
+}
+
+// ClearSignInThrottle mocks base method.
+func (m *MockQuerier) ClearSignInThrottle(ctx context.Context, arg db.ClearSignInThrottleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSignInThrottle", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearSignInThrottle indicates an expected call of ClearSignInThrottle.
+func (mr *MockQuerierMockRecorder) ClearSignInThrottle(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSignInThrottle", reflect.TypeOf((*MockQuerier)(nil).ClearSignInThrottle), ctx, arg)
+}
+
+// CompleteDataJob mocks base method.
+func (m *MockQuerier) CompleteDataJob(ctx context.Context, arg db.CompleteDataJobParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDataJob", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteDataJob indicates an expected call of CompleteDataJob.
+func (mr *MockQuerierMockRecorder) CompleteDataJob(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataJob", reflect.TypeOf((*MockQuerier)(nil).CompleteDataJob), ctx, arg)
+}
+
+// ConfirmEmailChangeRequest mocks base method.
+func (m *MockQuerier) ConfirmEmailChangeRequest(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChangeRequest", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmailChangeRequest indicates an expected call of ConfirmEmailChangeRequest.
+func (mr *MockQuerierMockRecorder) ConfirmEmailChangeRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChangeRequest", reflect.TypeOf((*MockQuerier)(nil).ConfirmEmailChangeRequest), ctx, arg)
+}
+
+// ConsumeOIDCLoginRequest mocks base method.
+func (m *MockQuerier) ConsumeOIDCLoginRequest(ctx context.Context, arg string) (db.OidcLoginRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeOIDCLoginRequest", ctx, arg)
+	ret0, _ := ret[0].(db.OidcLoginRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeOIDCLoginRequest indicates an expected call of ConsumeOIDCLoginRequest.
+func (mr *MockQuerierMockRecorder) ConsumeOIDCLoginRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOIDCLoginRequest", reflect.TypeOf((*MockQuerier)(nil).ConsumeOIDCLoginRequest), ctx, arg)
+}
+
+// ConsumePasskeyChallenge mocks base method.
+func (m *MockQuerier) ConsumePasskeyChallenge(ctx context.Context, arg db.ConsumePasskeyChallengeParams) (db.PasskeyChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumePasskeyChallenge", ctx, arg)
+	ret0, _ := ret[0].(db.PasskeyChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumePasskeyChallenge indicates an expected call of ConsumePasskeyChallenge.
+func (mr *MockQuerierMockRecorder) ConsumePasskeyChallenge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumePasskeyChallenge", reflect.TypeOf((*MockQuerier)(nil).ConsumePasskeyChallenge), ctx, arg)
+}
+
+// CountAccounts mocks base method.
+func (m *MockQuerier) CountAccounts(ctx context.Context, arg db.CountAccountsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAccounts", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAccounts indicates an expected call of CountAccounts.
+func (mr *MockQuerierMockRecorder) CountAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccounts", reflect.TypeOf((*MockQuerier)(nil).CountAccounts), ctx, arg)
+}
+
+// CountProjects mocks base method.
+func (m *MockQuerier) CountProjects(ctx context.Context, arg db.CountProjectsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProjects", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProjects indicates an expected call of CountProjects.
+func (mr *MockQuerierMockRecorder) CountProjects(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProjects", reflect.TypeOf((*MockQuerier)(nil).CountProjects), ctx, arg)
+}
+
+// CountTasks mocks base method.
+func (m *MockQuerier) CountTasks(ctx context.Context, arg db.CountTasksParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTasks", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTasks indicates an expected call of CountTasks.
+func (mr *MockQuerierMockRecorder) CountTasks(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTasks", reflect.TypeOf((*MockQuerier)(nil).CountTasks), ctx, arg)
+}
+
+// CountUnusedRecoveryCodes mocks base method.
+func (m *MockQuerier) CountUnusedRecoveryCodes(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnusedRecoveryCodes", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnusedRecoveryCodes indicates an expected call of CountUnusedRecoveryCodes.
+func (mr *MockQuerierMockRecorder) CountUnusedRecoveryCodes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnusedRecoveryCodes", reflect.TypeOf((*MockQuerier)(nil).CountUnusedRecoveryCodes), ctx, arg)
+}
+
 // CreateAccount mocks base method.
 func (m *MockQuerier) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -57,32 +341,1875 @@ func (mr *MockQuerierMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockQuerier)(nil).CreateAccount), ctx, arg)
 }
 
-// FindAccount mocks base method.
-func (m *MockQuerier) FindAccount(ctx context.Context, arg uuid.UUID) (db.FindAccountRow, error) {
+// CreateAccountIdentity mocks base method.
+func (m *MockQuerier) CreateAccountIdentity(ctx context.Context, arg db.CreateAccountIdentityParams) (db.AccountIdentity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAccount", ctx, arg)
-	ret0, _ := ret[0].(db.FindAccountRow)
+	ret := m.ctrl.Call(m, "CreateAccountIdentity", ctx, arg)
+	ret0, _ := ret[0].(db.AccountIdentity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindAccount indicates an expected call of FindAccount.
-func (mr *MockQuerierMockRecorder) FindAccount(ctx, arg any) *gomock.Call {
+// CreateAccountIdentity indicates an expected call of CreateAccountIdentity.
+func (mr *MockQuerierMockRecorder) CreateAccountIdentity(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccount", reflect.TypeOf((*MockQuerier)(nil).FindAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountIdentity", reflect.TypeOf((*MockQuerier)(nil).CreateAccountIdentity), ctx, arg)
 }
 
-// FindAccountByEmail mocks base method.
-func (m *MockQuerier) FindAccountByEmail(ctx context.Context, arg string) (db.FindAccountByEmailRow, error) {
+// CreateDataJob mocks base method.
+func (m *MockQuerier) CreateDataJob(ctx context.Context, arg db.CreateDataJobParams) (db.DataJob, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAccountByEmail", ctx, arg)
-	ret0, _ := ret[0].(db.FindAccountByEmailRow)
+	ret := m.ctrl.Call(m, "CreateDataJob", ctx, arg)
+	ret0, _ := ret[0].(db.DataJob)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindAccountByEmail indicates an expected call of FindAccountByEmail.
-func (mr *MockQuerierMockRecorder) FindAccountByEmail(ctx, arg any) *gomock.Call {
+// CreateDataJob indicates an expected call of CreateDataJob.
+func (mr *MockQuerierMockRecorder) CreateDataJob(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccountByEmail", reflect.TypeOf((*MockQuerier)(nil).FindAccountByEmail), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataJob", reflect.TypeOf((*MockQuerier)(nil).CreateDataJob), ctx, arg)
+}
+
+// CreateEmailChangeRequest mocks base method.
+func (m *MockQuerier) CreateEmailChangeRequest(ctx context.Context, arg db.CreateEmailChangeRequestParams) (db.EmailChangeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailChangeRequest", ctx, arg)
+	ret0, _ := ret[0].(db.EmailChangeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEmailChangeRequest indicates an expected call of CreateEmailChangeRequest.
+func (mr *MockQuerierMockRecorder) CreateEmailChangeRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailChangeRequest", reflect.TypeOf((*MockQuerier)(nil).CreateEmailChangeRequest), ctx, arg)
+}
+
+// CreateImpersonation mocks base method.
+func (m *MockQuerier) CreateImpersonation(ctx context.Context, arg db.CreateImpersonationParams) (db.Impersonation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImpersonation", ctx, arg)
+	ret0, _ := ret[0].(db.Impersonation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImpersonation indicates an expected call of CreateImpersonation.
+func (mr *MockQuerierMockRecorder) CreateImpersonation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImpersonation", reflect.TypeOf((*MockQuerier)(nil).CreateImpersonation), ctx, arg)
+}
+
+// CreateImpersonationRequest mocks base method.
+func (m *MockQuerier) CreateImpersonationRequest(ctx context.Context, arg db.CreateImpersonationRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImpersonationRequest", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateImpersonationRequest indicates an expected call of CreateImpersonationRequest.
+func (mr *MockQuerierMockRecorder) CreateImpersonationRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImpersonationRequest", reflect.TypeOf((*MockQuerier)(nil).CreateImpersonationRequest), ctx, arg)
+}
+
+// CreateOIDCLoginRequest mocks base method.
+func (m *MockQuerier) CreateOIDCLoginRequest(ctx context.Context, arg db.CreateOIDCLoginRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOIDCLoginRequest", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOIDCLoginRequest indicates an expected call of CreateOIDCLoginRequest.
+func (mr *MockQuerierMockRecorder) CreateOIDCLoginRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOIDCLoginRequest", reflect.TypeOf((*MockQuerier)(nil).CreateOIDCLoginRequest), ctx, arg)
+}
+
+// CreatePasskey mocks base method.
+func (m *MockQuerier) CreatePasskey(ctx context.Context, arg db.CreatePasskeyParams) (db.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasskey", ctx, arg)
+	ret0, _ := ret[0].(db.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasskey indicates an expected call of CreatePasskey.
+func (mr *MockQuerierMockRecorder) CreatePasskey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasskey", reflect.TypeOf((*MockQuerier)(nil).CreatePasskey), ctx, arg)
+}
+
+// CreatePasskeyChallenge mocks base method.
+func (m *MockQuerier) CreatePasskeyChallenge(ctx context.Context, arg db.CreatePasskeyChallengeParams) (db.PasskeyChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasskeyChallenge", ctx, arg)
+	ret0, _ := ret[0].(db.PasskeyChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasskeyChallenge indicates an expected call of CreatePasskeyChallenge.
+func (mr *MockQuerierMockRecorder) CreatePasskeyChallenge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasskeyChallenge", reflect.TypeOf((*MockQuerier)(nil).CreatePasskeyChallenge), ctx, arg)
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockQuerier) CreatePasswordResetToken(ctx context.Context, arg db.CreatePasswordResetTokenParams) (db.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", ctx, arg)
+	ret0, _ := ret[0].(db.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockQuerierMockRecorder) CreatePasswordResetToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockQuerier)(nil).CreatePasswordResetToken), ctx, arg)
+}
+
+// CreatePersonalAccessToken mocks base method.
+func (m *MockQuerier) CreatePersonalAccessToken(ctx context.Context, arg db.CreatePersonalAccessTokenParams) (db.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalAccessToken", ctx, arg)
+	ret0, _ := ret[0].(db.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonalAccessToken indicates an expected call of CreatePersonalAccessToken.
+func (mr *MockQuerierMockRecorder) CreatePersonalAccessToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalAccessToken", reflect.TypeOf((*MockQuerier)(nil).CreatePersonalAccessToken), ctx, arg)
+}
+
+// CreateProject mocks base method.
+func (m *MockQuerier) CreateProject(ctx context.Context, arg db.CreateProjectParams) (db.CreateProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, arg)
+	ret0, _ := ret[0].(db.CreateProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockQuerierMockRecorder) CreateProject(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockQuerier)(nil).CreateProject), ctx, arg)
+}
+
+// CreateRecoveryCodes mocks base method.
+func (m *MockQuerier) CreateRecoveryCodes(ctx context.Context, arg db.CreateRecoveryCodesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryCodes", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecoveryCodes indicates an expected call of CreateRecoveryCodes.
+func (mr *MockQuerierMockRecorder) CreateRecoveryCodes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCodes", reflect.TypeOf((*MockQuerier)(nil).CreateRecoveryCodes), ctx, arg)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockQuerier) CreateRefreshToken(ctx context.Context, arg db.CreateRefreshTokenParams) (db.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, arg)
+	ret0, _ := ret[0].(db.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockQuerierMockRecorder) CreateRefreshToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockQuerier)(nil).CreateRefreshToken), ctx, arg)
+}
+
+// CreateSession mocks base method.
+func (m *MockQuerier) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockQuerierMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockQuerier)(nil).CreateSession), ctx, arg)
+}
+
+// CreateTask mocks base method.
+func (m *MockQuerier) CreateTask(ctx context.Context, arg db.CreateTaskParams) (db.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", ctx, arg)
+	ret0, _ := ret[0].(db.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockQuerierMockRecorder) CreateTask(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockQuerier)(nil).CreateTask), ctx, arg)
+}
+
+// CreateTeam mocks base method.
+func (m *MockQuerier) CreateTeam(ctx context.Context, arg db.CreateTeamParams) (db.CreateTeamRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, arg)
+	ret0, _ := ret[0].(db.CreateTeamRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockQuerierMockRecorder) CreateTeam(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockQuerier)(nil).CreateTeam), ctx, arg)
+}
+
+// CreateWorkspace mocks base method.
+func (m *MockQuerier) CreateWorkspace(ctx context.Context, arg db.CreateWorkspaceParams) (db.CreateWorkspaceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkspace", ctx, arg)
+	ret0, _ := ret[0].(db.CreateWorkspaceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkspace indicates an expected call of CreateWorkspace.
+func (mr *MockQuerierMockRecorder) CreateWorkspace(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockQuerier)(nil).CreateWorkspace), ctx, arg)
+}
+
+// CreateWorkspaceInvitation mocks base method.
+func (m *MockQuerier) CreateWorkspaceInvitation(ctx context.Context, arg db.CreateWorkspaceInvitationParams) (db.WorkspaceInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkspaceInvitation", ctx, arg)
+	ret0, _ := ret[0].(db.WorkspaceInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkspaceInvitation indicates an expected call of CreateWorkspaceInvitation.
+func (mr *MockQuerierMockRecorder) CreateWorkspaceInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspaceInvitation", reflect.TypeOf((*MockQuerier)(nil).CreateWorkspaceInvitation), ctx, arg)
+}
+
+// DeleteAccountPasskeyChallenges mocks base method.
+func (m *MockQuerier) DeleteAccountPasskeyChallenges(ctx context.Context, accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountPasskeyChallenges", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountPasskeyChallenges indicates an expected call of DeleteAccountPasskeyChallenges.
+func (mr *MockQuerierMockRecorder) DeleteAccountPasskeyChallenges(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountPasskeyChallenges", reflect.TypeOf((*MockQuerier)(nil).DeleteAccountPasskeyChallenges), ctx, accountID)
+}
+
+// DeleteAccountPasskeys mocks base method.
+func (m *MockQuerier) DeleteAccountPasskeys(ctx context.Context, accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountPasskeys", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountPasskeys indicates an expected call of DeleteAccountPasskeys.
+func (mr *MockQuerierMockRecorder) DeleteAccountPasskeys(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountPasskeys", reflect.TypeOf((*MockQuerier)(nil).DeleteAccountPasskeys), ctx, accountID)
+}
+
+// DeleteAccountRecoveryCodes mocks base method.
+func (m *MockQuerier) DeleteAccountRecoveryCodes(ctx context.Context, arg uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountRecoveryCodes", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountRecoveryCodes indicates an expected call of DeleteAccountRecoveryCodes.
+func (mr *MockQuerierMockRecorder) DeleteAccountRecoveryCodes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountRecoveryCodes", reflect.TypeOf((*MockQuerier)(nil).DeleteAccountRecoveryCodes), ctx, arg)
+}
+
+// DeleteAccountWorkspaceInvitations mocks base method.
+func (m *MockQuerier) DeleteAccountWorkspaceInvitations(ctx context.Context, accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountWorkspaceInvitations", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountWorkspaceInvitations indicates an expected call of DeleteAccountWorkspaceInvitations.
+func (mr *MockQuerierMockRecorder) DeleteAccountWorkspaceInvitations(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountWorkspaceInvitations", reflect.TypeOf((*MockQuerier)(nil).DeleteAccountWorkspaceInvitations), ctx, accountID)
+}
+
+// DeleteExpiredOIDCLoginRequests mocks base method.
+func (m *MockQuerier) DeleteExpiredOIDCLoginRequests(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredOIDCLoginRequests", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredOIDCLoginRequests indicates an expected call of DeleteExpiredOIDCLoginRequests.
+func (mr *MockQuerierMockRecorder) DeleteExpiredOIDCLoginRequests(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredOIDCLoginRequests", reflect.TypeOf((*MockQuerier)(nil).DeleteExpiredOIDCLoginRequests), ctx)
+}
+
+// DeleteExpiredPasskeyChallenges mocks base method.
+func (m *MockQuerier) DeleteExpiredPasskeyChallenges(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredPasskeyChallenges", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredPasskeyChallenges indicates an expected call of DeleteExpiredPasskeyChallenges.
+func (mr *MockQuerierMockRecorder) DeleteExpiredPasskeyChallenges(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredPasskeyChallenges", reflect.TypeOf((*MockQuerier)(nil).DeleteExpiredPasskeyChallenges), ctx)
+}
+
+// DeletePasskey mocks base method.
+func (m *MockQuerier) DeletePasskey(ctx context.Context, arg db.DeletePasskeyParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePasskey", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePasskey indicates an expected call of DeletePasskey.
+func (mr *MockQuerierMockRecorder) DeletePasskey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasskey", reflect.TypeOf((*MockQuerier)(nil).DeletePasskey), ctx, arg)
+}
+
+// DeleteTask mocks base method.
+func (m *MockQuerier) DeleteTask(ctx context.Context, arg db.DeleteTaskParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MockQuerierMockRecorder) DeleteTask(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockQuerier)(nil).DeleteTask), ctx, arg)
+}
+
+// DeleteTeam mocks base method.
+func (m *MockQuerier) DeleteTeam(ctx context.Context, arg db.DeleteTeamParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeam", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *MockQuerierMockRecorder) DeleteTeam(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockQuerier)(nil).DeleteTeam), ctx, arg)
+}
+
+// DeleteWorkspace mocks base method.
+func (m *MockQuerier) DeleteWorkspace(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkspace", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkspace indicates an expected call of DeleteWorkspace.
+func (mr *MockQuerierMockRecorder) DeleteWorkspace(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkspace", reflect.TypeOf((*MockQuerier)(nil).DeleteWorkspace), ctx, arg)
+}
+
+// DisableAccountTwoFactor mocks base method.
+func (m *MockQuerier) DisableAccountTwoFactor(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAccountTwoFactor", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableAccountTwoFactor indicates an expected call of DisableAccountTwoFactor.
+func (mr *MockQuerierMockRecorder) DisableAccountTwoFactor(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccountTwoFactor", reflect.TypeOf((*MockQuerier)(nil).DisableAccountTwoFactor), ctx, arg)
+}
+
+// EnableAccountTwoFactor mocks base method.
+func (m *MockQuerier) EnableAccountTwoFactor(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableAccountTwoFactor", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableAccountTwoFactor indicates an expected call of EnableAccountTwoFactor.
+func (mr *MockQuerierMockRecorder) EnableAccountTwoFactor(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAccountTwoFactor", reflect.TypeOf((*MockQuerier)(nil).EnableAccountTwoFactor), ctx, arg)
+}
+
+// EndImpersonation mocks base method.
+func (m *MockQuerier) EndImpersonation(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndImpersonation", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndImpersonation indicates an expected call of EndImpersonation.
+func (mr *MockQuerierMockRecorder) EndImpersonation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndImpersonation", reflect.TypeOf((*MockQuerier)(nil).EndImpersonation), ctx, arg)
+}
+
+// EraseAccount mocks base method.
+func (m *MockQuerier) EraseAccount(ctx context.Context, arg uuid.UUID) (pgtype.Text, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseAccount", ctx, arg)
+	ret0, _ := ret[0].(pgtype.Text)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseAccount indicates an expected call of EraseAccount.
+func (mr *MockQuerierMockRecorder) EraseAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseAccount", reflect.TypeOf((*MockQuerier)(nil).EraseAccount), ctx, arg)
+}
+
+// ExpireDataJobArchives mocks base method.
+func (m *MockQuerier) ExpireDataJobArchives(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireDataJobArchives", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireDataJobArchives indicates an expected call of ExpireDataJobArchives.
+func (mr *MockQuerierMockRecorder) ExpireDataJobArchives(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireDataJobArchives", reflect.TypeOf((*MockQuerier)(nil).ExpireDataJobArchives), ctx)
+}
+
+// FailDataJob mocks base method.
+func (m *MockQuerier) FailDataJob(ctx context.Context, arg db.FailDataJobParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailDataJob", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailDataJob indicates an expected call of FailDataJob.
+func (mr *MockQuerierMockRecorder) FailDataJob(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailDataJob", reflect.TypeOf((*MockQuerier)(nil).FailDataJob), ctx, arg)
+}
+
+// FindAccount mocks base method.
+func (m *MockQuerier) FindAccount(ctx context.Context, arg uuid.UUID) (db.FindAccountRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccount", ctx, arg)
+	ret0, _ := ret[0].(db.FindAccountRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccount indicates an expected call of FindAccount.
+func (mr *MockQuerierMockRecorder) FindAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccount", reflect.TypeOf((*MockQuerier)(nil).FindAccount), ctx, arg)
+}
+
+// FindAccountByEmail mocks base method.
+func (m *MockQuerier) FindAccountByEmail(ctx context.Context, arg string) (db.FindAccountByEmailRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccountByEmail", ctx, arg)
+	ret0, _ := ret[0].(db.FindAccountByEmailRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccountByEmail indicates an expected call of FindAccountByEmail.
+func (mr *MockQuerierMockRecorder) FindAccountByEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccountByEmail", reflect.TypeOf((*MockQuerier)(nil).FindAccountByEmail), ctx, arg)
+}
+
+// FindAccountForAdmin mocks base method.
+func (m *MockQuerier) FindAccountForAdmin(ctx context.Context, arg uuid.UUID) (db.FindAccountForAdminRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccountForAdmin", ctx, arg)
+	ret0, _ := ret[0].(db.FindAccountForAdminRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccountForAdmin indicates an expected call of FindAccountForAdmin.
+func (mr *MockQuerierMockRecorder) FindAccountForAdmin(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccountForAdmin", reflect.TypeOf((*MockQuerier)(nil).FindAccountForAdmin), ctx, arg)
+}
+
+// FindAccountIdentity mocks base method.
+func (m *MockQuerier) FindAccountIdentity(ctx context.Context, arg db.FindAccountIdentityParams) (db.AccountIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccountIdentity", ctx, arg)
+	ret0, _ := ret[0].(db.AccountIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccountIdentity indicates an expected call of FindAccountIdentity.
+func (mr *MockQuerierMockRecorder) FindAccountIdentity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccountIdentity", reflect.TypeOf((*MockQuerier)(nil).FindAccountIdentity), ctx, arg)
+}
+
+// FindDataJob mocks base method.
+func (m *MockQuerier) FindDataJob(ctx context.Context, arg uuid.UUID) (db.DataJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDataJob", ctx, arg)
+	ret0, _ := ret[0].(db.DataJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDataJob indicates an expected call of FindDataJob.
+func (mr *MockQuerierMockRecorder) FindDataJob(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDataJob", reflect.TypeOf((*MockQuerier)(nil).FindDataJob), ctx, arg)
+}
+
+// FindEmailChangeRequestByCancelTokenHash mocks base method.
+func (m *MockQuerier) FindEmailChangeRequestByCancelTokenHash(ctx context.Context, arg string) (db.EmailChangeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEmailChangeRequestByCancelTokenHash", ctx, arg)
+	ret0, _ := ret[0].(db.EmailChangeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEmailChangeRequestByCancelTokenHash indicates an expected call of FindEmailChangeRequestByCancelTokenHash.
+func (mr *MockQuerierMockRecorder) FindEmailChangeRequestByCancelTokenHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEmailChangeRequestByCancelTokenHash", reflect.TypeOf((*MockQuerier)(nil).FindEmailChangeRequestByCancelTokenHash), ctx, arg)
+}
+
+// FindEmailChangeRequestByTokenHash mocks base method.
+func (m *MockQuerier) FindEmailChangeRequestByTokenHash(ctx context.Context, arg string) (db.EmailChangeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEmailChangeRequestByTokenHash", ctx, arg)
+	ret0, _ := ret[0].(db.EmailChangeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEmailChangeRequestByTokenHash indicates an expected call of FindEmailChangeRequestByTokenHash.
+func (mr *MockQuerierMockRecorder) FindEmailChangeRequestByTokenHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEmailChangeRequestByTokenHash", reflect.TypeOf((*MockQuerier)(nil).FindEmailChangeRequestByTokenHash), ctx, arg)
+}
+
+// FindImpersonation mocks base method.
+func (m *MockQuerier) FindImpersonation(ctx context.Context, arg uuid.UUID) (db.Impersonation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImpersonation", ctx, arg)
+	ret0, _ := ret[0].(db.Impersonation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImpersonation indicates an expected call of FindImpersonation.
+func (mr *MockQuerierMockRecorder) FindImpersonation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImpersonation", reflect.TypeOf((*MockQuerier)(nil).FindImpersonation), ctx, arg)
+}
+
+// FindPasswordResetTokenByHash mocks base method.
+func (m *MockQuerier) FindPasswordResetTokenByHash(ctx context.Context, arg string) (db.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPasswordResetTokenByHash", ctx, arg)
+	ret0, _ := ret[0].(db.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPasswordResetTokenByHash indicates an expected call of FindPasswordResetTokenByHash.
+func (mr *MockQuerierMockRecorder) FindPasswordResetTokenByHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPasswordResetTokenByHash", reflect.TypeOf((*MockQuerier)(nil).FindPasswordResetTokenByHash), ctx, arg)
+}
+
+// FindPendingWorkspaceInvitation mocks base method.
+func (m *MockQuerier) FindPendingWorkspaceInvitation(ctx context.Context, arg db.FindPendingWorkspaceInvitationParams) (db.WorkspaceInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingWorkspaceInvitation", ctx, arg)
+	ret0, _ := ret[0].(db.WorkspaceInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingWorkspaceInvitation indicates an expected call of FindPendingWorkspaceInvitation.
+func (mr *MockQuerierMockRecorder) FindPendingWorkspaceInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingWorkspaceInvitation", reflect.TypeOf((*MockQuerier)(nil).FindPendingWorkspaceInvitation), ctx, arg)
+}
+
+// FindPersonalAccessTokenByHash mocks base method.
+func (m *MockQuerier) FindPersonalAccessTokenByHash(ctx context.Context, arg string) (db.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPersonalAccessTokenByHash", ctx, arg)
+	ret0, _ := ret[0].(db.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPersonalAccessTokenByHash indicates an expected call of FindPersonalAccessTokenByHash.
+func (mr *MockQuerierMockRecorder) FindPersonalAccessTokenByHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPersonalAccessTokenByHash", reflect.TypeOf((*MockQuerier)(nil).FindPersonalAccessTokenByHash), ctx, arg)
+}
+
+// FindProject mocks base method.
+func (m *MockQuerier) FindProject(ctx context.Context, arg db.FindProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProject", ctx, arg)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProject indicates an expected call of FindProject.
+func (mr *MockQuerierMockRecorder) FindProject(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProject", reflect.TypeOf((*MockQuerier)(nil).FindProject), ctx, arg)
+}
+
+// FindProjectMember mocks base method.
+func (m *MockQuerier) FindProjectMember(ctx context.Context, arg db.FindProjectMemberParams) (db.FindProjectMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProjectMember", ctx, arg)
+	ret0, _ := ret[0].(db.FindProjectMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProjectMember indicates an expected call of FindProjectMember.
+func (mr *MockQuerierMockRecorder) FindProjectMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProjectMember", reflect.TypeOf((*MockQuerier)(nil).FindProjectMember), ctx, arg)
+}
+
+// FindRefreshTokenByHash mocks base method.
+func (m *MockQuerier) FindRefreshTokenByHash(ctx context.Context, arg string) (db.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRefreshTokenByHash", ctx, arg)
+	ret0, _ := ret[0].(db.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRefreshTokenByHash indicates an expected call of FindRefreshTokenByHash.
+func (mr *MockQuerierMockRecorder) FindRefreshTokenByHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRefreshTokenByHash", reflect.TypeOf((*MockQuerier)(nil).FindRefreshTokenByHash), ctx, arg)
+}
+
+// FindRoleByName mocks base method.
+func (m *MockQuerier) FindRoleByName(ctx context.Context, arg string) (db.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRoleByName", ctx, arg)
+	ret0, _ := ret[0].(db.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRoleByName indicates an expected call of FindRoleByName.
+func (mr *MockQuerierMockRecorder) FindRoleByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRoleByName", reflect.TypeOf((*MockQuerier)(nil).FindRoleByName), ctx, arg)
+}
+
+// FindSignInThrottle mocks base method.
+func (m *MockQuerier) FindSignInThrottle(ctx context.Context, arg db.FindSignInThrottleParams) (db.SignInThrottle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSignInThrottle", ctx, arg)
+	ret0, _ := ret[0].(db.SignInThrottle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSignInThrottle indicates an expected call of FindSignInThrottle.
+func (mr *MockQuerierMockRecorder) FindSignInThrottle(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSignInThrottle", reflect.TypeOf((*MockQuerier)(nil).FindSignInThrottle), ctx, arg)
+}
+
+// FindTask mocks base method.
+func (m *MockQuerier) FindTask(ctx context.Context, arg db.FindTaskParams) (db.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTask", ctx, arg)
+	ret0, _ := ret[0].(db.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTask indicates an expected call of FindTask.
+func (mr *MockQuerierMockRecorder) FindTask(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTask", reflect.TypeOf((*MockQuerier)(nil).FindTask), ctx, arg)
+}
+
+// FindTeam mocks base method.
+func (m *MockQuerier) FindTeam(ctx context.Context, arg db.FindTeamParams) (db.FindTeamRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTeam", ctx, arg)
+	ret0, _ := ret[0].(db.FindTeamRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTeam indicates an expected call of FindTeam.
+func (mr *MockQuerierMockRecorder) FindTeam(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeam", reflect.TypeOf((*MockQuerier)(nil).FindTeam), ctx, arg)
+}
+
+// FindTeamMember mocks base method.
+func (m *MockQuerier) FindTeamMember(ctx context.Context, arg db.FindTeamMemberParams) (db.FindTeamMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTeamMember", ctx, arg)
+	ret0, _ := ret[0].(db.FindTeamMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTeamMember indicates an expected call of FindTeamMember.
+func (mr *MockQuerierMockRecorder) FindTeamMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeamMember", reflect.TypeOf((*MockQuerier)(nil).FindTeamMember), ctx, arg)
+}
+
+// FindWorkspace mocks base method.
+func (m *MockQuerier) FindWorkspace(ctx context.Context, arg uuid.UUID) (db.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWorkspace", ctx, arg)
+	ret0, _ := ret[0].(db.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWorkspace indicates an expected call of FindWorkspace.
+func (mr *MockQuerierMockRecorder) FindWorkspace(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWorkspace", reflect.TypeOf((*MockQuerier)(nil).FindWorkspace), ctx, arg)
+}
+
+// FindWorkspaceInvitationByHash mocks base method.
+func (m *MockQuerier) FindWorkspaceInvitationByHash(ctx context.Context, arg string) (db.FindWorkspaceInvitationByHashRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWorkspaceInvitationByHash", ctx, arg)
+	ret0, _ := ret[0].(db.FindWorkspaceInvitationByHashRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWorkspaceInvitationByHash indicates an expected call of FindWorkspaceInvitationByHash.
+func (mr *MockQuerierMockRecorder) FindWorkspaceInvitationByHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWorkspaceInvitationByHash", reflect.TypeOf((*MockQuerier)(nil).FindWorkspaceInvitationByHash), ctx, arg)
+}
+
+// FindWorkspaceMember mocks base method.
+func (m *MockQuerier) FindWorkspaceMember(ctx context.Context, arg db.FindWorkspaceMemberParams) (db.FindWorkspaceMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWorkspaceMember", ctx, arg)
+	ret0, _ := ret[0].(db.FindWorkspaceMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWorkspaceMember indicates an expected call of FindWorkspaceMember.
+func (mr *MockQuerierMockRecorder) FindWorkspaceMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWorkspaceMember", reflect.TypeOf((*MockQuerier)(nil).FindWorkspaceMember), ctx, arg)
+}
+
+// HardDeleteAccount mocks base method.
+func (m *MockQuerier) HardDeleteAccount(ctx context.Context, arg uuid.UUID) (pgtype.Text, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDeleteAccount", ctx, arg)
+	ret0, _ := ret[0].(pgtype.Text)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HardDeleteAccount indicates an expected call of HardDeleteAccount.
+func (mr *MockQuerierMockRecorder) HardDeleteAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDeleteAccount", reflect.TypeOf((*MockQuerier)(nil).HardDeleteAccount), ctx, arg)
+}
+
+// InvalidateAccountPasswordResetTokens mocks base method.
+func (m *MockQuerier) InvalidateAccountPasswordResetTokens(ctx context.Context, arg uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateAccountPasswordResetTokens", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateAccountPasswordResetTokens indicates an expected call of InvalidateAccountPasswordResetTokens.
+func (mr *MockQuerierMockRecorder) InvalidateAccountPasswordResetTokens(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateAccountPasswordResetTokens", reflect.TypeOf((*MockQuerier)(nil).InvalidateAccountPasswordResetTokens), ctx, arg)
+}
+
+// ListAccountDataJobs mocks base method.
+func (m *MockQuerier) ListAccountDataJobs(ctx context.Context, arg uuid.UUID) ([]db.DataJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountDataJobs", ctx, arg)
+	ret0, _ := ret[0].([]db.DataJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountDataJobs indicates an expected call of ListAccountDataJobs.
+func (mr *MockQuerierMockRecorder) ListAccountDataJobs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountDataJobs", reflect.TypeOf((*MockQuerier)(nil).ListAccountDataJobs), ctx, arg)
+}
+
+// ListAccountEmailChangeRequests mocks base method.
+func (m *MockQuerier) ListAccountEmailChangeRequests(ctx context.Context, accountID uuid.UUID) ([]db.EmailChangeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountEmailChangeRequests", ctx, accountID)
+	ret0, _ := ret[0].([]db.EmailChangeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountEmailChangeRequests indicates an expected call of ListAccountEmailChangeRequests.
+func (mr *MockQuerierMockRecorder) ListAccountEmailChangeRequests(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountEmailChangeRequests", reflect.TypeOf((*MockQuerier)(nil).ListAccountEmailChangeRequests), ctx, accountID)
+}
+
+// ListAccountIdentities mocks base method.
+func (m *MockQuerier) ListAccountIdentities(ctx context.Context, accountID uuid.UUID) ([]db.AccountIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountIdentities", ctx, accountID)
+	ret0, _ := ret[0].([]db.AccountIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountIdentities indicates an expected call of ListAccountIdentities.
+func (mr *MockQuerierMockRecorder) ListAccountIdentities(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountIdentities", reflect.TypeOf((*MockQuerier)(nil).ListAccountIdentities), ctx, accountID)
+}
+
+// ListAccountPasskeys mocks base method.
+func (m *MockQuerier) ListAccountPasskeys(ctx context.Context, arg uuid.UUID) ([]db.Passkey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountPasskeys", ctx, arg)
+	ret0, _ := ret[0].([]db.Passkey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountPasskeys indicates an expected call of ListAccountPasskeys.
+func (mr *MockQuerierMockRecorder) ListAccountPasskeys(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountPasskeys", reflect.TypeOf((*MockQuerier)(nil).ListAccountPasskeys), ctx, arg)
+}
+
+// ListAccountPersonalAccessTokens mocks base method.
+func (m *MockQuerier) ListAccountPersonalAccessTokens(ctx context.Context, arg uuid.UUID) ([]db.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountPersonalAccessTokens", ctx, arg)
+	ret0, _ := ret[0].([]db.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountPersonalAccessTokens indicates an expected call of ListAccountPersonalAccessTokens.
+func (mr *MockQuerierMockRecorder) ListAccountPersonalAccessTokens(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountPersonalAccessTokens", reflect.TypeOf((*MockQuerier)(nil).ListAccountPersonalAccessTokens), ctx, arg)
+}
+
+// ListAccountProjects mocks base method.
+func (m *MockQuerier) ListAccountProjects(ctx context.Context, arg db.ListAccountProjectsParams) ([]db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountProjects", ctx, arg)
+	ret0, _ := ret[0].([]db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountProjects indicates an expected call of ListAccountProjects.
+func (mr *MockQuerierMockRecorder) ListAccountProjects(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountProjects", reflect.TypeOf((*MockQuerier)(nil).ListAccountProjects), ctx, arg)
+}
+
+// ListAccountRoles mocks base method.
+func (m *MockQuerier) ListAccountRoles(ctx context.Context, arg uuid.UUID) ([]db.ListAccountRolesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountRoles", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAccountRolesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountRoles indicates an expected call of ListAccountRoles.
+func (mr *MockQuerierMockRecorder) ListAccountRoles(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountRoles", reflect.TypeOf((*MockQuerier)(nil).ListAccountRoles), ctx, arg)
+}
+
+// ListAccountSessions mocks base method.
+func (m *MockQuerier) ListAccountSessions(ctx context.Context, arg uuid.UUID) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountSessions", ctx, arg)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountSessions indicates an expected call of ListAccountSessions.
+func (mr *MockQuerierMockRecorder) ListAccountSessions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountSessions", reflect.TypeOf((*MockQuerier)(nil).ListAccountSessions), ctx, arg)
+}
+
+// ListAccountTasks mocks base method.
+func (m *MockQuerier) ListAccountTasks(ctx context.Context, arg db.ListAccountTasksParams) ([]db.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountTasks", ctx, arg)
+	ret0, _ := ret[0].([]db.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountTasks indicates an expected call of ListAccountTasks.
+func (mr *MockQuerierMockRecorder) ListAccountTasks(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountTasks", reflect.TypeOf((*MockQuerier)(nil).ListAccountTasks), ctx, arg)
+}
+
+// ListAccountTeams mocks base method.
+func (m *MockQuerier) ListAccountTeams(ctx context.Context, arg uuid.UUID) ([]db.ListAccountTeamsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountTeams", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAccountTeamsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountTeams indicates an expected call of ListAccountTeams.
+func (mr *MockQuerierMockRecorder) ListAccountTeams(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountTeams", reflect.TypeOf((*MockQuerier)(nil).ListAccountTeams), ctx, arg)
+}
+
+// ListAccountWorkspaceIDs mocks base method.
+func (m *MockQuerier) ListAccountWorkspaceIDs(ctx context.Context, arg uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountWorkspaceIDs", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountWorkspaceIDs indicates an expected call of ListAccountWorkspaceIDs.
+func (mr *MockQuerierMockRecorder) ListAccountWorkspaceIDs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountWorkspaceIDs", reflect.TypeOf((*MockQuerier)(nil).ListAccountWorkspaceIDs), ctx, arg)
+}
+
+// ListAccountWorkspaceInvitations mocks base method.
+func (m *MockQuerier) ListAccountWorkspaceInvitations(ctx context.Context, accountID uuid.UUID) ([]db.ListAccountWorkspaceInvitationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountWorkspaceInvitations", ctx, accountID)
+	ret0, _ := ret[0].([]db.ListAccountWorkspaceInvitationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountWorkspaceInvitations indicates an expected call of ListAccountWorkspaceInvitations.
+func (mr *MockQuerierMockRecorder) ListAccountWorkspaceInvitations(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountWorkspaceInvitations", reflect.TypeOf((*MockQuerier)(nil).ListAccountWorkspaceInvitations), ctx, accountID)
+}
+
+// ListAccountWorkspaces mocks base method.
+func (m *MockQuerier) ListAccountWorkspaces(ctx context.Context, arg uuid.UUID) ([]db.ListAccountWorkspacesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountWorkspaces", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAccountWorkspacesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountWorkspaces indicates an expected call of ListAccountWorkspaces.
+func (mr *MockQuerierMockRecorder) ListAccountWorkspaces(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountWorkspaces", reflect.TypeOf((*MockQuerier)(nil).ListAccountWorkspaces), ctx, arg)
+}
+
+// ListAccounts mocks base method.
+func (m *MockQuerier) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.ListAccountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAccountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockQuerierMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockQuerier)(nil).ListAccounts), ctx, arg)
+}
+
+// ListImpersonationRequests mocks base method.
+func (m *MockQuerier) ListImpersonationRequests(ctx context.Context, arg uuid.UUID) ([]db.ImpersonationRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImpersonationRequests", ctx, arg)
+	ret0, _ := ret[0].([]db.ImpersonationRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImpersonationRequests indicates an expected call of ListImpersonationRequests.
+func (mr *MockQuerierMockRecorder) ListImpersonationRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImpersonationRequests", reflect.TypeOf((*MockQuerier)(nil).ListImpersonationRequests), ctx, arg)
+}
+
+// ListPendingWorkspaceInvitations mocks base method.
+func (m *MockQuerier) ListPendingWorkspaceInvitations(ctx context.Context, arg uuid.UUID) ([]db.WorkspaceInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingWorkspaceInvitations", ctx, arg)
+	ret0, _ := ret[0].([]db.WorkspaceInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingWorkspaceInvitations indicates an expected call of ListPendingWorkspaceInvitations.
+func (mr *MockQuerierMockRecorder) ListPendingWorkspaceInvitations(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingWorkspaceInvitations", reflect.TypeOf((*MockQuerier)(nil).ListPendingWorkspaceInvitations), ctx, arg)
+}
+
+// ListPermissions mocks base method.
+func (m *MockQuerier) ListPermissions(ctx context.Context) ([]db.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", ctx)
+	ret0, _ := ret[0].([]db.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockQuerierMockRecorder) ListPermissions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockQuerier)(nil).ListPermissions), ctx)
+}
+
+// ListProjectMembers mocks base method.
+func (m *MockQuerier) ListProjectMembers(ctx context.Context, arg db.ListProjectMembersParams) ([]db.ListProjectMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectMembers", ctx, arg)
+	ret0, _ := ret[0].([]db.ListProjectMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectMembers indicates an expected call of ListProjectMembers.
+func (mr *MockQuerierMockRecorder) ListProjectMembers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectMembers", reflect.TypeOf((*MockQuerier)(nil).ListProjectMembers), ctx, arg)
+}
+
+// ListProjects mocks base method.
+func (m *MockQuerier) ListProjects(ctx context.Context, arg db.ListProjectsParams) ([]db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx, arg)
+	ret0, _ := ret[0].([]db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockQuerierMockRecorder) ListProjects(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockQuerier)(nil).ListProjects), ctx, arg)
+}
+
+// ListRolePermissions mocks base method.
+func (m *MockQuerier) ListRolePermissions(ctx context.Context) ([]db.ListRolePermissionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolePermissions", ctx)
+	ret0, _ := ret[0].([]db.ListRolePermissionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolePermissions indicates an expected call of ListRolePermissions.
+func (mr *MockQuerierMockRecorder) ListRolePermissions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePermissions", reflect.TypeOf((*MockQuerier)(nil).ListRolePermissions), ctx)
+}
+
+// ListRoles mocks base method.
+func (m *MockQuerier) ListRoles(ctx context.Context) ([]db.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].([]db.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockQuerierMockRecorder) ListRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockQuerier)(nil).ListRoles), ctx)
+}
+
+// ListTasks mocks base method.
+func (m *MockQuerier) ListTasks(ctx context.Context, arg db.ListTasksParams) ([]db.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasks", ctx, arg)
+	ret0, _ := ret[0].([]db.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks.
+func (mr *MockQuerierMockRecorder) ListTasks(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockQuerier)(nil).ListTasks), ctx, arg)
+}
+
+// ListTeamMembers mocks base method.
+func (m *MockQuerier) ListTeamMembers(ctx context.Context, arg db.ListTeamMembersParams) ([]db.ListTeamMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamMembers", ctx, arg)
+	ret0, _ := ret[0].([]db.ListTeamMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamMembers indicates an expected call of ListTeamMembers.
+func (mr *MockQuerierMockRecorder) ListTeamMembers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamMembers", reflect.TypeOf((*MockQuerier)(nil).ListTeamMembers), ctx, arg)
+}
+
+// ListTeamProjects mocks base method.
+func (m *MockQuerier) ListTeamProjects(ctx context.Context, arg db.ListTeamProjectsParams) ([]db.ListTeamProjectsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamProjects", ctx, arg)
+	ret0, _ := ret[0].([]db.ListTeamProjectsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamProjects indicates an expected call of ListTeamProjects.
+func (mr *MockQuerierMockRecorder) ListTeamProjects(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamProjects", reflect.TypeOf((*MockQuerier)(nil).ListTeamProjects), ctx, arg)
+}
+
+// ListWorkspaceMembers mocks base method.
+func (m *MockQuerier) ListWorkspaceMembers(ctx context.Context, arg uuid.UUID) ([]db.ListWorkspaceMembersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspaceMembers", ctx, arg)
+	ret0, _ := ret[0].([]db.ListWorkspaceMembersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkspaceMembers indicates an expected call of ListWorkspaceMembers.
+func (mr *MockQuerierMockRecorder) ListWorkspaceMembers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaceMembers", reflect.TypeOf((*MockQuerier)(nil).ListWorkspaceMembers), ctx, arg)
+}
+
+// ListWorkspaceTeams mocks base method.
+func (m *MockQuerier) ListWorkspaceTeams(ctx context.Context, arg uuid.UUID) ([]db.ListWorkspaceTeamsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspaceTeams", ctx, arg)
+	ret0, _ := ret[0].([]db.ListWorkspaceTeamsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkspaceTeams indicates an expected call of ListWorkspaceTeams.
+func (mr *MockQuerierMockRecorder) ListWorkspaceTeams(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaceTeams", reflect.TypeOf((*MockQuerier)(nil).ListWorkspaceTeams), ctx, arg)
+}
+
+// LockSignInThrottle mocks base method.
+func (m *MockQuerier) LockSignInThrottle(ctx context.Context, arg db.LockSignInThrottleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockSignInThrottle", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockSignInThrottle indicates an expected call of LockSignInThrottle.
+func (mr *MockQuerierMockRecorder) LockSignInThrottle(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockSignInThrottle", reflect.TypeOf((*MockQuerier)(nil).LockSignInThrottle), ctx, arg)
+}
+
+// MarkAccountVerificationSent mocks base method.
+func (m *MockQuerier) MarkAccountVerificationSent(ctx context.Context, arg db.MarkAccountVerificationSentParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAccountVerificationSent", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAccountVerificationSent indicates an expected call of MarkAccountVerificationSent.
+func (mr *MockQuerierMockRecorder) MarkAccountVerificationSent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAccountVerificationSent", reflect.TypeOf((*MockQuerier)(nil).MarkAccountVerificationSent), ctx, arg)
+}
+
+// ReactivateAccount mocks base method.
+func (m *MockQuerier) ReactivateAccount(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReactivateAccount", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReactivateAccount indicates an expected call of ReactivateAccount.
+func (mr *MockQuerierMockRecorder) ReactivateAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateAccount", reflect.TypeOf((*MockQuerier)(nil).ReactivateAccount), ctx, arg)
+}
+
+// RecordSignInFailure mocks base method.
+func (m *MockQuerier) RecordSignInFailure(ctx context.Context, arg db.RecordSignInFailureParams) (db.SignInThrottle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSignInFailure", ctx, arg)
+	ret0, _ := ret[0].(db.SignInThrottle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordSignInFailure indicates an expected call of RecordSignInFailure.
+func (mr *MockQuerierMockRecorder) RecordSignInFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSignInFailure", reflect.TypeOf((*MockQuerier)(nil).RecordSignInFailure), ctx, arg)
+}
+
+// RehashAccountPassword mocks base method.
+func (m *MockQuerier) RehashAccountPassword(ctx context.Context, arg db.RehashAccountPasswordParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RehashAccountPassword", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RehashAccountPassword indicates an expected call of RehashAccountPassword.
+func (mr *MockQuerierMockRecorder) RehashAccountPassword(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehashAccountPassword", reflect.TypeOf((*MockQuerier)(nil).RehashAccountPassword), ctx, arg)
+}
+
+// RemoveProjectMember mocks base method.
+func (m *MockQuerier) RemoveProjectMember(ctx context.Context, arg db.RemoveProjectMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveProjectMember", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveProjectMember indicates an expected call of RemoveProjectMember.
+func (mr *MockQuerierMockRecorder) RemoveProjectMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProjectMember", reflect.TypeOf((*MockQuerier)(nil).RemoveProjectMember), ctx, arg)
+}
+
+// RemoveTeamMember mocks base method.
+func (m *MockQuerier) RemoveTeamMember(ctx context.Context, arg db.RemoveTeamMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTeamMember", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTeamMember indicates an expected call of RemoveTeamMember.
+func (mr *MockQuerierMockRecorder) RemoveTeamMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMember", reflect.TypeOf((*MockQuerier)(nil).RemoveTeamMember), ctx, arg)
+}
+
+// RemoveWorkspaceMember mocks base method.
+func (m *MockQuerier) RemoveWorkspaceMember(ctx context.Context, arg db.RemoveWorkspaceMemberParams) (db.RemoveWorkspaceMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWorkspaceMember", ctx, arg)
+	ret0, _ := ret[0].(db.RemoveWorkspaceMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveWorkspaceMember indicates an expected call of RemoveWorkspaceMember.
+func (mr *MockQuerierMockRecorder) RemoveWorkspaceMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkspaceMember", reflect.TypeOf((*MockQuerier)(nil).RemoveWorkspaceMember), ctx, arg)
+}
+
+// RenewWorkspaceInvitation mocks base method.
+func (m *MockQuerier) RenewWorkspaceInvitation(ctx context.Context, arg db.RenewWorkspaceInvitationParams) (db.WorkspaceInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewWorkspaceInvitation", ctx, arg)
+	ret0, _ := ret[0].(db.WorkspaceInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewWorkspaceInvitation indicates an expected call of RenewWorkspaceInvitation.
+func (mr *MockQuerierMockRecorder) RenewWorkspaceInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewWorkspaceInvitation", reflect.TypeOf((*MockQuerier)(nil).RenewWorkspaceInvitation), ctx, arg)
+}
+
+// ReplaceAccountAvatar mocks base method.
+func (m *MockQuerier) ReplaceAccountAvatar(ctx context.Context, arg db.ReplaceAccountAvatarParams) (pgtype.Text, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAccountAvatar", ctx, arg)
+	ret0, _ := ret[0].(pgtype.Text)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceAccountAvatar indicates an expected call of ReplaceAccountAvatar.
+func (mr *MockQuerierMockRecorder) ReplaceAccountAvatar(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAccountAvatar", reflect.TypeOf((*MockQuerier)(nil).ReplaceAccountAvatar), ctx, arg)
+}
+
+// RestoreAccount mocks base method.
+func (m *MockQuerier) RestoreAccount(ctx context.Context, arg uuid.UUID) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAccount", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreAccount indicates an expected call of RestoreAccount.
+func (mr *MockQuerierMockRecorder) RestoreAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAccount", reflect.TypeOf((*MockQuerier)(nil).RestoreAccount), ctx, arg)
+}
+
+// RestoreProject mocks base method.
+func (m *MockQuerier) RestoreProject(ctx context.Context, arg db.RestoreProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProject", ctx, arg)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProject indicates an expected call of RestoreProject.
+func (mr *MockQuerierMockRecorder) RestoreProject(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProject", reflect.TypeOf((*MockQuerier)(nil).RestoreProject), ctx, arg)
+}
+
+// RevokeAccountPersonalAccessTokens mocks base method.
+func (m *MockQuerier) RevokeAccountPersonalAccessTokens(ctx context.Context, accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountPersonalAccessTokens", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccountPersonalAccessTokens indicates an expected call of RevokeAccountPersonalAccessTokens.
+func (mr *MockQuerierMockRecorder) RevokeAccountPersonalAccessTokens(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountPersonalAccessTokens", reflect.TypeOf((*MockQuerier)(nil).RevokeAccountPersonalAccessTokens), ctx, accountID)
+}
+
+// RevokeAccountRefreshTokens mocks base method.
+func (m *MockQuerier) RevokeAccountRefreshTokens(ctx context.Context, arg uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountRefreshTokens", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccountRefreshTokens indicates an expected call of RevokeAccountRefreshTokens.
+func (mr *MockQuerierMockRecorder) RevokeAccountRefreshTokens(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountRefreshTokens", reflect.TypeOf((*MockQuerier)(nil).RevokeAccountRefreshTokens), ctx, arg)
+}
+
+// RevokeAccountRole mocks base method.
+func (m *MockQuerier) RevokeAccountRole(ctx context.Context, arg db.RevokeAccountRoleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountRole", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccountRole indicates an expected call of RevokeAccountRole.
+func (mr *MockQuerierMockRecorder) RevokeAccountRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountRole", reflect.TypeOf((*MockQuerier)(nil).RevokeAccountRole), ctx, arg)
+}
+
+// RevokeAccountSessions mocks base method.
+func (m *MockQuerier) RevokeAccountSessions(ctx context.Context, arg db.RevokeAccountSessionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountSessions", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccountSessions indicates an expected call of RevokeAccountSessions.
+func (mr *MockQuerierMockRecorder) RevokeAccountSessions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountSessions", reflect.TypeOf((*MockQuerier)(nil).RevokeAccountSessions), ctx, arg)
+}
+
+// RevokePersonalAccessToken mocks base method.
+func (m *MockQuerier) RevokePersonalAccessToken(ctx context.Context, arg db.RevokePersonalAccessTokenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePersonalAccessToken", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokePersonalAccessToken indicates an expected call of RevokePersonalAccessToken.
+func (mr *MockQuerierMockRecorder) RevokePersonalAccessToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePersonalAccessToken", reflect.TypeOf((*MockQuerier)(nil).RevokePersonalAccessToken), ctx, arg)
+}
+
+// RevokeRefreshToken mocks base method.
+func (m *MockQuerier) RevokeRefreshToken(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshToken", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeRefreshToken indicates an expected call of RevokeRefreshToken.
+func (mr *MockQuerierMockRecorder) RevokeRefreshToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshToken", reflect.TypeOf((*MockQuerier)(nil).RevokeRefreshToken), ctx, arg)
+}
+
+// RevokeSession mocks base method.
+func (m *MockQuerier) RevokeSession(ctx context.Context, arg db.RevokeSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockQuerierMockRecorder) RevokeSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockQuerier)(nil).RevokeSession), ctx, arg)
+}
+
+// RevokeWorkspaceInvitation mocks base method.
+func (m *MockQuerier) RevokeWorkspaceInvitation(ctx context.Context, arg db.RevokeWorkspaceInvitationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeWorkspaceInvitation", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeWorkspaceInvitation indicates an expected call of RevokeWorkspaceInvitation.
+func (mr *MockQuerierMockRecorder) RevokeWorkspaceInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeWorkspaceInvitation", reflect.TypeOf((*MockQuerier)(nil).RevokeWorkspaceInvitation), ctx, arg)
+}
+
+// SetAccountTOTPSecret mocks base method.
+func (m *MockQuerier) SetAccountTOTPSecret(ctx context.Context, arg db.SetAccountTOTPSecretParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountTOTPSecret", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAccountTOTPSecret indicates an expected call of SetAccountTOTPSecret.
+func (mr *MockQuerierMockRecorder) SetAccountTOTPSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountTOTPSecret", reflect.TypeOf((*MockQuerier)(nil).SetAccountTOTPSecret), ctx, arg)
+}
+
+// SetProjectMember mocks base method.
+func (m *MockQuerier) SetProjectMember(ctx context.Context, arg db.SetProjectMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectMember", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectMember indicates an expected call of SetProjectMember.
+func (mr *MockQuerierMockRecorder) SetProjectMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectMember", reflect.TypeOf((*MockQuerier)(nil).SetProjectMember), ctx, arg)
+}
+
+// SetTeamMember mocks base method.
+func (m *MockQuerier) SetTeamMember(ctx context.Context, arg db.SetTeamMemberParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamMember", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamMember indicates an expected call of SetTeamMember.
+func (mr *MockQuerierMockRecorder) SetTeamMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamMember", reflect.TypeOf((*MockQuerier)(nil).SetTeamMember), ctx, arg)
+}
+
+// SetWorkspaceScope mocks base method.
+func (m *MockQuerier) SetWorkspaceScope(ctx context.Context, arg uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWorkspaceScope", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWorkspaceScope indicates an expected call of SetWorkspaceScope.
+func (mr *MockQuerierMockRecorder) SetWorkspaceScope(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkspaceScope", reflect.TypeOf((*MockQuerier)(nil).SetWorkspaceScope), ctx, arg)
+}
+
+// SoftDeleteAccount mocks base method.
+func (m *MockQuerier) SoftDeleteAccount(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteAccount", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteAccount indicates an expected call of SoftDeleteAccount.
+func (mr *MockQuerierMockRecorder) SoftDeleteAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteAccount", reflect.TypeOf((*MockQuerier)(nil).SoftDeleteAccount), ctx, arg)
+}
+
+// SoftDeleteProject mocks base method.
+func (m *MockQuerier) SoftDeleteProject(ctx context.Context, arg db.SoftDeleteProjectParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteProject", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteProject indicates an expected call of SoftDeleteProject.
+func (mr *MockQuerierMockRecorder) SoftDeleteProject(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteProject", reflect.TypeOf((*MockQuerier)(nil).SoftDeleteProject), ctx, arg)
+}
+
+// SummarizeAccountRecoveryCodes mocks base method.
+func (m *MockQuerier) SummarizeAccountRecoveryCodes(ctx context.Context, accountID uuid.UUID) (db.SummarizeAccountRecoveryCodesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeAccountRecoveryCodes", ctx, accountID)
+	ret0, _ := ret[0].(db.SummarizeAccountRecoveryCodesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeAccountRecoveryCodes indicates an expected call of SummarizeAccountRecoveryCodes.
+func (mr *MockQuerierMockRecorder) SummarizeAccountRecoveryCodes(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeAccountRecoveryCodes", reflect.TypeOf((*MockQuerier)(nil).SummarizeAccountRecoveryCodes), ctx, accountID)
+}
+
+// SuspendAccount mocks base method.
+func (m *MockQuerier) SuspendAccount(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendAccount", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendAccount indicates an expected call of SuspendAccount.
+func (mr *MockQuerierMockRecorder) SuspendAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendAccount", reflect.TypeOf((*MockQuerier)(nil).SuspendAccount), ctx, arg)
+}
+
+// TouchPersonalAccessToken mocks base method.
+func (m *MockQuerier) TouchPersonalAccessToken(ctx context.Context, arg db.TouchPersonalAccessTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchPersonalAccessToken", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchPersonalAccessToken indicates an expected call of TouchPersonalAccessToken.
+func (mr *MockQuerierMockRecorder) TouchPersonalAccessToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchPersonalAccessToken", reflect.TypeOf((*MockQuerier)(nil).TouchPersonalAccessToken), ctx, arg)
+}
+
+// TouchSession mocks base method.
+func (m *MockQuerier) TouchSession(ctx context.Context, arg db.TouchSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockQuerierMockRecorder) TouchSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockQuerier)(nil).TouchSession), ctx, arg)
+}
+
+// UnassignTeamProject mocks base method.
+func (m *MockQuerier) UnassignTeamProject(ctx context.Context, arg db.UnassignTeamProjectParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignTeamProject", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignTeamProject indicates an expected call of UnassignTeamProject.
+func (mr *MockQuerierMockRecorder) UnassignTeamProject(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignTeamProject", reflect.TypeOf((*MockQuerier)(nil).UnassignTeamProject), ctx, arg)
+}
+
+// UpdateAccount mocks base method.
+func (m *MockQuerier) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccount", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccount indicates an expected call of UpdateAccount.
+func (mr *MockQuerierMockRecorder) UpdateAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockQuerier)(nil).UpdateAccount), ctx, arg)
+}
+
+// UpdateAccountPassword mocks base method.
+func (m *MockQuerier) UpdateAccountPassword(ctx context.Context, arg db.UpdateAccountPasswordParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountPassword", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountPassword indicates an expected call of UpdateAccountPassword.
+func (mr *MockQuerierMockRecorder) UpdateAccountPassword(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountPassword", reflect.TypeOf((*MockQuerier)(nil).UpdateAccountPassword), ctx, arg)
+}
+
+// UpdatePasskeyUsage mocks base method.
+func (m *MockQuerier) UpdatePasskeyUsage(ctx context.Context, arg db.UpdatePasskeyUsageParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePasskeyUsage", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePasskeyUsage indicates an expected call of UpdatePasskeyUsage.
+func (mr *MockQuerierMockRecorder) UpdatePasskeyUsage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasskeyUsage", reflect.TypeOf((*MockQuerier)(nil).UpdatePasskeyUsage), ctx, arg)
+}
+
+// UpdateProject mocks base method.
+func (m *MockQuerier) UpdateProject(ctx context.Context, arg db.UpdateProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", ctx, arg)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockQuerierMockRecorder) UpdateProject(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockQuerier)(nil).UpdateProject), ctx, arg)
+}
+
+// UpdateTask mocks base method.
+func (m *MockQuerier) UpdateTask(ctx context.Context, arg db.UpdateTaskParams) (db.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTask", ctx, arg)
+	ret0, _ := ret[0].(db.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTask indicates an expected call of UpdateTask.
+func (mr *MockQuerierMockRecorder) UpdateTask(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockQuerier)(nil).UpdateTask), ctx, arg)
+}
+
+// UpdateTeam mocks base method.
+func (m *MockQuerier) UpdateTeam(ctx context.Context, arg db.UpdateTeamParams) (db.UpdateTeamRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeam", ctx, arg)
+	ret0, _ := ret[0].(db.UpdateTeamRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeam indicates an expected call of UpdateTeam.
+func (mr *MockQuerierMockRecorder) UpdateTeam(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockQuerier)(nil).UpdateTeam), ctx, arg)
+}
+
+// UpdateWorkspace mocks base method.
+func (m *MockQuerier) UpdateWorkspace(ctx context.Context, arg db.UpdateWorkspaceParams) (db.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspace", ctx, arg)
+	ret0, _ := ret[0].(db.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspace indicates an expected call of UpdateWorkspace.
+func (mr *MockQuerierMockRecorder) UpdateWorkspace(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspace", reflect.TypeOf((*MockQuerier)(nil).UpdateWorkspace), ctx, arg)
+}
+
+// UseAccountTOTPStep mocks base method.
+func (m *MockQuerier) UseAccountTOTPStep(ctx context.Context, arg db.UseAccountTOTPStepParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseAccountTOTPStep", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseAccountTOTPStep indicates an expected call of UseAccountTOTPStep.
+func (mr *MockQuerierMockRecorder) UseAccountTOTPStep(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseAccountTOTPStep", reflect.TypeOf((*MockQuerier)(nil).UseAccountTOTPStep), ctx, arg)
+}
+
+// UsePasswordResetToken mocks base method.
+func (m *MockQuerier) UsePasswordResetToken(ctx context.Context, arg uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsePasswordResetToken", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsePasswordResetToken indicates an expected call of UsePasswordResetToken.
+func (mr *MockQuerierMockRecorder) UsePasswordResetToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsePasswordResetToken", reflect.TypeOf((*MockQuerier)(nil).UsePasswordResetToken), ctx, arg)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockQuerier) UseRecoveryCode(ctx context.Context, arg db.UseRecoveryCodeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockQuerierMockRecorder) UseRecoveryCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockQuerier)(nil).UseRecoveryCode), ctx, arg)
+}
+
+// VerifyAccountEmail mocks base method.
+func (m *MockQuerier) VerifyAccountEmail(ctx context.Context, arg db.VerifyAccountEmailParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAccountEmail", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAccountEmail indicates an expected call of VerifyAccountEmail.
+func (mr *MockQuerierMockRecorder) VerifyAccountEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAccountEmail", reflect.TypeOf((*MockQuerier)(nil).VerifyAccountEmail), ctx, arg)
 }
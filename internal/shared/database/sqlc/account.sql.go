@@ -12,55 +12,166 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countAccounts = `-- name: CountAccounts :one
+SELECT COUNT(*)
+FROM accounts AS a
+LEFT JOIN sign_in_throttles AS t ON t.scope = 'account' AND t.subject = lower(a.email)
+WHERE ($1::text IS NULL
+       OR a.name ILIKE '%' || $1::text || '%'
+       OR a.email ILIKE '%' || $1::text || '%')
+  AND ($2::text IS NULL OR CASE $2::text
+        WHEN 'active' THEN a.deleted_at IS NULL AND a.suspended_at IS NULL
+        WHEN 'deleted' THEN a.deleted_at IS NOT NULL
+        WHEN 'suspended' THEN a.suspended_at IS NOT NULL
+        WHEN 'locked' THEN t.locked_until > NOW()
+        WHEN 'unverified' THEN a.email_verified_at IS NULL
+      END)
+`
+
+type CountAccountsParams struct {
+	Search pgtype.Text
+	Status pgtype.Text
+}
+
+func (q *Queries) CountAccounts(ctx context.Context, arg CountAccountsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAccounts, arg.Search, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAccount = `-- name: CreateAccount :one
-INSERT INTO accounts (name, email, password, avatar)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, email, password, avatar, created_at, updated_at, deleted_at
+INSERT INTO accounts (name, email, password)
+VALUES ($1, $2, $3)
+RETURNING id, name, email, password, avatar_key, created_at, updated_at, deleted_at, email_verified_at, verification_sent_at, totp_secret, totp_last_used_step, two_factor_enabled_at, suspended_at
 `
 
 type CreateAccountParams struct {
 	Name     string
 	Email    string
 	Password string
-	Avatar   pgtype.Text
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, createAccount,
-		arg.Name,
-		arg.Email,
-		arg.Password,
-		arg.Avatar,
-	)
+	row := q.db.QueryRow(ctx, createAccount, arg.Name, arg.Email, arg.Password)
 	var i Account
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Email,
 		&i.Password,
-		&i.Avatar,
+		&i.AvatarKey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.EmailVerifiedAt,
+		&i.VerificationSentAt,
+		&i.TotpSecret,
+		&i.TotpLastUsedStep,
+		&i.TwoFactorEnabledAt,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
 
+const disableAccountTwoFactor = `-- name: DisableAccountTwoFactor :execrows
+UPDATE accounts
+SET totp_secret = NULL, totp_last_used_step = NULL, two_factor_enabled_at = NULL, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DisableAccountTwoFactor(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, disableAccountTwoFactor, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enableAccountTwoFactor = `-- name: EnableAccountTwoFactor :execrows
+UPDATE accounts
+SET two_factor_enabled_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL AND totp_secret IS NOT NULL AND two_factor_enabled_at IS NULL
+`
+
+func (q *Queries) EnableAccountTwoFactor(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, enableAccountTwoFactor, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const eraseAccount = `-- name: EraseAccount :one
+WITH erased_identities AS (
+    DELETE FROM account_identities WHERE account_identities.account_id = $1
+), erased_passkeys AS (
+    DELETE FROM passkeys WHERE passkeys.account_id = $1
+), erased_passkey_challenges AS (
+    DELETE FROM passkey_challenges WHERE passkey_challenges.account_id = $1
+), erased_sessions AS (
+    DELETE FROM sessions WHERE sessions.account_id = $1
+), erased_password_reset_tokens AS (
+    DELETE FROM password_reset_tokens WHERE password_reset_tokens.account_id = $1
+), erased_email_change_requests AS (
+    DELETE FROM email_change_requests WHERE email_change_requests.account_id = $1
+), erased_recovery_codes AS (
+    DELETE FROM recovery_codes WHERE recovery_codes.account_id = $1
+), erased_personal_access_tokens AS (
+    DELETE FROM personal_access_tokens WHERE personal_access_tokens.account_id = $1
+), erased_throttles AS (
+    DELETE FROM sign_in_throttles
+    WHERE scope = 'account' AND subject = (SELECT lower(email) FROM accounts WHERE accounts.id = $1)
+), previous AS (
+    SELECT avatar_key FROM accounts WHERE accounts.id = $1
+)
+UPDATE accounts
+SET name = 'Conta removida',
+    email = 'erased+' || accounts.id::TEXT || '@erased.invalid',
+    password = '',
+    avatar_key = NULL,
+    email_verified_at = NULL,
+    verification_sent_at = NULL,
+    totp_secret = NULL,
+    totp_last_used_step = NULL,
+    two_factor_enabled_at = NULL,
+    deleted_at = COALESCE(deleted_at, NOW()),
+    updated_at = NOW()
+FROM previous
+WHERE accounts.id = $1
+RETURNING previous.avatar_key
+`
+
+// EraseAccount anonymizes the personal fields of the account, keeping its
+// row for what references it, and removes its credentials, devices and
+// pending requests. The email is replaced by a unique address that cannot
+// receive mail. Erased accounts count as deleted and cannot be restored.
+func (q *Queries) EraseAccount(ctx context.Context, id uuid.UUID) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, eraseAccount, id)
+	var avatar_key pgtype.Text
+	err := row.Scan(&avatar_key)
+	return avatar_key, err
+}
+
 const findAccount = `-- name: FindAccount :one
-SELECT id, name, email, avatar, created_at, updated_at, deleted_at, password
+SELECT id, name, email, avatar_key, created_at, updated_at, deleted_at, password, email_verified_at, verification_sent_at, totp_secret, two_factor_enabled_at
 FROM accounts
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL AND suspended_at IS NULL
 `
 
 type FindAccountRow struct {
-	ID        uuid.UUID
-	Name      string
-	Email     string
-	Avatar    pgtype.Text
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
-	Password  string
+	ID                 uuid.UUID
+	Name               string
+	Email              string
+	AvatarKey          pgtype.Text
+	CreatedAt          pgtype.Timestamp
+	UpdatedAt          pgtype.Timestamp
+	DeletedAt          pgtype.Timestamp
+	Password           string
+	EmailVerifiedAt    pgtype.Timestamp
+	VerificationSentAt pgtype.Timestamp
+	TotpSecret         pgtype.Text
+	TwoFactorEnabledAt pgtype.Timestamp
 }
 
 func (q *Queries) FindAccount(ctx context.Context, id uuid.UUID) (FindAccountRow, error) {
@@ -70,30 +181,38 @@ func (q *Queries) FindAccount(ctx context.Context, id uuid.UUID) (FindAccountRow
 		&i.ID,
 		&i.Name,
 		&i.Email,
-		&i.Avatar,
+		&i.AvatarKey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Password,
+		&i.EmailVerifiedAt,
+		&i.VerificationSentAt,
+		&i.TotpSecret,
+		&i.TwoFactorEnabledAt,
 	)
 	return i, err
 }
 
 const findAccountByEmail = `-- name: FindAccountByEmail :one
-SELECT id, name, email, avatar, created_at, updated_at, deleted_at, password
+SELECT id, name, email, avatar_key, created_at, updated_at, deleted_at, password, email_verified_at, verification_sent_at, totp_secret, two_factor_enabled_at
 FROM accounts
-WHERE email = $1
+WHERE lower(email) = lower($1::text) AND deleted_at IS NULL AND suspended_at IS NULL
 `
 
 type FindAccountByEmailRow struct {
-	ID        uuid.UUID
-	Name      string
-	Email     string
-	Avatar    pgtype.Text
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
-	Password  string
+	ID                 uuid.UUID
+	Name               string
+	Email              string
+	AvatarKey          pgtype.Text
+	CreatedAt          pgtype.Timestamp
+	UpdatedAt          pgtype.Timestamp
+	DeletedAt          pgtype.Timestamp
+	Password           string
+	EmailVerifiedAt    pgtype.Timestamp
+	VerificationSentAt pgtype.Timestamp
+	TotpSecret         pgtype.Text
+	TwoFactorEnabledAt pgtype.Timestamp
 }
 
 func (q *Queries) FindAccountByEmail(ctx context.Context, email string) (FindAccountByEmailRow, error) {
@@ -103,40 +222,409 @@ func (q *Queries) FindAccountByEmail(ctx context.Context, email string) (FindAcc
 		&i.ID,
 		&i.Name,
 		&i.Email,
-		&i.Avatar,
+		&i.AvatarKey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Password,
+		&i.EmailVerifiedAt,
+		&i.VerificationSentAt,
+		&i.TotpSecret,
+		&i.TwoFactorEnabledAt,
+	)
+	return i, err
+}
+
+const findAccountForAdmin = `-- name: FindAccountForAdmin :one
+
+SELECT a.id, a.name, a.email, a.avatar_key, a.created_at, a.updated_at, a.deleted_at, a.email_verified_at, a.two_factor_enabled_at, a.suspended_at, t.locked_until
+FROM accounts AS a
+LEFT JOIN sign_in_throttles AS t ON t.scope = 'account' AND t.subject = lower(a.email)
+WHERE a.id = $1
+`
+
+type FindAccountForAdminRow struct {
+	ID                 uuid.UUID
+	Name               string
+	Email              string
+	AvatarKey          pgtype.Text
+	CreatedAt          pgtype.Timestamp
+	UpdatedAt          pgtype.Timestamp
+	DeletedAt          pgtype.Timestamp
+	EmailVerifiedAt    pgtype.Timestamp
+	TwoFactorEnabledAt pgtype.Timestamp
+	SuspendedAt        pgtype.Timestamp
+	LockedUntil        pgtype.Timestamp
+}
+
+// The queries below serve the administration of accounts and, unlike the
+// lookups above, see accounts in every state. An account is locked while its
+// sign-in throttle, kept under the lowercased email, has a lockout running.
+func (q *Queries) FindAccountForAdmin(ctx context.Context, id uuid.UUID) (FindAccountForAdminRow, error) {
+	row := q.db.QueryRow(ctx, findAccountForAdmin, id)
+	var i FindAccountForAdminRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.AvatarKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
+		&i.TwoFactorEnabledAt,
+		&i.SuspendedAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const hardDeleteAccount = `-- name: HardDeleteAccount :one
+DELETE FROM accounts
+WHERE id = $1
+RETURNING avatar_key
+`
+
+// Returns the avatar key of the removed account so its images can be removed
+// from storage. Every row referencing the account is removed with it.
+func (q *Queries) HardDeleteAccount(ctx context.Context, id uuid.UUID) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, hardDeleteAccount, id)
+	var avatar_key pgtype.Text
+	err := row.Scan(&avatar_key)
+	return avatar_key, err
+}
+
+const listAccounts = `-- name: ListAccounts :many
+SELECT a.id, a.name, a.email, a.avatar_key, a.created_at, a.updated_at, a.deleted_at, a.email_verified_at, a.two_factor_enabled_at, a.suspended_at, t.locked_until
+FROM accounts AS a
+LEFT JOIN sign_in_throttles AS t ON t.scope = 'account' AND t.subject = lower(a.email)
+WHERE ($1::text IS NULL
+       OR a.name ILIKE '%' || $1::text || '%'
+       OR a.email ILIKE '%' || $1::text || '%')
+  AND ($2::text IS NULL OR CASE $2::text
+        WHEN 'active' THEN a.deleted_at IS NULL AND a.suspended_at IS NULL
+        WHEN 'deleted' THEN a.deleted_at IS NOT NULL
+        WHEN 'suspended' THEN a.suspended_at IS NOT NULL
+        WHEN 'locked' THEN t.locked_until > NOW()
+        WHEN 'unverified' THEN a.email_verified_at IS NULL
+      END)
+ORDER BY a.created_at DESC, a.id
+LIMIT $4 OFFSET $3
+`
+
+type ListAccountsParams struct {
+	Search    pgtype.Text
+	Status    pgtype.Text
+	RowOffset int32
+	RowLimit  int32
+}
+
+type ListAccountsRow struct {
+	ID                 uuid.UUID
+	Name               string
+	Email              string
+	AvatarKey          pgtype.Text
+	CreatedAt          pgtype.Timestamp
+	UpdatedAt          pgtype.Timestamp
+	DeletedAt          pgtype.Timestamp
+	EmailVerifiedAt    pgtype.Timestamp
+	TwoFactorEnabledAt pgtype.Timestamp
+	SuspendedAt        pgtype.Timestamp
+	LockedUntil        pgtype.Timestamp
+}
+
+func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]ListAccountsRow, error) {
+	rows, err := q.db.Query(ctx, listAccounts,
+		arg.Search,
+		arg.Status,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountsRow
+	for rows.Next() {
+		var i ListAccountsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.AvatarKey,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.EmailVerifiedAt,
+			&i.TwoFactorEnabledAt,
+			&i.SuspendedAt,
+			&i.LockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAccountVerificationSent = `-- name: MarkAccountVerificationSent :execrows
+UPDATE accounts
+SET verification_sent_at = $1
+WHERE id = $2
+  AND deleted_at IS NULL
+  AND email_verified_at IS NULL
+  AND (verification_sent_at IS NULL OR verification_sent_at <= $3)
+`
+
+type MarkAccountVerificationSentParams struct {
+	SentAt       pgtype.Timestamp
+	ID           uuid.UUID
+	ResendBefore pgtype.Timestamp
+}
+
+func (q *Queries) MarkAccountVerificationSent(ctx context.Context, arg MarkAccountVerificationSentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markAccountVerificationSent, arg.SentAt, arg.ID, arg.ResendBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reactivateAccount = `-- name: ReactivateAccount :execrows
+UPDATE accounts
+SET suspended_at = NULL, updated_at = NOW()
+WHERE id = $1 AND suspended_at IS NOT NULL
+`
+
+func (q *Queries) ReactivateAccount(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, reactivateAccount, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const rehashAccountPassword = `-- name: RehashAccountPassword :execrows
+UPDATE accounts
+SET password = $1
+WHERE id = $2 AND password = $3 AND deleted_at IS NULL
+`
+
+type RehashAccountPasswordParams struct {
+	NewPassword string
+	ID          uuid.UUID
+	OldPassword string
+}
+
+// Replacing the hash only while it is still the one that was verified keeps a
+// concurrent password change from being undone.
+func (q *Queries) RehashAccountPassword(ctx context.Context, arg RehashAccountPasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rehashAccountPassword, arg.NewPassword, arg.ID, arg.OldPassword)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const replaceAccountAvatar = `-- name: ReplaceAccountAvatar :one
+UPDATE accounts AS a
+SET avatar_key = $1, updated_at = NOW()
+FROM (
+    SELECT p.id, p.avatar_key
+    FROM accounts AS p
+    WHERE p.id = $2 AND p.deleted_at IS NULL
+    FOR UPDATE
+) AS previous
+WHERE a.id = previous.id
+RETURNING previous.avatar_key AS previous_avatar_key
+`
+
+type ReplaceAccountAvatarParams struct {
+	AvatarKey pgtype.Text
+	ID        uuid.UUID
+}
+
+// Returns the key of the replaced avatar, read under the same row lock, so
+// its images can be removed from storage.
+func (q *Queries) ReplaceAccountAvatar(ctx context.Context, arg ReplaceAccountAvatarParams) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, replaceAccountAvatar, arg.AvatarKey, arg.ID)
+	var previous_avatar_key pgtype.Text
+	err := row.Scan(&previous_avatar_key)
+	return previous_avatar_key, err
+}
+
+const restoreAccount = `-- name: RestoreAccount :one
+UPDATE accounts
+SET deleted_at = NULL, updated_at = NOW()
+WHERE accounts.id = $1 AND accounts.deleted_at IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM data_jobs AS j
+      WHERE j.account_id = $1 AND j.kind = 'erasure' AND j.status = 'completed'
+  )
+RETURNING id, name, email, password, avatar_key, created_at, updated_at, deleted_at, email_verified_at, verification_sent_at, totp_secret, totp_last_used_step, two_factor_enabled_at, suspended_at
+`
+
+func (q *Queries) RestoreAccount(ctx context.Context, id uuid.UUID) (Account, error) {
+	row := q.db.QueryRow(ctx, restoreAccount, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Password,
+		&i.AvatarKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.EmailVerifiedAt,
+		&i.VerificationSentAt,
+		&i.TotpSecret,
+		&i.TotpLastUsedStep,
+		&i.TwoFactorEnabledAt,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
 
+const setAccountTOTPSecret = `-- name: SetAccountTOTPSecret :execrows
+UPDATE accounts
+SET totp_secret = $2, totp_last_used_step = NULL, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL AND two_factor_enabled_at IS NULL
+`
+
+type SetAccountTOTPSecretParams struct {
+	ID         uuid.UUID
+	TotpSecret pgtype.Text
+}
+
+func (q *Queries) SetAccountTOTPSecret(ctx context.Context, arg SetAccountTOTPSecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setAccountTOTPSecret, arg.ID, arg.TotpSecret)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteAccount = `-- name: SoftDeleteAccount :execrows
+UPDATE accounts
+SET deleted_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteAccount(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteAccount, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const suspendAccount = `-- name: SuspendAccount :execrows
+UPDATE accounts
+SET suspended_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL AND suspended_at IS NULL
+`
+
+func (q *Queries) SuspendAccount(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, suspendAccount, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
-SET name = $2, avatar = $3
-WHERE id = $1
-RETURNING id, name, email, password, avatar, created_at, updated_at, deleted_at
+SET name = $2, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, email, password, avatar_key, created_at, updated_at, deleted_at, email_verified_at, verification_sent_at, totp_secret, totp_last_used_step, two_factor_enabled_at, suspended_at
 `
 
 type UpdateAccountParams struct {
-	ID     uuid.UUID
-	Name   string
-	Avatar pgtype.Text
+	ID   uuid.UUID
+	Name string
 }
 
 func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, updateAccount, arg.ID, arg.Name, arg.Avatar)
+	row := q.db.QueryRow(ctx, updateAccount, arg.ID, arg.Name)
 	var i Account
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Email,
 		&i.Password,
-		&i.Avatar,
+		&i.AvatarKey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.EmailVerifiedAt,
+		&i.VerificationSentAt,
+		&i.TotpSecret,
+		&i.TotpLastUsedStep,
+		&i.TwoFactorEnabledAt,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
+
+const updateAccountPassword = `-- name: UpdateAccountPassword :execrows
+UPDATE accounts
+SET password = $2, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+type UpdateAccountPasswordParams struct {
+	ID       uuid.UUID
+	Password string
+}
+
+func (q *Queries) UpdateAccountPassword(ctx context.Context, arg UpdateAccountPasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAccountPassword, arg.ID, arg.Password)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useAccountTOTPStep = `-- name: UseAccountTOTPStep :execrows
+UPDATE accounts
+SET totp_last_used_step = $1::bigint
+WHERE id = $2
+  AND deleted_at IS NULL
+  AND (totp_last_used_step IS NULL OR totp_last_used_step < $1::bigint)
+`
+
+type UseAccountTOTPStepParams struct {
+	Step int64
+	ID   uuid.UUID
+}
+
+func (q *Queries) UseAccountTOTPStep(ctx context.Context, arg UseAccountTOTPStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useAccountTOTPStep, arg.Step, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const verifyAccountEmail = `-- name: VerifyAccountEmail :execrows
+UPDATE accounts
+SET email_verified_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND email = $2 AND deleted_at IS NULL AND email_verified_at IS NULL
+`
+
+type VerifyAccountEmailParams struct {
+	ID    uuid.UUID
+	Email string
+}
+
+func (q *Queries) VerifyAccountEmail(ctx context.Context, arg VerifyAccountEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, verifyAccountEmail, arg.ID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
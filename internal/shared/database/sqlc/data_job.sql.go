@@ -0,0 +1,234 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: data_job.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDataJob = `-- name: ClaimDataJob :one
+UPDATE data_jobs
+SET status = 'running', started_at = NOW()
+WHERE id = (
+    SELECT j.id FROM data_jobs AS j
+    WHERE j.status = 'pending' OR (j.status = 'running' AND j.started_at < $1)
+    ORDER BY j.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, account_id, requested_by, kind, status, archive_key, error, created_at, started_at, finished_at, expires_at
+`
+
+// ClaimDataJob marks the oldest pending job as running and returns it. Jobs
+// left running since before stale_before, by a server that stopped midway,
+// are claimed again. SKIP LOCKED lets several servers claim jobs at once.
+func (q *Queries) ClaimDataJob(ctx context.Context, staleBefore pgtype.Timestamp) (DataJob, error) {
+	row := q.db.QueryRow(ctx, claimDataJob, staleBefore)
+	var i DataJob
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.RequestedBy,
+		&i.Kind,
+		&i.Status,
+		&i.ArchiveKey,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const clearAccountDataJobArchives = `-- name: ClearAccountDataJobArchives :many
+UPDATE data_jobs
+SET archive_key = NULL
+WHERE account_id = $1 AND archive_key IS NOT NULL
+RETURNING archive_key::TEXT
+`
+
+// ClearAccountDataJobArchives forgets every archive of the account and
+// returns their keys, so they can be removed from the storage.
+func (q *Queries) ClearAccountDataJobArchives(ctx context.Context, accountID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, clearAccountDataJobArchives, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var archive_key string
+		if err := rows.Scan(&archive_key); err != nil {
+			return nil, err
+		}
+		items = append(items, archive_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeDataJob = `-- name: CompleteDataJob :exec
+UPDATE data_jobs
+SET status = 'completed', archive_key = $2, expires_at = $3, error = NULL, finished_at = NOW()
+WHERE id = $1
+`
+
+type CompleteDataJobParams struct {
+	ID         uuid.UUID
+	ArchiveKey pgtype.Text
+	ExpiresAt  pgtype.Timestamp
+}
+
+func (q *Queries) CompleteDataJob(ctx context.Context, arg CompleteDataJobParams) error {
+	_, err := q.db.Exec(ctx, completeDataJob, arg.ID, arg.ArchiveKey, arg.ExpiresAt)
+	return err
+}
+
+const createDataJob = `-- name: CreateDataJob :one
+INSERT INTO data_jobs (account_id, requested_by, kind)
+VALUES ($1, $2, $3)
+RETURNING id, account_id, requested_by, kind, status, archive_key, error, created_at, started_at, finished_at, expires_at
+`
+
+type CreateDataJobParams struct {
+	AccountID   uuid.UUID
+	RequestedBy pgtype.UUID
+	Kind        string
+}
+
+func (q *Queries) CreateDataJob(ctx context.Context, arg CreateDataJobParams) (DataJob, error) {
+	row := q.db.QueryRow(ctx, createDataJob, arg.AccountID, arg.RequestedBy, arg.Kind)
+	var i DataJob
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.RequestedBy,
+		&i.Kind,
+		&i.Status,
+		&i.ArchiveKey,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const expireDataJobArchives = `-- name: ExpireDataJobArchives :many
+UPDATE data_jobs
+SET archive_key = NULL
+WHERE archive_key IS NOT NULL AND expires_at <= NOW()
+RETURNING archive_key::TEXT
+`
+
+// ExpireDataJobArchives forgets the archives of exports past expires_at and
+// returns their keys, so they can be removed from the storage.
+func (q *Queries) ExpireDataJobArchives(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, expireDataJobArchives)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var archive_key string
+		if err := rows.Scan(&archive_key); err != nil {
+			return nil, err
+		}
+		items = append(items, archive_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const failDataJob = `-- name: FailDataJob :exec
+UPDATE data_jobs
+SET status = 'failed', error = $2, finished_at = NOW()
+WHERE id = $1
+`
+
+type FailDataJobParams struct {
+	ID    uuid.UUID
+	Error pgtype.Text
+}
+
+func (q *Queries) FailDataJob(ctx context.Context, arg FailDataJobParams) error {
+	_, err := q.db.Exec(ctx, failDataJob, arg.ID, arg.Error)
+	return err
+}
+
+const findDataJob = `-- name: FindDataJob :one
+SELECT id, account_id, requested_by, kind, status, archive_key, error, created_at, started_at, finished_at, expires_at
+FROM data_jobs
+WHERE id = $1
+`
+
+func (q *Queries) FindDataJob(ctx context.Context, id uuid.UUID) (DataJob, error) {
+	row := q.db.QueryRow(ctx, findDataJob, id)
+	var i DataJob
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.RequestedBy,
+		&i.Kind,
+		&i.Status,
+		&i.ArchiveKey,
+		&i.Error,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const listAccountDataJobs = `-- name: ListAccountDataJobs :many
+SELECT id, account_id, requested_by, kind, status, archive_key, error, created_at, started_at, finished_at, expires_at
+FROM data_jobs
+WHERE account_id = $1
+ORDER BY created_at DESC, id
+`
+
+func (q *Queries) ListAccountDataJobs(ctx context.Context, accountID uuid.UUID) ([]DataJob, error) {
+	rows, err := q.db.Query(ctx, listAccountDataJobs, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DataJob
+	for rows.Next() {
+		var i DataJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.RequestedBy,
+			&i.Kind,
+			&i.Status,
+			&i.ArchiveKey,
+			&i.Error,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
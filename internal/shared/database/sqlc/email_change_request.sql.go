@@ -0,0 +1,186 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: email_change_request.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const cancelAccountEmailChangeRequests = `-- name: CancelAccountEmailChangeRequests :exec
+UPDATE email_change_requests
+SET cancelled_at = NOW()
+WHERE account_id = $1 AND confirmed_at IS NULL AND cancelled_at IS NULL
+`
+
+func (q *Queries) CancelAccountEmailChangeRequests(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, cancelAccountEmailChangeRequests, accountID)
+	return err
+}
+
+const cancelEmailChangeRequest = `-- name: CancelEmailChangeRequest :execrows
+UPDATE email_change_requests
+SET cancelled_at = NOW()
+WHERE id = $1 AND confirmed_at IS NULL AND cancelled_at IS NULL
+`
+
+func (q *Queries) CancelEmailChangeRequest(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelEmailChangeRequest, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const confirmEmailChangeRequest = `-- name: ConfirmEmailChangeRequest :execrows
+WITH confirmed AS (
+    UPDATE email_change_requests
+    SET confirmed_at = NOW()
+    WHERE email_change_requests.id = $1
+      AND confirmed_at IS NULL
+      AND cancelled_at IS NULL
+      AND expires_at > NOW()
+    RETURNING account_id, new_email
+)
+UPDATE accounts
+SET email = confirmed.new_email, email_verified_at = NOW(), updated_at = NOW()
+FROM confirmed
+WHERE accounts.id = confirmed.account_id AND accounts.deleted_at IS NULL
+`
+
+// Consumes the request and moves the account to the new address in a single
+// statement, so a unique violation on accounts.email leaves the request
+// pending. The new address counts as verified, since the link confirming the
+// change was sent to it.
+func (q *Queries) ConfirmEmailChangeRequest(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, confirmEmailChangeRequest, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createEmailChangeRequest = `-- name: CreateEmailChangeRequest :one
+INSERT INTO email_change_requests (account_id, new_email, token_hash, cancel_token_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, new_email, token_hash, cancel_token_hash, expires_at, confirmed_at, cancelled_at, created_at
+`
+
+type CreateEmailChangeRequestParams struct {
+	AccountID       uuid.UUID
+	NewEmail        string
+	TokenHash       string
+	CancelTokenHash string
+	ExpiresAt       pgtype.Timestamp
+}
+
+func (q *Queries) CreateEmailChangeRequest(ctx context.Context, arg CreateEmailChangeRequestParams) (EmailChangeRequest, error) {
+	row := q.db.QueryRow(ctx, createEmailChangeRequest,
+		arg.AccountID,
+		arg.NewEmail,
+		arg.TokenHash,
+		arg.CancelTokenHash,
+		arg.ExpiresAt,
+	)
+	var i EmailChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.NewEmail,
+		&i.TokenHash,
+		&i.CancelTokenHash,
+		&i.ExpiresAt,
+		&i.ConfirmedAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findEmailChangeRequestByCancelTokenHash = `-- name: FindEmailChangeRequestByCancelTokenHash :one
+SELECT id, account_id, new_email, token_hash, cancel_token_hash, expires_at, confirmed_at, cancelled_at, created_at
+FROM email_change_requests
+WHERE cancel_token_hash = $1
+`
+
+func (q *Queries) FindEmailChangeRequestByCancelTokenHash(ctx context.Context, cancelTokenHash string) (EmailChangeRequest, error) {
+	row := q.db.QueryRow(ctx, findEmailChangeRequestByCancelTokenHash, cancelTokenHash)
+	var i EmailChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.NewEmail,
+		&i.TokenHash,
+		&i.CancelTokenHash,
+		&i.ExpiresAt,
+		&i.ConfirmedAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findEmailChangeRequestByTokenHash = `-- name: FindEmailChangeRequestByTokenHash :one
+SELECT id, account_id, new_email, token_hash, cancel_token_hash, expires_at, confirmed_at, cancelled_at, created_at
+FROM email_change_requests
+WHERE token_hash = $1
+`
+
+func (q *Queries) FindEmailChangeRequestByTokenHash(ctx context.Context, tokenHash string) (EmailChangeRequest, error) {
+	row := q.db.QueryRow(ctx, findEmailChangeRequestByTokenHash, tokenHash)
+	var i EmailChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.NewEmail,
+		&i.TokenHash,
+		&i.CancelTokenHash,
+		&i.ExpiresAt,
+		&i.ConfirmedAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountEmailChangeRequests = `-- name: ListAccountEmailChangeRequests :many
+SELECT id, account_id, new_email, token_hash, cancel_token_hash, expires_at, confirmed_at, cancelled_at, created_at
+FROM email_change_requests
+WHERE account_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListAccountEmailChangeRequests(ctx context.Context, accountID uuid.UUID) ([]EmailChangeRequest, error) {
+	rows, err := q.db.Query(ctx, listAccountEmailChangeRequests, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailChangeRequest
+	for rows.Next() {
+		var i EmailChangeRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.NewEmail,
+			&i.TokenHash,
+			&i.CancelTokenHash,
+			&i.ExpiresAt,
+			&i.ConfirmedAt,
+			&i.CancelledAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
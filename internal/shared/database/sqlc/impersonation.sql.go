@@ -0,0 +1,151 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: impersonation.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createImpersonation = `-- name: CreateImpersonation :one
+INSERT INTO impersonations (actor_account_id, account_id, reason, ip_address, user_agent, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, actor_account_id, account_id, reason, ip_address, user_agent, expires_at, ended_at, created_at
+`
+
+type CreateImpersonationParams struct {
+	ActorAccountID pgtype.UUID
+	AccountID      pgtype.UUID
+	Reason         string
+	IpAddress      string
+	UserAgent      string
+	ExpiresAt      pgtype.Timestamp
+}
+
+func (q *Queries) CreateImpersonation(ctx context.Context, arg CreateImpersonationParams) (Impersonation, error) {
+	row := q.db.QueryRow(ctx, createImpersonation,
+		arg.ActorAccountID,
+		arg.AccountID,
+		arg.Reason,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.ExpiresAt,
+	)
+	var i Impersonation
+	err := row.Scan(
+		&i.ID,
+		&i.ActorAccountID,
+		&i.AccountID,
+		&i.Reason,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.EndedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createImpersonationRequest = `-- name: CreateImpersonationRequest :exec
+INSERT INTO impersonation_requests (impersonation_id, method, path, status, ip_address, user_agent)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateImpersonationRequestParams struct {
+	ImpersonationID uuid.UUID
+	Method          string
+	Path            string
+	Status          int32
+	IpAddress       string
+	UserAgent       string
+}
+
+func (q *Queries) CreateImpersonationRequest(ctx context.Context, arg CreateImpersonationRequestParams) error {
+	_, err := q.db.Exec(ctx, createImpersonationRequest,
+		arg.ImpersonationID,
+		arg.Method,
+		arg.Path,
+		arg.Status,
+		arg.IpAddress,
+		arg.UserAgent,
+	)
+	return err
+}
+
+const endImpersonation = `-- name: EndImpersonation :execrows
+UPDATE impersonations
+SET ended_at = NOW()
+WHERE id = $1 AND ended_at IS NULL AND expires_at > NOW()
+`
+
+func (q *Queries) EndImpersonation(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, endImpersonation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findImpersonation = `-- name: FindImpersonation :one
+SELECT id, actor_account_id, account_id, reason, ip_address, user_agent, expires_at, ended_at, created_at
+FROM impersonations
+WHERE id = $1
+`
+
+func (q *Queries) FindImpersonation(ctx context.Context, id uuid.UUID) (Impersonation, error) {
+	row := q.db.QueryRow(ctx, findImpersonation, id)
+	var i Impersonation
+	err := row.Scan(
+		&i.ID,
+		&i.ActorAccountID,
+		&i.AccountID,
+		&i.Reason,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
+		&i.EndedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listImpersonationRequests = `-- name: ListImpersonationRequests :many
+SELECT id, impersonation_id, method, path, status, ip_address, user_agent, created_at
+FROM impersonation_requests
+WHERE impersonation_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListImpersonationRequests(ctx context.Context, impersonationID uuid.UUID) ([]ImpersonationRequest, error) {
+	rows, err := q.db.Query(ctx, listImpersonationRequests, impersonationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ImpersonationRequest
+	for rows.Next() {
+		var i ImpersonationRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.ImpersonationID,
+			&i.Method,
+			&i.Path,
+			&i.Status,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
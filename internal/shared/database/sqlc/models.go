@@ -10,12 +10,278 @@ import (
 )
 
 type Account struct {
+	ID                 uuid.UUID
+	Name               string
+	Email              string
+	Password           string
+	AvatarKey          pgtype.Text
+	CreatedAt          pgtype.Timestamp
+	UpdatedAt          pgtype.Timestamp
+	DeletedAt          pgtype.Timestamp
+	EmailVerifiedAt    pgtype.Timestamp
+	VerificationSentAt pgtype.Timestamp
+	TotpSecret         pgtype.Text
+	TotpLastUsedStep   pgtype.Int8
+	TwoFactorEnabledAt pgtype.Timestamp
+	SuspendedAt        pgtype.Timestamp
+}
+
+type AccountIdentity struct {
 	ID        uuid.UUID
-	Name      string
+	AccountID uuid.UUID
+	Provider  string
+	Subject   string
 	Email     string
-	Password  string
-	Avatar    pgtype.Text
+	CreatedAt pgtype.Timestamp
+}
+
+type AccountRole struct {
+	ID           uuid.UUID
+	AccountID    uuid.UUID
+	RoleID       uuid.UUID
+	ResourceType string
+	ResourceID   pgtype.UUID
+	CreatedAt    pgtype.Timestamp
+}
+
+type DataJob struct {
+	ID          uuid.UUID
+	AccountID   uuid.UUID
+	RequestedBy pgtype.UUID
+	Kind        string
+	Status      string
+	ArchiveKey  pgtype.Text
+	Error       pgtype.Text
+	CreatedAt   pgtype.Timestamp
+	StartedAt   pgtype.Timestamp
+	FinishedAt  pgtype.Timestamp
+	ExpiresAt   pgtype.Timestamp
+}
+
+type EmailChangeRequest struct {
+	ID              uuid.UUID
+	AccountID       uuid.UUID
+	NewEmail        string
+	TokenHash       string
+	CancelTokenHash string
+	ExpiresAt       pgtype.Timestamp
+	ConfirmedAt     pgtype.Timestamp
+	CancelledAt     pgtype.Timestamp
+	CreatedAt       pgtype.Timestamp
+}
+
+type Impersonation struct {
+	ID             uuid.UUID
+	ActorAccountID pgtype.UUID
+	AccountID      pgtype.UUID
+	Reason         string
+	IpAddress      string
+	UserAgent      string
+	ExpiresAt      pgtype.Timestamp
+	EndedAt        pgtype.Timestamp
+	CreatedAt      pgtype.Timestamp
+}
+
+type ImpersonationRequest struct {
+	ID              uuid.UUID
+	ImpersonationID uuid.UUID
+	Method          string
+	Path            string
+	Status          int32
+	IpAddress       string
+	UserAgent       string
+	CreatedAt       pgtype.Timestamp
+}
+
+type OidcLoginRequest struct {
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    pgtype.Timestamp
+	CreatedAt    pgtype.Timestamp
+}
+
+type Passkey struct {
+	ID              uuid.UUID
+	AccountID       uuid.UUID
+	Name            string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	Aaguid          []byte
+	SignCount       int64
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+	LastUsedAt      pgtype.Timestamp
+	CreatedAt       pgtype.Timestamp
+}
+
+type PasskeyChallenge struct {
+	ID          uuid.UUID
+	AccountID   pgtype.UUID
+	Purpose     string
+	SessionData []byte
+	ExpiresAt   pgtype.Timestamp
+	CreatedAt   pgtype.Timestamp
+}
+
+type PasswordResetToken struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	TokenHash string
+	ExpiresAt pgtype.Timestamp
+	UsedAt    pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+}
+
+type Permission struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	CreatedAt   pgtype.Timestamp
+}
+
+type PersonalAccessToken struct {
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	Name       string
+	TokenHash  string
+	Scopes     []string
+	ExpiresAt  pgtype.Timestamp
+	LastUsedAt pgtype.Timestamp
+	RevokedAt  pgtype.Timestamp
+	CreatedAt  pgtype.Timestamp
+}
+
+type Project struct {
+	ID             uuid.UUID
+	OwnerAccountID pgtype.UUID
+	Name           string
+	Key            string
+	Description    string
+	Status         string
+	StartDate      pgtype.Date
+	TargetDate     pgtype.Date
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	DeletedAt      pgtype.Timestamp
+	WorkspaceID    uuid.UUID
+}
+
+type ProjectMember struct {
+	ProjectID   uuid.UUID
+	AccountID   uuid.UUID
+	WorkspaceID uuid.UUID
+	RoleID      uuid.UUID
+	CreatedAt   pgtype.Timestamp
+}
+
+type RecoveryCode struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	CodeHash  string
+	UsedAt    pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+}
+
+type RefreshToken struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	TokenHash string
+	ExpiresAt pgtype.Timestamp
+	RevokedAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+	SessionID uuid.UUID
+}
+
+type Role struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	CreatedAt   pgtype.Timestamp
+}
+
+type RolePermission struct {
+	RoleID       uuid.UUID
+	PermissionID uuid.UUID
+}
+
+type Session struct {
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	UserAgent  string
+	IpAddress  string
+	ExpiresAt  pgtype.Timestamp
+	LastSeenAt pgtype.Timestamp
+	RevokedAt  pgtype.Timestamp
+	CreatedAt  pgtype.Timestamp
+}
+
+type SignInThrottle struct {
+	Scope         string
+	Subject       string
+	Failures      int32
+	LastFailureAt pgtype.Timestamp
+	LockedUntil   pgtype.Timestamp
+}
+
+type Task struct {
+	ID                uuid.UUID
+	ProjectID         uuid.UUID
+	WorkspaceID       uuid.UUID
+	Title             string
+	Description       string
+	Status            string
+	AssigneeAccountID pgtype.UUID
+	AssigneeTeamID    pgtype.UUID
+	CreatedBy         pgtype.UUID
+	CreatedAt         pgtype.Timestamp
+	UpdatedAt         pgtype.Timestamp
+}
+
+type Team struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	RoleID      uuid.UUID
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
+type TeamMember struct {
+	TeamID    uuid.UUID
+	AccountID uuid.UUID
+	RoleID    uuid.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+type TeamProject struct {
+	TeamID    uuid.UUID
+	ProjectID uuid.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+type Workspace struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedBy pgtype.UUID
 	CreatedAt pgtype.Timestamp
 	UpdatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
+}
+
+type WorkspaceInvitation struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Email       string
+	Role        string
+	TokenHash   string
+	InvitedBy   pgtype.UUID
+	ExpiresAt   pgtype.Timestamp
+	SentAt      pgtype.Timestamp
+	AcceptedAt  pgtype.Timestamp
+	AcceptedBy  pgtype.UUID
+	RevokedAt   pgtype.Timestamp
+	CreatedAt   pgtype.Timestamp
 }
@@ -0,0 +1,159 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: oidc.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const consumeOIDCLoginRequest = `-- name: ConsumeOIDCLoginRequest :one
+DELETE FROM oidc_login_requests
+WHERE state_hash = $1
+RETURNING state_hash, provider, nonce, code_verifier, expires_at, created_at
+`
+
+// Consuming the request deletes it, so a state can only be redeemed once.
+func (q *Queries) ConsumeOIDCLoginRequest(ctx context.Context, stateHash string) (OidcLoginRequest, error) {
+	row := q.db.QueryRow(ctx, consumeOIDCLoginRequest, stateHash)
+	var i OidcLoginRequest
+	err := row.Scan(
+		&i.StateHash,
+		&i.Provider,
+		&i.Nonce,
+		&i.CodeVerifier,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createAccountIdentity = `-- name: CreateAccountIdentity :one
+INSERT INTO account_identities (account_id, provider, subject, email)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, provider, subject, email, created_at
+`
+
+type CreateAccountIdentityParams struct {
+	AccountID uuid.UUID
+	Provider  string
+	Subject   string
+	Email     string
+}
+
+func (q *Queries) CreateAccountIdentity(ctx context.Context, arg CreateAccountIdentityParams) (AccountIdentity, error) {
+	row := q.db.QueryRow(ctx, createAccountIdentity,
+		arg.AccountID,
+		arg.Provider,
+		arg.Subject,
+		arg.Email,
+	)
+	var i AccountIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createOIDCLoginRequest = `-- name: CreateOIDCLoginRequest :exec
+INSERT INTO oidc_login_requests (state_hash, provider, nonce, code_verifier, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateOIDCLoginRequestParams struct {
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    pgtype.Timestamp
+}
+
+func (q *Queries) CreateOIDCLoginRequest(ctx context.Context, arg CreateOIDCLoginRequestParams) error {
+	_, err := q.db.Exec(ctx, createOIDCLoginRequest,
+		arg.StateHash,
+		arg.Provider,
+		arg.Nonce,
+		arg.CodeVerifier,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const deleteExpiredOIDCLoginRequests = `-- name: DeleteExpiredOIDCLoginRequests :exec
+DELETE FROM oidc_login_requests
+WHERE expires_at < NOW()
+`
+
+func (q *Queries) DeleteExpiredOIDCLoginRequests(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredOIDCLoginRequests)
+	return err
+}
+
+const findAccountIdentity = `-- name: FindAccountIdentity :one
+SELECT id, account_id, provider, subject, email, created_at
+FROM account_identities
+WHERE provider = $1 AND subject = $2
+`
+
+type FindAccountIdentityParams struct {
+	Provider string
+	Subject  string
+}
+
+func (q *Queries) FindAccountIdentity(ctx context.Context, arg FindAccountIdentityParams) (AccountIdentity, error) {
+	row := q.db.QueryRow(ctx, findAccountIdentity, arg.Provider, arg.Subject)
+	var i AccountIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountIdentities = `-- name: ListAccountIdentities :many
+SELECT id, account_id, provider, subject, email, created_at
+FROM account_identities
+WHERE account_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListAccountIdentities(ctx context.Context, accountID uuid.UUID) ([]AccountIdentity, error) {
+	rows, err := q.db.Query(ctx, listAccountIdentities, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountIdentity
+	for rows.Next() {
+		var i AccountIdentity
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Provider,
+			&i.Subject,
+			&i.Email,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
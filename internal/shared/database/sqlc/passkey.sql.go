@@ -0,0 +1,232 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: passkey.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const consumePasskeyChallenge = `-- name: ConsumePasskeyChallenge :one
+DELETE FROM passkey_challenges
+WHERE id = $1 AND purpose = $2
+RETURNING id, account_id, purpose, session_data, expires_at, created_at
+`
+
+type ConsumePasskeyChallengeParams struct {
+	ID      uuid.UUID
+	Purpose string
+}
+
+// Consuming the challenge deletes it, so a ceremony can only be completed
+// once.
+func (q *Queries) ConsumePasskeyChallenge(ctx context.Context, arg ConsumePasskeyChallengeParams) (PasskeyChallenge, error) {
+	row := q.db.QueryRow(ctx, consumePasskeyChallenge, arg.ID, arg.Purpose)
+	var i PasskeyChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Purpose,
+		&i.SessionData,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPasskey = `-- name: CreatePasskey :one
+INSERT INTO passkeys (account_id, name, credential_id, public_key, attestation_type, aaguid, sign_count, transports, backup_eligible, backup_state)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, account_id, name, credential_id, public_key, attestation_type, aaguid, sign_count, transports, backup_eligible, backup_state, last_used_at, created_at
+`
+
+type CreatePasskeyParams struct {
+	AccountID       uuid.UUID
+	Name            string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	Aaguid          []byte
+	SignCount       int64
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+}
+
+func (q *Queries) CreatePasskey(ctx context.Context, arg CreatePasskeyParams) (Passkey, error) {
+	row := q.db.QueryRow(ctx, createPasskey,
+		arg.AccountID,
+		arg.Name,
+		arg.CredentialID,
+		arg.PublicKey,
+		arg.AttestationType,
+		arg.Aaguid,
+		arg.SignCount,
+		arg.Transports,
+		arg.BackupEligible,
+		arg.BackupState,
+	)
+	var i Passkey
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.CredentialID,
+		&i.PublicKey,
+		&i.AttestationType,
+		&i.Aaguid,
+		&i.SignCount,
+		&i.Transports,
+		&i.BackupEligible,
+		&i.BackupState,
+		&i.LastUsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPasskeyChallenge = `-- name: CreatePasskeyChallenge :one
+INSERT INTO passkey_challenges (account_id, purpose, session_data, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, purpose, session_data, expires_at, created_at
+`
+
+type CreatePasskeyChallengeParams struct {
+	AccountID   pgtype.UUID
+	Purpose     string
+	SessionData []byte
+	ExpiresAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreatePasskeyChallenge(ctx context.Context, arg CreatePasskeyChallengeParams) (PasskeyChallenge, error) {
+	row := q.db.QueryRow(ctx, createPasskeyChallenge,
+		arg.AccountID,
+		arg.Purpose,
+		arg.SessionData,
+		arg.ExpiresAt,
+	)
+	var i PasskeyChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Purpose,
+		&i.SessionData,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAccountPasskeyChallenges = `-- name: DeleteAccountPasskeyChallenges :exec
+DELETE FROM passkey_challenges
+WHERE account_id = $1::uuid
+`
+
+func (q *Queries) DeleteAccountPasskeyChallenges(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAccountPasskeyChallenges, accountID)
+	return err
+}
+
+const deleteAccountPasskeys = `-- name: DeleteAccountPasskeys :exec
+DELETE FROM passkeys
+WHERE account_id = $1
+`
+
+func (q *Queries) DeleteAccountPasskeys(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAccountPasskeys, accountID)
+	return err
+}
+
+const deleteExpiredPasskeyChallenges = `-- name: DeleteExpiredPasskeyChallenges :exec
+DELETE FROM passkey_challenges
+WHERE expires_at < NOW()
+`
+
+func (q *Queries) DeleteExpiredPasskeyChallenges(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredPasskeyChallenges)
+	return err
+}
+
+const deletePasskey = `-- name: DeletePasskey :execrows
+DELETE FROM passkeys
+WHERE id = $1 AND account_id = $2
+`
+
+type DeletePasskeyParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+}
+
+func (q *Queries) DeletePasskey(ctx context.Context, arg DeletePasskeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePasskey, arg.ID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listAccountPasskeys = `-- name: ListAccountPasskeys :many
+SELECT id, account_id, name, credential_id, public_key, attestation_type, aaguid, sign_count, transports, backup_eligible, backup_state, last_used_at, created_at
+FROM passkeys
+WHERE account_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListAccountPasskeys(ctx context.Context, accountID uuid.UUID) ([]Passkey, error) {
+	rows, err := q.db.Query(ctx, listAccountPasskeys, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Passkey
+	for rows.Next() {
+		var i Passkey
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.CredentialID,
+			&i.PublicKey,
+			&i.AttestationType,
+			&i.Aaguid,
+			&i.SignCount,
+			&i.Transports,
+			&i.BackupEligible,
+			&i.BackupState,
+			&i.LastUsedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updatePasskeyUsage = `-- name: UpdatePasskeyUsage :execrows
+UPDATE passkeys
+SET sign_count = $2, backup_state = $3, last_used_at = NOW()
+WHERE id = $1
+`
+
+type UpdatePasskeyUsageParams struct {
+	ID          uuid.UUID
+	SignCount   int64
+	BackupState bool
+}
+
+func (q *Queries) UpdatePasskeyUsage(ctx context.Context, arg UpdatePasskeyUsageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePasskeyUsage, arg.ID, arg.SignCount, arg.BackupState)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
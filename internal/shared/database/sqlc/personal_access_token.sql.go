@@ -0,0 +1,158 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: personal_access_token.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createPersonalAccessToken = `-- name: CreatePersonalAccessToken :one
+INSERT INTO personal_access_tokens (account_id, name, token_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, name, token_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+`
+
+type CreatePersonalAccessTokenParams struct {
+	AccountID uuid.UUID
+	Name      string
+	TokenHash string
+	Scopes    []string
+	ExpiresAt pgtype.Timestamp
+}
+
+func (q *Queries) CreatePersonalAccessToken(ctx context.Context, arg CreatePersonalAccessTokenParams) (PersonalAccessToken, error) {
+	row := q.db.QueryRow(ctx, createPersonalAccessToken,
+		arg.AccountID,
+		arg.Name,
+		arg.TokenHash,
+		arg.Scopes,
+		arg.ExpiresAt,
+	)
+	var i PersonalAccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.TokenHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findPersonalAccessTokenByHash = `-- name: FindPersonalAccessTokenByHash :one
+SELECT id, account_id, name, token_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+FROM personal_access_tokens
+WHERE token_hash = $1
+`
+
+func (q *Queries) FindPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (PersonalAccessToken, error) {
+	row := q.db.QueryRow(ctx, findPersonalAccessTokenByHash, tokenHash)
+	var i PersonalAccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.TokenHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountPersonalAccessTokens = `-- name: ListAccountPersonalAccessTokens :many
+SELECT id, account_id, name, token_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+FROM personal_access_tokens
+WHERE account_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListAccountPersonalAccessTokens(ctx context.Context, accountID uuid.UUID) ([]PersonalAccessToken, error) {
+	rows, err := q.db.Query(ctx, listAccountPersonalAccessTokens, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PersonalAccessToken
+	for rows.Next() {
+		var i PersonalAccessToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.TokenHash,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAccountPersonalAccessTokens = `-- name: RevokeAccountPersonalAccessTokens :exec
+UPDATE personal_access_tokens
+SET revoked_at = NOW()
+WHERE account_id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeAccountPersonalAccessTokens(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, revokeAccountPersonalAccessTokens, accountID)
+	return err
+}
+
+const revokePersonalAccessToken = `-- name: RevokePersonalAccessToken :execrows
+UPDATE personal_access_tokens
+SET revoked_at = NOW()
+WHERE id = $1 AND account_id = $2 AND revoked_at IS NULL
+`
+
+type RevokePersonalAccessTokenParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+}
+
+func (q *Queries) RevokePersonalAccessToken(ctx context.Context, arg RevokePersonalAccessTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokePersonalAccessToken, arg.ID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchPersonalAccessToken = `-- name: TouchPersonalAccessToken :exec
+UPDATE personal_access_tokens
+SET last_used_at = $1
+WHERE id = $2
+  AND (last_used_at IS NULL OR last_used_at <= $3)
+`
+
+type TouchPersonalAccessTokenParams struct {
+	UsedAt     pgtype.Timestamp
+	ID         uuid.UUID
+	UsedBefore pgtype.Timestamp
+}
+
+func (q *Queries) TouchPersonalAccessToken(ctx context.Context, arg TouchPersonalAccessTokenParams) error {
+	_, err := q.db.Exec(ctx, touchPersonalAccessToken, arg.UsedAt, arg.ID, arg.UsedBefore)
+	return err
+}
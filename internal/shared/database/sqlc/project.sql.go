@@ -0,0 +1,546 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: project.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countProjects = `-- name: CountProjects :one
+SELECT COUNT(*)
+FROM projects AS p
+JOIN accounts AS viewer ON viewer.id = $1::uuid
+WHERE p.workspace_id = $2 AND p.deleted_at IS NULL
+  AND ($3::text IS NULL
+       OR p.name ILIKE '%' || $3::text || '%'
+       OR p.key ILIKE '%' || $3::text || '%')
+  AND ($4::text IS NULL OR p.status = $4::text)
+  AND EXISTS (
+      SELECT 1
+      FROM (
+          SELECT ar.role_id
+          FROM account_roles ar
+          WHERE ar.account_id = viewer.id
+            AND (ar.resource_type = 'system'
+                 OR (ar.resource_type = 'workspace' AND ar.resource_id = p.workspace_id))
+          UNION ALL
+          SELECT pm.role_id
+          FROM project_members pm
+          WHERE pm.project_id = p.id AND pm.account_id = viewer.id
+          UNION ALL
+          SELECT t.role_id
+          FROM team_projects tp
+          JOIN teams t ON t.id = tp.team_id
+          JOIN team_members tm ON tm.team_id = t.id
+          WHERE tp.project_id = p.id AND tm.account_id = viewer.id
+      ) granted
+      JOIN role_permissions rp ON rp.role_id = granted.role_id
+      JOIN permissions perm ON perm.id = rp.permission_id
+      WHERE perm.name = 'projects:read'
+  )
+`
+
+type CountProjectsParams struct {
+	ViewerID    uuid.UUID
+	WorkspaceID uuid.UUID
+	Search      pgtype.Text
+	Status      pgtype.Text
+}
+
+func (q *Queries) CountProjects(ctx context.Context, arg CountProjectsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countProjects,
+		arg.ViewerID,
+		arg.WorkspaceID,
+		arg.Search,
+		arg.Status,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createProject = `-- name: CreateProject :one
+
+WITH created AS (
+    INSERT INTO projects (workspace_id, owner_account_id, name, key, description, status, start_date, target_date)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    RETURNING id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+), admin AS (
+    INSERT INTO project_members (project_id, account_id, workspace_id, role_id)
+    SELECT c.id, c.owner_account_id, c.workspace_id, r.id
+    FROM created c JOIN roles r ON r.name = 'project_admin'
+)
+SELECT id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+FROM created
+`
+
+type CreateProjectParams struct {
+	WorkspaceID    uuid.UUID
+	OwnerAccountID pgtype.UUID
+	Name           string
+	Key            string
+	Description    string
+	Status         string
+	StartDate      pgtype.Date
+	TargetDate     pgtype.Date
+}
+
+type CreateProjectRow struct {
+	ID             uuid.UUID
+	OwnerAccountID pgtype.UUID
+	Name           string
+	Key            string
+	Description    string
+	Status         string
+	StartDate      pgtype.Date
+	TargetDate     pgtype.Date
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	DeletedAt      pgtype.Timestamp
+	WorkspaceID    uuid.UUID
+}
+
+// Every query on projects runs inside the scope of a workspace, where
+// row-level security hides the rows of the others. The workspace is still
+// filtered explicitly so a missing scope never widens a query.
+// The account that creates a project becomes its admin.
+func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (CreateProjectRow, error) {
+	row := q.db.QueryRow(ctx, createProject,
+		arg.WorkspaceID,
+		arg.OwnerAccountID,
+		arg.Name,
+		arg.Key,
+		arg.Description,
+		arg.Status,
+		arg.StartDate,
+		arg.TargetDate,
+	)
+	var i CreateProjectRow
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerAccountID,
+		&i.Name,
+		&i.Key,
+		&i.Description,
+		&i.Status,
+		&i.StartDate,
+		&i.TargetDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
+
+const findProject = `-- name: FindProject :one
+SELECT id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+FROM projects
+WHERE id = $1 AND workspace_id = $2 AND deleted_at IS NULL
+`
+
+type FindProjectParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+func (q *Queries) FindProject(ctx context.Context, arg FindProjectParams) (Project, error) {
+	row := q.db.QueryRow(ctx, findProject, arg.ID, arg.WorkspaceID)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerAccountID,
+		&i.Name,
+		&i.Key,
+		&i.Description,
+		&i.Status,
+		&i.StartDate,
+		&i.TargetDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
+
+const findProjectMember = `-- name: FindProjectMember :one
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, pm.created_at
+FROM project_members pm
+JOIN accounts a ON a.id = pm.account_id
+JOIN roles r ON r.id = pm.role_id
+WHERE pm.project_id = $1 AND pm.workspace_id = $2 AND pm.account_id = $3
+`
+
+type FindProjectMemberParams struct {
+	ProjectID   uuid.UUID
+	WorkspaceID uuid.UUID
+	AccountID   uuid.UUID
+}
+
+type FindProjectMemberRow struct {
+	AccountID uuid.UUID
+	Name      string
+	Email     string
+	RoleName  string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) FindProjectMember(ctx context.Context, arg FindProjectMemberParams) (FindProjectMemberRow, error) {
+	row := q.db.QueryRow(ctx, findProjectMember, arg.ProjectID, arg.WorkspaceID, arg.AccountID)
+	var i FindProjectMemberRow
+	err := row.Scan(
+		&i.AccountID,
+		&i.Name,
+		&i.Email,
+		&i.RoleName,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountProjects = `-- name: ListAccountProjects :many
+SELECT id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+FROM projects
+WHERE workspace_id = $1 AND owner_account_id = $2
+ORDER BY created_at
+`
+
+type ListAccountProjectsParams struct {
+	WorkspaceID    uuid.UUID
+	OwnerAccountID pgtype.UUID
+}
+
+// Every project the account created in the workspace, deleted or not, for
+// the exports of its personal data.
+func (q *Queries) ListAccountProjects(ctx context.Context, arg ListAccountProjectsParams) ([]Project, error) {
+	rows, err := q.db.Query(ctx, listAccountProjects, arg.WorkspaceID, arg.OwnerAccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerAccountID,
+			&i.Name,
+			&i.Key,
+			&i.Description,
+			&i.Status,
+			&i.StartDate,
+			&i.TargetDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.WorkspaceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProjectMembers = `-- name: ListProjectMembers :many
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, pm.created_at
+FROM project_members pm
+JOIN accounts a ON a.id = pm.account_id
+JOIN roles r ON r.id = pm.role_id
+WHERE pm.project_id = $1 AND pm.workspace_id = $2
+ORDER BY a.name, a.id
+`
+
+type ListProjectMembersParams struct {
+	ProjectID   uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+type ListProjectMembersRow struct {
+	AccountID uuid.UUID
+	Name      string
+	Email     string
+	RoleName  string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) ListProjectMembers(ctx context.Context, arg ListProjectMembersParams) ([]ListProjectMembersRow, error) {
+	rows, err := q.db.Query(ctx, listProjectMembers, arg.ProjectID, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProjectMembersRow
+	for rows.Next() {
+		var i ListProjectMembersRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.Name,
+			&i.Email,
+			&i.RoleName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProjects = `-- name: ListProjects :many
+SELECT p.id, p.owner_account_id, p.name, p.key, p.description, p.status, p.start_date, p.target_date, p.created_at, p.updated_at, p.deleted_at, p.workspace_id
+FROM projects AS p
+JOIN accounts AS viewer ON viewer.id = $1::uuid
+WHERE p.workspace_id = $2 AND p.deleted_at IS NULL
+  AND ($3::text IS NULL
+       OR p.name ILIKE '%' || $3::text || '%'
+       OR p.key ILIKE '%' || $3::text || '%')
+  AND ($4::text IS NULL OR p.status = $4::text)
+  AND EXISTS (
+      SELECT 1
+      FROM (
+          SELECT ar.role_id
+          FROM account_roles ar
+          WHERE ar.account_id = viewer.id
+            AND (ar.resource_type = 'system'
+                 OR (ar.resource_type = 'workspace' AND ar.resource_id = p.workspace_id))
+          UNION ALL
+          SELECT pm.role_id
+          FROM project_members pm
+          WHERE pm.project_id = p.id AND pm.account_id = viewer.id
+          UNION ALL
+          SELECT t.role_id
+          FROM team_projects tp
+          JOIN teams t ON t.id = tp.team_id
+          JOIN team_members tm ON tm.team_id = t.id
+          WHERE tp.project_id = p.id AND tm.account_id = viewer.id
+      ) granted
+      JOIN role_permissions rp ON rp.role_id = granted.role_id
+      JOIN permissions perm ON perm.id = rp.permission_id
+      WHERE perm.name = 'projects:read'
+  )
+ORDER BY p.created_at DESC, p.id
+LIMIT $6 OFFSET $5
+`
+
+type ListProjectsParams struct {
+	ViewerID    uuid.UUID
+	WorkspaceID uuid.UUID
+	Search      pgtype.Text
+	Status      pgtype.Text
+	RowOffset   int32
+	RowLimit    int32
+}
+
+// The listings only hold the projects the viewer can read: all of them
+// when it reads the projects of the workspace, otherwise those it reads as
+// a member of the project or of one of its teams.
+func (q *Queries) ListProjects(ctx context.Context, arg ListProjectsParams) ([]Project, error) {
+	rows, err := q.db.Query(ctx, listProjects,
+		arg.ViewerID,
+		arg.WorkspaceID,
+		arg.Search,
+		arg.Status,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerAccountID,
+			&i.Name,
+			&i.Key,
+			&i.Description,
+			&i.Status,
+			&i.StartDate,
+			&i.TargetDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.WorkspaceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeProjectMember = `-- name: RemoveProjectMember :execrows
+DELETE FROM project_members AS pm
+WHERE pm.project_id = $1::uuid
+  AND pm.workspace_id = $2::uuid
+  AND pm.account_id = $3::uuid
+`
+
+type RemoveProjectMemberParams struct {
+	ProjectID   uuid.UUID
+	WorkspaceID uuid.UUID
+	AccountID   uuid.UUID
+}
+
+func (q *Queries) RemoveProjectMember(ctx context.Context, arg RemoveProjectMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeProjectMember, arg.ProjectID, arg.WorkspaceID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreProject = `-- name: RestoreProject :one
+UPDATE projects
+SET deleted_at = NULL, updated_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND deleted_at IS NOT NULL
+RETURNING id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+`
+
+type RestoreProjectParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+func (q *Queries) RestoreProject(ctx context.Context, arg RestoreProjectParams) (Project, error) {
+	row := q.db.QueryRow(ctx, restoreProject, arg.ID, arg.WorkspaceID)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerAccountID,
+		&i.Name,
+		&i.Key,
+		&i.Description,
+		&i.Status,
+		&i.StartDate,
+		&i.TargetDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
+
+const setProjectMember = `-- name: SetProjectMember :execrows
+INSERT INTO project_members (project_id, account_id, workspace_id, role_id)
+SELECT p.id, $1::uuid, p.workspace_id, r.id
+FROM projects p CROSS JOIN roles r
+WHERE p.id = $2::uuid
+  AND p.workspace_id = $3::uuid
+  AND p.deleted_at IS NULL
+  AND r.name = $4
+  AND EXISTS (
+      SELECT 1 FROM account_roles ar
+      WHERE ar.resource_type = 'workspace' AND ar.resource_id = p.workspace_id AND ar.account_id = $1::uuid
+  )
+ON CONFLICT (project_id, account_id) DO UPDATE SET role_id = EXCLUDED.role_id
+`
+
+type SetProjectMemberParams struct {
+	AccountID   uuid.UUID
+	ProjectID   uuid.UUID
+	WorkspaceID uuid.UUID
+	RoleName    string
+}
+
+// Adds the account to the project with the role, or changes its role when
+// it is a member already. Only members of the workspace of the project can
+// join it.
+func (q *Queries) SetProjectMember(ctx context.Context, arg SetProjectMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setProjectMember,
+		arg.AccountID,
+		arg.ProjectID,
+		arg.WorkspaceID,
+		arg.RoleName,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteProject = `-- name: SoftDeleteProject :execrows
+UPDATE projects
+SET deleted_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND deleted_at IS NULL
+`
+
+type SoftDeleteProjectParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+func (q *Queries) SoftDeleteProject(ctx context.Context, arg SoftDeleteProjectParams) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteProject, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateProject = `-- name: UpdateProject :one
+UPDATE projects
+SET name = $3, key = $4, description = $5, status = $6, start_date = $7, target_date = $8, updated_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND deleted_at IS NULL
+RETURNING id, owner_account_id, name, key, description, status, start_date, target_date, created_at, updated_at, deleted_at, workspace_id
+`
+
+type UpdateProjectParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	Key         string
+	Description string
+	Status      string
+	StartDate   pgtype.Date
+	TargetDate  pgtype.Date
+}
+
+func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error) {
+	row := q.db.QueryRow(ctx, updateProject,
+		arg.ID,
+		arg.WorkspaceID,
+		arg.Name,
+		arg.Key,
+		arg.Description,
+		arg.Status,
+		arg.StartDate,
+		arg.TargetDate,
+	)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerAccountID,
+		&i.Name,
+		&i.Key,
+		&i.Description,
+		&i.Status,
+		&i.StartDate,
+		&i.TargetDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
@@ -4,13 +4,157 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 //go:generate mockgen -source=querier.go -destination=../mocks/querier_mock.go -package=mocks
 type Querier interface {
+	AcceptWorkspaceInvitation(ctx context.Context, arg AcceptWorkspaceInvitationParams) (bool, error)
+	AccountHasPermission(ctx context.Context, arg AccountHasPermissionParams) (bool, error)
+	AssignAccountRole(ctx context.Context, arg AssignAccountRoleParams) (int64, error)
+	AssignTask(ctx context.Context, arg AssignTaskParams) (Task, error)
+	AssignTeamProject(ctx context.Context, arg AssignTeamProjectParams) (int64, error)
+	CancelAccountEmailChangeRequests(ctx context.Context, arg uuid.UUID) error
+	CancelEmailChangeRequest(ctx context.Context, arg uuid.UUID) (int64, error)
+	ChangeWorkspaceMemberRole(ctx context.Context, arg ChangeWorkspaceMemberRoleParams) (ChangeWorkspaceMemberRoleRow, error)
+	ClaimDataJob(ctx context.Context, arg pgtype.Timestamp) (DataJob, error)
+	ClearAccountDataJobArchives(ctx context.Context, arg uuid.UUID) ([]string, error)
+	ClearSignInThrottle(ctx context.Context, arg ClearSignInThrottleParams) (int64, error)
+	CompleteDataJob(ctx context.Context, arg CompleteDataJobParams) error
+	ConfirmEmailChangeRequest(ctx context.Context, arg uuid.UUID) (int64, error)
+	ConsumeOIDCLoginRequest(ctx context.Context, arg string) (OidcLoginRequest, error)
+	ConsumePasskeyChallenge(ctx context.Context, arg ConsumePasskeyChallengeParams) (PasskeyChallenge, error)
+	CountAccounts(ctx context.Context, arg CountAccountsParams) (int64, error)
+	CountProjects(ctx context.Context, arg CountProjectsParams) (int64, error)
+	CountTasks(ctx context.Context, arg CountTasksParams) (int64, error)
+	CountUnusedRecoveryCodes(ctx context.Context, arg uuid.UUID) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAccountIdentity(ctx context.Context, arg CreateAccountIdentityParams) (AccountIdentity, error)
+	CreateDataJob(ctx context.Context, arg CreateDataJobParams) (DataJob, error)
+	CreateEmailChangeRequest(ctx context.Context, arg CreateEmailChangeRequestParams) (EmailChangeRequest, error)
+	CreateImpersonation(ctx context.Context, arg CreateImpersonationParams) (Impersonation, error)
+	CreateImpersonationRequest(ctx context.Context, arg CreateImpersonationRequestParams) error
+	CreateOIDCLoginRequest(ctx context.Context, arg CreateOIDCLoginRequestParams) error
+	CreatePasskey(ctx context.Context, arg CreatePasskeyParams) (Passkey, error)
+	CreatePasskeyChallenge(ctx context.Context, arg CreatePasskeyChallengeParams) (PasskeyChallenge, error)
+	CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) (PasswordResetToken, error)
+	CreatePersonalAccessToken(ctx context.Context, arg CreatePersonalAccessTokenParams) (PersonalAccessToken, error)
+	CreateProject(ctx context.Context, arg CreateProjectParams) (CreateProjectRow, error)
+	CreateRecoveryCodes(ctx context.Context, arg CreateRecoveryCodesParams) error
+	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error)
+	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateTask(ctx context.Context, arg CreateTaskParams) (Task, error)
+	CreateTeam(ctx context.Context, arg CreateTeamParams) (CreateTeamRow, error)
+	CreateWorkspace(ctx context.Context, arg CreateWorkspaceParams) (CreateWorkspaceRow, error)
+	CreateWorkspaceInvitation(ctx context.Context, arg CreateWorkspaceInvitationParams) (WorkspaceInvitation, error)
+	DeleteAccountPasskeyChallenges(ctx context.Context, accountID uuid.UUID) error
+	DeleteAccountPasskeys(ctx context.Context, accountID uuid.UUID) error
+	DeleteAccountRecoveryCodes(ctx context.Context, arg uuid.UUID) error
+	DeleteAccountWorkspaceInvitations(ctx context.Context, accountID uuid.UUID) error
+	DeleteExpiredOIDCLoginRequests(ctx context.Context) error
+	DeleteExpiredPasskeyChallenges(ctx context.Context) error
+	DeletePasskey(ctx context.Context, arg DeletePasskeyParams) (int64, error)
+	DeleteTask(ctx context.Context, arg DeleteTaskParams) (int64, error)
+	DeleteTeam(ctx context.Context, arg DeleteTeamParams) (int64, error)
+	DeleteWorkspace(ctx context.Context, arg uuid.UUID) (int64, error)
+	DisableAccountTwoFactor(ctx context.Context, arg uuid.UUID) (int64, error)
+	EnableAccountTwoFactor(ctx context.Context, arg uuid.UUID) (int64, error)
+	EndImpersonation(ctx context.Context, arg uuid.UUID) (int64, error)
+	EraseAccount(ctx context.Context, arg uuid.UUID) (pgtype.Text, error)
+	ExpireDataJobArchives(ctx context.Context) ([]string, error)
+	FailDataJob(ctx context.Context, arg FailDataJobParams) error
 	FindAccount(ctx context.Context, arg uuid.UUID) (FindAccountRow, error)
 	FindAccountByEmail(ctx context.Context, arg string) (FindAccountByEmailRow, error)
+	FindAccountForAdmin(ctx context.Context, arg uuid.UUID) (FindAccountForAdminRow, error)
+	FindAccountIdentity(ctx context.Context, arg FindAccountIdentityParams) (AccountIdentity, error)
+	FindDataJob(ctx context.Context, arg uuid.UUID) (DataJob, error)
+	FindEmailChangeRequestByCancelTokenHash(ctx context.Context, arg string) (EmailChangeRequest, error)
+	FindEmailChangeRequestByTokenHash(ctx context.Context, arg string) (EmailChangeRequest, error)
+	FindImpersonation(ctx context.Context, arg uuid.UUID) (Impersonation, error)
+	FindPasswordResetTokenByHash(ctx context.Context, arg string) (PasswordResetToken, error)
+	FindPendingWorkspaceInvitation(ctx context.Context, arg FindPendingWorkspaceInvitationParams) (WorkspaceInvitation, error)
+	FindPersonalAccessTokenByHash(ctx context.Context, arg string) (PersonalAccessToken, error)
+	FindProject(ctx context.Context, arg FindProjectParams) (Project, error)
+	FindProjectMember(ctx context.Context, arg FindProjectMemberParams) (FindProjectMemberRow, error)
+	FindRefreshTokenByHash(ctx context.Context, arg string) (RefreshToken, error)
+	FindRoleByName(ctx context.Context, arg string) (Role, error)
+	FindSignInThrottle(ctx context.Context, arg FindSignInThrottleParams) (SignInThrottle, error)
+	FindTask(ctx context.Context, arg FindTaskParams) (Task, error)
+	FindTeam(ctx context.Context, arg FindTeamParams) (FindTeamRow, error)
+	FindTeamMember(ctx context.Context, arg FindTeamMemberParams) (FindTeamMemberRow, error)
+	FindWorkspace(ctx context.Context, arg uuid.UUID) (Workspace, error)
+	FindWorkspaceInvitationByHash(ctx context.Context, arg string) (FindWorkspaceInvitationByHashRow, error)
+	FindWorkspaceMember(ctx context.Context, arg FindWorkspaceMemberParams) (FindWorkspaceMemberRow, error)
+	HardDeleteAccount(ctx context.Context, arg uuid.UUID) (pgtype.Text, error)
+	InvalidateAccountPasswordResetTokens(ctx context.Context, arg uuid.UUID) error
+	ListAccountDataJobs(ctx context.Context, arg uuid.UUID) ([]DataJob, error)
+	ListAccountEmailChangeRequests(ctx context.Context, accountID uuid.UUID) ([]EmailChangeRequest, error)
+	ListAccountIdentities(ctx context.Context, accountID uuid.UUID) ([]AccountIdentity, error)
+	ListAccountPasskeys(ctx context.Context, arg uuid.UUID) ([]Passkey, error)
+	ListAccountPersonalAccessTokens(ctx context.Context, arg uuid.UUID) ([]PersonalAccessToken, error)
+	ListAccountProjects(ctx context.Context, arg ListAccountProjectsParams) ([]Project, error)
+	ListAccountRoles(ctx context.Context, arg uuid.UUID) ([]ListAccountRolesRow, error)
+	ListAccountSessions(ctx context.Context, arg uuid.UUID) ([]Session, error)
+	ListAccountTasks(ctx context.Context, arg ListAccountTasksParams) ([]Task, error)
+	ListAccountTeams(ctx context.Context, arg uuid.UUID) ([]ListAccountTeamsRow, error)
+	ListAccountWorkspaceIDs(ctx context.Context, arg uuid.UUID) ([]uuid.UUID, error)
+	ListAccountWorkspaceInvitations(ctx context.Context, accountID uuid.UUID) ([]ListAccountWorkspaceInvitationsRow, error)
+	ListAccountWorkspaces(ctx context.Context, arg uuid.UUID) ([]ListAccountWorkspacesRow, error)
+	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]ListAccountsRow, error)
+	ListImpersonationRequests(ctx context.Context, arg uuid.UUID) ([]ImpersonationRequest, error)
+	ListPendingWorkspaceInvitations(ctx context.Context, arg uuid.UUID) ([]WorkspaceInvitation, error)
+	ListPermissions(ctx context.Context) ([]Permission, error)
+	ListProjectMembers(ctx context.Context, arg ListProjectMembersParams) ([]ListProjectMembersRow, error)
+	ListProjects(ctx context.Context, arg ListProjectsParams) ([]Project, error)
+	ListRolePermissions(ctx context.Context) ([]ListRolePermissionsRow, error)
+	ListRoles(ctx context.Context) ([]Role, error)
+	ListTasks(ctx context.Context, arg ListTasksParams) ([]Task, error)
+	ListTeamMembers(ctx context.Context, arg ListTeamMembersParams) ([]ListTeamMembersRow, error)
+	ListTeamProjects(ctx context.Context, arg ListTeamProjectsParams) ([]ListTeamProjectsRow, error)
+	ListWorkspaceMembers(ctx context.Context, arg uuid.UUID) ([]ListWorkspaceMembersRow, error)
+	ListWorkspaceTeams(ctx context.Context, arg uuid.UUID) ([]ListWorkspaceTeamsRow, error)
+	LockSignInThrottle(ctx context.Context, arg LockSignInThrottleParams) error
+	MarkAccountVerificationSent(ctx context.Context, arg MarkAccountVerificationSentParams) (int64, error)
+	ReactivateAccount(ctx context.Context, arg uuid.UUID) (int64, error)
+	RecordSignInFailure(ctx context.Context, arg RecordSignInFailureParams) (SignInThrottle, error)
+	RehashAccountPassword(ctx context.Context, arg RehashAccountPasswordParams) (int64, error)
+	RemoveProjectMember(ctx context.Context, arg RemoveProjectMemberParams) (int64, error)
+	RemoveTeamMember(ctx context.Context, arg RemoveTeamMemberParams) (int64, error)
+	RemoveWorkspaceMember(ctx context.Context, arg RemoveWorkspaceMemberParams) (RemoveWorkspaceMemberRow, error)
+	RenewWorkspaceInvitation(ctx context.Context, arg RenewWorkspaceInvitationParams) (WorkspaceInvitation, error)
+	ReplaceAccountAvatar(ctx context.Context, arg ReplaceAccountAvatarParams) (pgtype.Text, error)
+	RestoreAccount(ctx context.Context, arg uuid.UUID) (Account, error)
+	RestoreProject(ctx context.Context, arg RestoreProjectParams) (Project, error)
+	RevokeAccountPersonalAccessTokens(ctx context.Context, accountID uuid.UUID) error
+	RevokeAccountRefreshTokens(ctx context.Context, arg uuid.UUID) error
+	RevokeAccountRole(ctx context.Context, arg RevokeAccountRoleParams) (int64, error)
+	RevokeAccountSessions(ctx context.Context, arg RevokeAccountSessionsParams) error
+	RevokePersonalAccessToken(ctx context.Context, arg RevokePersonalAccessTokenParams) (int64, error)
+	RevokeRefreshToken(ctx context.Context, arg uuid.UUID) (int64, error)
+	RevokeSession(ctx context.Context, arg RevokeSessionParams) (int64, error)
+	RevokeWorkspaceInvitation(ctx context.Context, arg RevokeWorkspaceInvitationParams) (int64, error)
+	SetAccountTOTPSecret(ctx context.Context, arg SetAccountTOTPSecretParams) (int64, error)
+	SetProjectMember(ctx context.Context, arg SetProjectMemberParams) (int64, error)
+	SetTeamMember(ctx context.Context, arg SetTeamMemberParams) (int64, error)
+	SetWorkspaceScope(ctx context.Context, arg uuid.UUID) error
+	SoftDeleteAccount(ctx context.Context, arg uuid.UUID) (int64, error)
+	SoftDeleteProject(ctx context.Context, arg SoftDeleteProjectParams) (int64, error)
+	SummarizeAccountRecoveryCodes(ctx context.Context, accountID uuid.UUID) (SummarizeAccountRecoveryCodesRow, error)
+	SuspendAccount(ctx context.Context, arg uuid.UUID) (int64, error)
+	TouchPersonalAccessToken(ctx context.Context, arg TouchPersonalAccessTokenParams) error
+	TouchSession(ctx context.Context, arg TouchSessionParams) (int64, error)
+	UnassignTeamProject(ctx context.Context, arg UnassignTeamProjectParams) (int64, error)
+	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountPassword(ctx context.Context, arg UpdateAccountPasswordParams) (int64, error)
+	UpdatePasskeyUsage(ctx context.Context, arg UpdatePasskeyUsageParams) (int64, error)
+	UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error)
+	UpdateTask(ctx context.Context, arg UpdateTaskParams) (Task, error)
+	UpdateTeam(ctx context.Context, arg UpdateTeamParams) (UpdateTeamRow, error)
+	UpdateWorkspace(ctx context.Context, arg UpdateWorkspaceParams) (Workspace, error)
+	UseAccountTOTPStep(ctx context.Context, arg UseAccountTOTPStepParams) (int64, error)
+	UsePasswordResetToken(ctx context.Context, arg uuid.UUID) (int64, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error)
+	VerifyAccountEmail(ctx context.Context, arg VerifyAccountEmailParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
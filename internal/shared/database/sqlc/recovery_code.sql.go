@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: recovery_code.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUnusedRecoveryCodes = `-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*)
+FROM recovery_codes
+WHERE account_id = $1 AND used_at IS NULL
+`
+
+func (q *Queries) CountUnusedRecoveryCodes(ctx context.Context, accountID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnusedRecoveryCodes, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createRecoveryCodes = `-- name: CreateRecoveryCodes :exec
+INSERT INTO recovery_codes (account_id, code_hash)
+SELECT $1, unnest($2::text[])
+`
+
+type CreateRecoveryCodesParams struct {
+	AccountID  uuid.UUID
+	CodeHashes []string
+}
+
+func (q *Queries) CreateRecoveryCodes(ctx context.Context, arg CreateRecoveryCodesParams) error {
+	_, err := q.db.Exec(ctx, createRecoveryCodes, arg.AccountID, arg.CodeHashes)
+	return err
+}
+
+const deleteAccountRecoveryCodes = `-- name: DeleteAccountRecoveryCodes :exec
+DELETE FROM recovery_codes
+WHERE account_id = $1
+`
+
+func (q *Queries) DeleteAccountRecoveryCodes(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAccountRecoveryCodes, accountID)
+	return err
+}
+
+const summarizeAccountRecoveryCodes = `-- name: SummarizeAccountRecoveryCodes :one
+SELECT COUNT(*)::bigint AS total,
+       COUNT(*) FILTER (WHERE used_at IS NULL)::bigint AS unused,
+       MAX(created_at)::timestamp AS created_at
+FROM recovery_codes
+WHERE account_id = $1
+`
+
+type SummarizeAccountRecoveryCodesRow struct {
+	Total     int64
+	Unused    int64
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) SummarizeAccountRecoveryCodes(ctx context.Context, accountID uuid.UUID) (SummarizeAccountRecoveryCodesRow, error) {
+	row := q.db.QueryRow(ctx, summarizeAccountRecoveryCodes, accountID)
+	var i SummarizeAccountRecoveryCodesRow
+	err := row.Scan(&i.Total, &i.Unused, &i.CreatedAt)
+	return i, err
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE recovery_codes
+SET used_at = NOW()
+WHERE account_id = $1 AND code_hash = $2 AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	AccountID uuid.UUID
+	CodeHash  string
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, arg.AccountID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
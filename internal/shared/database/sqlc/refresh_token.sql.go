@@ -0,0 +1,92 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: refresh_token.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createRefreshToken = `-- name: CreateRefreshToken :one
+INSERT INTO refresh_tokens (account_id, session_id, token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, account_id, token_hash, expires_at, revoked_at, created_at, session_id
+`
+
+type CreateRefreshTokenParams struct {
+	AccountID uuid.UUID
+	SessionID uuid.UUID
+	TokenHash string
+	ExpiresAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error) {
+	row := q.db.QueryRow(ctx, createRefreshToken,
+		arg.AccountID,
+		arg.SessionID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	var i RefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.SessionID,
+	)
+	return i, err
+}
+
+const findRefreshTokenByHash = `-- name: FindRefreshTokenByHash :one
+SELECT id, account_id, token_hash, expires_at, revoked_at, created_at, session_id
+FROM refresh_tokens
+WHERE token_hash = $1
+`
+
+func (q *Queries) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (RefreshToken, error) {
+	row := q.db.QueryRow(ctx, findRefreshTokenByHash, tokenHash)
+	var i RefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.SessionID,
+	)
+	return i, err
+}
+
+const revokeAccountRefreshTokens = `-- name: RevokeAccountRefreshTokens :exec
+UPDATE refresh_tokens
+SET revoked_at = NOW()
+WHERE account_id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeAccountRefreshTokens(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, revokeAccountRefreshTokens, accountID)
+	return err
+}
+
+const revokeRefreshToken = `-- name: RevokeRefreshToken :execrows
+UPDATE refresh_tokens
+SET revoked_at = NOW()
+WHERE id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeRefreshToken(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeRefreshToken, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,279 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: role.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const accountHasPermission = `-- name: AccountHasPermission :one
+SELECT EXISTS (
+    SELECT 1
+    FROM (
+        SELECT ar.role_id
+        FROM account_roles ar
+        WHERE ar.account_id = $1
+          AND (ar.resource_type = 'system'
+               OR (ar.resource_type = $2 AND ar.resource_id = $3))
+        UNION ALL
+        SELECT tm.role_id
+        FROM team_members tm
+        WHERE $2 = 'team'
+          AND tm.team_id = $3 AND tm.account_id = $1
+        UNION ALL
+        SELECT pm.role_id
+        FROM project_members pm
+        WHERE $2 = 'project'
+          AND pm.project_id = $3 AND pm.account_id = $1
+        UNION ALL
+        SELECT t.role_id
+        FROM team_projects tp
+        JOIN teams t ON t.id = tp.team_id
+        JOIN team_members tm ON tm.team_id = t.id
+        WHERE $2 = 'project'
+          AND tp.project_id = $3 AND tm.account_id = $1
+    ) granted
+    JOIN role_permissions rp ON rp.role_id = granted.role_id
+    JOIN permissions p ON p.id = rp.permission_id
+    WHERE p.name = $4
+)
+`
+
+type AccountHasPermissionParams struct {
+	AccountID    uuid.UUID
+	ResourceType string
+	ResourceID   pgtype.UUID
+	Permission   string
+}
+
+// The roles of an account on a resource are those granted to it there or
+// system-wide, its role in a team or project when the resource is the team
+// or project, and the role of its teams on the projects assigned to them.
+func (q *Queries) AccountHasPermission(ctx context.Context, arg AccountHasPermissionParams) (bool, error) {
+	row := q.db.QueryRow(ctx, accountHasPermission,
+		arg.AccountID,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.Permission,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const assignAccountRole = `-- name: AssignAccountRole :execrows
+INSERT INTO account_roles (account_id, role_id, resource_type, resource_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT DO NOTHING
+`
+
+type AssignAccountRoleParams struct {
+	AccountID    uuid.UUID
+	RoleID       uuid.UUID
+	ResourceType string
+	ResourceID   pgtype.UUID
+}
+
+func (q *Queries) AssignAccountRole(ctx context.Context, arg AssignAccountRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, assignAccountRole,
+		arg.AccountID,
+		arg.RoleID,
+		arg.ResourceType,
+		arg.ResourceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findRoleByName = `-- name: FindRoleByName :one
+SELECT id, name, description, created_at
+FROM roles
+WHERE name = $1
+`
+
+func (q *Queries) FindRoleByName(ctx context.Context, name string) (Role, error) {
+	row := q.db.QueryRow(ctx, findRoleByName, name)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountRoles = `-- name: ListAccountRoles :many
+SELECT ar.id, ar.account_id, r.name AS role_name, ar.resource_type, ar.resource_id, ar.created_at
+FROM account_roles ar
+JOIN roles r ON r.id = ar.role_id
+WHERE ar.account_id = $1
+ORDER BY ar.created_at
+`
+
+type ListAccountRolesRow struct {
+	ID           uuid.UUID
+	AccountID    uuid.UUID
+	RoleName     string
+	ResourceType string
+	ResourceID   pgtype.UUID
+	CreatedAt    pgtype.Timestamp
+}
+
+func (q *Queries) ListAccountRoles(ctx context.Context, accountID uuid.UUID) ([]ListAccountRolesRow, error) {
+	rows, err := q.db.Query(ctx, listAccountRoles, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountRolesRow
+	for rows.Next() {
+		var i ListAccountRolesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.RoleName,
+			&i.ResourceType,
+			&i.ResourceID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPermissions = `-- name: ListPermissions :many
+SELECT id, name, description, created_at
+FROM permissions
+ORDER BY name
+`
+
+func (q *Queries) ListPermissions(ctx context.Context) ([]Permission, error) {
+	rows, err := q.db.Query(ctx, listPermissions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Permission
+	for rows.Next() {
+		var i Permission
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRolePermissions = `-- name: ListRolePermissions :many
+SELECT r.name AS role_name, p.name AS permission_name
+FROM role_permissions rp
+JOIN roles r ON r.id = rp.role_id
+JOIN permissions p ON p.id = rp.permission_id
+ORDER BY r.name, p.name
+`
+
+type ListRolePermissionsRow struct {
+	RoleName       string
+	PermissionName string
+}
+
+func (q *Queries) ListRolePermissions(ctx context.Context) ([]ListRolePermissionsRow, error) {
+	rows, err := q.db.Query(ctx, listRolePermissions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRolePermissionsRow
+	for rows.Next() {
+		var i ListRolePermissionsRow
+		if err := rows.Scan(&i.RoleName, &i.PermissionName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoles = `-- name: ListRoles :many
+SELECT id, name, description, created_at
+FROM roles
+ORDER BY name
+`
+
+func (q *Queries) ListRoles(ctx context.Context) ([]Role, error) {
+	rows, err := q.db.Query(ctx, listRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Role
+	for rows.Next() {
+		var i Role
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAccountRole = `-- name: RevokeAccountRole :execrows
+DELETE FROM account_roles
+WHERE account_id = $1
+  AND role_id = $2
+  AND resource_type = $3
+  AND resource_id IS NOT DISTINCT FROM $4
+`
+
+type RevokeAccountRoleParams struct {
+	AccountID    uuid.UUID
+	RoleID       uuid.UUID
+	ResourceType string
+	ResourceID   pgtype.UUID
+}
+
+func (q *Queries) RevokeAccountRole(ctx context.Context, arg RevokeAccountRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAccountRole,
+		arg.AccountID,
+		arg.RoleID,
+		arg.ResourceType,
+		arg.ResourceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
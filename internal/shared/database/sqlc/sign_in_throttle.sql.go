@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: sign_in_throttle.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const clearSignInThrottle = `-- name: ClearSignInThrottle :execrows
+DELETE FROM sign_in_throttles
+WHERE scope = $1 AND subject = $2
+`
+
+type ClearSignInThrottleParams struct {
+	Scope   string
+	Subject string
+}
+
+func (q *Queries) ClearSignInThrottle(ctx context.Context, arg ClearSignInThrottleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, clearSignInThrottle, arg.Scope, arg.Subject)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findSignInThrottle = `-- name: FindSignInThrottle :one
+SELECT scope, subject, failures, last_failure_at, locked_until
+FROM sign_in_throttles
+WHERE scope = $1 AND subject = $2
+`
+
+type FindSignInThrottleParams struct {
+	Scope   string
+	Subject string
+}
+
+func (q *Queries) FindSignInThrottle(ctx context.Context, arg FindSignInThrottleParams) (SignInThrottle, error) {
+	row := q.db.QueryRow(ctx, findSignInThrottle, arg.Scope, arg.Subject)
+	var i SignInThrottle
+	err := row.Scan(
+		&i.Scope,
+		&i.Subject,
+		&i.Failures,
+		&i.LastFailureAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const lockSignInThrottle = `-- name: LockSignInThrottle :exec
+UPDATE sign_in_throttles
+SET locked_until = $3
+WHERE scope = $1 AND subject = $2
+`
+
+type LockSignInThrottleParams struct {
+	Scope       string
+	Subject     string
+	LockedUntil pgtype.Timestamp
+}
+
+func (q *Queries) LockSignInThrottle(ctx context.Context, arg LockSignInThrottleParams) error {
+	_, err := q.db.Exec(ctx, lockSignInThrottle, arg.Scope, arg.Subject, arg.LockedUntil)
+	return err
+}
+
+const recordSignInFailure = `-- name: RecordSignInFailure :one
+INSERT INTO sign_in_throttles (scope, subject, failures, last_failure_at)
+VALUES ($1, $2, 1, $3)
+ON CONFLICT (scope, subject) DO UPDATE
+SET failures = CASE
+        WHEN sign_in_throttles.last_failure_at <= $4
+          OR sign_in_throttles.locked_until <= $3 THEN 1
+        ELSE sign_in_throttles.failures + 1
+    END,
+    locked_until = CASE
+        WHEN sign_in_throttles.locked_until <= $3 THEN NULL
+        ELSE sign_in_throttles.locked_until
+    END,
+    last_failure_at = $3
+RETURNING scope, subject, failures, last_failure_at, locked_until
+`
+
+type RecordSignInFailureParams struct {
+	Scope       string
+	Subject     string
+	FailedAt    pgtype.Timestamp
+	ResetBefore pgtype.Timestamp
+}
+
+// RecordSignInFailure counts a failure, restarting the count when the last
+// failure is older than reset_before or the previous lockout has expired.
+func (q *Queries) RecordSignInFailure(ctx context.Context, arg RecordSignInFailureParams) (SignInThrottle, error) {
+	row := q.db.QueryRow(ctx, recordSignInFailure,
+		arg.Scope,
+		arg.Subject,
+		arg.FailedAt,
+		arg.ResetBefore,
+	)
+	var i SignInThrottle
+	err := row.Scan(
+		&i.Scope,
+		&i.Subject,
+		&i.Failures,
+		&i.LastFailureAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
@@ -0,0 +1,353 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: task.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const assignTask = `-- name: AssignTask :one
+UPDATE tasks AS t
+SET assignee_account_id = $1::uuid,
+    assignee_team_id = $2::uuid,
+    updated_at = NOW()
+FROM projects p
+WHERE t.id = $3::uuid AND t.project_id = $4::uuid AND t.workspace_id = $5::uuid
+  AND p.id = t.project_id AND p.deleted_at IS NULL
+  AND ($1::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM account_roles ar
+      WHERE ar.resource_type = 'workspace' AND ar.resource_id = t.workspace_id AND ar.account_id = $1::uuid
+  ))
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM teams tm
+      WHERE tm.id = $2::uuid AND tm.workspace_id = t.workspace_id
+  ))
+RETURNING t.id, t.project_id, t.workspace_id, t.title, t.description, t.status, t.assignee_account_id, t.assignee_team_id, t.created_by, t.created_at, t.updated_at
+`
+
+type AssignTaskParams struct {
+	AssigneeAccountID pgtype.UUID
+	AssigneeTeamID    pgtype.UUID
+	ID                uuid.UUID
+	ProjectID         uuid.UUID
+	WorkspaceID       uuid.UUID
+}
+
+// Assigns the task to the account and to the team, either of which may be
+// null to leave the task without one. The account must be a member of the
+// workspace of the task and the team one of its teams, otherwise the task
+// is left untouched.
+func (q *Queries) AssignTask(ctx context.Context, arg AssignTaskParams) (Task, error) {
+	row := q.db.QueryRow(ctx, assignTask,
+		arg.AssigneeAccountID,
+		arg.AssigneeTeamID,
+		arg.ID,
+		arg.ProjectID,
+		arg.WorkspaceID,
+	)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Title,
+		&i.Description,
+		&i.Status,
+		&i.AssigneeAccountID,
+		&i.AssigneeTeamID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const countTasks = `-- name: CountTasks :one
+SELECT COUNT(*)
+FROM tasks t
+JOIN projects p ON p.id = t.project_id AND p.deleted_at IS NULL
+WHERE t.project_id = $1 AND t.workspace_id = $2
+  AND ($3::text IS NULL OR t.status = $3::text)
+  AND ($4::uuid IS NULL OR t.assignee_account_id = $4::uuid)
+  AND ($5::uuid IS NULL OR t.assignee_team_id = $5::uuid)
+`
+
+type CountTasksParams struct {
+	ProjectID         uuid.UUID
+	WorkspaceID       uuid.UUID
+	Status            pgtype.Text
+	AssigneeAccountID pgtype.UUID
+	AssigneeTeamID    pgtype.UUID
+}
+
+func (q *Queries) CountTasks(ctx context.Context, arg CountTasksParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTasks,
+		arg.ProjectID,
+		arg.WorkspaceID,
+		arg.Status,
+		arg.AssigneeAccountID,
+		arg.AssigneeTeamID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createTask = `-- name: CreateTask :one
+
+INSERT INTO tasks (project_id, workspace_id, title, description, status, created_by)
+SELECT p.id, p.workspace_id, $1::text, $2::text, $3::text, $4::uuid
+FROM projects p
+WHERE p.id = $5::uuid AND p.workspace_id = $6::uuid AND p.deleted_at IS NULL
+RETURNING id, project_id, workspace_id, title, description, status, assignee_account_id, assignee_team_id, created_by, created_at, updated_at
+`
+
+type CreateTaskParams struct {
+	Title       string
+	Description string
+	Status      string
+	CreatedBy   uuid.UUID
+	ProjectID   uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+// Tasks are read and written inside the scope of their workspace, which
+// lets the queries see the project they belong to. The tasks of deleted
+// projects are out of reach until the project is restored.
+func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, error) {
+	row := q.db.QueryRow(ctx, createTask,
+		arg.Title,
+		arg.Description,
+		arg.Status,
+		arg.CreatedBy,
+		arg.ProjectID,
+		arg.WorkspaceID,
+	)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Title,
+		&i.Description,
+		&i.Status,
+		&i.AssigneeAccountID,
+		&i.AssigneeTeamID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteTask = `-- name: DeleteTask :execrows
+DELETE FROM tasks AS t
+USING projects p
+WHERE t.id = $1 AND t.project_id = $2 AND t.workspace_id = $3
+  AND p.id = t.project_id AND p.deleted_at IS NULL
+`
+
+type DeleteTaskParams struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+func (q *Queries) DeleteTask(ctx context.Context, arg DeleteTaskParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTask, arg.ID, arg.ProjectID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findTask = `-- name: FindTask :one
+SELECT t.id, t.project_id, t.workspace_id, t.title, t.description, t.status, t.assignee_account_id, t.assignee_team_id, t.created_by, t.created_at, t.updated_at
+FROM tasks t
+JOIN projects p ON p.id = t.project_id AND p.deleted_at IS NULL
+WHERE t.id = $1 AND t.project_id = $2 AND t.workspace_id = $3
+`
+
+type FindTaskParams struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+func (q *Queries) FindTask(ctx context.Context, arg FindTaskParams) (Task, error) {
+	row := q.db.QueryRow(ctx, findTask, arg.ID, arg.ProjectID, arg.WorkspaceID)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Title,
+		&i.Description,
+		&i.Status,
+		&i.AssigneeAccountID,
+		&i.AssigneeTeamID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listAccountTasks = `-- name: ListAccountTasks :many
+SELECT id, project_id, workspace_id, title, description, status, assignee_account_id, assignee_team_id, created_by, created_at, updated_at
+FROM tasks
+WHERE workspace_id = $1
+  AND (created_by = $2::uuid OR assignee_account_id = $2::uuid)
+ORDER BY created_at
+`
+
+type ListAccountTasksParams struct {
+	WorkspaceID uuid.UUID
+	AccountID   uuid.UUID
+}
+
+// Every task of the workspace the account created or is assigned to, for
+// the exports of its personal data.
+func (q *Queries) ListAccountTasks(ctx context.Context, arg ListAccountTasksParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listAccountTasks, arg.WorkspaceID, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.WorkspaceID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.AssigneeAccountID,
+			&i.AssigneeTeamID,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasks = `-- name: ListTasks :many
+SELECT t.id, t.project_id, t.workspace_id, t.title, t.description, t.status, t.assignee_account_id, t.assignee_team_id, t.created_by, t.created_at, t.updated_at
+FROM tasks t
+JOIN projects p ON p.id = t.project_id AND p.deleted_at IS NULL
+WHERE t.project_id = $1 AND t.workspace_id = $2
+  AND ($3::text IS NULL OR t.status = $3::text)
+  AND ($4::uuid IS NULL OR t.assignee_account_id = $4::uuid)
+  AND ($5::uuid IS NULL OR t.assignee_team_id = $5::uuid)
+ORDER BY t.created_at DESC, t.id
+LIMIT $7 OFFSET $6
+`
+
+type ListTasksParams struct {
+	ProjectID         uuid.UUID
+	WorkspaceID       uuid.UUID
+	Status            pgtype.Text
+	AssigneeAccountID pgtype.UUID
+	AssigneeTeamID    pgtype.UUID
+	RowOffset         int32
+	RowLimit          int32
+}
+
+func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listTasks,
+		arg.ProjectID,
+		arg.WorkspaceID,
+		arg.Status,
+		arg.AssigneeAccountID,
+		arg.AssigneeTeamID,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.WorkspaceID,
+			&i.Title,
+			&i.Description,
+			&i.Status,
+			&i.AssigneeAccountID,
+			&i.AssigneeTeamID,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTask = `-- name: UpdateTask :one
+UPDATE tasks AS t
+SET title = $1, description = $2, status = $3, updated_at = NOW()
+FROM projects p
+WHERE t.id = $4 AND t.project_id = $5 AND t.workspace_id = $6
+  AND p.id = t.project_id AND p.deleted_at IS NULL
+RETURNING t.id, t.project_id, t.workspace_id, t.title, t.description, t.status, t.assignee_account_id, t.assignee_team_id, t.created_by, t.created_at, t.updated_at
+`
+
+type UpdateTaskParams struct {
+	Title       string
+	Description string
+	Status      string
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (Task, error) {
+	row := q.db.QueryRow(ctx, updateTask,
+		arg.Title,
+		arg.Description,
+		arg.Status,
+		arg.ID,
+		arg.ProjectID,
+		arg.WorkspaceID,
+	)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Title,
+		&i.Description,
+		&i.Status,
+		&i.AssigneeAccountID,
+		&i.AssigneeTeamID,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,477 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: team.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const assignTeamProject = `-- name: AssignTeamProject :execrows
+INSERT INTO team_projects (team_id, project_id)
+SELECT t.id, p.id
+FROM teams t
+JOIN projects p ON p.workspace_id = t.workspace_id
+WHERE t.id = $1::uuid AND t.workspace_id = $2::uuid AND p.id = $3::uuid AND p.deleted_at IS NULL
+ON CONFLICT (team_id, project_id) DO UPDATE SET created_at = team_projects.created_at
+`
+
+type AssignTeamProjectParams struct {
+	TeamID      uuid.UUID
+	WorkspaceID uuid.UUID
+	ProjectID   uuid.UUID
+}
+
+// Assigns a project of the workspace of the team to it. A project assigned
+// already counts as assigned again.
+func (q *Queries) AssignTeamProject(ctx context.Context, arg AssignTeamProjectParams) (int64, error) {
+	result, err := q.db.Exec(ctx, assignTeamProject, arg.TeamID, arg.WorkspaceID, arg.ProjectID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createTeam = `-- name: CreateTeam :one
+WITH created AS (
+    INSERT INTO teams (workspace_id, name, role_id)
+    SELECT $1::uuid, $2, r.id
+    FROM roles r
+    WHERE r.name = $3
+    RETURNING id, workspace_id, name, role_id, created_at, updated_at
+)
+SELECT c.id, c.workspace_id, c.name, r.name AS role_name, c.created_at, c.updated_at
+FROM created c
+JOIN roles r ON r.id = c.role_id
+`
+
+type CreateTeamParams struct {
+	WorkspaceID uuid.UUID
+	Name        string
+	RoleName    string
+}
+
+type CreateTeamRow struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	RoleName    string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (CreateTeamRow, error) {
+	row := q.db.QueryRow(ctx, createTeam, arg.WorkspaceID, arg.Name, arg.RoleName)
+	var i CreateTeamRow
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Name,
+		&i.RoleName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteTeam = `-- name: DeleteTeam :execrows
+DELETE FROM teams
+WHERE id = $1 AND workspace_id = $2
+`
+
+type DeleteTeamParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+// Deleting a team deletes its memberships and project assignments.
+func (q *Queries) DeleteTeam(ctx context.Context, arg DeleteTeamParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeam, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findTeam = `-- name: FindTeam :one
+SELECT t.id, t.workspace_id, t.name, r.name AS role_name, t.created_at, t.updated_at
+FROM teams t
+JOIN roles r ON r.id = t.role_id
+WHERE t.id = $1 AND t.workspace_id = $2
+`
+
+type FindTeamParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+type FindTeamRow struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	RoleName    string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
+func (q *Queries) FindTeam(ctx context.Context, arg FindTeamParams) (FindTeamRow, error) {
+	row := q.db.QueryRow(ctx, findTeam, arg.ID, arg.WorkspaceID)
+	var i FindTeamRow
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Name,
+		&i.RoleName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findTeamMember = `-- name: FindTeamMember :one
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, tm.created_at
+FROM team_members tm
+JOIN teams t ON t.id = tm.team_id
+JOIN accounts a ON a.id = tm.account_id
+JOIN roles r ON r.id = tm.role_id
+WHERE tm.team_id = $1 AND t.workspace_id = $2 AND tm.account_id = $3
+`
+
+type FindTeamMemberParams struct {
+	TeamID      uuid.UUID
+	WorkspaceID uuid.UUID
+	AccountID   uuid.UUID
+}
+
+type FindTeamMemberRow struct {
+	AccountID uuid.UUID
+	Name      string
+	Email     string
+	RoleName  string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) FindTeamMember(ctx context.Context, arg FindTeamMemberParams) (FindTeamMemberRow, error) {
+	row := q.db.QueryRow(ctx, findTeamMember, arg.TeamID, arg.WorkspaceID, arg.AccountID)
+	var i FindTeamMemberRow
+	err := row.Scan(
+		&i.AccountID,
+		&i.Name,
+		&i.Email,
+		&i.RoleName,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountTeams = `-- name: ListAccountTeams :many
+SELECT t.id, t.workspace_id, t.name, r.name AS member_role, t.created_at, t.updated_at
+FROM team_members tm
+JOIN teams t ON t.id = tm.team_id
+JOIN roles r ON r.id = tm.role_id
+WHERE tm.account_id = $1
+ORDER BY lower(t.name), t.id
+`
+
+type ListAccountTeamsRow struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	MemberRole  string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
+// The teams the account belongs to, in every workspace, with its role in
+// each.
+func (q *Queries) ListAccountTeams(ctx context.Context, accountID uuid.UUID) ([]ListAccountTeamsRow, error) {
+	rows, err := q.db.Query(ctx, listAccountTeams, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountTeamsRow
+	for rows.Next() {
+		var i ListAccountTeamsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Name,
+			&i.MemberRole,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamMembers = `-- name: ListTeamMembers :many
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, tm.created_at
+FROM team_members tm
+JOIN teams t ON t.id = tm.team_id
+JOIN accounts a ON a.id = tm.account_id
+JOIN roles r ON r.id = tm.role_id
+WHERE tm.team_id = $1 AND t.workspace_id = $2
+ORDER BY a.name, a.id
+`
+
+type ListTeamMembersParams struct {
+	TeamID      uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+type ListTeamMembersRow struct {
+	AccountID uuid.UUID
+	Name      string
+	Email     string
+	RoleName  string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) ListTeamMembers(ctx context.Context, arg ListTeamMembersParams) ([]ListTeamMembersRow, error) {
+	rows, err := q.db.Query(ctx, listTeamMembers, arg.TeamID, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTeamMembersRow
+	for rows.Next() {
+		var i ListTeamMembersRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.Name,
+			&i.Email,
+			&i.RoleName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamProjects = `-- name: ListTeamProjects :many
+SELECT p.id, p.key, p.name, tp.created_at
+FROM team_projects tp
+JOIN teams t ON t.id = tp.team_id
+JOIN projects p ON p.id = tp.project_id
+WHERE tp.team_id = $1 AND t.workspace_id = $2 AND p.deleted_at IS NULL
+ORDER BY p.name, p.id
+`
+
+type ListTeamProjectsParams struct {
+	TeamID      uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+type ListTeamProjectsRow struct {
+	ID        uuid.UUID
+	Key       string
+	Name      string
+	CreatedAt pgtype.Timestamp
+}
+
+// Projects are only visible within the scope of their workspace.
+func (q *Queries) ListTeamProjects(ctx context.Context, arg ListTeamProjectsParams) ([]ListTeamProjectsRow, error) {
+	rows, err := q.db.Query(ctx, listTeamProjects, arg.TeamID, arg.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTeamProjectsRow
+	for rows.Next() {
+		var i ListTeamProjectsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Key,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkspaceTeams = `-- name: ListWorkspaceTeams :many
+SELECT t.id, t.workspace_id, t.name, r.name AS role_name, t.created_at, t.updated_at
+FROM teams t
+JOIN roles r ON r.id = t.role_id
+WHERE t.workspace_id = $1
+ORDER BY lower(t.name), t.id
+`
+
+type ListWorkspaceTeamsRow struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	RoleName    string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
+func (q *Queries) ListWorkspaceTeams(ctx context.Context, workspaceID uuid.UUID) ([]ListWorkspaceTeamsRow, error) {
+	rows, err := q.db.Query(ctx, listWorkspaceTeams, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWorkspaceTeamsRow
+	for rows.Next() {
+		var i ListWorkspaceTeamsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Name,
+			&i.RoleName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeTeamMember = `-- name: RemoveTeamMember :execrows
+DELETE FROM team_members AS tm
+USING teams t
+WHERE t.id = tm.team_id AND tm.team_id = $1::uuid AND t.workspace_id = $2::uuid AND tm.account_id = $3::uuid
+`
+
+type RemoveTeamMemberParams struct {
+	TeamID      uuid.UUID
+	WorkspaceID uuid.UUID
+	AccountID   uuid.UUID
+}
+
+func (q *Queries) RemoveTeamMember(ctx context.Context, arg RemoveTeamMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeTeamMember, arg.TeamID, arg.WorkspaceID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setTeamMember = `-- name: SetTeamMember :execrows
+INSERT INTO team_members (team_id, account_id, role_id)
+SELECT t.id, $1::uuid, r.id
+FROM teams t CROSS JOIN roles r
+WHERE t.id = $2::uuid
+  AND t.workspace_id = $3::uuid
+  AND r.name = $4
+  AND EXISTS (
+      SELECT 1 FROM account_roles ar
+      WHERE ar.resource_type = 'workspace' AND ar.resource_id = t.workspace_id AND ar.account_id = $1::uuid
+  )
+ON CONFLICT (team_id, account_id) DO UPDATE SET role_id = EXCLUDED.role_id
+`
+
+type SetTeamMemberParams struct {
+	AccountID   uuid.UUID
+	TeamID      uuid.UUID
+	WorkspaceID uuid.UUID
+	RoleName    string
+}
+
+// Adds the account to the team with the role, or changes its role when it
+// is a member already. Only members of the workspace of the team can join
+// it.
+func (q *Queries) SetTeamMember(ctx context.Context, arg SetTeamMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setTeamMember,
+		arg.AccountID,
+		arg.TeamID,
+		arg.WorkspaceID,
+		arg.RoleName,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unassignTeamProject = `-- name: UnassignTeamProject :execrows
+DELETE FROM team_projects AS tp
+USING teams t
+WHERE t.id = tp.team_id AND tp.team_id = $1::uuid AND t.workspace_id = $2::uuid AND tp.project_id = $3::uuid
+`
+
+type UnassignTeamProjectParams struct {
+	TeamID      uuid.UUID
+	WorkspaceID uuid.UUID
+	ProjectID   uuid.UUID
+}
+
+func (q *Queries) UnassignTeamProject(ctx context.Context, arg UnassignTeamProjectParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unassignTeamProject, arg.TeamID, arg.WorkspaceID, arg.ProjectID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTeam = `-- name: UpdateTeam :one
+UPDATE teams AS t
+SET name = $1, role_id = r.id, updated_at = NOW()
+FROM roles r
+WHERE r.name = $2 AND t.id = $3 AND t.workspace_id = $4
+RETURNING t.id, t.workspace_id, t.name, r.name AS role_name, t.created_at, t.updated_at
+`
+
+type UpdateTeamParams struct {
+	Name        string
+	RoleName    string
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+type UpdateTeamRow struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	RoleName    string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
+func (q *Queries) UpdateTeam(ctx context.Context, arg UpdateTeamParams) (UpdateTeamRow, error) {
+	row := q.db.QueryRow(ctx, updateTeam,
+		arg.Name,
+		arg.RoleName,
+		arg.ID,
+		arg.WorkspaceID,
+	)
+	var i UpdateTeamRow
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Name,
+		&i.RoleName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: tenant.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const setWorkspaceScope = `-- name: SetWorkspaceScope :exec
+SELECT set_config('app.workspace_id', $1::uuid::text, true)
+`
+
+// Sets the workspace row-level security lets the current transaction see.
+func (q *Queries) SetWorkspaceScope(ctx context.Context, workspaceID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, setWorkspaceScope, workspaceID)
+	return err
+}
@@ -0,0 +1,372 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: workspace.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const changeWorkspaceMemberRole = `-- name: ChangeWorkspaceMemberRole :one
+WITH owners AS (
+    SELECT ar.account_id
+    FROM account_roles ar
+    JOIN roles r ON r.id = ar.role_id
+    WHERE ar.resource_type = 'workspace' AND ar.resource_id = $1::uuid AND r.name = 'workspace_owner'
+    ORDER BY ar.account_id
+    FOR UPDATE OF ar
+), guard AS (
+    SELECT $2::text <> 'workspace_owner'
+        AND $3::uuid IN (SELECT account_id FROM owners)
+        AND (SELECT COUNT(*) FROM owners) <= 1 AS last_owner
+), changed AS (
+    UPDATE account_roles
+    SET role_id = r.id
+    FROM roles r, guard
+    WHERE NOT guard.last_owner
+      AND r.name = $2::text
+      AND account_roles.resource_type = 'workspace'
+      AND account_roles.resource_id = $1::uuid
+      AND account_roles.account_id = $3::uuid
+    RETURNING account_roles.account_id
+)
+SELECT EXISTS (SELECT 1 FROM changed)::boolean AS changed, guard.last_owner::boolean AS last_owner
+FROM guard
+`
+
+type ChangeWorkspaceMemberRoleParams struct {
+	WorkspaceID uuid.UUID
+	RoleName    string
+	AccountID   uuid.UUID
+}
+
+type ChangeWorkspaceMemberRoleRow struct {
+	Changed   bool
+	LastOwner bool
+}
+
+// The owners of the workspace are locked before counting them, so two
+// concurrent changes cannot each take away one of the last two owners. A
+// change that would leave the workspace without an owner is not made and is
+// reported by last_owner.
+func (q *Queries) ChangeWorkspaceMemberRole(ctx context.Context, arg ChangeWorkspaceMemberRoleParams) (ChangeWorkspaceMemberRoleRow, error) {
+	row := q.db.QueryRow(ctx, changeWorkspaceMemberRole, arg.WorkspaceID, arg.RoleName, arg.AccountID)
+	var i ChangeWorkspaceMemberRoleRow
+	err := row.Scan(&i.Changed, &i.LastOwner)
+	return i, err
+}
+
+const createWorkspace = `-- name: CreateWorkspace :one
+WITH created AS (
+    INSERT INTO workspaces (name, created_by)
+    VALUES ($1, $2::uuid)
+    RETURNING id, name, created_by, created_at, updated_at
+), owner AS (
+    INSERT INTO account_roles (account_id, role_id, resource_type, resource_id)
+    SELECT $2::uuid, r.id, 'workspace', c.id
+    FROM created c CROSS JOIN roles r
+    WHERE r.name = 'workspace_owner'
+)
+SELECT id, name, created_by, created_at, updated_at FROM created
+`
+
+type CreateWorkspaceParams struct {
+	Name      string
+	CreatedBy uuid.UUID
+}
+
+type CreateWorkspaceRow struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+// The creator of a workspace becomes its owner.
+func (q *Queries) CreateWorkspace(ctx context.Context, arg CreateWorkspaceParams) (CreateWorkspaceRow, error) {
+	row := q.db.QueryRow(ctx, createWorkspace, arg.Name, arg.CreatedBy)
+	var i CreateWorkspaceRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWorkspace = `-- name: DeleteWorkspace :execrows
+WITH revoked AS (
+    DELETE FROM account_roles AS ar
+    WHERE ar.resource_type = 'workspace' AND ar.resource_id = $1::uuid
+)
+DELETE FROM workspaces AS w WHERE w.id = $1::uuid
+`
+
+// Deleting a workspace deletes its projects and the roles granted on it.
+func (q *Queries) DeleteWorkspace(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWorkspace, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findWorkspace = `-- name: FindWorkspace :one
+SELECT id, name, created_by, created_at, updated_at
+FROM workspaces
+WHERE id = $1
+`
+
+func (q *Queries) FindWorkspace(ctx context.Context, id uuid.UUID) (Workspace, error) {
+	row := q.db.QueryRow(ctx, findWorkspace, id)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findWorkspaceMember = `-- name: FindWorkspaceMember :one
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, ar.created_at
+FROM account_roles ar
+JOIN accounts a ON a.id = ar.account_id
+JOIN roles r ON r.id = ar.role_id
+WHERE ar.resource_type = 'workspace' AND ar.resource_id = $1::uuid AND ar.account_id = $2
+`
+
+type FindWorkspaceMemberParams struct {
+	WorkspaceID uuid.UUID
+	AccountID   uuid.UUID
+}
+
+type FindWorkspaceMemberRow struct {
+	AccountID uuid.UUID
+	Name      string
+	Email     string
+	RoleName  string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) FindWorkspaceMember(ctx context.Context, arg FindWorkspaceMemberParams) (FindWorkspaceMemberRow, error) {
+	row := q.db.QueryRow(ctx, findWorkspaceMember, arg.WorkspaceID, arg.AccountID)
+	var i FindWorkspaceMemberRow
+	err := row.Scan(
+		&i.AccountID,
+		&i.Name,
+		&i.Email,
+		&i.RoleName,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountWorkspaceIDs = `-- name: ListAccountWorkspaceIDs :many
+SELECT w.id
+FROM workspaces w
+JOIN account_roles ar ON ar.resource_type = 'workspace' AND ar.resource_id = w.id
+WHERE ar.account_id = $1
+ORDER BY w.created_at
+`
+
+func (q *Queries) ListAccountWorkspaceIDs(ctx context.Context, accountID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listAccountWorkspaceIDs, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountWorkspaces = `-- name: ListAccountWorkspaces :many
+SELECT w.id, w.name, w.created_by, w.created_at, w.updated_at, r.name AS role_name
+FROM workspaces w
+JOIN account_roles ar ON ar.resource_type = 'workspace' AND ar.resource_id = w.id
+JOIN roles r ON r.id = ar.role_id
+WHERE ar.account_id = $1
+ORDER BY w.name, w.id
+`
+
+type ListAccountWorkspacesRow struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedBy pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+	RoleName  string
+}
+
+func (q *Queries) ListAccountWorkspaces(ctx context.Context, accountID uuid.UUID) ([]ListAccountWorkspacesRow, error) {
+	rows, err := q.db.Query(ctx, listAccountWorkspaces, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountWorkspacesRow
+	for rows.Next() {
+		var i ListAccountWorkspacesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RoleName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkspaceMembers = `-- name: ListWorkspaceMembers :many
+SELECT a.id AS account_id, a.name, a.email, r.name AS role_name, ar.created_at
+FROM account_roles ar
+JOIN accounts a ON a.id = ar.account_id
+JOIN roles r ON r.id = ar.role_id
+WHERE ar.resource_type = 'workspace' AND ar.resource_id = $1::uuid
+ORDER BY a.name, a.id
+`
+
+type ListWorkspaceMembersRow struct {
+	AccountID uuid.UUID
+	Name      string
+	Email     string
+	RoleName  string
+	CreatedAt pgtype.Timestamp
+}
+
+func (q *Queries) ListWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID) ([]ListWorkspaceMembersRow, error) {
+	rows, err := q.db.Query(ctx, listWorkspaceMembers, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWorkspaceMembersRow
+	for rows.Next() {
+		var i ListWorkspaceMembersRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.Name,
+			&i.Email,
+			&i.RoleName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeWorkspaceMember = `-- name: RemoveWorkspaceMember :one
+WITH owners AS (
+    SELECT ar.account_id
+    FROM account_roles ar
+    JOIN roles r ON r.id = ar.role_id
+    WHERE ar.resource_type = 'workspace' AND ar.resource_id = $1::uuid AND r.name = 'workspace_owner'
+    ORDER BY ar.account_id
+    FOR UPDATE OF ar
+), guard AS (
+    SELECT $2::uuid IN (SELECT account_id FROM owners)
+        AND (SELECT COUNT(*) FROM owners) <= 1 AS last_owner
+), left_teams AS (
+    DELETE FROM team_members AS tm
+    USING teams t, guard
+    WHERE NOT guard.last_owner
+      AND t.id = tm.team_id AND t.workspace_id = $1::uuid AND tm.account_id = $2::uuid
+), left_projects AS (
+    DELETE FROM project_members AS pm
+    USING guard
+    WHERE NOT guard.last_owner
+      AND pm.workspace_id = $1::uuid AND pm.account_id = $2::uuid
+), unassigned_tasks AS (
+    UPDATE tasks AS t
+    SET assignee_account_id = NULL, updated_at = NOW()
+    FROM guard
+    WHERE NOT guard.last_owner
+      AND t.workspace_id = $1::uuid AND t.assignee_account_id = $2::uuid
+), removed AS (
+    DELETE FROM account_roles AS ar
+    USING guard
+    WHERE NOT guard.last_owner
+      AND ar.resource_type = 'workspace' AND ar.resource_id = $1::uuid AND ar.account_id = $2::uuid
+    RETURNING ar.account_id
+)
+SELECT EXISTS (SELECT 1 FROM removed)::boolean AS removed, guard.last_owner::boolean AS last_owner
+FROM guard
+`
+
+type RemoveWorkspaceMemberParams struct {
+	WorkspaceID uuid.UUID
+	AccountID   uuid.UUID
+}
+
+type RemoveWorkspaceMemberRow struct {
+	Removed   bool
+	LastOwner bool
+}
+
+// Leaving a workspace also leaves its teams and projects and unassigns its
+// tasks. As when changing roles, the owners are locked first and the last
+// owner cannot leave.
+func (q *Queries) RemoveWorkspaceMember(ctx context.Context, arg RemoveWorkspaceMemberParams) (RemoveWorkspaceMemberRow, error) {
+	row := q.db.QueryRow(ctx, removeWorkspaceMember, arg.WorkspaceID, arg.AccountID)
+	var i RemoveWorkspaceMemberRow
+	err := row.Scan(&i.Removed, &i.LastOwner)
+	return i, err
+}
+
+const updateWorkspace = `-- name: UpdateWorkspace :one
+UPDATE workspaces
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, created_by, created_at, updated_at
+`
+
+type UpdateWorkspaceParams struct {
+	ID   uuid.UUID
+	Name string
+}
+
+func (q *Queries) UpdateWorkspace(ctx context.Context, arg UpdateWorkspaceParams) (Workspace, error) {
+	row := q.db.QueryRow(ctx, updateWorkspace, arg.ID, arg.Name)
+	var i Workspace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
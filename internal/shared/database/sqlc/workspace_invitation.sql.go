@@ -0,0 +1,337 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: workspace_invitation.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const acceptWorkspaceInvitation = `-- name: AcceptWorkspaceInvitation :one
+WITH accepted AS (
+    UPDATE workspace_invitations
+    SET accepted_at = NOW(), accepted_by = $1::uuid
+    WHERE workspace_invitations.id = $2 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()
+    RETURNING workspace_id, role
+), joined AS (
+    INSERT INTO account_roles (account_id, role_id, resource_type, resource_id)
+    SELECT $1::uuid, r.id, 'workspace', accepted.workspace_id
+    FROM accepted
+    JOIN roles r ON r.name = accepted.role
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM account_roles ar
+        WHERE ar.account_id = $1::uuid
+          AND ar.resource_type = 'workspace'
+          AND ar.resource_id = accepted.workspace_id
+    )
+    ON CONFLICT DO NOTHING
+)
+SELECT EXISTS (SELECT 1 FROM accepted)::boolean AS accepted
+`
+
+type AcceptWorkspaceInvitationParams struct {
+	AcceptedBy uuid.UUID
+	ID         uuid.UUID
+}
+
+// Accepting the invitation and granting the invited role are one statement,
+// so an invitation is never used up without the account joining. An account
+// that is a member of the workspace already keeps its role.
+func (q *Queries) AcceptWorkspaceInvitation(ctx context.Context, arg AcceptWorkspaceInvitationParams) (bool, error) {
+	row := q.db.QueryRow(ctx, acceptWorkspaceInvitation, arg.AcceptedBy, arg.ID)
+	var accepted bool
+	err := row.Scan(&accepted)
+	return accepted, err
+}
+
+const createWorkspaceInvitation = `-- name: CreateWorkspaceInvitation :one
+INSERT INTO workspace_invitations (workspace_id, email, role, token_hash, invited_by, expires_at)
+VALUES ($1, $2, $3, $4, $5::uuid, $6)
+RETURNING id, workspace_id, email, role, token_hash, invited_by, expires_at, sent_at, accepted_at, accepted_by, revoked_at, created_at
+`
+
+type CreateWorkspaceInvitationParams struct {
+	WorkspaceID uuid.UUID
+	Email       string
+	Role        string
+	TokenHash   string
+	InvitedBy   uuid.UUID
+	ExpiresAt   pgtype.Timestamp
+}
+
+func (q *Queries) CreateWorkspaceInvitation(ctx context.Context, arg CreateWorkspaceInvitationParams) (WorkspaceInvitation, error) {
+	row := q.db.QueryRow(ctx, createWorkspaceInvitation,
+		arg.WorkspaceID,
+		arg.Email,
+		arg.Role,
+		arg.TokenHash,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	var i WorkspaceInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.SentAt,
+		&i.AcceptedAt,
+		&i.AcceptedBy,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAccountWorkspaceInvitations = `-- name: DeleteAccountWorkspaceInvitations :exec
+DELETE FROM workspace_invitations
+WHERE lower(email) = (SELECT lower(a.email) FROM accounts a WHERE a.id = $1::uuid)
+   OR accepted_by = $1::uuid
+`
+
+func (q *Queries) DeleteAccountWorkspaceInvitations(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAccountWorkspaceInvitations, accountID)
+	return err
+}
+
+const findPendingWorkspaceInvitation = `-- name: FindPendingWorkspaceInvitation :one
+SELECT id, workspace_id, email, role, token_hash, invited_by, expires_at, sent_at, accepted_at, accepted_by, revoked_at, created_at
+FROM workspace_invitations
+WHERE id = $1 AND workspace_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL
+`
+
+type FindPendingWorkspaceInvitationParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+func (q *Queries) FindPendingWorkspaceInvitation(ctx context.Context, arg FindPendingWorkspaceInvitationParams) (WorkspaceInvitation, error) {
+	row := q.db.QueryRow(ctx, findPendingWorkspaceInvitation, arg.ID, arg.WorkspaceID)
+	var i WorkspaceInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.SentAt,
+		&i.AcceptedAt,
+		&i.AcceptedBy,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findWorkspaceInvitationByHash = `-- name: FindWorkspaceInvitationByHash :one
+SELECT i.id, i.workspace_id, i.email, i.role, i.token_hash, i.invited_by, i.expires_at, i.sent_at, i.accepted_at, i.accepted_by, i.revoked_at, i.created_at,
+       w.name AS workspace_name, COALESCE(a.name, '')::text AS inviter_name
+FROM workspace_invitations i
+JOIN workspaces w ON w.id = i.workspace_id
+LEFT JOIN accounts a ON a.id = i.invited_by
+WHERE i.token_hash = $1
+`
+
+type FindWorkspaceInvitationByHashRow struct {
+	ID            uuid.UUID
+	WorkspaceID   uuid.UUID
+	Email         string
+	Role          string
+	TokenHash     string
+	InvitedBy     pgtype.UUID
+	ExpiresAt     pgtype.Timestamp
+	SentAt        pgtype.Timestamp
+	AcceptedAt    pgtype.Timestamp
+	AcceptedBy    pgtype.UUID
+	RevokedAt     pgtype.Timestamp
+	CreatedAt     pgtype.Timestamp
+	WorkspaceName string
+	InviterName   string
+}
+
+func (q *Queries) FindWorkspaceInvitationByHash(ctx context.Context, tokenHash string) (FindWorkspaceInvitationByHashRow, error) {
+	row := q.db.QueryRow(ctx, findWorkspaceInvitationByHash, tokenHash)
+	var i FindWorkspaceInvitationByHashRow
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.SentAt,
+		&i.AcceptedAt,
+		&i.AcceptedBy,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.WorkspaceName,
+		&i.InviterName,
+	)
+	return i, err
+}
+
+const listAccountWorkspaceInvitations = `-- name: ListAccountWorkspaceInvitations :many
+SELECT i.id, i.workspace_id, w.name AS workspace_name, i.email, i.role, i.expires_at, i.sent_at, i.accepted_at, i.revoked_at, i.created_at
+FROM workspace_invitations i
+JOIN workspaces w ON w.id = i.workspace_id
+WHERE lower(i.email) = (SELECT lower(a.email) FROM accounts a WHERE a.id = $1::uuid)
+   OR i.accepted_by = $1::uuid
+ORDER BY i.created_at, i.id
+`
+
+type ListAccountWorkspaceInvitationsRow struct {
+	ID            uuid.UUID
+	WorkspaceID   uuid.UUID
+	WorkspaceName string
+	Email         string
+	Role          string
+	ExpiresAt     pgtype.Timestamp
+	SentAt        pgtype.Timestamp
+	AcceptedAt    pgtype.Timestamp
+	RevokedAt     pgtype.Timestamp
+	CreatedAt     pgtype.Timestamp
+}
+
+// Invitations of an account are the ones sent to its email or accepted by it.
+func (q *Queries) ListAccountWorkspaceInvitations(ctx context.Context, accountID uuid.UUID) ([]ListAccountWorkspaceInvitationsRow, error) {
+	rows, err := q.db.Query(ctx, listAccountWorkspaceInvitations, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountWorkspaceInvitationsRow
+	for rows.Next() {
+		var i ListAccountWorkspaceInvitationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.WorkspaceName,
+			&i.Email,
+			&i.Role,
+			&i.ExpiresAt,
+			&i.SentAt,
+			&i.AcceptedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingWorkspaceInvitations = `-- name: ListPendingWorkspaceInvitations :many
+SELECT id, workspace_id, email, role, token_hash, invited_by, expires_at, sent_at, accepted_at, accepted_by, revoked_at, created_at
+FROM workspace_invitations
+WHERE workspace_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL
+ORDER BY created_at, id
+`
+
+// Pending invitations are neither accepted nor revoked, expired ones included
+// so they can be resent.
+func (q *Queries) ListPendingWorkspaceInvitations(ctx context.Context, workspaceID uuid.UUID) ([]WorkspaceInvitation, error) {
+	rows, err := q.db.Query(ctx, listPendingWorkspaceInvitations, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkspaceInvitation
+	for rows.Next() {
+		var i WorkspaceInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Email,
+			&i.Role,
+			&i.TokenHash,
+			&i.InvitedBy,
+			&i.ExpiresAt,
+			&i.SentAt,
+			&i.AcceptedAt,
+			&i.AcceptedBy,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renewWorkspaceInvitation = `-- name: RenewWorkspaceInvitation :one
+UPDATE workspace_invitations
+SET token_hash = $3, expires_at = $4, sent_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL
+RETURNING id, workspace_id, email, role, token_hash, invited_by, expires_at, sent_at, accepted_at, accepted_by, revoked_at, created_at
+`
+
+type RenewWorkspaceInvitationParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	TokenHash   string
+	ExpiresAt   pgtype.Timestamp
+}
+
+func (q *Queries) RenewWorkspaceInvitation(ctx context.Context, arg RenewWorkspaceInvitationParams) (WorkspaceInvitation, error) {
+	row := q.db.QueryRow(ctx, renewWorkspaceInvitation,
+		arg.ID,
+		arg.WorkspaceID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	var i WorkspaceInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.WorkspaceID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.SentAt,
+		&i.AcceptedAt,
+		&i.AcceptedBy,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const revokeWorkspaceInvitation = `-- name: RevokeWorkspaceInvitation :execrows
+UPDATE workspace_invitations
+SET revoked_at = NOW()
+WHERE id = $1 AND workspace_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL
+`
+
+type RevokeWorkspaceInvitationParams struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+}
+
+func (q *Queries) RevokeWorkspaceInvitation(ctx context.Context, arg RevokeWorkspaceInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeWorkspaceInvitation, arg.ID, arg.WorkspaceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
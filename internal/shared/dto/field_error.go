@@ -0,0 +1,9 @@
+package dto
+
+// FieldError describes why the value of a request field was rejected, so
+// clients can show it next to the field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
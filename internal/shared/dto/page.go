@@ -0,0 +1,10 @@
+package dto
+
+// Page is one page of a paginated listing, along with the total number of
+// items across every page.
+type Page[T any] struct {
+	Items   []T   `json:"items"`
+	Page    int   `json:"page"`
+	PerPage int   `json:"per_page"`
+	Total   int64 `json:"total"`
+}
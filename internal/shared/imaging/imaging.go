@@ -0,0 +1,118 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+const (
+	// maxPixels bounds the size of decoded images, so a small file cannot
+	// decompress into gigabytes of pixels.
+	maxPixels   = 40_000_000
+	jpegQuality = 90
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrInvalidImage      = errors.New("invalid image")
+	ErrTooManyPixels     = errors.New("image has too many pixels")
+)
+
+type format struct {
+	decode       func(io.Reader) (image.Image, error)
+	decodeConfig func(io.Reader) (image.Config, error)
+}
+
+// formats are the accepted types, keyed by the MIME type sniffed from the
+// content.
+var formats = map[string]format{
+	"image/jpeg": {jpeg.Decode, jpeg.DecodeConfig},
+	"image/png":  {png.Decode, png.DecodeConfig},
+	"image/gif":  {gif.Decode, gif.DecodeConfig},
+	"image/webp": {webp.Decode, webp.DecodeConfig},
+}
+
+// Image is a decoded image along with the orientation its EXIF metadata
+// asks it to be displayed with.
+type Image struct {
+	MIMEType    string
+	image       image.Image
+	orientation int
+}
+
+// Decode decodes a JPEG, PNG, GIF or WebP image. The type is sniffed from
+// the content, whatever the client declared, and only the first frame of
+// animations is kept.
+func Decode(data []byte) (*Image, error) {
+	mimeType := http.DetectContentType(data)
+
+	format, ok := formats[mimeType]
+	if !ok {
+		return nil, ErrUnsupportedFormat
+	}
+
+	cfg, err := format.decodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if cfg.Width < 1 || cfg.Height < 1 {
+		return nil, ErrInvalidImage
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, err := format.decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	orientation := 1
+	if mimeType == "image/jpeg" {
+		orientation = exifOrientation(data)
+	}
+
+	return &Image{MIMEType: mimeType, image: img, orientation: orientation}, nil
+}
+
+// Square crops the centered square of the image and scales it to size
+// pixels, upright. Transparent areas are filled with white.
+func (i *Image) Square(size int) image.Image {
+	bounds := i.image.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(
+		bounds.Min.X+(bounds.Dx()-side)/2,
+		bounds.Min.Y+(bounds.Dy()-side)/2,
+	))
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), i.image, crop, draw.Over, nil)
+
+	// The centered square of a rotated image is the rotated centered
+	// square, so orienting the small result is enough.
+	return orient(dst, i.orientation)
+}
+
+// EncodeJPEG encodes img as a JPEG. Nothing but the pixels is written, which
+// leaves out the metadata of the original file, such as EXIF.
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
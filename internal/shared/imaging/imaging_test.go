@@ -0,0 +1,158 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"trilha-api/internal/shared/imaging"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	red  = color.RGBA{R: 255, A: 255}
+	blue = color.RGBA{B: 255, A: 255}
+)
+
+// halves draws an image whose top half is red and bottom half blue.
+func halves(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			if y < h/2 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	return buf.Bytes()
+}
+
+// withOrientation inserts an EXIF segment with the orientation tag right
+// after the start of a JPEG, as cameras do.
+func withOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+func isRed(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r > 0xC000 && g < 0x4000 && b < 0x4000
+}
+
+func isBlue(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return b > 0xC000 && r < 0x4000 && g < 0x4000
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("should sniff the type from the content", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, halves(4, 4)))
+
+		img, err := imaging.Decode(buf.Bytes())
+
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", img.MIMEType)
+	})
+
+	t.Run("should reject content that is not a supported image", func(t *testing.T) {
+		_, err := imaging.Decode([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+
+		assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+	})
+
+	t.Run("should reject a truncated image", func(t *testing.T) {
+		data := encodeJPEG(t, halves(16, 16))
+
+		_, err := imaging.Decode(data[:len(data)/2])
+
+		assert.ErrorIs(t, err, imaging.ErrInvalidImage)
+	})
+
+	t.Run("should reject images with too many pixels before decoding them", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+		data := buf.Bytes()
+		// Forge the IHDR chunk of a 10000x10000 image.
+		binary.BigEndian.PutUint32(data[16:], 10000)
+		binary.BigEndian.PutUint32(data[20:], 10000)
+		binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+		_, err := imaging.Decode(data)
+
+		assert.ErrorIs(t, err, imaging.ErrTooManyPixels)
+	})
+}
+
+func TestImage_Square(t *testing.T) {
+	t.Run("should crop the centered square and scale it", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 60, 20))
+		for y := range 20 {
+			for x := range 60 {
+				if x >= 20 && x < 40 {
+					src.Set(x, y, red)
+				} else {
+					src.Set(x, y, blue)
+				}
+			}
+		}
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, src))
+		img, err := imaging.Decode(buf.Bytes())
+		require.NoError(t, err)
+
+		square := img.Square(10)
+
+		assert.Equal(t, image.Rect(0, 0, 10, 10), square.Bounds())
+		assert.True(t, isRed(square.At(0, 5)))
+		assert.True(t, isRed(square.At(9, 5)))
+	})
+
+	t.Run("should turn the image upright as its EXIF orientation asks", func(t *testing.T) {
+		img, err := imaging.Decode(withOrientation(encodeJPEG(t, halves(32, 32)), 6))
+		require.NoError(t, err)
+
+		square := img.Square(16)
+
+		// Rotated 90° clockwise, the red top half ends up on the right.
+		assert.True(t, isBlue(square.At(2, 8)))
+		assert.True(t, isRed(square.At(13, 8)))
+	})
+
+	t.Run("should not write the EXIF metadata back", func(t *testing.T) {
+		img, err := imaging.Decode(withOrientation(encodeJPEG(t, halves(32, 32)), 6))
+		require.NoError(t, err)
+
+		data, err := imaging.EncodeJPEG(img.Square(16))
+
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "Exif")
+	})
+}
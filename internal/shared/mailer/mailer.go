@@ -0,0 +1,28 @@
+package mailer
+
+import (
+	"log"
+	"trilha-api/internal/shared/config"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// NewFromConfig returns an SMTP mailer when SMTP_HOST is configured and falls
+// back to an in-memory mailer otherwise, which keeps local development free
+// of an SMTP server.
+func NewFromConfig(cfg config.MailConfig) Mailer {
+	if cfg.SMTPHost == "" {
+		log.Println("SMTP_HOST não configurado, emails serão mantidos em memória")
+		return NewMemoryMailer()
+	}
+
+	return NewSMTPMailer(cfg)
+}
@@ -0,0 +1,23 @@
+package mailer_test
+
+import (
+	"testing"
+	"trilha-api/internal/shared/mailer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryMailer(t *testing.T) {
+	m := mailer.NewMemoryMailer()
+
+	_, ok := m.Last()
+	assert.False(t, ok)
+
+	assert.NoError(t, m.Send(mailer.Message{To: "frodo@lor.com.br", Subject: "first"}))
+	assert.NoError(t, m.Send(mailer.Message{To: "sam@lor.com.br", Subject: "second"}))
+
+	last, ok := m.Last()
+	assert.True(t, ok)
+	assert.Equal(t, "second", last.Subject)
+	assert.Len(t, m.Messages(), 2)
+}
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"trilha-api/internal/shared/auth"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Authenticate resolves the bearer token of the request, when present, into
+// the current principal. Requests without a token are let through so that
+// public routes keep working; protected routes must add RequireAuth.
+//
+// Both access tokens and personal access tokens are accepted. Personal access
+// tokens are limited by their scopes: read for safe methods, write otherwise.
+func Authenticate(tokens auth.TokenManager, pats auth.PersonalAccessTokenAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			abortUnauthorized(c, "Invalid authorization header")
+			return
+		}
+
+		var principal *auth.Principal
+		var err error
+
+		if auth.IsPersonalAccessToken(token) {
+			principal, err = pats.AuthenticatePersonalAccessToken(token)
+		} else {
+			principal, err = tokens.ParseAccessToken(token)
+		}
+
+		if err != nil {
+			if errors.Is(err, auth.ErrInvalidToken) {
+				abortUnauthorized(c, "Invalid or expired token")
+				return
+			}
+
+			c.AbortWithStatusJSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+				Status:  http.StatusInternalServerError,
+				Message: "Internal server error",
+			})
+			return
+		}
+
+		if !principal.HasScope(auth.ScopeForMethod(c.Request.Method)) {
+			c.AbortWithStatusJSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+				Status:  http.StatusForbidden,
+				Message: "Token scope does not allow this request",
+			})
+			return
+		}
+
+		auth.SetPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := auth.CurrentPrincipal(c); !ok {
+			abortUnauthorized(c, "Authentication required")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireVerified restricts a route to accounts that confirmed their email.
+func RequireVerified() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.CurrentPrincipal(c)
+		if !ok {
+			abortUnauthorized(c, "Authentication required")
+			return
+		}
+
+		if !principal.Verified {
+			c.AbortWithStatusJSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+				Status:  http.StatusForbidden,
+				Message: "Email verification required",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireSession restricts a route to principals signed in interactively,
+// keeping credentials and personal access tokens out of reach of scripts.
+func RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.CurrentPrincipal(c)
+		if !ok {
+			abortUnauthorized(c, "Authentication required")
+			return
+		}
+
+		if principal.IsPersonalAccessToken() {
+			c.AbortWithStatusJSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+				Status:  http.StatusForbidden,
+				Message: "This endpoint cannot be used with a personal access token",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func abortUnauthorized(c *gin.Context, message string) {
+	c.Header("WWW-Authenticate", `Bearer realm="trilha-api"`)
+	c.AbortWithStatusJSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+		Status:  http.StatusUnauthorized,
+		Message: message,
+	})
+}
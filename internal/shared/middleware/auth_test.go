@@ -0,0 +1,164 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePersonalAccessTokens authenticates the personal access tokens it holds.
+type fakePersonalAccessTokens map[string]*auth.Principal
+
+func (f fakePersonalAccessTokens) AuthenticatePersonalAccessToken(token string) (*auth.Principal, error) {
+	principal, ok := f[token]
+	if !ok {
+		return nil, auth.ErrInvalidToken
+	}
+	return principal, nil
+}
+
+func setup() (*gin.Engine, *auth.JWTManager, fakePersonalAccessTokens) {
+	gin.SetMode(gin.TestMode)
+
+	tokens := auth.NewJWTManager(config.AuthConfig{
+		JWTSecret:      "test-secret",
+		JWTIssuer:      "trilha-api",
+		AccessTokenTTL: time.Minute,
+	})
+
+	pats := fakePersonalAccessTokens{}
+
+	router := gin.New()
+	apiGroup := router.Group("/api/v1", middleware.Authenticate(tokens, pats))
+
+	apiGroup.GET("/public", func(c *gin.Context) {
+		_, ok := auth.CurrentPrincipal(c)
+		c.JSON(http.StatusOK, gin.H{"authenticated": ok})
+	})
+	apiGroup.GET("/protected", middleware.RequireAuth(), func(c *gin.Context) {
+		principal, _ := auth.CurrentPrincipal(c)
+		if fromContext, _ := auth.PrincipalFromContext(c.Request.Context()); fromContext != principal {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.String(http.StatusOK, principal.AccountID.String())
+	})
+	apiGroup.POST("/protected", middleware.RequireAuth(), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+	apiGroup.GET("/session", middleware.RequireSession(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return router, tokens, pats
+}
+
+func request(router *gin.Engine, path, authorization string) *httptest.ResponseRecorder {
+	return send(router, http.MethodGet, path, authorization)
+}
+
+func send(router *gin.Engine, method, path, authorization string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuthenticate(t *testing.T) {
+	router, tokens, _ := setup()
+
+	accountID := uuid.New()
+	token, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: accountID})
+
+	t.Run("should let anonymous requests reach public routes", func(t *testing.T) {
+		w := request(router, "/api/v1/public", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"authenticated":false}`, w.Body.String())
+	})
+
+	t.Run("should reject anonymous requests to protected routes", func(t *testing.T) {
+		w := request(router, "/api/v1/protected", "")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should expose the principal of a valid bearer token", func(t *testing.T) {
+		w := request(router, "/api/v1/protected", "Bearer "+token)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, accountID.String(), w.Body.String())
+	})
+
+	t.Run("should reject an invalid bearer token even on public routes", func(t *testing.T) {
+		w := request(router, "/api/v1/public", "Bearer invalid")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should reject a malformed authorization header", func(t *testing.T) {
+		w := request(router, "/api/v1/protected", "Basic abc")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestRequireVerified(t *testing.T) {
+	router, tokens, pats := setup()
+	router.GET("/api/v1/verified", middleware.Authenticate(tokens, pats), middleware.RequireVerified(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	verifiedToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: uuid.New(), Verified: true})
+	unverifiedToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: uuid.New()})
+
+	assert.Equal(t, http.StatusOK, request(router, "/api/v1/verified", "Bearer "+verifiedToken).Code)
+	assert.Equal(t, http.StatusForbidden, request(router, "/api/v1/verified", "Bearer "+unverifiedToken).Code)
+}
+
+func TestAuthenticate_PersonalAccessToken(t *testing.T) {
+	router, tokens, pats := setup()
+
+	accountID := uuid.New()
+	readToken := auth.PersonalAccessTokenPrefix + "read"
+	writeToken := auth.PersonalAccessTokenPrefix + "write"
+
+	pats[readToken] = &auth.Principal{AccountID: accountID, PersonalAccessTokenID: uuid.New(), Scopes: []string{auth.ScopeRead}}
+	pats[writeToken] = &auth.Principal{AccountID: accountID, PersonalAccessTokenID: uuid.New(), Scopes: []string{auth.ScopeRead, auth.ScopeWrite}}
+
+	t.Run("should expose the principal of a valid personal access token", func(t *testing.T) {
+		w := request(router, "/api/v1/protected", "Bearer "+readToken)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, accountID.String(), w.Body.String())
+	})
+
+	t.Run("should reject an unknown personal access token", func(t *testing.T) {
+		w := request(router, "/api/v1/protected", "Bearer "+auth.PersonalAccessTokenPrefix+"unknown")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should require the write scope for unsafe methods", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, send(router, http.MethodPost, "/api/v1/protected", "Bearer "+readToken).Code)
+		assert.Equal(t, http.StatusCreated, send(router, http.MethodPost, "/api/v1/protected", "Bearer "+writeToken).Code)
+	})
+
+	t.Run("should keep personal access tokens out of session-only routes", func(t *testing.T) {
+		sessionToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: accountID})
+
+		assert.Equal(t, http.StatusForbidden, request(router, "/api/v1/session", "Bearer "+writeToken).Code)
+		assert.Equal(t, http.StatusOK, request(router, "/api/v1/session", "Bearer "+sessionToken).Code)
+	})
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/authz"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ResourceResolver returns the resource a request acts on.
+type ResourceResolver func(c *gin.Context) (authz.Resource, error)
+
+func SystemResource() ResourceResolver {
+	return func(c *gin.Context) (authz.Resource, error) {
+		return authz.System(), nil
+	}
+}
+
+// ResourceFromParam reads the ID of the target resource from a path param.
+func ResourceFromParam(resourceType, param string) ResourceResolver {
+	return func(c *gin.Context) (authz.Resource, error) {
+		id, err := uuid.Parse(c.Param(param))
+		if err != nil {
+			return authz.Resource{}, err
+		}
+		return authz.NewResource(resourceType, id), nil
+	}
+}
+
+// NestedResource resolves the resource of child within the resource of
+// parent, such as a project within the workspace in its path, so the roles
+// granted on the parent apply to it too.
+func NestedResource(parent, child ResourceResolver) ResourceResolver {
+	return func(c *gin.Context) (authz.Resource, error) {
+		outer, err := parent(c)
+		if err != nil {
+			return authz.Resource{}, err
+		}
+
+		inner, err := child(c)
+		if err != nil {
+			return authz.Resource{}, err
+		}
+
+		return inner.Within(outer), nil
+	}
+}
+
+// RequirePermission lets the request through only when the policy grants
+// permission on the resource returned by resolve.
+func RequirePermission(policy *authz.Policy, permission authz.Permission, resolve ResourceResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.CurrentPrincipal(c)
+		if !ok {
+			abortUnauthorized(c, "Authentication required")
+			return
+		}
+
+		resource, err := resolve(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+				Status:  http.StatusBadRequest,
+				Message: "Invalid resource ID",
+			})
+			return
+		}
+
+		if err := policy.Authorize(principal, permission, resource); err != nil {
+			if errors.Is(err, authz.ErrForbidden) {
+				c.AbortWithStatusJSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+					Status:  http.StatusForbidden,
+					Message: "You do not have permission to perform this action",
+				})
+				return
+			}
+
+			c.AbortWithStatusJSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+				Status:  http.StatusInternalServerError,
+				Message: "Internal server error",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,138 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/authz"
+	"trilha-api/internal/shared/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePermissionChecker grants permissions on the resources listed per account.
+type fakePermissionChecker struct {
+	grants map[uuid.UUID][]authz.Resource
+	err    error
+}
+
+func (f *fakePermissionChecker) HasPermission(accountID uuid.UUID, permission authz.Permission, resource authz.Resource) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	for _, granted := range f.grants[accountID] {
+		if granted.IsSystem() || (granted.Type == resource.Type && granted.ID == resource.ID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestRequirePermission(t *testing.T) {
+	router, tokens, pats := setup()
+
+	checker := &fakePermissionChecker{grants: map[uuid.UUID][]authz.Resource{}}
+	policy := authz.NewPolicy(checker)
+
+	router.GET("/api/v1/accounts/:id",
+		middleware.Authenticate(tokens, pats),
+		middleware.RequirePermission(policy, authz.PermissionAccountsRestore, middleware.ResourceFromParam("account", "id")),
+		func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		},
+	)
+
+	adminID := uuid.New()
+	ownerID := uuid.New()
+	targetID := uuid.New()
+
+	checker.grants[adminID] = []authz.Resource{authz.System()}
+	checker.grants[ownerID] = []authz.Resource{authz.NewResource("account", targetID)}
+
+	adminToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: adminID})
+	ownerToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: ownerID})
+	memberToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: uuid.New()})
+
+	path := "/api/v1/accounts/" + targetID.String()
+
+	t.Run("should allow a system role on any resource", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request(router, path, "Bearer "+adminToken).Code)
+	})
+
+	t.Run("should allow a role granted on the target resource only", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request(router, path, "Bearer "+ownerToken).Code)
+		assert.Equal(t, http.StatusForbidden, request(router, "/api/v1/accounts/"+uuid.NewString(), "Bearer "+ownerToken).Code)
+	})
+
+	t.Run("should return 403 in the standard envelope", func(t *testing.T) {
+		w := request(router, path, "Bearer "+memberToken)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.JSONEq(t, `{"status":403,"message":"You do not have permission to perform this action"}`, w.Body.String())
+	})
+
+	t.Run("should require authentication", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request(router, path, "").Code)
+	})
+
+	t.Run("should reject an invalid resource ID", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, request(router, "/api/v1/accounts/invalid", "Bearer "+adminToken).Code)
+	})
+
+	t.Run("should return 500 when the permission lookup fails", func(t *testing.T) {
+		checker.err = errors.New("db down")
+		defer func() { checker.err = nil }()
+
+		assert.Equal(t, http.StatusInternalServerError, request(router, path, "Bearer "+adminToken).Code)
+	})
+}
+
+func TestRequirePermission_NestedResource(t *testing.T) {
+	router, tokens, pats := setup()
+
+	checker := &fakePermissionChecker{grants: map[uuid.UUID][]authz.Resource{}}
+	policy := authz.NewPolicy(checker)
+
+	router.GET("/api/v1/workspaces/:ws/projects/:id",
+		middleware.Authenticate(tokens, pats),
+		middleware.RequirePermission(policy, authz.PermissionProjectsWrite, middleware.NestedResource(
+			middleware.ResourceFromParam("workspace", "ws"),
+			middleware.ResourceFromParam("project", "id"),
+		)),
+		func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		},
+	)
+
+	workspaceID := uuid.New()
+	projectID := uuid.New()
+	memberID := uuid.New()
+	teamMemberID := uuid.New()
+
+	checker.grants[memberID] = []authz.Resource{authz.NewResource("workspace", workspaceID)}
+	checker.grants[teamMemberID] = []authz.Resource{authz.NewResource("project", projectID)}
+
+	memberToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: memberID})
+	teamMemberToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: teamMemberID})
+
+	path := "/api/v1/workspaces/" + workspaceID.String() + "/projects/" + projectID.String()
+
+	t.Run("should allow a role granted on the parent resource", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request(router, path, "Bearer "+memberToken).Code)
+	})
+
+	t.Run("should allow a role granted on the nested resource only", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request(router, path, "Bearer "+teamMemberToken).Code)
+
+		other := "/api/v1/workspaces/" + workspaceID.String() + "/projects/" + uuid.NewString()
+		assert.Equal(t, http.StatusForbidden, request(router, other, "Bearer "+teamMemberToken).Code)
+	})
+
+	t.Run("should reject an invalid nested resource ID", func(t *testing.T) {
+		invalid := "/api/v1/workspaces/" + workspaceID.String() + "/projects/invalid"
+		assert.Equal(t, http.StatusBadRequest, request(router, invalid, "Bearer "+memberToken).Code)
+	})
+}
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"trilha-api/internal/shared/auth"
+	sharedDto "trilha-api/internal/shared/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TrackImpersonation checks that the impersonation behind the request, if
+// any, is still running, and records the request in its audit trail once it
+// is answered, refused ones included. While readOnly is set, requests that
+// change data are refused during impersonations.
+func TrackImpersonation(tracker auth.ImpersonationTracker, readOnly bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.CurrentPrincipal(c)
+		if !ok || !principal.IsImpersonated() {
+			c.Next()
+			return
+		}
+
+		if err := tracker.VerifyImpersonation(principal); err != nil {
+			if errors.Is(err, auth.ErrInvalidToken) {
+				abortUnauthorized(c, "Impersonation has ended")
+				return
+			}
+
+			c.AbortWithStatusJSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+				Status:  http.StatusInternalServerError,
+				Message: "Internal server error",
+			})
+			return
+		}
+
+		defer recordImpersonatedRequest(c, tracker, principal)
+
+		if readOnly && auth.ScopeForMethod(c.Request.Method) == auth.ScopeWrite {
+			abortImpersonating(c, "Changes are not allowed while impersonating")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// ForbidImpersonation keeps a route out of reach during impersonations, for
+// routes that manage credentials or administer the platform.
+func ForbidImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if principal, ok := auth.CurrentPrincipal(c); ok && principal.IsImpersonated() {
+			abortImpersonating(c, "This endpoint cannot be used while impersonating")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// recordImpersonatedRequest adds the answered request to the audit trail.
+// Failures are only logged, as the response is already written.
+func recordImpersonatedRequest(c *gin.Context, tracker auth.ImpersonationTracker, principal *auth.Principal) {
+	err := tracker.RecordImpersonatedRequest(auth.ImpersonatedRequest{
+		ImpersonationID: principal.ImpersonationID,
+		ActorID:         principal.ImpersonatorID,
+		AccountID:       principal.AccountID,
+		Method:          c.Request.Method,
+		Path:            c.Request.URL.Path,
+		Status:          c.Writer.Status(),
+		IPAddress:       c.ClientIP(),
+		UserAgent:       c.Request.UserAgent(),
+	})
+	if err != nil {
+		log.Printf("Erro ao registrar requisição da personificação %s: %v", principal.ImpersonationID, err)
+	}
+}
+
+func abortImpersonating(c *gin.Context, message string) {
+	c.AbortWithStatusJSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+		Status:  http.StatusForbidden,
+		Message: message,
+	})
+}
@@ -0,0 +1,139 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeImpersonations tracks the impersonations it holds as running and keeps
+// the requests recorded for them.
+type fakeImpersonations struct {
+	running  map[uuid.UUID]bool
+	requests []auth.ImpersonatedRequest
+}
+
+func (f *fakeImpersonations) VerifyImpersonation(principal *auth.Principal) error {
+	if !f.running[principal.ImpersonationID] {
+		return auth.ErrInvalidToken
+	}
+	return nil
+}
+
+func (f *fakeImpersonations) RecordImpersonatedRequest(request auth.ImpersonatedRequest) error {
+	f.requests = append(f.requests, request)
+	return nil
+}
+
+func setupImpersonation(readOnly bool) (*gin.Engine, *auth.JWTManager, *fakeImpersonations) {
+	gin.SetMode(gin.TestMode)
+
+	tokens := auth.NewJWTManager(config.AuthConfig{
+		JWTSecret:      "test-secret",
+		JWTIssuer:      "trilha-api",
+		AccessTokenTTL: time.Minute,
+	})
+
+	impersonations := &fakeImpersonations{running: map[uuid.UUID]bool{}}
+
+	router := gin.New()
+	apiGroup := router.Group("/api/v1",
+		middleware.Authenticate(tokens, fakePersonalAccessTokens{}),
+		middleware.TrackImpersonation(impersonations, readOnly),
+	)
+
+	apiGroup.GET("/projects", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	apiGroup.POST("/projects", func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+	apiGroup.PUT("/me/password", middleware.ForbidImpersonation(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return router, tokens, impersonations
+}
+
+func impersonationToken(t *testing.T, tokens *auth.JWTManager, principal auth.Principal) string {
+	token, err := tokens.GenerateImpersonationToken(principal, time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	return token
+}
+
+func TestTrackImpersonation(t *testing.T) {
+	principal := auth.Principal{AccountID: uuid.New(), ImpersonatorID: uuid.New(), ImpersonationID: uuid.New()}
+
+	t.Run("should record the request with the real actor", func(t *testing.T) {
+		router, tokens, impersonations := setupImpersonation(true)
+		impersonations.running[principal.ImpersonationID] = true
+
+		w := request(router, "/api/v1/projects", "Bearer "+impersonationToken(t, tokens, principal))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, impersonations.requests, 1)
+		recorded := impersonations.requests[0]
+		assert.Equal(t, principal.ImpersonationID, recorded.ImpersonationID)
+		assert.Equal(t, principal.ImpersonatorID, recorded.ActorID)
+		assert.Equal(t, principal.AccountID, recorded.AccountID)
+		assert.Equal(t, http.MethodGet, recorded.Method)
+		assert.Equal(t, "/api/v1/projects", recorded.Path)
+		assert.Equal(t, http.StatusOK, recorded.Status)
+	})
+
+	t.Run("should refuse and record changes while read-only", func(t *testing.T) {
+		router, tokens, impersonations := setupImpersonation(true)
+		impersonations.running[principal.ImpersonationID] = true
+
+		w := send(router, http.MethodPost, "/api/v1/projects", "Bearer "+impersonationToken(t, tokens, principal))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Len(t, impersonations.requests, 1)
+		assert.Equal(t, http.StatusForbidden, impersonations.requests[0].Status)
+	})
+
+	t.Run("should allow changes when not read-only", func(t *testing.T) {
+		router, tokens, impersonations := setupImpersonation(false)
+		impersonations.running[principal.ImpersonationID] = true
+
+		w := send(router, http.MethodPost, "/api/v1/projects", "Bearer "+impersonationToken(t, tokens, principal))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should reject an impersonation that has ended", func(t *testing.T) {
+		router, tokens, impersonations := setupImpersonation(true)
+
+		w := request(router, "/api/v1/projects", "Bearer "+impersonationToken(t, tokens, principal))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Empty(t, impersonations.requests)
+	})
+
+	t.Run("should leave regular requests alone", func(t *testing.T) {
+		router, tokens, impersonations := setupImpersonation(true)
+		token, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: uuid.New()})
+
+		w := send(router, http.MethodPost, "/api/v1/projects", "Bearer "+token)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Empty(t, impersonations.requests)
+	})
+}
+
+func TestForbidImpersonation(t *testing.T) {
+	router, tokens, impersonations := setupImpersonation(false)
+	principal := auth.Principal{AccountID: uuid.New(), ImpersonatorID: uuid.New(), ImpersonationID: uuid.New()}
+	impersonations.running[principal.ImpersonationID] = true
+	sessionToken, _, _ := tokens.GenerateAccessToken(auth.Principal{AccountID: principal.AccountID})
+
+	assert.Equal(t, http.StatusForbidden, send(router, http.MethodPut, "/api/v1/me/password", "Bearer "+impersonationToken(t, tokens, principal)).Code)
+	assert.Equal(t, http.StatusOK, send(router, http.MethodPut, "/api/v1/me/password", "Bearer "+sessionToken).Code)
+}
@@ -0,0 +1,149 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+	"trilha-api/internal/shared/config"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+const requestTimeout = 10 * time.Second
+
+var ErrInvalidIDToken = errors.New("invalid id token")
+
+// Identity is the end-user authenticated by a provider, as described by the
+// claims of its ID token.
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type Provider interface {
+	// AuthCodeURL returns the authorization URL the user is sent to, bound to
+	// state, nonce and the PKCE challenge derived from verifier.
+	AuthCodeURL(state, nonce, verifier string) (string, error)
+	// Exchange redeems the authorization code and returns the identity of
+	// its ID token, once its signature, audience and nonce are checked.
+	Exchange(code, verifier, nonce string) (*Identity, error)
+}
+
+// Providers holds the configured providers by name.
+type Providers map[string]Provider
+
+func NewFromConfig(cfg config.OIDCConfig) Providers {
+	providers := Providers{}
+	for _, provider := range cfg.Providers {
+		providers[provider.Name] = NewProvider(provider)
+	}
+	return providers
+}
+
+// DiscoveryProvider talks to a provider found through OpenID Connect
+// discovery. Discovery happens on first use, so an unreachable provider does
+// not keep the server from starting.
+type DiscoveryProvider struct {
+	cfg    config.OIDCProviderConfig
+	client *http.Client
+
+	mu       sync.Mutex
+	oauth    *oauth2.Config
+	verifier *gooidc.IDTokenVerifier
+}
+
+func NewProvider(cfg config.OIDCProviderConfig) *DiscoveryProvider {
+	return &DiscoveryProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+func (p *DiscoveryProvider) AuthCodeURL(state, nonce, verifier string) (string, error) {
+	oauth, _, err := p.discover()
+	if err != nil {
+		return "", err
+	}
+
+	return oauth.AuthCodeURL(state, gooidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), nil
+}
+
+func (p *DiscoveryProvider) Exchange(code, verifier, nonce string) (*Identity, error) {
+	oauth, idTokenVerifier, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := p.context()
+
+	token, err := oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing from token response", ErrInvalidIDToken)
+	}
+
+	idToken, err := idTokenVerifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	if idToken.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	return &Identity{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+func (p *DiscoveryProvider) discover() (*oauth2.Config, *gooidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth != nil {
+		return p.oauth, p.verifier, nil
+	}
+
+	provider, err := gooidc.NewProvider(p.context(), p.cfg.IssuerURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao descobrir o provedor OIDC %s: %w", p.cfg.Name, err)
+	}
+
+	p.oauth = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       p.cfg.Scopes,
+	}
+	p.verifier = provider.Verifier(&gooidc.Config{ClientID: p.cfg.ClientID})
+
+	return p.oauth, p.verifier, nil
+}
+
+func (p *DiscoveryProvider) context() context.Context {
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, p.client)
+	return gooidc.ClientContext(ctx, p.client)
+}
@@ -0,0 +1,269 @@
+package oidc_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/oidc"
+	"trilha-api/internal/shared/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockServer is a minimal OpenID Connect provider: it serves discovery and
+// JWKS, authorizes every request at once and redeems codes with PKCE.
+type mockServer struct {
+	*httptest.Server
+	key     *rsa.PrivateKey
+	subject string
+
+	// signingKey and audience, when set, replace the ones of issued ID
+	// tokens, to exercise validation failures.
+	signingKey *rsa.PrivateKey
+	audience   string
+
+	mu    sync.Mutex
+	codes map[string]authorization
+}
+
+type authorization struct {
+	challenge string
+	nonce     string
+	clientID  string
+}
+
+func newMockServer(t *testing.T) *mockServer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	m := &mockServer{key: key, subject: "user-123", codes: map[string]authorization{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", m.discovery)
+	mux.HandleFunc("/jwks", m.jwks)
+	mux.HandleFunc("/authorize", m.authorize)
+	mux.HandleFunc("/token", m.token)
+
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+func (m *mockServer) discovery(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                m.URL,
+		"authorization_endpoint":                m.URL + "/authorize",
+		"token_endpoint":                        m.URL + "/token",
+		"jwks_uri":                              m.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (m *mockServer) jwks(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (m *mockServer) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "pkce required", http.StatusBadRequest)
+		return
+	}
+
+	code, _ := utils.GenerateRandomToken(16)
+
+	m.mu.Lock()
+	m.codes[code] = authorization{
+		challenge: query.Get("code_challenge"),
+		nonce:     query.Get("nonce"),
+		clientID:  query.Get("client_id"),
+	}
+	m.mu.Unlock()
+
+	redirect, _ := url.Parse(query.Get("redirect_uri"))
+	redirect.RawQuery = url.Values{"code": {code}, "state": {query.Get("state")}}.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (m *mockServer) token(w http.ResponseWriter, r *http.Request) {
+	_ = r.ParseForm()
+
+	m.mu.Lock()
+	auth, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != auth.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	audience := auth.clientID
+	if m.audience != "" {
+		audience = m.audience
+	}
+	signingKey := m.key
+	if m.signingKey != nil {
+		signingKey = m.signingKey
+	}
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            m.URL,
+		"sub":            m.subject,
+		"aud":            audience,
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          auth.nonce,
+		"email":          "gandalf@lor.com.br",
+		"email_verified": true,
+		"name":           "Gandalf",
+	})
+	idToken.Header["kid"] = "test"
+
+	signed, _ := idToken.SignedString(signingKey)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     signed,
+	})
+}
+
+// login follows the authorization URL and returns the code sent back.
+func login(t *testing.T, authURL string) string {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+
+	res, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	location, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+
+	return location.Query().Get("code")
+}
+
+func newProvider(server *mockServer) *oidc.DiscoveryProvider {
+	return oidc.NewProvider(config.OIDCProviderConfig{
+		Name:        "mock",
+		IssuerURL:   server.URL,
+		ClientID:    "trilha-api",
+		RedirectURL: "http://localhost:8080/api/v1/accounts/oidc/mock/callback",
+		Scopes:      []string{"openid", "email", "profile"},
+	})
+}
+
+func TestDiscoveryProvider(t *testing.T) {
+	verifier := "verifier-0123456789-0123456789-0123456789"
+
+	t.Run("should return the identity of a valid ID token", func(t *testing.T) {
+		server := newMockServer(t)
+		provider := newProvider(server)
+
+		authURL, err := provider.AuthCodeURL("state", "nonce", verifier)
+		require.NoError(t, err)
+
+		parsed, _ := url.Parse(authURL)
+		assert.Equal(t, "state", parsed.Query().Get("state"))
+		assert.Equal(t, "nonce", parsed.Query().Get("nonce"))
+		assert.Equal(t, "S256", parsed.Query().Get("code_challenge_method"))
+
+		identity, err := provider.Exchange(login(t, authURL), verifier, "nonce")
+
+		require.NoError(t, err)
+		assert.Equal(t, &oidc.Identity{
+			Subject:       "user-123",
+			Email:         "gandalf@lor.com.br",
+			EmailVerified: true,
+			Name:          "Gandalf",
+		}, identity)
+	})
+
+	t.Run("should reject a code redeemed with another verifier", func(t *testing.T) {
+		server := newMockServer(t)
+		provider := newProvider(server)
+
+		authURL, _ := provider.AuthCodeURL("state", "nonce", verifier)
+
+		_, err := provider.Exchange(login(t, authURL), "another-verifier-0123456789-0123456789", "nonce")
+
+		assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+	})
+
+	t.Run("should reject an ID token with another nonce", func(t *testing.T) {
+		server := newMockServer(t)
+		provider := newProvider(server)
+
+		authURL, _ := provider.AuthCodeURL("state", "nonce", verifier)
+
+		_, err := provider.Exchange(login(t, authURL), verifier, "another-nonce")
+
+		assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+	})
+
+	t.Run("should reject an ID token not signed by the provider keys", func(t *testing.T) {
+		server := newMockServer(t)
+		server.signingKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+		provider := newProvider(server)
+
+		authURL, _ := provider.AuthCodeURL("state", "nonce", verifier)
+
+		_, err := provider.Exchange(login(t, authURL), verifier, "nonce")
+
+		assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+	})
+
+	t.Run("should reject an ID token issued to another client", func(t *testing.T) {
+		server := newMockServer(t)
+		server.audience = "another-client"
+		provider := newProvider(server)
+
+		authURL, _ := provider.AuthCodeURL("state", "nonce", verifier)
+
+		_, err := provider.Exchange(login(t, authURL), verifier, "nonce")
+
+		assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+	})
+
+	t.Run("should fail when the provider cannot be discovered", func(t *testing.T) {
+		server := newMockServer(t)
+		provider := newProvider(server)
+		server.Close()
+
+		_, err := provider.AuthCodeURL("state", "nonce", verifier)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,43 @@
+package password
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Bcrypt verifies the bcrypt hashes stored before Argon2id. It can still
+// hash, but its hashes always need a rehash.
+type Bcrypt struct {
+	cost int
+}
+
+func NewBcrypt() *Bcrypt {
+	return &Bcrypt{cost: bcrypt.DefaultCost}
+}
+
+func (b *Bcrypt) Hash(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), b.cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hashed), nil
+}
+
+func (b *Bcrypt) Compare(encoded, password string) error {
+	if _, err := bcrypt.Cost([]byte(encoded)); err != nil {
+		return ErrUnknownHash
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrMismatch
+	}
+
+	return err
+}
+
+func (b *Bcrypt) NeedsRehash(string) bool {
+	return true
+}
@@ -0,0 +1,93 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+// breachedPrefixSize is the length of the hash prefixes the list is indexed
+// by, as in the range queries of Have I Been Pwned.
+const breachedPrefixSize = 5
+
+// BreachedList is a set of breached passwords, known only by the SHA-1 hash.
+// Hashes are grouped by their first five hex digits, so a lookup only ever
+// handles the range of its prefix and never the password itself.
+type BreachedList struct {
+	ranges map[string][]string
+}
+
+// LoadBreachedList reads a list from the file at path.
+func LoadBreachedList(path string) (*BreachedList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir a lista de senhas vazadas: %w", err)
+	}
+	defer file.Close()
+
+	return ReadBreachedList(file)
+}
+
+// ReadBreachedList reads a list with a SHA-1 hash per line, in hex and
+// optionally followed by ":count", as in the downloads of Have I Been Pwned.
+// Blank lines and lines starting with # are ignored.
+func ReadBreachedList(r io.Reader) (*BreachedList, error) {
+	list := &BreachedList{ranges: map[string][]string{}}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(entry, ":")
+		hash = strings.ToUpper(hash)
+
+		if _, err := hex.DecodeString(hash); err != nil || len(hash) != sha1.Size*2 {
+			return nil, fmt.Errorf("hash inválido na linha %d da lista de senhas vazadas", line)
+		}
+
+		prefix := hash[:breachedPrefixSize]
+		list.ranges[prefix] = append(list.ranges[prefix], hash[breachedPrefixSize:])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("erro ao ler a lista de senhas vazadas: %w", err)
+	}
+
+	for prefix, suffixes := range list.ranges {
+		slices.Sort(suffixes)
+		list.ranges[prefix] = slices.Compact(suffixes)
+	}
+
+	return list, nil
+}
+
+// Range returns the sorted hash suffixes of the breached passwords whose
+// hash starts with prefix.
+func (l *BreachedList) Range(prefix string) []string {
+	return l.ranges[strings.ToUpper(prefix)]
+}
+
+// Contains reports whether password is in the list.
+func (l *BreachedList) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	_, found := slices.BinarySearch(l.Range(hash[:breachedPrefixSize]), hash[breachedPrefixSize:])
+	return found
+}
+
+// Len returns the number of passwords in the list.
+func (l *BreachedList) Len() int {
+	total := 0
+	for _, suffixes := range l.ranges {
+		total += len(suffixes)
+	}
+	return total
+}
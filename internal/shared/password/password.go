@@ -0,0 +1,76 @@
+package password
+
+import (
+	"errors"
+	"trilha-api/internal/shared/config"
+)
+
+var (
+	// ErrMismatch is returned when a password does not match its hash.
+	ErrMismatch = errors.New("password does not match")
+	// ErrUnknownHash is returned for hashes of another algorithm, or for no
+	// hash at all, as for accounts without a password.
+	ErrUnknownHash = errors.New("unknown password hash")
+)
+
+// Hasher hashes passwords into self-describing strings, which carry the
+// algorithm and parameters needed to verify them later.
+type Hasher interface {
+	Hash(password string) (string, error)
+	// Compare returns nil when password matches encoded, ErrMismatch when
+	// it does not and ErrUnknownHash when encoded is not a hash of this
+	// hasher.
+	Compare(encoded, password string) error
+	// NeedsRehash reports whether encoded should be replaced by a new hash,
+	// being of another algorithm or weaker parameters.
+	NeedsRehash(encoded string) bool
+}
+
+// Chain hashes with its current hasher and verifies hashes of the current
+// or any legacy hasher. Every legacy hash needs a rehash.
+type Chain struct {
+	current Hasher
+	legacy  []Hasher
+}
+
+func NewChain(current Hasher, legacy ...Hasher) *Chain {
+	return &Chain{current: current, legacy: legacy}
+}
+
+// NewFromConfig returns the hasher of the application: Argon2id with the
+// configured parameters, still verifying the bcrypt hashes of older
+// accounts.
+func NewFromConfig(cfg config.PasswordConfig) Hasher {
+	return NewChain(
+		NewArgon2id(Argon2idParams{
+			Memory:      cfg.Argon2Memory,
+			Iterations:  cfg.Argon2Iterations,
+			Parallelism: cfg.Argon2Parallelism,
+		}),
+		NewBcrypt(),
+	)
+}
+
+func (c *Chain) Hash(password string) (string, error) {
+	return c.current.Hash(password)
+}
+
+func (c *Chain) Compare(encoded, password string) error {
+	err := c.current.Compare(encoded, password)
+	if !errors.Is(err, ErrUnknownHash) {
+		return err
+	}
+
+	for _, hasher := range c.legacy {
+		err := hasher.Compare(encoded, password)
+		if !errors.Is(err, ErrUnknownHash) {
+			return err
+		}
+	}
+
+	return ErrUnknownHash
+}
+
+func (c *Chain) NeedsRehash(encoded string) bool {
+	return c.current.NeedsRehash(encoded)
+}
@@ -0,0 +1,89 @@
+package password_test
+
+import (
+	"strings"
+	"testing"
+	"trilha-api/internal/shared/password"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var params = password.Argon2idParams{Memory: 64, Iterations: 2, Parallelism: 1}
+
+func TestArgon2id(t *testing.T) {
+	hasher := password.NewArgon2id(params)
+
+	t.Run("should encode the parameters in the hash", func(t *testing.T) {
+		hashed, err := hasher.Hash("password123")
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(hashed, "$argon2id$v=19$m=64,t=2,p=1$"))
+		assert.NoError(t, hasher.Compare(hashed, "password123"))
+		assert.ErrorIs(t, hasher.Compare(hashed, "wrong-password"), password.ErrMismatch)
+	})
+
+	t.Run("should salt every hash", func(t *testing.T) {
+		first, _ := hasher.Hash("password123")
+		second, _ := hasher.Hash("password123")
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("should verify hashes made with other parameters", func(t *testing.T) {
+		hashed, _ := password.NewArgon2id(password.Argon2idParams{Memory: 32, Iterations: 1, Parallelism: 1}).Hash("password123")
+
+		assert.NoError(t, hasher.Compare(hashed, "password123"))
+		assert.True(t, hasher.NeedsRehash(hashed))
+	})
+
+	t.Run("should not need a rehash with the same or stronger parameters", func(t *testing.T) {
+		same, _ := hasher.Hash("password123")
+		stronger, _ := password.NewArgon2id(password.Argon2idParams{Memory: 128, Iterations: 2, Parallelism: 1}).Hash("password123")
+
+		assert.False(t, hasher.NeedsRehash(same))
+		assert.False(t, hasher.NeedsRehash(stronger))
+	})
+
+	t.Run("should not recognize other hashes", func(t *testing.T) {
+		bcryptHash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+
+		for _, encoded := range []string{"", string(bcryptHash), "$argon2id$v=19$m=64,t=2,p=1$salt", "$argon2i$v=19$m=64,t=2,p=1$c2FsdA$a2V5"} {
+			assert.ErrorIs(t, hasher.Compare(encoded, "password123"), password.ErrUnknownHash, encoded)
+		}
+	})
+}
+
+func TestBcrypt(t *testing.T) {
+	hasher := password.NewBcrypt()
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+
+	assert.NoError(t, hasher.Compare(string(hashed), "password123"))
+	assert.ErrorIs(t, hasher.Compare(string(hashed), "wrong-password"), password.ErrMismatch)
+	assert.ErrorIs(t, hasher.Compare("", "password123"), password.ErrUnknownHash)
+	assert.True(t, hasher.NeedsRehash(string(hashed)))
+}
+
+func TestChain(t *testing.T) {
+	chain := password.NewChain(password.NewArgon2id(params), password.NewBcrypt())
+	bcryptHash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+
+	t.Run("should hash with the current hasher", func(t *testing.T) {
+		hashed, err := chain.Hash("password123")
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(hashed, "$argon2id$"))
+		assert.False(t, chain.NeedsRehash(hashed))
+	})
+
+	t.Run("should verify legacy hashes and ask for their rehash", func(t *testing.T) {
+		assert.NoError(t, chain.Compare(string(bcryptHash), "password123"))
+		assert.ErrorIs(t, chain.Compare(string(bcryptHash), "wrong-password"), password.ErrMismatch)
+		assert.True(t, chain.NeedsRehash(string(bcryptHash)))
+	})
+
+	t.Run("should reject an empty hash", func(t *testing.T) {
+		assert.ErrorIs(t, chain.Compare("", ""), password.ErrUnknownHash)
+	})
+}
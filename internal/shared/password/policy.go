@@ -0,0 +1,178 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"trilha-api/internal/shared/config"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Codes of the rules a password can break.
+const (
+	CodeTooShort      = "too_short"
+	CodeTooLong       = "too_long"
+	CodeMissingLower  = "missing_lower"
+	CodeMissingUpper  = "missing_upper"
+	CodeMissingDigit  = "missing_digit"
+	CodeMissingSymbol = "missing_symbol"
+	CodePersonalInfo  = "contains_personal_info"
+	CodeBreached      = "breached"
+)
+
+// minPersonalInfoSize is the length from which a word of the name or email
+// of the owner is not allowed in the password.
+const minPersonalInfoSize = 3
+
+// Violation is a rule of the policy broken by a password.
+type Violation struct {
+	Code    string
+	Message string
+}
+
+// PolicyError lists every rule of the policy broken by a password.
+type PolicyError struct {
+	Violations []Violation
+}
+
+func (e *PolicyError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.Message
+	}
+	return "password does not follow the policy: " + strings.Join(messages, "; ")
+}
+
+// characterClass is a kind of character a policy can require.
+type characterClass struct {
+	code    string
+	message string
+	matches func(rune) bool
+}
+
+var characterClasses = map[string]characterClass{
+	"lower":  {CodeMissingLower, "Password must contain a lowercase letter", unicode.IsLower},
+	"upper":  {CodeMissingUpper, "Password must contain an uppercase letter", unicode.IsUpper},
+	"digit":  {CodeMissingDigit, "Password must contain a digit", unicode.IsDigit},
+	"symbol": {CodeMissingSymbol, "Password must contain a symbol", isSymbol},
+}
+
+// Policy holds the rules new passwords must follow.
+type Policy struct {
+	minLength int
+	maxLength int
+	classes   []characterClass
+	breached  *BreachedList
+}
+
+// NewPolicy returns the policy of cfg. Passwords are only checked against
+// breaches with a breached list.
+func NewPolicy(cfg config.PasswordConfig, breached *BreachedList) *Policy {
+	policy := &Policy{
+		minLength: cfg.MinLength,
+		maxLength: cfg.MaxLength,
+		breached:  breached,
+	}
+
+	for _, name := range cfg.RequiredClasses {
+		if class, ok := characterClasses[name]; ok {
+			policy.classes = append(policy.classes, class)
+		}
+	}
+
+	return policy
+}
+
+// NewPolicyFromConfig returns the policy of the application, loading the
+// configured breached list.
+func NewPolicyFromConfig(cfg config.PasswordConfig) (*Policy, error) {
+	if cfg.BreachedList == "" {
+		return NewPolicy(cfg, nil), nil
+	}
+
+	breached, err := LoadBreachedList(cfg.BreachedList)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPolicy(cfg, breached), nil
+}
+
+// Check returns a PolicyError when password breaks any rule of the policy.
+// personal holds what is known about the owner, such as the name and email,
+// which the password must not contain.
+func (p *Policy) Check(password string, personal ...string) error {
+	var violations []Violation
+
+	length := utf8.RuneCountInString(password)
+	if length < p.minLength {
+		violations = append(violations, Violation{
+			Code:    CodeTooShort,
+			Message: fmt.Sprintf("Password must be at least %d characters long", p.minLength),
+		})
+	}
+	if p.maxLength > 0 && length > p.maxLength {
+		violations = append(violations, Violation{
+			Code:    CodeTooLong,
+			Message: fmt.Sprintf("Password must be at most %d characters long", p.maxLength),
+		})
+	}
+
+	for _, class := range p.classes {
+		if !strings.ContainsFunc(password, class.matches) {
+			violations = append(violations, Violation{Code: class.code, Message: class.message})
+		}
+	}
+
+	if containsPersonalInfo(password, personal) {
+		violations = append(violations, Violation{
+			Code:    CodePersonalInfo,
+			Message: "Password must not contain your name or email",
+		})
+	}
+
+	if p.breached != nil && p.breached.Contains(password) {
+		violations = append(violations, Violation{
+			Code:    CodeBreached,
+			Message: "Password has appeared in a data breach, choose another one",
+		})
+	}
+
+	if len(violations) > 0 {
+		return &PolicyError{Violations: violations}
+	}
+
+	return nil
+}
+
+// containsPersonalInfo reports whether password contains, ignoring case, any
+// word of personal. Emails only count by their local part, as domains are
+// shared by many. Words too short to be telling are ignored.
+func containsPersonalInfo(password string, personal []string) bool {
+	password = strings.ToLower(password)
+
+	for _, info := range personal {
+		info = strings.ToLower(info)
+
+		var words []string
+		if local, _, isEmail := strings.Cut(info, "@"); isEmail {
+			info = local
+			words = append(words, local)
+		}
+		words = append(words, strings.FieldsFunc(info, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})...)
+
+		for _, word := range words {
+			if utf8.RuneCountInString(word) >= minPersonalInfoSize && strings.Contains(password, word) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isSymbol(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+}
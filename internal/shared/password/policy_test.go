@@ -0,0 +1,105 @@
+package password_test
+
+import (
+	"strings"
+	"testing"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/password"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// "password" and "P@ssw0rd!" as SHA-1, with the counts of the downloads of
+// Have I Been Pwned.
+const breachedFixture = `# breached passwords
+5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824
+076d3e6c4b9f654b5b220b9045b7458ab6b4cbc6:12
+
+`
+
+func violationCodes(t *testing.T, err error) []string {
+	t.Helper()
+
+	var policyErr *password.PolicyError
+	require.ErrorAs(t, err, &policyErr)
+
+	codes := make([]string, len(policyErr.Violations))
+	for i, violation := range policyErr.Violations {
+		codes[i] = violation.Code
+	}
+	return codes
+}
+
+func TestPolicy(t *testing.T) {
+	breached, err := password.ReadBreachedList(strings.NewReader(breachedFixture))
+	require.NoError(t, err)
+
+	policy := password.NewPolicy(config.PasswordConfig{
+		MinLength:       8,
+		MaxLength:       16,
+		RequiredClasses: []string{"lower", "upper", "digit", "symbol"},
+	}, breached)
+
+	t.Run("should accept a password following every rule", func(t *testing.T) {
+		assert.NoError(t, policy.Check("Tr0ub4dor&3", "Gandalf", "gandalf@lor.com.br"))
+	})
+
+	t.Run("should report every broken rule", func(t *testing.T) {
+		err := policy.Check("abc")
+
+		assert.Equal(t, []string{
+			password.CodeTooShort,
+			password.CodeMissingUpper,
+			password.CodeMissingDigit,
+			password.CodeMissingSymbol,
+		}, violationCodes(t, err))
+	})
+
+	t.Run("should count characters rather than bytes", func(t *testing.T) {
+		assert.NoError(t, policy.Check("Ção-1ãõéíóú"))
+		assert.Equal(t, []string{password.CodeTooLong}, violationCodes(t, policy.Check("Ação-1ãõéíóúàèìòù")))
+	})
+
+	t.Run("should reject a password containing the name or email", func(t *testing.T) {
+		assert.Equal(t, []string{password.CodePersonalInfo}, violationCodes(t, policy.Check("Mithrandir-1!", "Mithrandir The Grey")))
+		assert.Equal(t, []string{password.CodePersonalInfo}, violationCodes(t, policy.Check("Gr3y.Pilgrim", "grey.pilgrim@lor.com.br")))
+		assert.Equal(t, []string{password.CodePersonalInfo}, violationCodes(t, policy.Check("My-GREY-0ne", "grey.pilgrim@lor.com.br")))
+	})
+
+	t.Run("should ignore short words and the email domain", func(t *testing.T) {
+		assert.NoError(t, policy.Check("Al-c0m-Lor!", "Al Jo", "al@lor.com"))
+	})
+
+	t.Run("should reject a breached password", func(t *testing.T) {
+		assert.Equal(t, []string{password.CodeBreached}, violationCodes(t, policy.Check("P@ssw0rd!")))
+	})
+
+	t.Run("should not check breaches without a list", func(t *testing.T) {
+		policy := password.NewPolicy(config.PasswordConfig{MinLength: 8, MaxLength: 64}, nil)
+
+		assert.NoError(t, policy.Check("P@ssw0rd!"))
+	})
+}
+
+func TestBreachedList(t *testing.T) {
+	list, err := password.ReadBreachedList(strings.NewReader(breachedFixture))
+	require.NoError(t, err)
+
+	t.Run("should find listed passwords only", func(t *testing.T) {
+		assert.Equal(t, 2, list.Len())
+		assert.True(t, list.Contains("password"))
+		assert.False(t, list.Contains("Password"))
+	})
+
+	t.Run("should answer range queries by the hash prefix", func(t *testing.T) {
+		assert.Equal(t, []string{"1E4C9B93F3F0682250B6CF8331B7EE68FD8"}, list.Range("5baa6"))
+		assert.Empty(t, list.Range("00000"))
+	})
+
+	t.Run("should reject lines that are not SHA-1 hashes", func(t *testing.T) {
+		_, err := password.ReadBreachedList(strings.NewReader("password:10\n"))
+
+		assert.Error(t, err)
+	})
+}
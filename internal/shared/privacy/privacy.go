@@ -0,0 +1,57 @@
+// Package privacy gathers the personal data the modules keep about an
+// account, to export or erase it.
+package privacy
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// Section is part of the data export of an account, written to the archive
+// as <Name>.json.
+type Section struct {
+	Name string
+	Data any
+}
+
+// Exporter collects the personal data a module keeps about an account. Every
+// module keeping personal data provides one, so exports grow with the
+// modules.
+type Exporter interface {
+	ExportAccountData(accountID uuid.UUID) ([]Section, error)
+}
+
+// Eraser removes or anonymizes the personal data a module keeps about an
+// account, keeping the rows other data depends on.
+type Eraser interface {
+	EraseAccountData(accountID uuid.UUID) error
+}
+
+// WriteArchive writes the sections to w as a ZIP archive of indented JSON
+// files.
+func WriteArchive(w io.Writer, sections []Section) error {
+	archive := zip.NewWriter(w)
+
+	for _, section := range sections {
+		file, err := archive.Create(section.Name + ".json")
+		if err != nil {
+			return fmt.Errorf("erro ao criar %s no arquivo: %w", section.Name, err)
+		}
+
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(section.Data); err != nil {
+			return fmt.Errorf("erro ao gravar %s no arquivo: %w", section.Name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("erro ao finalizar o arquivo: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package privacy_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"trilha-api/internal/shared/privacy"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteArchive(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := privacy.WriteArchive(&buf, []privacy.Section{
+		{Name: "account", Data: map[string]string{"name": "Gandalf"}},
+		{Name: "sessions", Data: []string{}},
+	})
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, archive.File, 2)
+	assert.Equal(t, "account.json", archive.File[0].Name)
+	assert.Equal(t, "sessions.json", archive.File[1].Name)
+
+	file, err := archive.File[0].Open()
+	require.NoError(t, err)
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+
+	var account map[string]string
+	require.NoError(t, json.Unmarshal(content, &account))
+	assert.Equal(t, "Gandalf", account["name"])
+}
@@ -1,18 +1,105 @@
 package router
 
 import (
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/authz"
 	config "trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/middleware"
+	"trilha-api/internal/shared/oidc"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/storage"
 	"trilha-api/internal/wire"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/webauthn"
 )
 
-func AccountRoutes(apiGroup *gin.RouterGroup) {
-	accountHandler := wire.NewAccountHandler(config.DB)
+func AccountRoutes(apiGroup *gin.RouterGroup, tokens auth.TokenManager, mail mailer.Mailer, hasher password.Hasher, passwordPolicy *password.Policy, providers oidc.Providers, relyingParty *webauthn.WebAuthn, store storage.Storage, archives storage.Private, policy *authz.Policy) {
+	accountHandler := wire.NewAccountHandler(config.DB, tokens, mail, hasher, passwordPolicy, config.Auth, config.Mail, store, config.Avatar)
+	sessionHandler := wire.NewSessionHandler(config.DB, tokens)
+	passwordResetHandler := wire.NewPasswordResetHandler(config.DB, tokens, mail, hasher, passwordPolicy, config.Auth, config.Mail)
+	emailVerificationHandler := wire.NewEmailVerificationHandler(config.DB, tokens, mail, config.Auth, config.Mail)
+	emailChangeHandler := wire.NewEmailChangeHandler(config.DB, mail, hasher, config.Auth, config.Mail)
+	twoFactorHandler := wire.NewTwoFactorHandler(config.DB, tokens, mail, config.Auth, config.Mail, store, config.Avatar)
+	signInThrottleHandler := wire.NewSignInThrottleHandler(config.DB, tokens, mail, config.Auth, config.Mail)
+	personalAccessTokenHandler := wire.NewPersonalAccessTokenHandler(config.DB)
+	oidcHandler := wire.NewOIDCHandler(config.DB, tokens, mail, providers, config.Auth, config.Mail, config.OIDC, store, config.Avatar)
+	passkeyHandler := wire.NewPasskeyHandler(config.DB, tokens, relyingParty, config.WebAuthn, store, config.Avatar)
+	avatarHandler := wire.NewAvatarHandler(config.DB, store, config.Avatar)
+	dataJobHandler := wire.NewDataJobHandler(config.DB, config.Pool, store, archives, config.Avatar, config.Privacy)
 
 	accountGroup := apiGroup.Group("/accounts")
 
+	// public routes
 	accountGroup.POST("/", accountHandler.Register)
-	accountGroup.GET("/:id", accountHandler.Find)
-	accountGroup.GET("/find_by_email/:email", accountHandler.FindByEmail)
+	accountGroup.POST("/sign_in", accountHandler.SignIn)
+	accountGroup.POST("/sign_in/two_factor", twoFactorHandler.SignIn)
+	accountGroup.POST("/refresh_token", sessionHandler.Refresh)
+	accountGroup.POST("/sign_out", sessionHandler.SignOut)
+	accountGroup.POST("/forgot_password", passwordResetHandler.ForgotPassword)
+	accountGroup.POST("/reset_password", passwordResetHandler.ResetPassword)
+	accountGroup.POST("/verify_email", emailVerificationHandler.Verify)
+	accountGroup.POST("/confirm_email_change", emailChangeHandler.Confirm)
+	accountGroup.POST("/cancel_email_change", emailChangeHandler.Cancel)
+	accountGroup.POST("/unlock", signInThrottleHandler.Unlock)
+	accountGroup.GET("/oidc/:provider/authorize", oidcHandler.Authorize)
+	accountGroup.GET("/oidc/:provider/callback", oidcHandler.Callback)
+	accountGroup.POST("/sign_in/passkey/options", passkeyHandler.BeginSignIn)
+	accountGroup.POST("/sign_in/passkey", passkeyHandler.FinishSignIn)
+
+	// protected routes, also reachable before the email is verified
+	protectedGroup := accountGroup.Group("", middleware.RequireAuth())
+
+	protectedGroup.GET("/me", accountHandler.Me)
+	protectedGroup.POST("/me/resend_verification", emailVerificationHandler.Resend)
+
+	// protected routes that manage credentials, closed to personal access tokens
+	// and impersonations; new credentials also need a verified email, since an
+	// unverified account may be claimed by the owner of the email
+	sessionGroup := accountGroup.Group("", middleware.RequireSession(), middleware.ForbidImpersonation())
+
+	sessionGroup.PUT("/me/password", accountHandler.ChangePassword)
+	sessionGroup.POST("/me/email", emailChangeHandler.RequestChange)
+	sessionGroup.DELETE("/me", accountHandler.Delete)
+	sessionGroup.POST("/me/two_factor", middleware.RequireVerified(), twoFactorHandler.Enroll)
+	sessionGroup.POST("/me/two_factor/confirm", middleware.RequireVerified(), twoFactorHandler.Confirm)
+	sessionGroup.POST("/me/two_factor/recovery_codes", twoFactorHandler.RegenerateRecoveryCodes)
+	sessionGroup.DELETE("/me/two_factor", twoFactorHandler.Disable)
+	sessionGroup.GET("/me/sessions", sessionHandler.List)
+	sessionGroup.DELETE("/me/sessions", sessionHandler.SignOutEverywhere)
+	sessionGroup.DELETE("/me/sessions/:session_id", sessionHandler.RevokeSession)
+	sessionGroup.GET("/me/tokens", personalAccessTokenHandler.List)
+	sessionGroup.POST("/me/tokens", middleware.RequireVerified(), personalAccessTokenHandler.Create)
+	sessionGroup.DELETE("/me/tokens/:token_id", personalAccessTokenHandler.Revoke)
+	sessionGroup.GET("/me/passkeys", passkeyHandler.List)
+	sessionGroup.POST("/me/passkeys/options", middleware.RequireVerified(), passkeyHandler.BeginRegistration)
+	sessionGroup.POST("/me/passkeys", middleware.RequireVerified(), passkeyHandler.FinishRegistration)
+	sessionGroup.DELETE("/me/passkeys/:passkey_id", passkeyHandler.Delete)
+	sessionGroup.GET("/me/data_jobs", dataJobHandler.List)
+	sessionGroup.POST("/me/data_jobs/export", dataJobHandler.RequestExport)
+	sessionGroup.POST("/me/data_jobs/erasure", dataJobHandler.RequestErasure)
+	sessionGroup.GET("/me/data_jobs/:job_id", dataJobHandler.Find)
+	sessionGroup.GET("/me/data_jobs/:job_id/archive", dataJobHandler.DownloadArchive)
+
+	// protected routes restricted to verified accounts
+	verifiedGroup := accountGroup.Group("", middleware.RequireVerified())
+
+	verifiedGroup.PATCH("/me", accountHandler.Update)
+	verifiedGroup.PUT("/me/avatar", avatarHandler.Upload)
+	verifiedGroup.DELETE("/me/avatar", avatarHandler.Delete)
+	verifiedGroup.GET("/:id", accountHandler.Find)
+	verifiedGroup.GET("/find_by_email/:email", accountHandler.FindByEmail)
+
+	// routes guarded by a permission on the target account
+	accountGroup.POST("/:id/restore",
+		middleware.ForbidImpersonation(),
+		middleware.RequirePermission(policy, authz.PermissionAccountsRestore, middleware.ResourceFromParam("account", "id")),
+		accountHandler.Restore,
+	)
+	accountGroup.DELETE("/:id/lockout",
+		middleware.ForbidImpersonation(),
+		middleware.RequirePermission(policy, authz.PermissionAccountsUnlock, middleware.ResourceFromParam("account", "id")),
+		signInThrottleHandler.Clear,
+	)
 }
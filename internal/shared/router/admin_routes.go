@@ -0,0 +1,51 @@
+package router
+
+import (
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/authz"
+	config "trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/middleware"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/storage"
+	"trilha-api/internal/wire"
+
+	"github.com/gin-gonic/gin"
+)
+
+func AdminRoutes(apiGroup *gin.RouterGroup, tokens auth.TokenManager, mail mailer.Mailer, hasher password.Hasher, passwordPolicy *password.Policy, store storage.Storage, archives storage.Private, policy *authz.Policy) {
+	adminAccountHandler := wire.NewAdminAccountHandler(config.DB, tokens, mail, hasher, passwordPolicy, config.Auth, config.Mail, store, config.Avatar)
+	impersonationHandler := wire.NewImpersonationHandler(config.DB, tokens, config.Auth)
+	dataJobHandler := wire.NewDataJobHandler(config.DB, config.Pool, store, archives, config.Avatar, config.Privacy)
+
+	adminGroup := apiGroup.Group("/admin", middleware.RequireSession(), middleware.ForbidImpersonation())
+	accountGroup := adminGroup.Group("/accounts")
+	impersonationGroup := adminGroup.Group("/impersonations")
+	dataJobGroup := adminGroup.Group("/data_jobs")
+
+	canRead := middleware.RequirePermission(policy, authz.PermissionAccountsRead, middleware.SystemResource())
+	canSuspend := middleware.RequirePermission(policy, authz.PermissionAccountsSuspend, middleware.SystemResource())
+	canResetPassword := middleware.RequirePermission(policy, authz.PermissionAccountsResetPassword, middleware.SystemResource())
+	canDelete := middleware.RequirePermission(policy, authz.PermissionAccountsDelete, middleware.SystemResource())
+	canImpersonate := middleware.RequirePermission(policy, authz.PermissionAccountsImpersonate, middleware.SystemResource())
+	canExport := middleware.RequirePermission(policy, authz.PermissionAccountsExport, middleware.SystemResource())
+	canErase := middleware.RequirePermission(policy, authz.PermissionAccountsErase, middleware.SystemResource())
+
+	accountGroup.GET("/", canRead, adminAccountHandler.List)
+	accountGroup.GET("/:id", canRead, adminAccountHandler.Find)
+	accountGroup.POST("/:id/suspend", canSuspend, adminAccountHandler.Suspend)
+	accountGroup.POST("/:id/reactivate", canSuspend, adminAccountHandler.Reactivate)
+	accountGroup.POST("/:id/password_reset", canResetPassword, adminAccountHandler.ForcePasswordReset)
+	accountGroup.DELETE("/:id", canDelete, adminAccountHandler.Delete)
+	accountGroup.POST("/:id/impersonate", canImpersonate, impersonationHandler.Start)
+	accountGroup.GET("/:id/data_jobs", canRead, dataJobHandler.AdminList)
+	accountGroup.POST("/:id/data_jobs/export", canExport, dataJobHandler.AdminRequestExport)
+	accountGroup.POST("/:id/data_jobs/erasure", canErase, dataJobHandler.AdminRequestErasure)
+
+	impersonationGroup.GET("/:id", canImpersonate, impersonationHandler.Find)
+	impersonationGroup.DELETE("/:id", canImpersonate, impersonationHandler.End)
+	impersonationGroup.GET("/:id/requests", canImpersonate, impersonationHandler.ListRequests)
+
+	dataJobGroup.GET("/:job_id", canRead, dataJobHandler.AdminFind)
+	dataJobGroup.GET("/:job_id/archive", canExport, dataJobHandler.AdminDownloadArchive)
+}
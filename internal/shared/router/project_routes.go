@@ -0,0 +1,38 @@
+package router
+
+import (
+	"trilha-api/internal/shared/authz"
+	config "trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/middleware"
+	"trilha-api/internal/wire"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProjectRoutes mounts the projects under the workspace they belong to, in
+// /workspaces/:ws/projects. The routes of a project also check the roles
+// held on it, directly or through its teams, and any member of the
+// workspace lists the projects it can read.
+func ProjectRoutes(workspaceGroup *gin.RouterGroup, policy *authz.Policy) {
+	projectHandler := wire.NewProjectHandler(config.Pool)
+
+	projectGroup := workspaceGroup.Group("/:ws/projects")
+
+	workspace := middleware.ResourceFromParam("workspace", "ws")
+	project := middleware.NestedResource(workspace, middleware.ResourceFromParam("project", "id"))
+
+	projectGroup.GET("/", middleware.RequirePermission(policy, authz.PermissionWorkspacesRead, workspace), projectHandler.List)
+	projectGroup.POST("/", middleware.RequirePermission(policy, authz.PermissionProjectsWrite, workspace), projectHandler.Create)
+
+	canRead := middleware.RequirePermission(policy, authz.PermissionProjectsRead, project)
+	canWrite := middleware.RequirePermission(policy, authz.PermissionProjectsWrite, project)
+	canManageMembers := middleware.RequirePermission(policy, authz.PermissionProjectsMembers, project)
+
+	projectGroup.GET("/:id", canRead, projectHandler.Find)
+	projectGroup.PATCH("/:id", canWrite, projectHandler.Update)
+	projectGroup.DELETE("/:id", canWrite, projectHandler.Delete)
+	projectGroup.POST("/:id/restore", canWrite, projectHandler.Restore)
+	projectGroup.GET("/:id/members", canRead, projectHandler.ListMembers)
+	projectGroup.PUT("/:id/members/:account_id", canManageMembers, projectHandler.SetMember)
+	projectGroup.DELETE("/:id/members/:account_id", canManageMembers, projectHandler.RemoveMember)
+}
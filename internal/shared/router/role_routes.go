@@ -0,0 +1,25 @@
+package router
+
+import (
+	"trilha-api/internal/shared/authz"
+	config "trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/middleware"
+	"trilha-api/internal/wire"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RoleRoutes(apiGroup *gin.RouterGroup, policy *authz.Policy) {
+	roleHandler := wire.NewRoleHandler(config.DB)
+
+	roleGroup := apiGroup.Group("/roles", middleware.RequireSession(), middleware.ForbidImpersonation())
+
+	canRead := middleware.RequirePermission(policy, authz.PermissionRolesRead, middleware.SystemResource())
+	canAssign := middleware.RequirePermission(policy, authz.PermissionRolesAssign, middleware.SystemResource())
+
+	roleGroup.GET("/", canRead, roleHandler.ListRoles)
+	roleGroup.GET("/permissions", canRead, roleHandler.ListPermissions)
+	roleGroup.GET("/accounts/:account_id", canRead, roleHandler.ListAccountRoles)
+	roleGroup.POST("/accounts/:account_id", canAssign, roleHandler.Assign)
+	roleGroup.DELETE("/accounts/:account_id", canAssign, roleHandler.Revoke)
+}
@@ -1,13 +1,111 @@
 package router
 
-import "github.com/gin-gonic/gin"
+import (
+	"log"
+	"trilha-api/internal/shared/auth"
+	config "trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/middleware"
+	"trilha-api/internal/shared/oidc"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/storage"
+	"trilha-api/internal/shared/worker"
+	"trilha-api/internal/wire"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
 
 func Router() *gin.Engine {
 	router := gin.Default()
 
+	if err := router.SetTrustedProxies(config.Server.TrustedProxies); err != nil {
+		log.Fatalf("TRUSTED_PROXIES inválido: %v", err)
+	}
+
+	tokens := auth.NewJWTManager(config.Auth)
+	mail := mailer.NewFromConfig(config.Mail)
+	hasher := password.NewFromConfig(config.Password)
+	passwordPolicy := newPasswordPolicy(config.Password)
+	providers := oidc.NewFromConfig(config.OIDC)
+	relyingParty := newRelyingParty(config.WebAuthn)
+	store := newStorage(router, config.Storage)
+	archives := newPrivateStorage(config.Privacy.Archives)
+	pats := wire.NewPersonalAccessTokenAuthenticator(config.DB)
+	policy := wire.NewPolicy(config.DB)
+	impersonations := wire.NewImpersonationTracker(config.DB, tokens, config.Auth)
+	dataJobs := wire.NewDataJobWorker(config.DB, config.Pool, store, archives, config.Avatar, config.Privacy)
+
+	go worker.Run("tarefas de dados", config.Privacy.JobInterval, dataJobs.Work)
+
 	apiGroup := router.Group("/api/v1")
+	apiGroup.Use(
+		middleware.Authenticate(tokens, pats),
+		middleware.TrackImpersonation(impersonations, config.Auth.ImpersonationReadOnly),
+	)
 
-	AccountRoutes(apiGroup)
+	AccountRoutes(apiGroup, tokens, mail, hasher, passwordPolicy, providers, relyingParty, store, archives, policy)
+	RoleRoutes(apiGroup, policy)
+	AdminRoutes(apiGroup, tokens, mail, hasher, passwordPolicy, store, archives, policy)
+	WorkspaceRoutes(apiGroup, tokens, mail, hasher, passwordPolicy, policy)
 
 	return router
 }
+
+// newPasswordPolicy loads the password policy, stopping the server when the
+// breached list cannot be read rather than silently accepting any password.
+func newPasswordPolicy(cfg config.PasswordConfig) *password.Policy {
+	policy, err := password.NewPolicyFromConfig(cfg)
+	if err != nil {
+		log.Fatalf("Erro ao carregar a política de senhas: %v", err)
+	}
+	return policy
+}
+
+// newStorage connects to the storage of uploaded files. Files kept on the
+// local disk are served by the API itself under /storage.
+func newStorage(router *gin.Engine, cfg config.StorageConfig) storage.Storage {
+	store, err := storage.NewFromConfig(cfg)
+	if err != nil {
+		log.Fatalf("Erro ao configurar o armazenamento de arquivos: %v", err)
+	}
+
+	if local, ok := store.(*storage.LocalStorage); ok {
+		router.Static("/storage", local.Dir())
+	}
+
+	return store
+}
+
+// newPrivateStorage connects to the storage of files only the API reads,
+// such as export archives. It is never served under /storage.
+func newPrivateStorage(cfg config.StorageConfig) storage.Private {
+	store, err := storage.NewPrivateFromConfig(cfg)
+	if err != nil {
+		log.Fatalf("Erro ao configurar o armazenamento privado: %v", err)
+	}
+
+	return store
+}
+
+// newRelyingParty configures the WebAuthn relying party passkeys are bound
+// to, giving the browser as long to answer as the server keeps challenges.
+func newRelyingParty(cfg config.WebAuthnConfig) *webauthn.WebAuthn {
+	timeout := webauthn.TimeoutConfig{Timeout: cfg.ChallengeTTL, TimeoutUVD: cfg.ChallengeTTL}
+
+	relyingParty, err := webauthn.New(&webauthn.Config{
+		RPID:          cfg.RPID,
+		RPDisplayName: cfg.RPDisplayName,
+		RPOrigins:     cfg.RPOrigins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+
+	if err != nil {
+		log.Fatalf("Configuração de WebAuthn inválida: %v", err)
+	}
+
+	return relyingParty
+}
@@ -0,0 +1,32 @@
+package router
+
+import (
+	"trilha-api/internal/shared/authz"
+	config "trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/middleware"
+	"trilha-api/internal/wire"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TaskRoutes mounts the tasks under the project they belong to, in
+// /workspaces/:ws/projects/:id/tasks. Reading the project lets the caller
+// read its tasks, and writing it lets the caller change and assign them.
+func TaskRoutes(workspaceGroup *gin.RouterGroup, policy *authz.Policy) {
+	taskHandler := wire.NewTaskHandler(config.Pool)
+
+	taskGroup := workspaceGroup.Group("/:ws/projects/:id/tasks")
+
+	workspace := middleware.ResourceFromParam("workspace", "ws")
+	project := middleware.NestedResource(workspace, middleware.ResourceFromParam("project", "id"))
+
+	canRead := middleware.RequirePermission(policy, authz.PermissionProjectsRead, project)
+	canWrite := middleware.RequirePermission(policy, authz.PermissionProjectsWrite, project)
+
+	taskGroup.GET("/", canRead, taskHandler.List)
+	taskGroup.POST("/", canWrite, taskHandler.Create)
+	taskGroup.GET("/:task_id", canRead, taskHandler.Find)
+	taskGroup.PATCH("/:task_id", canWrite, taskHandler.Update)
+	taskGroup.DELETE("/:task_id", canWrite, taskHandler.Delete)
+	taskGroup.PUT("/:task_id/assignees", canWrite, taskHandler.Assign)
+}
@@ -0,0 +1,39 @@
+package router
+
+import (
+	"trilha-api/internal/shared/authz"
+	config "trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/middleware"
+	"trilha-api/internal/wire"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TeamRoutes mounts the teams under the workspace they belong to, in
+// /workspaces/:ws/teams. The routes of a team check the roles held in the
+// team as well as those held in its workspace.
+func TeamRoutes(workspaceGroup *gin.RouterGroup, policy *authz.Policy) {
+	teamHandler := wire.NewTeamHandler(config.DB, config.Pool)
+
+	teamGroup := workspaceGroup.Group("/:ws/teams")
+
+	workspace := middleware.ResourceFromParam("workspace", "ws")
+	team := middleware.NestedResource(workspace, middleware.ResourceFromParam("team", "team_id"))
+
+	teamGroup.GET("/", middleware.RequirePermission(policy, authz.PermissionTeamsRead, workspace), teamHandler.List)
+	teamGroup.POST("/", middleware.RequirePermission(policy, authz.PermissionTeamsManage, workspace), teamHandler.Create)
+
+	canRead := middleware.RequirePermission(policy, authz.PermissionTeamsRead, team)
+	canManage := middleware.RequirePermission(policy, authz.PermissionTeamsManage, team)
+	canManageMembers := middleware.RequirePermission(policy, authz.PermissionTeamsMembers, team)
+
+	teamGroup.GET("/:team_id", canRead, teamHandler.Find)
+	teamGroup.PATCH("/:team_id", canManage, teamHandler.Update)
+	teamGroup.DELETE("/:team_id", canManage, teamHandler.Delete)
+	teamGroup.GET("/:team_id/members", canRead, teamHandler.ListMembers)
+	teamGroup.PUT("/:team_id/members/:account_id", canManageMembers, teamHandler.SetMember)
+	teamGroup.DELETE("/:team_id/members/:account_id", canManageMembers, teamHandler.RemoveMember)
+	teamGroup.GET("/:team_id/projects", canRead, teamHandler.ListProjects)
+	teamGroup.PUT("/:team_id/projects/:project_id", canManage, teamHandler.AssignProject)
+	teamGroup.DELETE("/:team_id/projects/:project_id", canManage, teamHandler.UnassignProject)
+}
@@ -0,0 +1,53 @@
+package router
+
+import (
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/authz"
+	config "trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/middleware"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/wire"
+
+	"github.com/gin-gonic/gin"
+)
+
+func WorkspaceRoutes(apiGroup *gin.RouterGroup, tokens auth.TokenManager, mail mailer.Mailer, hasher password.Hasher, passwordPolicy *password.Policy, policy *authz.Policy) {
+	workspaceHandler := wire.NewWorkspaceHandler(config.DB)
+	invitationHandler := wire.NewWorkspaceInvitationHandler(config.DB, tokens, mail, hasher, passwordPolicy, config.Auth, config.Mail, config.Workspace)
+
+	workspaceGroup := apiGroup.Group("/workspaces", middleware.RequireVerified())
+
+	canRead := middleware.RequirePermission(policy, authz.PermissionWorkspacesRead, middleware.ResourceFromParam("workspace", "ws"))
+	canUpdate := middleware.RequirePermission(policy, authz.PermissionWorkspacesUpdate, middleware.ResourceFromParam("workspace", "ws"))
+	canDelete := middleware.RequirePermission(policy, authz.PermissionWorkspacesDelete, middleware.ResourceFromParam("workspace", "ws"))
+	canManageMembers := middleware.RequirePermission(policy, authz.PermissionWorkspacesMembers, middleware.ResourceFromParam("workspace", "ws"))
+
+	workspaceGroup.GET("/", workspaceHandler.List)
+	workspaceGroup.POST("/", workspaceHandler.Create)
+	workspaceGroup.GET("/:ws", canRead, workspaceHandler.Find)
+	workspaceGroup.PATCH("/:ws", canUpdate, workspaceHandler.Update)
+	workspaceGroup.DELETE("/:ws", canDelete, workspaceHandler.Delete)
+	workspaceGroup.POST("/:ws/leave", canRead, workspaceHandler.Leave)
+	workspaceGroup.GET("/:ws/members", canRead, workspaceHandler.ListMembers)
+	workspaceGroup.PUT("/:ws/members/:account_id", canManageMembers, workspaceHandler.SetMember)
+	workspaceGroup.DELETE("/:ws/members/:account_id", canManageMembers, workspaceHandler.RemoveMember)
+	workspaceGroup.GET("/:ws/invitations", canManageMembers, invitationHandler.ListPending)
+	workspaceGroup.POST("/:ws/invitations", canManageMembers, invitationHandler.Invite)
+	workspaceGroup.POST("/:ws/invitations/:invitation_id/resend", canManageMembers, invitationHandler.Resend)
+	workspaceGroup.DELETE("/:ws/invitations/:invitation_id", canManageMembers, invitationHandler.Revoke)
+
+	ProjectRoutes(workspaceGroup, policy)
+	TaskRoutes(workspaceGroup, policy)
+	TeamRoutes(workspaceGroup, policy)
+
+	invitationGroup := apiGroup.Group("/invitations")
+
+	// public routes, reached from the link sent by email
+	invitationGroup.POST("/preview", invitationHandler.Preview)
+	invitationGroup.POST("/register", invitationHandler.Register)
+
+	// accepting only asks for the account to be signed in: the invitation
+	// proves it owns the email, whether or not it verified it yet
+	invitationGroup.POST("/accept", middleware.RequireAuth(), invitationHandler.Accept)
+}
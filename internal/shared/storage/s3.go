@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"trilha-api/internal/shared/config"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Storage keeps files in a bucket of an S3-compatible service, such as AWS
+// S3, MinIO or Cloudflare R2. The bucket, or a CDN in front of it, must allow
+// public reads for the URLs to be served.
+type S3Storage struct {
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+// NewS3Storage connects to the endpoint of cfg, whose scheme tells whether
+// to use TLS.
+func NewS3Storage(cfg config.StorageConfig) (*S3Storage, error) {
+	endpoint, err := url.Parse(cfg.S3Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("S3_ENDPOINT inválido: %s", cfg.S3Endpoint)
+	}
+
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.S3AccessKeyID, cfg.S3SecretAccessKey, ""),
+		Secure: endpoint.Scheme == "https",
+		Region: cfg.S3Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao configurar o cliente S3: %w", err)
+	}
+
+	return &S3Storage{client: client, bucket: cfg.S3Bucket, publicURL: cfg.PublicURL}, nil
+}
+
+func (s *S3Storage) Put(key string, body io.Reader, size int64, contentType string) error {
+	if !fs.ValidPath(key) || key == "." {
+		return ErrInvalidKey
+	}
+
+	_, err := s.client.PutObject(context.Background(), s.bucket, key, body, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao enviar %s ao S3: %w", key, err)
+	}
+
+	return nil
+}
+
+// Open checks that the object exists before returning it, as the client
+// only requests it on the first read.
+func (s *S3Storage) Open(key string) (io.ReadCloser, error) {
+	if !fs.ValidPath(key) || key == "." {
+		return nil, ErrInvalidKey
+	}
+
+	object, err := s.client.GetObject(context.Background(), s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao abrir %s no S3: %w", key, err)
+	}
+
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, fmt.Errorf("erro ao abrir %s no S3: %w", key, err)
+	}
+
+	return object, nil
+}
+
+func (s *S3Storage) Delete(key string) error {
+	if !fs.ValidPath(key) || key == "." {
+		return ErrInvalidKey
+	}
+
+	if err := s.client.RemoveObject(context.Background(), s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("erro ao remover %s do S3: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *S3Storage) URL(key string) string {
+	return s.publicURL + "/" + key
+}
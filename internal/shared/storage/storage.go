@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"log"
+	"trilha-api/internal/shared/config"
+)
+
+// ErrInvalidKey is returned for keys that are not relative slash-separated
+// paths, which could escape the storage root.
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// Storage keeps files under slash-separated keys and serves them from public
+// URLs.
+type Storage interface {
+	Put(key string, body io.Reader, size int64, contentType string) error
+	// Open reads the file at key, for files served by the API rather than
+	// from their public URL.
+	Open(key string) (io.ReadCloser, error)
+	// Delete removes the file at key. Deleting a missing file is not an
+	// error.
+	Delete(key string) error
+	URL(key string) string
+}
+
+// Private keeps files that are only read back by the API, such as the
+// archives of data exports. It is never served, so it has no URLs, and must
+// not share a directory or bucket with a Storage.
+type Private interface {
+	Put(key string, body io.Reader, size int64, contentType string) error
+	Open(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
+
+// NewFromConfig returns the storage selected by STORAGE_DRIVER.
+func NewFromConfig(cfg config.StorageConfig) (Storage, error) {
+	if cfg.Driver == "s3" {
+		return NewS3Storage(cfg)
+	}
+
+	log.Printf("Arquivos enviados serão gravados em %s", cfg.LocalDir)
+	return NewLocalStorage(cfg.LocalDir, cfg.PublicURL), nil
+}
+
+// NewPrivateFromConfig returns the private storage selected by cfg.
+func NewPrivateFromConfig(cfg config.StorageConfig) (Private, error) {
+	if cfg.Driver == "s3" {
+		return NewS3Storage(cfg)
+	}
+
+	log.Printf("Arquivos privados serão gravados em %s", cfg.LocalDir)
+	return NewLocalStorage(cfg.LocalDir, ""), nil
+}
@@ -0,0 +1,125 @@
+package storage_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	s := storage.NewLocalStorage(dir, "http://localhost:8080/storage")
+
+	t.Run("should write the file under its key", func(t *testing.T) {
+		err := s.Put("avatars/1/128.jpg", strings.NewReader("image"), 5, "image/jpeg")
+
+		require.NoError(t, err)
+		content, err := os.ReadFile(filepath.Join(dir, "avatars", "1", "128.jpg"))
+		require.NoError(t, err)
+		assert.Equal(t, "image", string(content))
+		assert.Equal(t, "http://localhost:8080/storage/avatars/1/128.jpg", s.URL("avatars/1/128.jpg"))
+	})
+
+	t.Run("should open the file under its key", func(t *testing.T) {
+		require.NoError(t, s.Put("exports/1/data.zip", strings.NewReader("archive"), 7, "application/zip"))
+
+		file, err := s.Open("exports/1/data.zip")
+		require.NoError(t, err)
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "archive", string(content))
+
+		_, err = s.Open("exports/1/missing.zip")
+		assert.Error(t, err)
+	})
+
+	t.Run("should delete the file and ignore missing ones", func(t *testing.T) {
+		require.NoError(t, s.Put("avatars/2/32.jpg", strings.NewReader("image"), 5, "image/jpeg"))
+
+		assert.NoError(t, s.Delete("avatars/2/32.jpg"))
+		assert.NoFileExists(t, filepath.Join(dir, "avatars", "2", "32.jpg"))
+		assert.NoError(t, s.Delete("avatars/2/32.jpg"))
+	})
+
+	t.Run("should reject keys escaping the directory", func(t *testing.T) {
+		assert.ErrorIs(t, s.Put("../outside.jpg", strings.NewReader("image"), 5, "image/jpeg"), storage.ErrInvalidKey)
+		assert.ErrorIs(t, s.Put("/etc/outside.jpg", strings.NewReader("image"), 5, "image/jpeg"), storage.ErrInvalidKey)
+		assert.ErrorIs(t, s.Delete("avatars/../../outside.jpg"), storage.ErrInvalidKey)
+	})
+}
+
+// s3Request is a request received by the fake S3 server.
+type s3Request struct {
+	method      string
+	path        string
+	contentType string
+	body        string
+}
+
+func TestS3Storage(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []s3Request
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		requests = append(requests, s3Request{r.Method, r.URL.Path, r.Header.Get("Content-Type"), string(body)})
+		mu.Unlock()
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+	}))
+	t.Cleanup(server.Close)
+
+	s, err := storage.NewS3Storage(config.StorageConfig{
+		PublicURL:         "https://cdn.trilha.test",
+		S3Endpoint:        server.URL,
+		S3Region:          "us-east-1",
+		S3Bucket:          "trilha",
+		S3AccessKeyID:     "access-key",
+		S3SecretAccessKey: "secret-key",
+	})
+	require.NoError(t, err)
+
+	t.Run("should upload the object to the bucket", func(t *testing.T) {
+		err := s.Put("avatars/1/128.jpg", strings.NewReader("image"), 5, "image/jpeg")
+
+		require.NoError(t, err)
+		require.NotEmpty(t, requests)
+		last := requests[len(requests)-1]
+		assert.Equal(t, http.MethodPut, last.method)
+		assert.Equal(t, "/trilha/avatars/1/128.jpg", last.path)
+		assert.Equal(t, "image/jpeg", last.contentType)
+		assert.Contains(t, last.body, "image")
+	})
+
+	t.Run("should remove the object from the bucket", func(t *testing.T) {
+		require.NoError(t, s.Delete("avatars/1/128.jpg"))
+
+		last := requests[len(requests)-1]
+		assert.Equal(t, http.MethodDelete, last.method)
+		assert.Equal(t, "/trilha/avatars/1/128.jpg", last.path)
+	})
+
+	t.Run("should serve objects from the public URL", func(t *testing.T) {
+		assert.Equal(t, "https://cdn.trilha.test/avatars/1/128.jpg", s.URL("avatars/1/128.jpg"))
+	})
+}
@@ -0,0 +1,71 @@
+// Package tenant runs queries inside the scope of a workspace, so
+// row-level security only lets them see the rows of that workspace.
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	db "trilha-api/internal/shared/database/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrMissingWorkspace = errors.New("missing workspace")
+
+// Scope runs fn with queries that only reach the rows of a workspace.
+// Repositories of data owned by workspaces query through it rather than
+// through a shared db.Querier.
+type Scope interface {
+	Run(workspaceID uuid.UUID, fn func(q db.Querier) error) error
+}
+
+// Beginner starts transactions, such as *pgxpool.Pool.
+type Beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// TxScope runs fn inside a transaction with app.workspace_id set to the
+// workspace, which the row-level security policies compare rows with. The
+// setting ends with the transaction, so it never leaks to the next user of
+// the connection.
+type TxScope struct {
+	pool Beginner
+}
+
+func NewTxScope(pool Beginner) *TxScope {
+	return &TxScope{pool: pool}
+}
+
+// Run commits the transaction when fn succeeds and rolls it back otherwise,
+// returning the error of fn untouched.
+func (s *TxScope) Run(workspaceID uuid.UUID, fn func(q db.Querier) error) error {
+	if workspaceID == uuid.Nil {
+		return ErrMissingWorkspace
+	}
+
+	ctx := context.Background()
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	queries := db.New(tx)
+
+	if err := queries.SetWorkspaceScope(ctx, workspaceID); err != nil {
+		return fmt.Errorf("erro ao definir o workspace da transação: %w", err)
+	}
+
+	if err := fn(queries); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("erro ao confirmar transação: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package tenant_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/tenant"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTx records the statements run in the transaction and how it ended.
+type fakeTx struct {
+	pgx.Tx
+	args      [][]any
+	committed bool
+}
+
+func (tx *fakeTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	tx.args = append(tx.args, args)
+	return pgconn.CommandTag{}, nil
+}
+
+func (tx *fakeTx) Commit(ctx context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(ctx context.Context) error {
+	return nil
+}
+
+type fakePool struct {
+	tx *fakeTx
+}
+
+func (p *fakePool) Begin(ctx context.Context) (pgx.Tx, error) {
+	p.tx = &fakeTx{}
+	return p.tx, nil
+}
+
+func TestTxScope_Run(t *testing.T) {
+	pool := &fakePool{}
+	scope := tenant.NewTxScope(pool)
+	workspaceID := uuid.New()
+
+	t.Run("should set the workspace before running the queries and commit", func(t *testing.T) {
+		err := scope.Run(workspaceID, func(q db.Querier) error {
+			assert.Len(t, pool.tx.args, 1)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []any{workspaceID}, pool.tx.args[0])
+		assert.True(t, pool.tx.committed)
+	})
+
+	t.Run("should not commit when the queries fail", func(t *testing.T) {
+		failure := errors.New("query failed")
+
+		err := scope.Run(workspaceID, func(q db.Querier) error {
+			return failure
+		})
+
+		assert.ErrorIs(t, err, failure)
+		assert.False(t, pool.tx.committed)
+	})
+
+	t.Run("should refuse to run without a workspace", func(t *testing.T) {
+		pool.tx = nil
+
+		err := scope.Run(uuid.Nil, func(q db.Querier) error {
+			t.Fatal("ran without a workspace")
+			return nil
+		})
+
+		assert.ErrorIs(t, err, tenant.ErrMissingWorkspace)
+		assert.Nil(t, pool.tx)
+	})
+}
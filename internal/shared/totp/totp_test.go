@@ -0,0 +1,75 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+	"trilha-api/internal/shared/totp"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfcSecret is the SHA1 seed of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range vectors {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+
+	t.Run("should accept the current code", func(t *testing.T) {
+		step, ok := totp.Validate(rfcSecret, "081804", now)
+
+		assert.True(t, ok)
+		assert.Equal(t, totp.Step(now), step)
+	})
+
+	t.Run("should tolerate one period of clock drift", func(t *testing.T) {
+		previous, _ := totp.Code(rfcSecret, totp.Step(now)-1)
+
+		step, ok := totp.Validate(rfcSecret, previous, now)
+
+		assert.True(t, ok)
+		assert.Equal(t, totp.Step(now)-1, step)
+	})
+
+	t.Run("should reject an old code", func(t *testing.T) {
+		old, _ := totp.Code(rfcSecret, totp.Step(now)-2)
+
+		_, ok := totp.Validate(rfcSecret, old, now)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("should reject malformed codes", func(t *testing.T) {
+		_, ok := totp.Validate(rfcSecret, "12345", now)
+
+		assert.False(t, ok)
+	})
+}
+
+func TestURI(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+
+	uri := totp.URI("Trilha", "gandalf@lor.com.br", secret)
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Trilha:gandalf@lor.com.br?"))
+	assert.Contains(t, uri, "secret="+secret)
+	assert.Contains(t, uri, "issuer=Trilha")
+}
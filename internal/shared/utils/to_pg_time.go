@@ -12,3 +12,10 @@ func TimeToPgTimestamp(t *time.Time) pgtype.Timestamp {
 	}
 	return pgtype.Timestamp{Time: *t, Valid: true}
 }
+
+func PgTimestampToTime(ts pgtype.Timestamp) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	return &ts.Time
+}
@@ -0,0 +1,22 @@
+// Package worker runs background tasks inside the API process.
+package worker
+
+import (
+	"log"
+	"time"
+)
+
+// Run calls task right away and then every interval, for as long as the
+// process lives, logging its failures. It is meant to run in its own
+// goroutine.
+func Run(name string, interval time.Duration, task func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := task(); err != nil {
+			log.Printf("Erro na tarefa %s: %v", name, err)
+		}
+		<-ticker.C
+	}
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskResponse is a task of a project. AssigneeAccountID and AssigneeTeamID
+// are null when the task has no such assignee, and CreatedBy once the
+// account that created the task is gone.
+type TaskResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	WorkspaceID       uuid.UUID  `json:"workspace_id"`
+	ProjectID         uuid.UUID  `json:"project_id"`
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	Status            string     `json:"status"`
+	AssigneeAccountID *uuid.UUID `json:"assignee_account_id"`
+	AssigneeTeamID    *uuid.UUID `json:"assignee_team_id"`
+	CreatedBy         *uuid.UUID `json:"created_by"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// CreateTaskRequest creates a task, assigned to a member of the workspace,
+// to one of its teams, to both or to nobody.
+type CreateTaskRequest struct {
+	Title             string     `json:"title" binding:"required,max=200"`
+	Description       string     `json:"description" binding:"max=5000"`
+	Status            string     `json:"status" binding:"omitempty,oneof=todo in_progress done"`
+	AssigneeAccountID *uuid.UUID `json:"assignee_account_id"`
+	AssigneeTeamID    *uuid.UUID `json:"assignee_team_id"`
+}
+
+// UpdateTaskRequest holds the fields of a PATCH request; fields left out of
+// the body are kept unchanged.
+type UpdateTaskRequest struct {
+	Title       *string `json:"title" binding:"omitempty,min=1,max=200"`
+	Description *string `json:"description" binding:"omitempty,max=5000"`
+	Status      *string `json:"status" binding:"omitempty,oneof=todo in_progress done"`
+}
+
+// AssignTaskRequest replaces the assignees of a task; a null or missing one
+// leaves the task without it.
+type AssignTaskRequest struct {
+	AccountID *uuid.UUID `json:"account_id"`
+	TeamID    *uuid.UUID `json:"team_id"`
+}
+
+// ListTasksQuery holds the query string of the task listing.
+type ListTasksQuery struct {
+	Status            string `form:"status" binding:"omitempty,oneof=todo in_progress done"`
+	AssigneeAccountID string `form:"assignee_account_id" binding:"omitempty,uuid"`
+	AssigneeTeamID    string `form:"assignee_team_id" binding:"omitempty,uuid"`
+	Page              int    `form:"page" binding:"omitempty,min=1"`
+	PerPage           int    `form:"per_page" binding:"omitempty,min=1"`
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TaskStatusTodo       = "todo"
+	TaskStatusInProgress = "in_progress"
+	TaskStatusDone       = "done"
+)
+
+// TaskEntity is a task of the project ProjectID. It is assigned to the
+// member AssigneeAccountID, to the team AssigneeTeamID, to both or, when
+// both are nil, to nobody. CreatedBy is nil once the account that created
+// the task is gone.
+type TaskEntity struct {
+	ID                uuid.UUID
+	WorkspaceID       uuid.UUID
+	ProjectID         uuid.UUID
+	Title             string
+	Description       string
+	Status            string
+	AssigneeAccountID *uuid.UUID
+	AssigneeTeamID    *uuid.UUID
+	CreatedBy         *uuid.UUID
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// TaskFilter narrows and pages the tasks of a project. An empty Status or a
+// nil assignee does not filter.
+type TaskFilter struct {
+	WorkspaceID       uuid.UUID
+	ProjectID         uuid.UUID
+	Status            string
+	AssigneeAccountID *uuid.UUID
+	AssigneeTeamID    *uuid.UUID
+	Page              int
+	PerPage           int
+}
@@ -0,0 +1,330 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"trilha-api/internal/shared/auth"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/task/dto"
+	"trilha-api/internal/task/entity"
+	"trilha-api/internal/task/repository"
+	usecase "trilha-api/internal/task/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TaskHandler struct {
+	usecase usecase.TaskUseCaseInterface
+}
+
+func New(uc usecase.TaskUseCaseInterface) *TaskHandler {
+	return &TaskHandler{usecase: uc}
+}
+
+// List returns a page of the tasks of the project, optionally only those of
+// a status, of a member or of a team.
+func (h *TaskHandler) List(c *gin.Context) {
+	task, ok := parseProject(c)
+
+	if !ok {
+		return
+	}
+
+	query := dto.ListTasksQuery{}
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	filter := &entity.TaskFilter{
+		WorkspaceID:       task.WorkspaceID,
+		ProjectID:         task.ProjectID,
+		Status:            query.Status,
+		AssigneeAccountID: optionalUUID(query.AssigneeAccountID),
+		AssigneeTeamID:    optionalUUID(query.AssigneeTeamID),
+		Page:              query.Page,
+		PerPage:           query.PerPage,
+	}
+
+	tasks, total, err := h.usecase.List(filter)
+
+	if err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	items := make([]dto.TaskResponse, 0, len(tasks))
+	for i := range tasks {
+		items = append(items, toTaskResponse(&tasks[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[sharedDto.Page[dto.TaskResponse]]{
+		Status: http.StatusOK,
+		Data: sharedDto.Page[dto.TaskResponse]{
+			Items:   items,
+			Page:    filter.Page,
+			PerPage: filter.PerPage,
+			Total:   total,
+		},
+	})
+}
+
+func (h *TaskHandler) Create(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	task, ok := parseProject(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.CreateTaskRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	task.Title = req.Title
+	task.Description = req.Description
+	task.Status = req.Status
+	task.AssigneeAccountID = req.AssigneeAccountID
+	task.AssigneeTeamID = req.AssigneeTeamID
+	task.CreatedBy = &principal.AccountID
+
+	if err := h.usecase.Create(task); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.TaskResponse]{
+		Status: http.StatusCreated,
+		Data:   toTaskResponse(task),
+	})
+}
+
+func (h *TaskHandler) Find(c *gin.Context) {
+	task, ok := parseTask(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Find(task); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.TaskResponse]{
+		Status: http.StatusOK,
+		Data:   toTaskResponse(task),
+	})
+}
+
+func (h *TaskHandler) Update(c *gin.Context) {
+	task, ok := parseTask(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.UpdateTaskRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.usecase.Find(task); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	if req.Title != nil {
+		task.Title = *req.Title
+	}
+	if req.Description != nil {
+		task.Description = *req.Description
+	}
+	if req.Status != nil {
+		task.Status = *req.Status
+	}
+
+	if err := h.usecase.Update(task); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.TaskResponse]{
+		Status: http.StatusOK,
+		Data:   toTaskResponse(task),
+	})
+}
+
+// Assign replaces the assignees of the task with the member and the team of
+// the body, either of which may be null.
+func (h *TaskHandler) Assign(c *gin.Context) {
+	task, ok := parseTask(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.AssignTaskRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	task.AssigneeAccountID = req.AccountID
+	task.AssigneeTeamID = req.TeamID
+
+	if err := h.usecase.Assign(task); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.TaskResponse]{
+		Status: http.StatusOK,
+		Data:   toTaskResponse(task),
+	})
+}
+
+func (h *TaskHandler) Delete(c *gin.Context) {
+	task, ok := parseTask(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Delete(task); err != nil {
+		respondTaskError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// requirePrincipal returns the authenticated caller, answering 401 when the
+// route was reached without one.
+func requirePrincipal(c *gin.Context) (*auth.Principal, bool) {
+	principal, ok := auth.CurrentPrincipal(c)
+
+	if !ok {
+		c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+			Status:  http.StatusUnauthorized,
+			Message: "Authentication required",
+		})
+	}
+
+	return principal, ok
+}
+
+// parseProject reads the workspace and project IDs from the path into a
+// task of that project.
+func parseProject(c *gin.Context) (*entity.TaskEntity, bool) {
+	workspaceID, err := uuid.Parse(c.Param("ws"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid workspace ID")
+		return nil, false
+	}
+
+	projectID, err := uuid.Parse(c.Param("id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid project ID")
+		return nil, false
+	}
+
+	return &entity.TaskEntity{WorkspaceID: workspaceID, ProjectID: projectID}, true
+}
+
+func parseTask(c *gin.Context) (*entity.TaskEntity, bool) {
+	task, ok := parseProject(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	taskID, err := uuid.Parse(c.Param("task_id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid task ID")
+		return nil, false
+	}
+
+	task.ID = taskID
+
+	return task, true
+}
+
+// optionalUUID parses an ID of the query string, already validated, into
+// nil when it was left out.
+func optionalUUID(value string) *uuid.UUID {
+	id, err := uuid.Parse(value)
+
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
+
+func respondBadRequest(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+		Status:  http.StatusBadRequest,
+		Message: message,
+	})
+}
+
+func respondTaskError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Task not found",
+		})
+	case errors.Is(err, repository.ErrProjectNotFound):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Project not found",
+		})
+	case errors.Is(err, repository.ErrInvalidAssignee):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Assignee is not a member or a team of the workspace",
+		})
+	case errors.Is(err, usecase.ErrBlankTaskTitle):
+		respondBadRequest(c, "title cannot be blank")
+	default:
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
+
+func toTaskResponse(task *entity.TaskEntity) dto.TaskResponse {
+	return dto.TaskResponse{
+		ID:                task.ID,
+		WorkspaceID:       task.WorkspaceID,
+		ProjectID:         task.ProjectID,
+		Title:             task.Title,
+		Description:       task.Description,
+		Status:            task.Status,
+		AssigneeAccountID: task.AssigneeAccountID,
+		AssigneeTeamID:    task.AssigneeTeamID,
+		CreatedBy:         task.CreatedBy,
+		CreatedAt:         task.CreatedAt,
+		UpdatedAt:         task.UpdatedAt,
+	}
+}
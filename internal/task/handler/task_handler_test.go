@@ -0,0 +1,338 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/shared/auth"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/task/dto"
+	"trilha-api/internal/task/entity"
+	"trilha-api/internal/task/handler"
+	"trilha-api/internal/task/mocks"
+	"trilha-api/internal/task/repository"
+	usecase "trilha-api/internal/task/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	workspaceID = uuid.New()
+	projectID   = uuid.New()
+	tasksPath   = "/api/v1/workspaces/" + workspaceID.String() + "/projects/" + projectID.String() + "/tasks"
+)
+
+func setup(t *testing.T) (*gin.Engine, *mocks.MockTaskUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockTaskUseCaseInterface(ctrl)
+	h := handler.New(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.GET("/api/v1/workspaces/:ws/projects/:id/tasks", h.List)
+	router.POST("/api/v1/workspaces/:ws/projects/:id/tasks", h.Create)
+	router.GET("/api/v1/workspaces/:ws/projects/:id/tasks/:task_id", h.Find)
+	router.PATCH("/api/v1/workspaces/:ws/projects/:id/tasks/:task_id", h.Update)
+	router.DELETE("/api/v1/workspaces/:ws/projects/:id/tasks/:task_id", h.Delete)
+	router.PUT("/api/v1/workspaces/:ws/projects/:id/tasks/:task_id/assignees", h.Assign)
+
+	return router, mock
+}
+
+// fakeAuthentication authenticates requests as the account in the
+// X-Account-ID header, standing in for the auth middleware.
+func fakeAuthentication() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if accountID, err := uuid.Parse(c.GetHeader("X-Account-ID")); err == nil {
+			auth.SetPrincipal(c, &auth.Principal{AccountID: accountID, Verified: true})
+		}
+		c.Next()
+	}
+}
+
+func send(router *gin.Engine, method, path string, accountID uuid.UUID, body any) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(method, path, &buf)
+	r.Header.Set("Content-Type", "application/json")
+	if accountID != uuid.Nil {
+		r.Header.Set("X-Account-ID", accountID.String())
+	}
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func TestTaskHandler_Create(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	creatorID := uuid.New()
+	teamID := uuid.New()
+
+	t.Run("should return status 201 and the task created for the team", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).DoAndReturn(func(task *entity.TaskEntity) error {
+			assert.Equal(t, workspaceID, task.WorkspaceID)
+			assert.Equal(t, projectID, task.ProjectID)
+			assert.Equal(t, &creatorID, task.CreatedBy)
+			assert.Equal(t, &teamID, task.AssigneeTeamID)
+			assert.Nil(t, task.AssigneeAccountID)
+			task.ID = uuid.New()
+			task.Status = entity.TaskStatusTodo
+			return nil
+		})
+
+		w := send(router, http.MethodPost, tasksPath, creatorID, dto.CreateTaskRequest{
+			Title:          "Revisar o roteiro",
+			AssigneeTeamID: &teamID,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.TaskResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TaskStatusTodo, responseBody.Data.Status)
+		assert.Equal(t, &teamID, responseBody.Data.AssigneeTeamID)
+		assert.Nil(t, responseBody.Data.AssigneeAccountID)
+	})
+
+	t.Run("should return status 400 for an unknown status", func(t *testing.T) {
+		w := send(router, http.MethodPost, tasksPath, creatorID, dto.CreateTaskRequest{Title: "Revisar o roteiro", Status: "blocked"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 for a blank title", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).Return(usecase.ErrBlankTaskTitle)
+
+		w := send(router, http.MethodPost, tasksPath, creatorID, dto.CreateTaskRequest{Title: "  "})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 404 when the project is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).Return(repository.ErrProjectNotFound)
+
+		w := send(router, http.MethodPost, tasksPath, creatorID, dto.CreateTaskRequest{Title: "Revisar o roteiro"})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := send(router, http.MethodPost, tasksPath, uuid.Nil, dto.CreateTaskRequest{Title: "Revisar o roteiro"})
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestTaskHandler_List(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	viewerID := uuid.New()
+	accountID := uuid.New()
+	teamID := uuid.New()
+
+	t.Run("should return the page of tasks of the member and the team", func(t *testing.T) {
+		mockUseCase.EXPECT().List(gomock.Any()).DoAndReturn(func(filter *entity.TaskFilter) ([]entity.TaskEntity, int64, error) {
+			assert.Equal(t, workspaceID, filter.WorkspaceID)
+			assert.Equal(t, projectID, filter.ProjectID)
+			assert.Equal(t, &accountID, filter.AssigneeAccountID)
+			assert.Equal(t, &teamID, filter.AssigneeTeamID)
+			assert.Equal(t, entity.TaskStatusDone, filter.Status)
+			filter.Page = 1
+			filter.PerPage = 20
+			return []entity.TaskEntity{{ID: uuid.New(), Title: "Revisar o roteiro"}}, 1, nil
+		})
+
+		w := send(router, http.MethodGet, tasksPath+"?status=done&assignee_account_id="+accountID.String()+"&assignee_team_id="+teamID.String(), viewerID, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[sharedDto.Page[dto.TaskResponse]]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Len(t, responseBody.Data.Items, 1)
+		assert.Equal(t, int64(1), responseBody.Data.Total)
+	})
+
+	t.Run("should not filter on the assignees left out", func(t *testing.T) {
+		mockUseCase.EXPECT().List(gomock.Any()).DoAndReturn(func(filter *entity.TaskFilter) ([]entity.TaskEntity, int64, error) {
+			assert.Nil(t, filter.AssigneeAccountID)
+			assert.Nil(t, filter.AssigneeTeamID)
+			return []entity.TaskEntity{}, 0, nil
+		})
+
+		w := send(router, http.MethodGet, tasksPath, viewerID, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid team ID", func(t *testing.T) {
+		w := send(router, http.MethodGet, tasksPath+"?assignee_team_id=fellowship", viewerID, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid project ID", func(t *testing.T) {
+		w := send(router, http.MethodGet, "/api/v1/workspaces/"+workspaceID.String()+"/projects/shire/tasks", viewerID, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTaskHandler_Find(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	viewerID := uuid.New()
+	taskID := uuid.New()
+
+	t.Run("should look the task up among those of the project", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(&entity.TaskEntity{ID: taskID, ProjectID: projectID, WorkspaceID: workspaceID}).Return(nil)
+
+		w := send(router, http.MethodGet, tasksPath+"/"+taskID.String(), viewerID, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 404 when the task is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodGet, tasksPath+"/"+taskID.String(), viewerID, nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid ID", func(t *testing.T) {
+		w := send(router, http.MethodGet, tasksPath+"/frodo", viewerID, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTaskHandler_Update(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	editorID := uuid.New()
+	taskID := uuid.New()
+
+	t.Run("should change only the fields sent", func(t *testing.T) {
+		teamID := uuid.New()
+		status := entity.TaskStatusInProgress
+
+		gomock.InOrder(
+			mockUseCase.EXPECT().Find(gomock.Any()).DoAndReturn(func(task *entity.TaskEntity) error {
+				task.Title = "Revisar o roteiro"
+				task.Status = entity.TaskStatusTodo
+				task.AssigneeTeamID = &teamID
+				return nil
+			}),
+			mockUseCase.EXPECT().Update(gomock.Any()).DoAndReturn(func(task *entity.TaskEntity) error {
+				assert.Equal(t, "Revisar o roteiro", task.Title)
+				assert.Equal(t, entity.TaskStatusInProgress, task.Status)
+				assert.Equal(t, &teamID, task.AssigneeTeamID)
+				return nil
+			}),
+		)
+
+		w := send(router, http.MethodPatch, tasksPath+"/"+taskID.String(), editorID, dto.UpdateTaskRequest{Status: &status})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 404 when the task is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodPatch, tasksPath+"/"+taskID.String(), editorID, dto.UpdateTaskRequest{})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestTaskHandler_Assign(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	editorID := uuid.New()
+	taskID := uuid.New()
+	accountID := uuid.New()
+	teamID := uuid.New()
+
+	t.Run("should return status 200 and the task assigned to the member and the team", func(t *testing.T) {
+		mockUseCase.EXPECT().Assign(&entity.TaskEntity{
+			ID:                taskID,
+			ProjectID:         projectID,
+			WorkspaceID:       workspaceID,
+			AssigneeAccountID: &accountID,
+			AssigneeTeamID:    &teamID,
+		}).Return(nil)
+
+		w := send(router, http.MethodPut, tasksPath+"/"+taskID.String()+"/assignees", editorID, dto.AssignTaskRequest{
+			AccountID: &accountID,
+			TeamID:    &teamID,
+		})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.TaskResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, &accountID, responseBody.Data.AssigneeAccountID)
+		assert.Equal(t, &teamID, responseBody.Data.AssigneeTeamID)
+	})
+
+	t.Run("should unassign the task when both assignees are null", func(t *testing.T) {
+		mockUseCase.EXPECT().Assign(&entity.TaskEntity{ID: taskID, ProjectID: projectID, WorkspaceID: workspaceID}).Return(nil)
+
+		w := send(router, http.MethodPut, tasksPath+"/"+taskID.String()+"/assignees", editorID, dto.AssignTaskRequest{})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 404 for assignees out of the workspace", func(t *testing.T) {
+		mockUseCase.EXPECT().Assign(gomock.Any()).Return(repository.ErrInvalidAssignee)
+
+		w := send(router, http.MethodPut, tasksPath+"/"+taskID.String()+"/assignees", editorID, dto.AssignTaskRequest{TeamID: &teamID})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 404 when the task is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Assign(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodPut, tasksPath+"/"+taskID.String()+"/assignees", editorID, dto.AssignTaskRequest{TeamID: &teamID})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestTaskHandler_Delete(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	editorID := uuid.New()
+	taskID := uuid.New()
+
+	t.Run("should return status 204 on success", func(t *testing.T) {
+		mockUseCase.EXPECT().Delete(&entity.TaskEntity{ID: taskID, ProjectID: projectID, WorkspaceID: workspaceID}).Return(nil)
+
+		w := send(router, http.MethodDelete, tasksPath+"/"+taskID.String(), editorID, nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when the task is not found", func(t *testing.T) {
+		mockUseCase.EXPECT().Delete(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodDelete, tasksPath+"/"+taskID.String(), editorID, nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_repository.go -destination=../mocks/task_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/task/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskRepositoryInterface is a mock of TaskRepositoryInterface interface.
+type MockTaskRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskRepositoryInterfaceMockRecorder is the mock recorder for MockTaskRepositoryInterface.
+type MockTaskRepositoryInterfaceMockRecorder struct {
+	mock *MockTaskRepositoryInterface
+}
+
+// NewMockTaskRepositoryInterface creates a new mock instance.
+func NewMockTaskRepositoryInterface(ctrl *gomock.Controller) *MockTaskRepositoryInterface {
+	mock := &MockTaskRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockTaskRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskRepositoryInterface) EXPECT() *MockTaskRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockTaskRepositoryInterface) Assign(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Assign(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Assign), task)
+}
+
+// Count mocks base method.
+func (m *MockTaskRepositoryInterface) Count(filter entity.TaskFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Count(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Count), filter)
+}
+
+// Create mocks base method.
+func (m *MockTaskRepositoryInterface) Create(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Create(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Create), task)
+}
+
+// Delete mocks base method.
+func (m *MockTaskRepositoryInterface) Delete(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Delete(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Delete), task)
+}
+
+// Find mocks base method.
+func (m *MockTaskRepositoryInterface) Find(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Find(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Find), task)
+}
+
+// List mocks base method.
+func (m *MockTaskRepositoryInterface) List(filter entity.TaskFilter) ([]entity.TaskEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", filter)
+	ret0, _ := ret[0].([]entity.TaskEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) List(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).List), filter)
+}
+
+// ListByAccount mocks base method.
+func (m *MockTaskRepositoryInterface) ListByAccount(workspaceID, accountID uuid.UUID) ([]entity.TaskEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", workspaceID, accountID)
+	ret0, _ := ret[0].([]entity.TaskEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) ListByAccount(workspaceID, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).ListByAccount), workspaceID, accountID)
+}
+
+// Update mocks base method.
+func (m *MockTaskRepositoryInterface) Update(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Update(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Update), task)
+}
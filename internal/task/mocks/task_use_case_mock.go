@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_use_case.go -destination=../mocks/task_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/task/entity"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskUseCaseInterface is a mock of TaskUseCaseInterface interface.
+type MockTaskUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskUseCaseInterfaceMockRecorder is the mock recorder for MockTaskUseCaseInterface.
+type MockTaskUseCaseInterfaceMockRecorder struct {
+	mock *MockTaskUseCaseInterface
+}
+
+// NewMockTaskUseCaseInterface creates a new mock instance.
+func NewMockTaskUseCaseInterface(ctrl *gomock.Controller) *MockTaskUseCaseInterface {
+	mock := &MockTaskUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockTaskUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskUseCaseInterface) EXPECT() *MockTaskUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockTaskUseCaseInterface) Assign(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockTaskUseCaseInterfaceMockRecorder) Assign(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockTaskUseCaseInterface)(nil).Assign), task)
+}
+
+// Create mocks base method.
+func (m *MockTaskUseCaseInterface) Create(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaskUseCaseInterfaceMockRecorder) Create(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskUseCaseInterface)(nil).Create), task)
+}
+
+// Delete mocks base method.
+func (m *MockTaskUseCaseInterface) Delete(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaskUseCaseInterfaceMockRecorder) Delete(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskUseCaseInterface)(nil).Delete), task)
+}
+
+// Find mocks base method.
+func (m *MockTaskUseCaseInterface) Find(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockTaskUseCaseInterfaceMockRecorder) Find(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTaskUseCaseInterface)(nil).Find), task)
+}
+
+// List mocks base method.
+func (m *MockTaskUseCaseInterface) List(filter *entity.TaskFilter) ([]entity.TaskEntity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", filter)
+	ret0, _ := ret[0].([]entity.TaskEntity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTaskUseCaseInterfaceMockRecorder) List(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskUseCaseInterface)(nil).List), filter)
+}
+
+// Update mocks base method.
+func (m *MockTaskUseCaseInterface) Update(task *entity.TaskEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskUseCaseInterfaceMockRecorder) Update(task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskUseCaseInterface)(nil).Update), task)
+}
@@ -0,0 +1,304 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/tenant"
+	"trilha-api/internal/shared/utils"
+	"trilha-api/internal/task/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+	ErrInvalidAssignee = errors.New("assignee not a member or team of the workspace")
+)
+
+// TaskRepository reaches tasks through the scope of their workspace, where
+// the projects they belong to can be read.
+type TaskRepository struct {
+	scope tenant.Scope
+}
+
+//go:generate mockgen -source=task_repository.go -destination=../mocks/task_repository_mock.go -package=mocks
+
+type TaskRepositoryInterface interface {
+	Create(task *entity.TaskEntity) error
+	Find(task *entity.TaskEntity) error
+	List(filter entity.TaskFilter) ([]entity.TaskEntity, error)
+	Count(filter entity.TaskFilter) (int64, error)
+	ListByAccount(workspaceID, accountID uuid.UUID) ([]entity.TaskEntity, error)
+	Update(task *entity.TaskEntity) error
+	Assign(task *entity.TaskEntity) error
+	Delete(task *entity.TaskEntity) error
+}
+
+func New(scope tenant.Scope) *TaskRepository {
+	return &TaskRepository{scope: scope}
+}
+
+// Create stores the task along with its assignees, in one transaction. It
+// fails with ErrProjectNotFound when the workspace has no such project and
+// with ErrInvalidAssignee when an assignee is not a member or a team of the
+// workspace, storing nothing then.
+func (r *TaskRepository) Create(task *entity.TaskEntity) error {
+	fields := db.CreateTaskParams{
+		Title:       task.Title,
+		Description: task.Description,
+		Status:      task.Status,
+		ProjectID:   task.ProjectID,
+		WorkspaceID: task.WorkspaceID,
+	}
+	if task.CreatedBy != nil {
+		fields.CreatedBy = *task.CreatedBy
+	}
+
+	assignees := db.AssignTaskParams{
+		AssigneeAccountID: utils.UUIDToPgUUID(task.AssigneeAccountID),
+		AssigneeTeamID:    utils.UUIDToPgUUID(task.AssigneeTeamID),
+		ProjectID:         task.ProjectID,
+		WorkspaceID:       task.WorkspaceID,
+	}
+
+	var created db.Task
+	err := r.scope.Run(task.WorkspaceID, func(q db.Querier) error {
+		var err error
+		created, err = q.CreateTask(context.Background(), fields)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrProjectNotFound
+			}
+			return fmt.Errorf("erro ao criar tarefa: %w", err)
+		}
+
+		if task.AssigneeAccountID == nil && task.AssigneeTeamID == nil {
+			return nil
+		}
+
+		assignees.ID = created.ID
+		created, err = q.AssignTask(context.Background(), assignees)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrInvalidAssignee
+			}
+			return fmt.Errorf("erro ao atribuir tarefa: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	*task = toTaskEntity(created)
+
+	return nil
+}
+
+// Find loads the task of task.ProjectID with task.ID.
+func (r *TaskRepository) Find(task *entity.TaskEntity) error {
+	fields := db.FindTaskParams{
+		ID:          task.ID,
+		ProjectID:   task.ProjectID,
+		WorkspaceID: task.WorkspaceID,
+	}
+
+	var found db.Task
+	err := r.scope.Run(task.WorkspaceID, func(q db.Querier) error {
+		var err error
+		found, err = q.FindTask(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	*task = toTaskEntity(found)
+
+	return nil
+}
+
+func (r *TaskRepository) List(filter entity.TaskFilter) ([]entity.TaskEntity, error) {
+	fields := db.ListTasksParams{
+		ProjectID:         filter.ProjectID,
+		WorkspaceID:       filter.WorkspaceID,
+		Status:            utils.ToPgText(filter.Status),
+		AssigneeAccountID: utils.UUIDToPgUUID(filter.AssigneeAccountID),
+		AssigneeTeamID:    utils.UUIDToPgUUID(filter.AssigneeTeamID),
+		RowLimit:          int32(filter.PerPage),
+		RowOffset:         int32((filter.Page - 1) * filter.PerPage),
+	}
+
+	var rows []db.Task
+	err := r.scope.Run(filter.WorkspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.ListTasks(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar tarefas: %w", err)
+	}
+
+	return toTaskEntities(rows), nil
+}
+
+// Count returns how many tasks match the filter, regardless of its page.
+func (r *TaskRepository) Count(filter entity.TaskFilter) (int64, error) {
+	fields := db.CountTasksParams{
+		ProjectID:         filter.ProjectID,
+		WorkspaceID:       filter.WorkspaceID,
+		Status:            utils.ToPgText(filter.Status),
+		AssigneeAccountID: utils.UUIDToPgUUID(filter.AssigneeAccountID),
+		AssigneeTeamID:    utils.UUIDToPgUUID(filter.AssigneeTeamID),
+	}
+
+	var total int64
+	err := r.scope.Run(filter.WorkspaceID, func(q db.Querier) error {
+		var err error
+		total, err = q.CountTasks(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("erro ao contar tarefas: %w", err)
+	}
+
+	return total, nil
+}
+
+// ListByAccount returns every task of the workspace the account created or
+// is assigned to, those of deleted projects included.
+func (r *TaskRepository) ListByAccount(workspaceID, accountID uuid.UUID) ([]entity.TaskEntity, error) {
+	fields := db.ListAccountTasksParams{
+		WorkspaceID: workspaceID,
+		AccountID:   accountID,
+	}
+
+	var rows []db.Task
+	err := r.scope.Run(workspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.ListAccountTasks(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar tarefas da conta: %w", err)
+	}
+
+	return toTaskEntities(rows), nil
+}
+
+// Update saves the title, description and status of the task.
+func (r *TaskRepository) Update(task *entity.TaskEntity) error {
+	fields := db.UpdateTaskParams{
+		Title:       task.Title,
+		Description: task.Description,
+		Status:      task.Status,
+		ID:          task.ID,
+		ProjectID:   task.ProjectID,
+		WorkspaceID: task.WorkspaceID,
+	}
+
+	var updated db.Task
+	err := r.scope.Run(task.WorkspaceID, func(q db.Querier) error {
+		var err error
+		updated, err = q.UpdateTask(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	*task = toTaskEntity(updated)
+
+	return nil
+}
+
+// Assign saves the assignees of the task, a nil one leaving the task
+// without it. It fails with ErrInvalidAssignee when the task is left
+// untouched: an assignee is not a member or a team of the workspace, or the
+// task is gone.
+func (r *TaskRepository) Assign(task *entity.TaskEntity) error {
+	fields := db.AssignTaskParams{
+		AssigneeAccountID: utils.UUIDToPgUUID(task.AssigneeAccountID),
+		AssigneeTeamID:    utils.UUIDToPgUUID(task.AssigneeTeamID),
+		ID:                task.ID,
+		ProjectID:         task.ProjectID,
+		WorkspaceID:       task.WorkspaceID,
+	}
+
+	var assigned db.Task
+	err := r.scope.Run(task.WorkspaceID, func(q db.Querier) error {
+		var err error
+		assigned, err = q.AssignTask(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidAssignee
+		}
+		return fmt.Errorf("erro ao atribuir tarefa: %w", err)
+	}
+
+	*task = toTaskEntity(assigned)
+
+	return nil
+}
+
+func (r *TaskRepository) Delete(task *entity.TaskEntity) error {
+	fields := db.DeleteTaskParams{
+		ID:          task.ID,
+		ProjectID:   task.ProjectID,
+		WorkspaceID: task.WorkspaceID,
+	}
+
+	var rows int64
+	err := r.scope.Run(task.WorkspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.DeleteTask(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("erro ao remover tarefa: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func toTaskEntity(task db.Task) entity.TaskEntity {
+	return entity.TaskEntity{
+		ID:                task.ID,
+		WorkspaceID:       task.WorkspaceID,
+		ProjectID:         task.ProjectID,
+		Title:             task.Title,
+		Description:       task.Description,
+		Status:            task.Status,
+		AssigneeAccountID: utils.PgUUIDToUUID(task.AssigneeAccountID),
+		AssigneeTeamID:    utils.PgUUIDToUUID(task.AssigneeTeamID),
+		CreatedBy:         utils.PgUUIDToUUID(task.CreatedBy),
+		CreatedAt:         task.CreatedAt.Time,
+		UpdatedAt:         task.UpdatedAt.Time,
+	}
+}
+
+func toTaskEntities(rows []db.Task) []entity.TaskEntity {
+	tasks := make([]entity.TaskEntity, 0, len(rows))
+	for _, row := range rows {
+		tasks = append(tasks, toTaskEntity(row))
+	}
+	return tasks
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/task/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// scopeStub runs the queries on the mocked querier, remembering the
+// workspace they were scoped to.
+type scopeStub struct {
+	querier   db.Querier
+	workspace uuid.UUID
+}
+
+func (s *scopeStub) Run(workspaceID uuid.UUID, fn func(q db.Querier) error) error {
+	s.workspace = workspaceID
+	return fn(s.querier)
+}
+
+func setup(t *testing.T) (*mocks.MockQuerier, *scopeStub, *TaskRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	scope := &scopeStub{querier: dbMock}
+	repo := New(scope)
+
+	return dbMock, scope, repo
+}
+
+func TestTaskRepository_Create(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	workspaceID := uuid.New()
+	projectID := uuid.New()
+	creatorID := uuid.New()
+	teamID := uuid.New()
+
+	created := db.Task{
+		ID:          uuid.New(),
+		ProjectID:   projectID,
+		WorkspaceID: workspaceID,
+		Title:       "Revisar o roteiro",
+		Status:      entity.TaskStatusTodo,
+		CreatedBy:   pgtype.UUID{Bytes: creatorID, Valid: true},
+		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+
+	t.Run("should create an unassigned task in its project", func(t *testing.T) {
+		task := &entity.TaskEntity{
+			WorkspaceID: workspaceID,
+			ProjectID:   projectID,
+			Title:       "Revisar o roteiro",
+			Status:      entity.TaskStatusTodo,
+			CreatedBy:   &creatorID,
+		}
+
+		params := db.CreateTaskParams{
+			Title:       "Revisar o roteiro",
+			Status:      entity.TaskStatusTodo,
+			CreatedBy:   creatorID,
+			ProjectID:   projectID,
+			WorkspaceID: workspaceID,
+		}
+
+		dbMock.EXPECT().CreateTask(context.Background(), params).Return(created, nil)
+
+		err := repo.Create(task)
+
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, scope.workspace)
+		assert.Equal(t, created.ID, task.ID)
+		assert.Equal(t, &creatorID, task.CreatedBy)
+		assert.Nil(t, task.AssigneeAccountID)
+		assert.Nil(t, task.AssigneeTeamID)
+	})
+
+	t.Run("should assign the task to its team in the same transaction", func(t *testing.T) {
+		task := &entity.TaskEntity{WorkspaceID: workspaceID, ProjectID: projectID, Title: "Revisar o roteiro", AssigneeTeamID: &teamID}
+
+		assigned := created
+		assigned.AssigneeTeamID = pgtype.UUID{Bytes: teamID, Valid: true}
+
+		gomock.InOrder(
+			dbMock.EXPECT().CreateTask(context.Background(), gomock.Any()).Return(created, nil),
+			dbMock.EXPECT().AssignTask(context.Background(), db.AssignTaskParams{
+				AssigneeTeamID: pgtype.UUID{Bytes: teamID, Valid: true},
+				ID:             created.ID,
+				ProjectID:      projectID,
+				WorkspaceID:    workspaceID,
+			}).Return(assigned, nil),
+		)
+
+		err := repo.Create(task)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &teamID, task.AssigneeTeamID)
+		assert.Nil(t, task.AssigneeAccountID)
+	})
+
+	t.Run("should report assignees out of the workspace", func(t *testing.T) {
+		accountID := uuid.New()
+		task := &entity.TaskEntity{WorkspaceID: workspaceID, ProjectID: projectID, Title: "Revisar o roteiro", AssigneeAccountID: &accountID}
+
+		dbMock.EXPECT().CreateTask(context.Background(), gomock.Any()).Return(created, nil)
+		dbMock.EXPECT().AssignTask(context.Background(), gomock.Any()).Return(db.Task{}, sql.ErrNoRows)
+
+		assert.ErrorIs(t, repo.Create(task), ErrInvalidAssignee)
+	})
+
+	t.Run("should report a missing project", func(t *testing.T) {
+		dbMock.EXPECT().CreateTask(context.Background(), gomock.Any()).Return(db.Task{}, sql.ErrNoRows)
+
+		err := repo.Create(&entity.TaskEntity{WorkspaceID: workspaceID, ProjectID: projectID, Title: "Revisar o roteiro"})
+
+		assert.ErrorIs(t, err, ErrProjectNotFound)
+	})
+
+	t.Run("should wrap other errors", func(t *testing.T) {
+		dbMock.EXPECT().CreateTask(context.Background(), gomock.Any()).Return(db.Task{}, errors.New("database error"))
+
+		err := repo.Create(&entity.TaskEntity{WorkspaceID: workspaceID, ProjectID: projectID, Title: "Revisar o roteiro"})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrProjectNotFound)
+	})
+}
+
+func TestTaskRepository_List(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	workspaceID := uuid.New()
+	projectID := uuid.New()
+	teamID := uuid.New()
+
+	t.Run("should page the tasks of the team", func(t *testing.T) {
+		filter := entity.TaskFilter{WorkspaceID: workspaceID, ProjectID: projectID, AssigneeTeamID: &teamID, Page: 2, PerPage: 20}
+
+		params := db.ListTasksParams{
+			ProjectID:      projectID,
+			WorkspaceID:    workspaceID,
+			AssigneeTeamID: pgtype.UUID{Bytes: teamID, Valid: true},
+			RowLimit:       20,
+			RowOffset:      20,
+		}
+
+		dbMock.EXPECT().ListTasks(context.Background(), params).Return([]db.Task{
+			{ID: uuid.New(), Title: "Revisar o roteiro", AssigneeTeamID: pgtype.UUID{Bytes: teamID, Valid: true}},
+		}, nil)
+
+		tasks, err := repo.List(filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, scope.workspace)
+		assert.Len(t, tasks, 1)
+		assert.Equal(t, &teamID, tasks[0].AssigneeTeamID)
+	})
+}
+
+func TestTaskRepository_Assign(t *testing.T) {
+	dbMock, _, repo := setup(t)
+
+	accountID := uuid.New()
+	task := &entity.TaskEntity{ID: uuid.New(), ProjectID: uuid.New(), WorkspaceID: uuid.New()}
+
+	t.Run("should assign the task to the member, leaving it without a team", func(t *testing.T) {
+		assignee := *task
+		assignee.AssigneeAccountID = &accountID
+
+		params := db.AssignTaskParams{
+			AssigneeAccountID: pgtype.UUID{Bytes: accountID, Valid: true},
+			ID:                task.ID,
+			ProjectID:         task.ProjectID,
+			WorkspaceID:       task.WorkspaceID,
+		}
+
+		dbMock.EXPECT().AssignTask(context.Background(), params).Return(db.Task{
+			ID:                task.ID,
+			AssigneeAccountID: pgtype.UUID{Bytes: accountID, Valid: true},
+		}, nil)
+
+		assert.NoError(t, repo.Assign(&assignee))
+		assert.Equal(t, &accountID, assignee.AssigneeAccountID)
+		assert.Nil(t, assignee.AssigneeTeamID)
+	})
+
+	t.Run("should report assignees out of the workspace", func(t *testing.T) {
+		dbMock.EXPECT().AssignTask(context.Background(), gomock.Any()).Return(db.Task{}, sql.ErrNoRows)
+
+		assert.ErrorIs(t, repo.Assign(task), ErrInvalidAssignee)
+	})
+}
+
+func TestTaskRepository_Delete(t *testing.T) {
+	dbMock, _, repo := setup(t)
+
+	task := &entity.TaskEntity{ID: uuid.New(), ProjectID: uuid.New(), WorkspaceID: uuid.New()}
+
+	t.Run("should delete the task", func(t *testing.T) {
+		dbMock.EXPECT().DeleteTask(context.Background(), db.DeleteTaskParams{ID: task.ID, ProjectID: task.ProjectID, WorkspaceID: task.WorkspaceID}).Return(int64(1), nil)
+
+		assert.NoError(t, repo.Delete(task))
+	})
+
+	t.Run("should report a missing task", func(t *testing.T) {
+		dbMock.EXPECT().DeleteTask(context.Background(), gomock.Any()).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.Delete(task), sql.ErrNoRows)
+	})
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"time"
+	"trilha-api/internal/shared/privacy"
+	"trilha-api/internal/task/entity"
+	"trilha-api/internal/task/repository"
+
+	"github.com/google/uuid"
+)
+
+// AccountWorkspaces lists the workspaces an account belongs to.
+type AccountWorkspaces interface {
+	ListWorkspaceIDs(accountID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// TaskDataUseCase takes part in data exports with the tasks the account
+// created or is assigned to in the workspaces it belongs to. Tasks are not
+// erased with the account: they belong to their project.
+type TaskDataUseCase struct {
+	repo       repository.TaskRepositoryInterface
+	workspaces AccountWorkspaces
+}
+
+func NewTaskDataUseCase(repo repository.TaskRepositoryInterface, workspaces AccountWorkspaces) *TaskDataUseCase {
+	return &TaskDataUseCase{repo: repo, workspaces: workspaces}
+}
+
+type taskExport struct {
+	ID                uuid.UUID  `json:"id"`
+	WorkspaceID       uuid.UUID  `json:"workspace_id"`
+	ProjectID         uuid.UUID  `json:"project_id"`
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	Status            string     `json:"status"`
+	AssigneeAccountID *uuid.UUID `json:"assignee_account_id"`
+	AssigneeTeamID    *uuid.UUID `json:"assignee_team_id"`
+	CreatedBy         *uuid.UUID `json:"created_by"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+func (uc *TaskDataUseCase) ExportAccountData(accountID uuid.UUID) ([]privacy.Section, error) {
+	workspaceIDs, err := uc.workspaces.ListWorkspaceIDs(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := make([]taskExport, 0)
+	for _, workspaceID := range workspaceIDs {
+		tasks, err := uc.repo.ListByAccount(workspaceID, accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, task := range tasks {
+			exported = append(exported, toTaskExport(task))
+		}
+	}
+
+	return []privacy.Section{{Name: "tasks", Data: exported}}, nil
+}
+
+func toTaskExport(task entity.TaskEntity) taskExport {
+	return taskExport{
+		ID:                task.ID,
+		WorkspaceID:       task.WorkspaceID,
+		ProjectID:         task.ProjectID,
+		Title:             task.Title,
+		Description:       task.Description,
+		Status:            task.Status,
+		AssigneeAccountID: task.AssigneeAccountID,
+		AssigneeTeamID:    task.AssigneeTeamID,
+		CreatedBy:         task.CreatedBy,
+		CreatedAt:         task.CreatedAt,
+		UpdatedAt:         task.UpdatedAt,
+	}
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"trilha-api/internal/task/entity"
+	"trilha-api/internal/task/repository"
+)
+
+const (
+	DefaultTasksPerPage = 20
+	MaxTasksPerPage     = 100
+)
+
+var ErrBlankTaskTitle = errors.New("blank task title")
+
+//go:generate mockgen -source=task_use_case.go -destination=../mocks/task_use_case_mock.go -package=mocks
+type TaskUseCaseInterface interface {
+	Create(task *entity.TaskEntity) error
+	Find(task *entity.TaskEntity) error
+	List(filter *entity.TaskFilter) ([]entity.TaskEntity, int64, error)
+	Update(task *entity.TaskEntity) error
+	Assign(task *entity.TaskEntity) error
+	Delete(task *entity.TaskEntity) error
+}
+
+type TaskUseCase struct {
+	repo repository.TaskRepositoryInterface
+}
+
+func New(repo repository.TaskRepositoryInterface) *TaskUseCase {
+	return &TaskUseCase{repo: repo}
+}
+
+// Create stores a new task of task.ProjectID, created by task.CreatedBy and
+// assigned to the member, the team or both given in it. The status defaults
+// to todo.
+func (uc *TaskUseCase) Create(task *entity.TaskEntity) error {
+	if task.Status == "" {
+		task.Status = entity.TaskStatusTodo
+	}
+
+	if err := normalize(task); err != nil {
+		return err
+	}
+
+	return uc.repo.Create(task)
+}
+
+func (uc *TaskUseCase) Find(task *entity.TaskEntity) error {
+	return uc.repo.Find(task)
+}
+
+// List returns a page of the tasks of filter.ProjectID and how many match
+// the filter overall. The page defaults to the first and its size to
+// DefaultTasksPerPage, capped at MaxTasksPerPage.
+func (uc *TaskUseCase) List(filter *entity.TaskFilter) ([]entity.TaskEntity, int64, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 {
+		filter.PerPage = DefaultTasksPerPage
+	}
+	if filter.PerPage > MaxTasksPerPage {
+		filter.PerPage = MaxTasksPerPage
+	}
+
+	total, err := uc.repo.Count(*filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tasks, err := uc.repo.List(*filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
+
+func (uc *TaskUseCase) Update(task *entity.TaskEntity) error {
+	if err := normalize(task); err != nil {
+		return err
+	}
+
+	return uc.repo.Update(task)
+}
+
+// Assign replaces the assignees of the task with task.AssigneeAccountID and
+// task.AssigneeTeamID, a nil one leaving the task without it. It fails with
+// sql.ErrNoRows when the project has no such task and with
+// repository.ErrInvalidAssignee when the account is not a member of the
+// workspace or the team is not one of its teams.
+func (uc *TaskUseCase) Assign(task *entity.TaskEntity) error {
+	found := &entity.TaskEntity{ID: task.ID, ProjectID: task.ProjectID, WorkspaceID: task.WorkspaceID}
+
+	if err := uc.repo.Find(found); err != nil {
+		return err
+	}
+
+	return uc.repo.Assign(task)
+}
+
+func (uc *TaskUseCase) Delete(task *entity.TaskEntity) error {
+	return uc.repo.Delete(task)
+}
+
+// normalize trims the title and the description of the task, which must
+// keep a title.
+func normalize(task *entity.TaskEntity) error {
+	task.Title = strings.TrimSpace(task.Title)
+	task.Description = strings.TrimSpace(task.Description)
+
+	if task.Title == "" {
+		return ErrBlankTaskTitle
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"trilha-api/internal/task/entity"
+	"trilha-api/internal/task/mocks"
+	"trilha-api/internal/task/repository"
+	usecase "trilha-api/internal/task/use_case"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*mocks.MockTaskRepositoryInterface, *usecase.TaskUseCase) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockTaskRepositoryInterface(ctrl)
+
+	return repo, usecase.New(repo)
+}
+
+func TestTaskUseCase_Create(t *testing.T) {
+	repo, uc := setup(t)
+
+	t.Run("should trim the task and default the status to todo", func(t *testing.T) {
+		teamID := uuid.New()
+		task := &entity.TaskEntity{ProjectID: uuid.New(), Title: "  Revisar o roteiro ", AssigneeTeamID: &teamID}
+
+		repo.EXPECT().Create(task).Return(nil)
+
+		assert.NoError(t, uc.Create(task))
+		assert.Equal(t, "Revisar o roteiro", task.Title)
+		assert.Equal(t, entity.TaskStatusTodo, task.Status)
+		assert.Equal(t, &teamID, task.AssigneeTeamID)
+	})
+
+	t.Run("should keep the status given", func(t *testing.T) {
+		task := &entity.TaskEntity{Title: "Revisar o roteiro", Status: entity.TaskStatusInProgress}
+
+		repo.EXPECT().Create(task).Return(nil)
+
+		assert.NoError(t, uc.Create(task))
+		assert.Equal(t, entity.TaskStatusInProgress, task.Status)
+	})
+
+	t.Run("should refuse a blank title", func(t *testing.T) {
+		err := uc.Create(&entity.TaskEntity{Title: "   "})
+
+		assert.ErrorIs(t, err, usecase.ErrBlankTaskTitle)
+	})
+}
+
+func TestTaskUseCase_List(t *testing.T) {
+	repo, uc := setup(t)
+
+	t.Run("should default the page and cap its size", func(t *testing.T) {
+		filter := &entity.TaskFilter{PerPage: 500}
+
+		repo.EXPECT().Count(gomock.Any()).Return(int64(3), nil)
+		repo.EXPECT().List(gomock.Any()).DoAndReturn(func(filter entity.TaskFilter) ([]entity.TaskEntity, error) {
+			assert.Equal(t, 1, filter.Page)
+			assert.Equal(t, usecase.MaxTasksPerPage, filter.PerPage)
+			return []entity.TaskEntity{{}, {}, {}}, nil
+		})
+
+		tasks, total, err := uc.List(filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, tasks, 3)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("should use the default page size", func(t *testing.T) {
+		filter := &entity.TaskFilter{}
+
+		repo.EXPECT().Count(gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().List(gomock.Any()).Return([]entity.TaskEntity{}, nil)
+
+		_, _, err := uc.List(filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, usecase.DefaultTasksPerPage, filter.PerPage)
+	})
+}
+
+func TestTaskUseCase_Update(t *testing.T) {
+	repo, uc := setup(t)
+
+	t.Run("should save the trimmed task", func(t *testing.T) {
+		task := &entity.TaskEntity{Title: "Revisar o roteiro ", Description: " Até sexta "}
+
+		repo.EXPECT().Update(task).Return(nil)
+
+		assert.NoError(t, uc.Update(task))
+		assert.Equal(t, "Até sexta", task.Description)
+	})
+
+	t.Run("should refuse a blank title", func(t *testing.T) {
+		assert.ErrorIs(t, uc.Update(&entity.TaskEntity{}), usecase.ErrBlankTaskTitle)
+	})
+}
+
+func TestTaskUseCase_Assign(t *testing.T) {
+	repo, uc := setup(t)
+
+	accountID := uuid.New()
+	teamID := uuid.New()
+	task := &entity.TaskEntity{ID: uuid.New(), ProjectID: uuid.New(), WorkspaceID: uuid.New()}
+	found := &entity.TaskEntity{ID: task.ID, ProjectID: task.ProjectID, WorkspaceID: task.WorkspaceID}
+
+	t.Run("should assign a found task to the member and the team", func(t *testing.T) {
+		assigned := *task
+		assigned.AssigneeAccountID = &accountID
+		assigned.AssigneeTeamID = &teamID
+
+		gomock.InOrder(
+			repo.EXPECT().Find(found).Return(nil),
+			repo.EXPECT().Assign(&assigned).Return(nil),
+		)
+
+		assert.NoError(t, uc.Assign(&assigned))
+		assert.Equal(t, &accountID, assigned.AssigneeAccountID)
+		assert.Equal(t, &teamID, assigned.AssigneeTeamID)
+	})
+
+	t.Run("should fail when the project has no such task", func(t *testing.T) {
+		repo.EXPECT().Find(found).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.Assign(task), sql.ErrNoRows)
+	})
+
+	t.Run("should report assignees out of the workspace", func(t *testing.T) {
+		repo.EXPECT().Find(found).Return(nil)
+		repo.EXPECT().Assign(task).Return(repository.ErrInvalidAssignee)
+
+		assert.ErrorIs(t, uc.Assign(task), repository.ErrInvalidAssignee)
+	})
+}
+
+type workspacesStub []uuid.UUID
+
+func (s workspacesStub) ListWorkspaceIDs(accountID uuid.UUID) ([]uuid.UUID, error) {
+	return s, nil
+}
+
+func TestTaskDataUseCase_ExportAccountData(t *testing.T) {
+	t.Run("should export the tasks of the account in each of its workspaces", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockTaskRepositoryInterface(ctrl)
+		first, second := uuid.New(), uuid.New()
+		uc := usecase.NewTaskDataUseCase(repo, workspacesStub{first, second})
+		accountID := uuid.New()
+		teamID := uuid.New()
+
+		repo.EXPECT().ListByAccount(first, accountID).Return([]entity.TaskEntity{
+			{ID: uuid.New(), WorkspaceID: first, Title: "Revisar o roteiro", CreatedBy: &accountID},
+		}, nil)
+		repo.EXPECT().ListByAccount(second, accountID).Return([]entity.TaskEntity{
+			{ID: uuid.New(), WorkspaceID: second, Title: "Publicar", AssigneeAccountID: &accountID, AssigneeTeamID: &teamID},
+		}, nil)
+
+		sections, err := uc.ExportAccountData(accountID)
+
+		require.NoError(t, err)
+		require.Len(t, sections, 1)
+		assert.Equal(t, "tasks", sections[0].Name)
+
+		exported, err := json.Marshal(sections[0].Data)
+		require.NoError(t, err)
+		assert.Contains(t, string(exported), `"title":"Revisar o roteiro"`)
+		assert.Contains(t, string(exported), `"assignee_team_id":"`+teamID.String()+`"`)
+		assert.Contains(t, string(exported), `"workspace_id":"`+second.String()+`"`)
+	})
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TeamResponse is a team. ProjectRole is the role its members hold on the
+// projects assigned to it.
+type TeamResponse struct {
+	ID          uuid.UUID `json:"id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	Name        string    `json:"name"`
+	ProjectRole string    `json:"project_role"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateTeamRequest creates a team. ProjectRole is one of project_admin,
+// project_editor, project_commenter and project_viewer, project_editor when
+// left out.
+type CreateTeamRequest struct {
+	Name        string `json:"name" binding:"required,max=200"`
+	ProjectRole string `json:"project_role"`
+}
+
+// UpdateTeamRequest holds the fields of a PATCH request; fields left out of
+// the body are kept unchanged.
+type UpdateTeamRequest struct {
+	Name        *string `json:"name" binding:"omitempty,min=1,max=200"`
+	ProjectRole *string `json:"project_role"`
+}
+
+type TeamMemberResponse struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+// SetTeamMemberRequest gives the role of a member, one of team_lead and
+// team_member.
+type SetTeamMemberRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+type TeamProjectResponse struct {
+	ProjectID  uuid.UUID `json:"project_id"`
+	Key        string    `json:"key"`
+	Name       string    `json:"name"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
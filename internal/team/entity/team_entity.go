@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResourceType is the resource type of the roles held in a team.
+const ResourceType = "team"
+
+// Roles a member may hold in a team. Leads also manage its members.
+const (
+	TeamRoleLead   = "team_lead"
+	TeamRoleMember = "team_member"
+)
+
+// TeamEntity is a team of the workspace WorkspaceID. Its members hold
+// ProjectRole on the projects assigned to it. Role is the role in the team
+// of the account the team was listed for, when it was.
+type TeamEntity struct {
+	ID          uuid.UUID
+	WorkspaceID uuid.UUID
+	Name        string
+	ProjectRole string
+	Role        string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TeamMemberEntity is an account holding Role in the team TeamID since
+// JoinedAt.
+type TeamMemberEntity struct {
+	WorkspaceID uuid.UUID
+	TeamID      uuid.UUID
+	AccountID   uuid.UUID
+	Name        string
+	Email       string
+	Role        string
+	JoinedAt    time.Time
+}
+
+// TeamProjectEntity is a project assigned to the team TeamID since
+// AssignedAt.
+type TeamProjectEntity struct {
+	WorkspaceID uuid.UUID
+	TeamID      uuid.UUID
+	ProjectID   uuid.UUID
+	Key         string
+	Name        string
+	AssignedAt  time.Time
+}
@@ -0,0 +1,410 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/team/dto"
+	"trilha-api/internal/team/entity"
+	"trilha-api/internal/team/repository"
+	usecase "trilha-api/internal/team/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TeamHandler struct {
+	usecase usecase.TeamUseCaseInterface
+}
+
+func New(uc usecase.TeamUseCaseInterface) *TeamHandler {
+	return &TeamHandler{usecase: uc}
+}
+
+func (h *TeamHandler) List(c *gin.Context) {
+	workspaceID, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	teams, err := h.usecase.ListByWorkspace(workspaceID)
+
+	if err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	res := make([]dto.TeamResponse, 0, len(teams))
+	for i := range teams {
+		res = append(res, toTeamResponse(&teams[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.TeamResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func (h *TeamHandler) Create(c *gin.Context) {
+	workspaceID, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.CreateTeamRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	team := &entity.TeamEntity{
+		WorkspaceID: workspaceID,
+		Name:        req.Name,
+		ProjectRole: req.ProjectRole,
+	}
+
+	if err := h.usecase.Create(team); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.TeamResponse]{
+		Status: http.StatusCreated,
+		Data:   toTeamResponse(team),
+	})
+}
+
+func (h *TeamHandler) Find(c *gin.Context) {
+	team, ok := parseTeam(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Find(team); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.TeamResponse]{
+		Status: http.StatusOK,
+		Data:   toTeamResponse(team),
+	})
+}
+
+func (h *TeamHandler) Update(c *gin.Context) {
+	team, ok := parseTeam(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.UpdateTeamRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.usecase.Find(team); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	if req.Name != nil {
+		team.Name = *req.Name
+	}
+	if req.ProjectRole != nil {
+		team.ProjectRole = *req.ProjectRole
+	}
+
+	if err := h.usecase.Update(team); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.TeamResponse]{
+		Status: http.StatusOK,
+		Data:   toTeamResponse(team),
+	})
+}
+
+func (h *TeamHandler) Delete(c *gin.Context) {
+	team, ok := parseTeam(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Delete(team); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TeamHandler) ListMembers(c *gin.Context) {
+	team, ok := parseTeam(c)
+
+	if !ok {
+		return
+	}
+
+	members, err := h.usecase.ListMembers(team)
+
+	if err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	res := make([]dto.TeamMemberResponse, 0, len(members))
+	for i := range members {
+		res = append(res, toTeamMemberResponse(&members[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.TeamMemberResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+// SetMember adds a member of the workspace to the team, or changes its role
+// in the team.
+func (h *TeamHandler) SetMember(c *gin.Context) {
+	member, ok := parseMember(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.SetTeamMemberRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	member.Role = req.Role
+
+	if err := h.usecase.SetMember(member); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.TeamMemberResponse]{
+		Status: http.StatusOK,
+		Data:   toTeamMemberResponse(member),
+	})
+}
+
+func (h *TeamHandler) RemoveMember(c *gin.Context) {
+	member, ok := parseMember(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.RemoveMember(member); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TeamHandler) ListProjects(c *gin.Context) {
+	team, ok := parseTeam(c)
+
+	if !ok {
+		return
+	}
+
+	projects, err := h.usecase.ListProjects(team)
+
+	if err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	res := make([]dto.TeamProjectResponse, 0, len(projects))
+	for _, project := range projects {
+		res = append(res, dto.TeamProjectResponse{
+			ProjectID:  project.ProjectID,
+			Key:        project.Key,
+			Name:       project.Name,
+			AssignedAt: project.AssignedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.TeamProjectResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+// AssignProject assigns a project of the workspace to the team, granting
+// its members the project role of the team on it.
+func (h *TeamHandler) AssignProject(c *gin.Context) {
+	project, ok := parseTeamProject(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.AssignProject(project); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TeamHandler) UnassignProject(c *gin.Context) {
+	project, ok := parseTeamProject(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.UnassignProject(project); err != nil {
+		respondTeamError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseWorkspace reads the ID of the workspace the teams belong to from the
+// path.
+func parseWorkspace(c *gin.Context) (uuid.UUID, bool) {
+	workspaceID, err := uuid.Parse(c.Param("ws"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid workspace ID")
+		return uuid.Nil, false
+	}
+
+	return workspaceID, true
+}
+
+// parseTeam reads the workspace and team IDs from the path, scoping the team
+// to its workspace.
+func parseTeam(c *gin.Context) (*entity.TeamEntity, bool) {
+	workspaceID, ok := parseWorkspace(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	teamID, err := uuid.Parse(c.Param("team_id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid team ID")
+		return nil, false
+	}
+
+	return &entity.TeamEntity{ID: teamID, WorkspaceID: workspaceID}, true
+}
+
+func parseMember(c *gin.Context) (*entity.TeamMemberEntity, bool) {
+	team, ok := parseTeam(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	accountID, err := uuid.Parse(c.Param("account_id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid account ID")
+		return nil, false
+	}
+
+	return &entity.TeamMemberEntity{WorkspaceID: team.WorkspaceID, TeamID: team.ID, AccountID: accountID}, true
+}
+
+func parseTeamProject(c *gin.Context) (*entity.TeamProjectEntity, bool) {
+	team, ok := parseTeam(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	projectID, err := uuid.Parse(c.Param("project_id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid project ID")
+		return nil, false
+	}
+
+	return &entity.TeamProjectEntity{WorkspaceID: team.WorkspaceID, TeamID: team.ID, ProjectID: projectID}, true
+}
+
+func respondBadRequest(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+		Status:  http.StatusBadRequest,
+		Message: message,
+	})
+}
+
+func respondTeamError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Team not found",
+		})
+	case errors.Is(err, repository.ErrNotWorkspaceMember):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Account is not a member of the workspace",
+		})
+	case errors.Is(err, repository.ErrProjectNotFound):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Project not found",
+		})
+	case errors.Is(err, repository.ErrTeamNameInUse):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Team name already in use",
+		})
+	case errors.Is(err, usecase.ErrBlankTeamName):
+		respondBadRequest(c, "name cannot be blank")
+	case errors.Is(err, usecase.ErrUnknownProjectRole):
+		respondBadRequest(c, "project_role must be one of project_admin, project_editor, project_commenter and project_viewer")
+	case errors.Is(err, usecase.ErrUnknownTeamRole):
+		respondBadRequest(c, "role must be one of team_lead and team_member")
+	default:
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
+
+func toTeamResponse(team *entity.TeamEntity) dto.TeamResponse {
+	return dto.TeamResponse{
+		ID:          team.ID,
+		WorkspaceID: team.WorkspaceID,
+		Name:        team.Name,
+		ProjectRole: team.ProjectRole,
+		CreatedAt:   team.CreatedAt,
+		UpdatedAt:   team.UpdatedAt,
+	}
+}
+
+func toTeamMemberResponse(member *entity.TeamMemberEntity) dto.TeamMemberResponse {
+	return dto.TeamMemberResponse{
+		AccountID: member.AccountID,
+		Name:      member.Name,
+		Email:     member.Email,
+		Role:      member.Role,
+		JoinedAt:  member.JoinedAt,
+	}
+}
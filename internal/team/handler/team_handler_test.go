@@ -0,0 +1,223 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	projectEntity "trilha-api/internal/project/entity"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/team/dto"
+	"trilha-api/internal/team/entity"
+	"trilha-api/internal/team/handler"
+	"trilha-api/internal/team/mocks"
+	"trilha-api/internal/team/repository"
+	usecase "trilha-api/internal/team/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	workspaceID = uuid.New()
+	teamsPath   = "/api/v1/workspaces/" + workspaceID.String() + "/teams"
+)
+
+func setup(t *testing.T) (*gin.Engine, *mocks.MockTeamUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockTeamUseCaseInterface(ctrl)
+	h := handler.New(mock)
+	router := gin.Default()
+
+	router.GET("/api/v1/workspaces/:ws/teams", h.List)
+	router.POST("/api/v1/workspaces/:ws/teams", h.Create)
+	router.GET("/api/v1/workspaces/:ws/teams/:team_id", h.Find)
+	router.PATCH("/api/v1/workspaces/:ws/teams/:team_id", h.Update)
+	router.DELETE("/api/v1/workspaces/:ws/teams/:team_id", h.Delete)
+	router.GET("/api/v1/workspaces/:ws/teams/:team_id/members", h.ListMembers)
+	router.PUT("/api/v1/workspaces/:ws/teams/:team_id/members/:account_id", h.SetMember)
+	router.DELETE("/api/v1/workspaces/:ws/teams/:team_id/members/:account_id", h.RemoveMember)
+	router.GET("/api/v1/workspaces/:ws/teams/:team_id/projects", h.ListProjects)
+	router.PUT("/api/v1/workspaces/:ws/teams/:team_id/projects/:project_id", h.AssignProject)
+	router.DELETE("/api/v1/workspaces/:ws/teams/:team_id/projects/:project_id", h.UnassignProject)
+
+	return router, mock
+}
+
+func send(router *gin.Engine, method, path string, body any) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(method, path, &buf)
+	r.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func TestTeamHandler_Create(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	t.Run("should return status 201 and the created team", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).DoAndReturn(func(team *entity.TeamEntity) error {
+			assert.Equal(t, workspaceID, team.WorkspaceID)
+			assert.Equal(t, "Design", team.Name)
+			team.ID = uuid.New()
+			team.ProjectRole = projectEntity.ProjectRoleEditor
+			return nil
+		})
+
+		w := send(router, http.MethodPost, teamsPath, dto.CreateTeamRequest{Name: "Design"})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.TeamResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Design", responseBody.Data.Name)
+		assert.Equal(t, projectEntity.ProjectRoleEditor, responseBody.Data.ProjectRole)
+	})
+
+	t.Run("should return status 409 when the name is in use", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).Return(repository.ErrTeamNameInUse)
+
+		w := send(router, http.MethodPost, teamsPath, dto.CreateTeamRequest{Name: "Design"})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 400 for an unknown project role", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).Return(usecase.ErrUnknownProjectRole)
+
+		w := send(router, http.MethodPost, teamsPath, dto.CreateTeamRequest{Name: "Design", ProjectRole: "owner"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTeamHandler_Update(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	teamID := uuid.New()
+	path := teamsPath + "/" + teamID.String()
+
+	t.Run("should change only the fields sent", func(t *testing.T) {
+		role := projectEntity.ProjectRoleViewer
+
+		mockUseCase.EXPECT().Find(gomock.Any()).DoAndReturn(func(team *entity.TeamEntity) error {
+			assert.Equal(t, teamID, team.ID)
+			team.Name = "Design"
+			team.ProjectRole = projectEntity.ProjectRoleEditor
+			return nil
+		})
+		mockUseCase.EXPECT().Update(gomock.Any()).DoAndReturn(func(team *entity.TeamEntity) error {
+			assert.Equal(t, "Design", team.Name)
+			assert.Equal(t, projectEntity.ProjectRoleViewer, team.ProjectRole)
+			return nil
+		})
+
+		w := send(router, http.MethodPatch, path, dto.UpdateTeamRequest{ProjectRole: &role})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 404 when the workspace has no such team", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodPatch, path, dto.UpdateTeamRequest{})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid team ID", func(t *testing.T) {
+		w := send(router, http.MethodPatch, teamsPath+"/abc", dto.UpdateTeamRequest{})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTeamHandler_SetMember(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	teamID := uuid.New()
+	accountID := uuid.New()
+	path := teamsPath + "/" + teamID.String() + "/members/" + accountID.String()
+
+	t.Run("should return status 200 and the member", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).DoAndReturn(func(member *entity.TeamMemberEntity) error {
+			assert.Equal(t, workspaceID, member.WorkspaceID)
+			assert.Equal(t, teamID, member.TeamID)
+			assert.Equal(t, accountID, member.AccountID)
+			assert.Equal(t, entity.TeamRoleLead, member.Role)
+			member.Name = "Ana"
+			return nil
+		})
+
+		w := send(router, http.MethodPut, path, dto.SetTeamMemberRequest{Role: entity.TeamRoleLead})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.TeamMemberResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Ana", responseBody.Data.Name)
+		assert.Equal(t, entity.TeamRoleLead, responseBody.Data.Role)
+	})
+
+	t.Run("should return status 404 for accounts out of the workspace", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).Return(repository.ErrNotWorkspaceMember)
+
+		w := send(router, http.MethodPut, path, dto.SetTeamMemberRequest{Role: entity.TeamRoleMember})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an unknown role", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).Return(usecase.ErrUnknownTeamRole)
+
+		w := send(router, http.MethodPut, path, dto.SetTeamMemberRequest{Role: "owner"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTeamHandler_AssignProject(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	teamID := uuid.New()
+	projectID := uuid.New()
+	path := teamsPath + "/" + teamID.String() + "/projects/" + projectID.String()
+
+	t.Run("should return status 204", func(t *testing.T) {
+		mockUseCase.EXPECT().AssignProject(&entity.TeamProjectEntity{
+			WorkspaceID: workspaceID,
+			TeamID:      teamID,
+			ProjectID:   projectID,
+		}).Return(nil)
+
+		w := send(router, http.MethodPut, path, nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when the workspace has no such project", func(t *testing.T) {
+		mockUseCase.EXPECT().AssignProject(gomock.Any()).Return(repository.ErrProjectNotFound)
+
+		w := send(router, http.MethodPut, path, nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid project ID", func(t *testing.T) {
+		w := send(router, http.MethodPut, teamsPath+"/"+teamID.String()+"/projects/abc", nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
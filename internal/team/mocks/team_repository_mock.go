@@ -0,0 +1,228 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: team_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=team_repository.go -destination=../mocks/team_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/team/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTeamRepositoryInterface is a mock of TeamRepositoryInterface interface.
+type MockTeamRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTeamRepositoryInterfaceMockRecorder is the mock recorder for MockTeamRepositoryInterface.
+type MockTeamRepositoryInterfaceMockRecorder struct {
+	mock *MockTeamRepositoryInterface
+}
+
+// NewMockTeamRepositoryInterface creates a new mock instance.
+func NewMockTeamRepositoryInterface(ctrl *gomock.Controller) *MockTeamRepositoryInterface {
+	mock := &MockTeamRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockTeamRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamRepositoryInterface) EXPECT() *MockTeamRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AssignProject mocks base method.
+func (m *MockTeamRepositoryInterface) AssignProject(project *entity.TeamProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignProject", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignProject indicates an expected call of AssignProject.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) AssignProject(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProject", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).AssignProject), project)
+}
+
+// Create mocks base method.
+func (m *MockTeamRepositoryInterface) Create(team *entity.TeamEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) Create(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).Create), team)
+}
+
+// Delete mocks base method.
+func (m *MockTeamRepositoryInterface) Delete(team *entity.TeamEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) Delete(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).Delete), team)
+}
+
+// Find mocks base method.
+func (m *MockTeamRepositoryInterface) Find(team *entity.TeamEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) Find(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).Find), team)
+}
+
+// FindMember mocks base method.
+func (m *MockTeamRepositoryInterface) FindMember(member *entity.TeamMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindMember indicates an expected call of FindMember.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) FindMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMember", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).FindMember), member)
+}
+
+// ListByAccount mocks base method.
+func (m *MockTeamRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.TeamEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.TeamEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).ListByAccount), accountID)
+}
+
+// ListByWorkspace mocks base method.
+func (m *MockTeamRepositoryInterface) ListByWorkspace(workspaceID uuid.UUID) ([]entity.TeamEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByWorkspace", workspaceID)
+	ret0, _ := ret[0].([]entity.TeamEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByWorkspace indicates an expected call of ListByWorkspace.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) ListByWorkspace(workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWorkspace", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).ListByWorkspace), workspaceID)
+}
+
+// ListMembers mocks base method.
+func (m *MockTeamRepositoryInterface) ListMembers(workspaceID, teamID uuid.UUID) ([]entity.TeamMemberEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", workspaceID, teamID)
+	ret0, _ := ret[0].([]entity.TeamMemberEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) ListMembers(workspaceID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).ListMembers), workspaceID, teamID)
+}
+
+// ListProjects mocks base method.
+func (m *MockTeamRepositoryInterface) ListProjects(workspaceID, teamID uuid.UUID) ([]entity.TeamProjectEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", workspaceID, teamID)
+	ret0, _ := ret[0].([]entity.TeamProjectEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) ListProjects(workspaceID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).ListProjects), workspaceID, teamID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockTeamRepositoryInterface) RemoveMember(member *entity.TeamMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) RemoveMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).RemoveMember), member)
+}
+
+// SetMember mocks base method.
+func (m *MockTeamRepositoryInterface) SetMember(member *entity.TeamMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) SetMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).SetMember), member)
+}
+
+// UnassignProject mocks base method.
+func (m *MockTeamRepositoryInterface) UnassignProject(project *entity.TeamProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignProject", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignProject indicates an expected call of UnassignProject.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) UnassignProject(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignProject", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).UnassignProject), project)
+}
+
+// Update mocks base method.
+func (m *MockTeamRepositoryInterface) Update(team *entity.TeamEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTeamRepositoryInterfaceMockRecorder) Update(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTeamRepositoryInterface)(nil).Update), team)
+}
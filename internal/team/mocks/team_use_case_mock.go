@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: team_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=team_use_case.go -destination=../mocks/team_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/team/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTeamUseCaseInterface is a mock of TeamUseCaseInterface interface.
+type MockTeamUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTeamUseCaseInterfaceMockRecorder is the mock recorder for MockTeamUseCaseInterface.
+type MockTeamUseCaseInterfaceMockRecorder struct {
+	mock *MockTeamUseCaseInterface
+}
+
+// NewMockTeamUseCaseInterface creates a new mock instance.
+func NewMockTeamUseCaseInterface(ctrl *gomock.Controller) *MockTeamUseCaseInterface {
+	mock := &MockTeamUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockTeamUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamUseCaseInterface) EXPECT() *MockTeamUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AssignProject mocks base method.
+func (m *MockTeamUseCaseInterface) AssignProject(project *entity.TeamProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignProject", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignProject indicates an expected call of AssignProject.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) AssignProject(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignProject", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).AssignProject), project)
+}
+
+// Create mocks base method.
+func (m *MockTeamUseCaseInterface) Create(team *entity.TeamEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) Create(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).Create), team)
+}
+
+// Delete mocks base method.
+func (m *MockTeamUseCaseInterface) Delete(team *entity.TeamEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) Delete(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).Delete), team)
+}
+
+// Find mocks base method.
+func (m *MockTeamUseCaseInterface) Find(team *entity.TeamEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) Find(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).Find), team)
+}
+
+// ListByWorkspace mocks base method.
+func (m *MockTeamUseCaseInterface) ListByWorkspace(workspaceID uuid.UUID) ([]entity.TeamEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByWorkspace", workspaceID)
+	ret0, _ := ret[0].([]entity.TeamEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByWorkspace indicates an expected call of ListByWorkspace.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) ListByWorkspace(workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWorkspace", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).ListByWorkspace), workspaceID)
+}
+
+// ListMembers mocks base method.
+func (m *MockTeamUseCaseInterface) ListMembers(team *entity.TeamEntity) ([]entity.TeamMemberEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", team)
+	ret0, _ := ret[0].([]entity.TeamMemberEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) ListMembers(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).ListMembers), team)
+}
+
+// ListProjects mocks base method.
+func (m *MockTeamUseCaseInterface) ListProjects(team *entity.TeamEntity) ([]entity.TeamProjectEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", team)
+	ret0, _ := ret[0].([]entity.TeamProjectEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) ListProjects(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).ListProjects), team)
+}
+
+// RemoveMember mocks base method.
+func (m *MockTeamUseCaseInterface) RemoveMember(member *entity.TeamMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) RemoveMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).RemoveMember), member)
+}
+
+// SetMember mocks base method.
+func (m *MockTeamUseCaseInterface) SetMember(member *entity.TeamMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) SetMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).SetMember), member)
+}
+
+// UnassignProject mocks base method.
+func (m *MockTeamUseCaseInterface) UnassignProject(project *entity.TeamProjectEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignProject", project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignProject indicates an expected call of UnassignProject.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) UnassignProject(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignProject", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).UnassignProject), project)
+}
+
+// Update mocks base method.
+func (m *MockTeamUseCaseInterface) Update(team *entity.TeamEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTeamUseCaseInterfaceMockRecorder) Update(team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTeamUseCaseInterface)(nil).Update), team)
+}
@@ -0,0 +1,371 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/tenant"
+	"trilha-api/internal/team/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolationCode is the Postgres SQLSTATE for unique constraint violations.
+const uniqueViolationCode = "23505"
+
+var (
+	ErrTeamNameInUse      = errors.New("team name already in use in the workspace")
+	ErrNotWorkspaceMember = errors.New("account not a member of the workspace")
+	ErrProjectNotFound    = errors.New("project not found in the workspace")
+)
+
+// TeamRepository keeps the teams of workspaces, their members and the
+// projects assigned to them. Teams are read by the permission checks, so
+// they are not under row-level security and every query filters on the
+// workspace instead; the projects are only reached through the scope of
+// their workspace.
+type TeamRepository struct {
+	db    db.Querier
+	scope tenant.Scope
+}
+
+//go:generate mockgen -source=team_repository.go -destination=../mocks/team_repository_mock.go -package=mocks
+
+type TeamRepositoryInterface interface {
+	Create(team *entity.TeamEntity) error
+	Find(team *entity.TeamEntity) error
+	ListByWorkspace(workspaceID uuid.UUID) ([]entity.TeamEntity, error)
+	ListByAccount(accountID uuid.UUID) ([]entity.TeamEntity, error)
+	Update(team *entity.TeamEntity) error
+	Delete(team *entity.TeamEntity) error
+	ListMembers(workspaceID, teamID uuid.UUID) ([]entity.TeamMemberEntity, error)
+	FindMember(member *entity.TeamMemberEntity) error
+	SetMember(member *entity.TeamMemberEntity) error
+	RemoveMember(member *entity.TeamMemberEntity) error
+	ListProjects(workspaceID, teamID uuid.UUID) ([]entity.TeamProjectEntity, error)
+	AssignProject(project *entity.TeamProjectEntity) error
+	UnassignProject(project *entity.TeamProjectEntity) error
+}
+
+func New(db db.Querier, scope tenant.Scope) *TeamRepository {
+	return &TeamRepository{db: db, scope: scope}
+}
+
+// Create stores the team, failing with ErrTeamNameInUse when another team
+// of the workspace has its name.
+func (r *TeamRepository) Create(team *entity.TeamEntity) error {
+	fields := db.CreateTeamParams{
+		WorkspaceID: team.WorkspaceID,
+		Name:        team.Name,
+		RoleName:    team.ProjectRole,
+	}
+
+	created, err := r.db.CreateTeam(context.Background(), fields)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrTeamNameInUse
+		}
+		return fmt.Errorf("erro ao criar equipe: %w", err)
+	}
+
+	*team = toTeamEntity(db.FindTeamRow(created))
+
+	return nil
+}
+
+// Find loads the team team.ID of team.WorkspaceID.
+func (r *TeamRepository) Find(team *entity.TeamEntity) error {
+	fields := db.FindTeamParams{
+		ID:          team.ID,
+		WorkspaceID: team.WorkspaceID,
+	}
+
+	found, err := r.db.FindTeam(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*team = toTeamEntity(found)
+
+	return nil
+}
+
+func (r *TeamRepository) ListByWorkspace(workspaceID uuid.UUID) ([]entity.TeamEntity, error) {
+	rows, err := r.db.ListWorkspaceTeams(context.Background(), workspaceID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar equipes do workspace: %w", err)
+	}
+
+	teams := make([]entity.TeamEntity, 0, len(rows))
+	for _, row := range rows {
+		teams = append(teams, toTeamEntity(db.FindTeamRow(row)))
+	}
+
+	return teams, nil
+}
+
+// ListByAccount returns the teams the account belongs to, in every
+// workspace, with its role in each.
+func (r *TeamRepository) ListByAccount(accountID uuid.UUID) ([]entity.TeamEntity, error) {
+	rows, err := r.db.ListAccountTeams(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar equipes da conta: %w", err)
+	}
+
+	teams := make([]entity.TeamEntity, 0, len(rows))
+	for _, row := range rows {
+		teams = append(teams, entity.TeamEntity{
+			ID:          row.ID,
+			WorkspaceID: row.WorkspaceID,
+			Name:        row.Name,
+			Role:        row.MemberRole,
+			CreatedAt:   row.CreatedAt.Time,
+			UpdatedAt:   row.UpdatedAt.Time,
+		})
+	}
+
+	return teams, nil
+}
+
+// Update changes the name and project role of the team, failing with
+// ErrTeamNameInUse when another team of the workspace has its name.
+func (r *TeamRepository) Update(team *entity.TeamEntity) error {
+	fields := db.UpdateTeamParams{
+		ID:          team.ID,
+		WorkspaceID: team.WorkspaceID,
+		Name:        team.Name,
+		RoleName:    team.ProjectRole,
+	}
+
+	updated, err := r.db.UpdateTeam(context.Background(), fields)
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrTeamNameInUse
+		}
+		return err
+	}
+
+	*team = toTeamEntity(db.FindTeamRow(updated))
+
+	return nil
+}
+
+// Delete removes the team with its memberships and project assignments.
+func (r *TeamRepository) Delete(team *entity.TeamEntity) error {
+	fields := db.DeleteTeamParams{
+		ID:          team.ID,
+		WorkspaceID: team.WorkspaceID,
+	}
+
+	rows, err := r.db.DeleteTeam(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao remover equipe: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) ListMembers(workspaceID, teamID uuid.UUID) ([]entity.TeamMemberEntity, error) {
+	fields := db.ListTeamMembersParams{
+		TeamID:      teamID,
+		WorkspaceID: workspaceID,
+	}
+
+	rows, err := r.db.ListTeamMembers(context.Background(), fields)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar membros da equipe: %w", err)
+	}
+
+	members := make([]entity.TeamMemberEntity, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, toTeamMemberEntity(workspaceID, teamID, db.FindTeamMemberRow(row)))
+	}
+
+	return members, nil
+}
+
+// FindMember loads the membership of member.AccountID in member.TeamID.
+func (r *TeamRepository) FindMember(member *entity.TeamMemberEntity) error {
+	fields := db.FindTeamMemberParams{
+		TeamID:      member.TeamID,
+		WorkspaceID: member.WorkspaceID,
+		AccountID:   member.AccountID,
+	}
+
+	found, err := r.db.FindTeamMember(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*member = toTeamMemberEntity(member.WorkspaceID, member.TeamID, found)
+
+	return nil
+}
+
+// SetMember adds the account to the team with member.Role, or changes its
+// role when it is a member already. It fails with ErrNotWorkspaceMember
+// when the account is not a member of the workspace of the team.
+func (r *TeamRepository) SetMember(member *entity.TeamMemberEntity) error {
+	fields := db.SetTeamMemberParams{
+		AccountID:   member.AccountID,
+		TeamID:      member.TeamID,
+		WorkspaceID: member.WorkspaceID,
+		RoleName:    member.Role,
+	}
+
+	rows, err := r.db.SetTeamMember(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao adicionar membro à equipe: %w", err)
+	}
+
+	if rows == 0 {
+		return ErrNotWorkspaceMember
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) RemoveMember(member *entity.TeamMemberEntity) error {
+	fields := db.RemoveTeamMemberParams{
+		TeamID:      member.TeamID,
+		WorkspaceID: member.WorkspaceID,
+		AccountID:   member.AccountID,
+	}
+
+	rows, err := r.db.RemoveTeamMember(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao remover membro da equipe: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListProjects returns the projects assigned to the team, leaving out the
+// deleted ones.
+func (r *TeamRepository) ListProjects(workspaceID, teamID uuid.UUID) ([]entity.TeamProjectEntity, error) {
+	fields := db.ListTeamProjectsParams{
+		TeamID:      teamID,
+		WorkspaceID: workspaceID,
+	}
+
+	var rows []db.ListTeamProjectsRow
+	err := r.scope.Run(workspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.ListTeamProjects(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar projetos da equipe: %w", err)
+	}
+
+	projects := make([]entity.TeamProjectEntity, 0, len(rows))
+	for _, row := range rows {
+		projects = append(projects, entity.TeamProjectEntity{
+			WorkspaceID: workspaceID,
+			TeamID:      teamID,
+			ProjectID:   row.ID,
+			Key:         row.Key,
+			Name:        row.Name,
+			AssignedAt:  row.CreatedAt.Time,
+		})
+	}
+
+	return projects, nil
+}
+
+// AssignProject assigns a project of the workspace of the team to it,
+// failing with ErrProjectNotFound when the workspace has no such project.
+func (r *TeamRepository) AssignProject(project *entity.TeamProjectEntity) error {
+	fields := db.AssignTeamProjectParams{
+		TeamID:      project.TeamID,
+		WorkspaceID: project.WorkspaceID,
+		ProjectID:   project.ProjectID,
+	}
+
+	var rows int64
+	err := r.scope.Run(project.WorkspaceID, func(q db.Querier) error {
+		var err error
+		rows, err = q.AssignTeamProject(context.Background(), fields)
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("erro ao atribuir projeto à equipe: %w", err)
+	}
+
+	if rows == 0 {
+		return ErrProjectNotFound
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) UnassignProject(project *entity.TeamProjectEntity) error {
+	fields := db.UnassignTeamProjectParams{
+		TeamID:      project.TeamID,
+		WorkspaceID: project.WorkspaceID,
+		ProjectID:   project.ProjectID,
+	}
+
+	rows, err := r.db.UnassignTeamProject(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao retirar projeto da equipe: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+func toTeamEntity(team db.FindTeamRow) entity.TeamEntity {
+	return entity.TeamEntity{
+		ID:          team.ID,
+		WorkspaceID: team.WorkspaceID,
+		Name:        team.Name,
+		ProjectRole: team.RoleName,
+		CreatedAt:   team.CreatedAt.Time,
+		UpdatedAt:   team.UpdatedAt.Time,
+	}
+}
+
+func toTeamMemberEntity(workspaceID, teamID uuid.UUID, member db.FindTeamMemberRow) entity.TeamMemberEntity {
+	return entity.TeamMemberEntity{
+		WorkspaceID: workspaceID,
+		TeamID:      teamID,
+		AccountID:   member.AccountID,
+		Name:        member.Name,
+		Email:       member.Email,
+		Role:        member.RoleName,
+		JoinedAt:    member.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	projectEntity "trilha-api/internal/project/entity"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/team/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// scopeStub runs the queries on the mocked querier, remembering the
+// workspace they were scoped to.
+type scopeStub struct {
+	querier   db.Querier
+	workspace uuid.UUID
+}
+
+func (s *scopeStub) Run(workspaceID uuid.UUID, fn func(q db.Querier) error) error {
+	s.workspace = workspaceID
+	return fn(s.querier)
+}
+
+func setup(t *testing.T) (*mocks.MockQuerier, *scopeStub, *TeamRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	scope := &scopeStub{querier: dbMock}
+	repo := New(dbMock, scope)
+
+	return dbMock, scope, repo
+}
+
+func TestTeamRepository_Create(t *testing.T) {
+	dbMock, _, repo := setup(t)
+
+	workspaceID := uuid.New()
+
+	t.Run("should store the team with its project role", func(t *testing.T) {
+		params := db.CreateTeamParams{WorkspaceID: workspaceID, Name: "Design", RoleName: projectEntity.ProjectRoleViewer}
+		created := db.CreateTeamRow{ID: uuid.New(), WorkspaceID: workspaceID, Name: "Design", RoleName: projectEntity.ProjectRoleViewer}
+
+		dbMock.EXPECT().CreateTeam(context.Background(), params).Return(created, nil)
+
+		team := &entity.TeamEntity{WorkspaceID: workspaceID, Name: "Design", ProjectRole: projectEntity.ProjectRoleViewer}
+
+		assert.NoError(t, repo.Create(team))
+		assert.Equal(t, created.ID, team.ID)
+		assert.Equal(t, projectEntity.ProjectRoleViewer, team.ProjectRole)
+	})
+
+	t.Run("should tell when the name is in use in the workspace", func(t *testing.T) {
+		dbMock.EXPECT().CreateTeam(context.Background(), gomock.Any()).
+			Return(db.CreateTeamRow{}, &pgconn.PgError{Code: uniqueViolationCode})
+
+		err := repo.Create(&entity.TeamEntity{WorkspaceID: workspaceID, Name: "Design"})
+
+		assert.ErrorIs(t, err, ErrTeamNameInUse)
+	})
+
+	t.Run("should wrap other errors", func(t *testing.T) {
+		dbMock.EXPECT().CreateTeam(context.Background(), gomock.Any()).
+			Return(db.CreateTeamRow{}, errors.New("database error"))
+
+		err := repo.Create(&entity.TeamEntity{WorkspaceID: workspaceID, Name: "Design"})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrTeamNameInUse)
+	})
+}
+
+func TestTeamRepository_Delete(t *testing.T) {
+	dbMock, _, repo := setup(t)
+
+	team := &entity.TeamEntity{ID: uuid.New(), WorkspaceID: uuid.New()}
+	params := db.DeleteTeamParams{ID: team.ID, WorkspaceID: team.WorkspaceID}
+
+	t.Run("should remove the team", func(t *testing.T) {
+		dbMock.EXPECT().DeleteTeam(context.Background(), params).Return(int64(1), nil)
+
+		assert.NoError(t, repo.Delete(team))
+	})
+
+	t.Run("should return sql.ErrNoRows when the workspace has no such team", func(t *testing.T) {
+		dbMock.EXPECT().DeleteTeam(context.Background(), params).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.Delete(team), sql.ErrNoRows)
+	})
+}
+
+func TestTeamRepository_SetMember(t *testing.T) {
+	dbMock, _, repo := setup(t)
+
+	member := &entity.TeamMemberEntity{
+		WorkspaceID: uuid.New(),
+		TeamID:      uuid.New(),
+		AccountID:   uuid.New(),
+		Role:        entity.TeamRoleLead,
+	}
+	params := db.SetTeamMemberParams{
+		AccountID:   member.AccountID,
+		TeamID:      member.TeamID,
+		WorkspaceID: member.WorkspaceID,
+		RoleName:    entity.TeamRoleLead,
+	}
+
+	t.Run("should add the member of the workspace", func(t *testing.T) {
+		dbMock.EXPECT().SetTeamMember(context.Background(), params).Return(int64(1), nil)
+
+		assert.NoError(t, repo.SetMember(member))
+	})
+
+	t.Run("should refuse accounts out of the workspace", func(t *testing.T) {
+		dbMock.EXPECT().SetTeamMember(context.Background(), params).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.SetMember(member), ErrNotWorkspaceMember)
+	})
+}
+
+func TestTeamRepository_ListProjects(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	workspaceID := uuid.New()
+	teamID := uuid.New()
+
+	t.Run("should list the projects within the scope of the workspace", func(t *testing.T) {
+		params := db.ListTeamProjectsParams{TeamID: teamID, WorkspaceID: workspaceID}
+		rows := []db.ListTeamProjectsRow{{ID: uuid.New(), Key: "TRI", Name: "Trilha"}}
+
+		dbMock.EXPECT().ListTeamProjects(context.Background(), params).Return(rows, nil)
+
+		projects, err := repo.ListProjects(workspaceID, teamID)
+
+		assert.NoError(t, err)
+		assert.Len(t, projects, 1)
+		assert.Equal(t, rows[0].ID, projects[0].ProjectID)
+		assert.Equal(t, teamID, projects[0].TeamID)
+		assert.Equal(t, workspaceID, scope.workspace)
+	})
+}
+
+func TestTeamRepository_AssignProject(t *testing.T) {
+	dbMock, scope, repo := setup(t)
+
+	project := &entity.TeamProjectEntity{WorkspaceID: uuid.New(), TeamID: uuid.New(), ProjectID: uuid.New()}
+	params := db.AssignTeamProjectParams{TeamID: project.TeamID, WorkspaceID: project.WorkspaceID, ProjectID: project.ProjectID}
+
+	t.Run("should assign the project within the scope of the workspace", func(t *testing.T) {
+		dbMock.EXPECT().AssignTeamProject(context.Background(), params).Return(int64(1), nil)
+
+		assert.NoError(t, repo.AssignProject(project))
+		assert.Equal(t, project.WorkspaceID, scope.workspace)
+	})
+
+	t.Run("should tell when the workspace has no such project", func(t *testing.T) {
+		dbMock.EXPECT().AssignTeamProject(context.Background(), params).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.AssignProject(project), ErrProjectNotFound)
+	})
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"time"
+	"trilha-api/internal/shared/privacy"
+	"trilha-api/internal/team/repository"
+
+	"github.com/google/uuid"
+)
+
+// TeamDataUseCase takes part in data exports with the teams the account
+// belongs to. Team memberships are not erased with the account: it keeps its
+// place, anonymized, as it does in the workspace.
+type TeamDataUseCase struct {
+	repo repository.TeamRepositoryInterface
+}
+
+func NewTeamDataUseCase(repo repository.TeamRepositoryInterface) *TeamDataUseCase {
+	return &TeamDataUseCase{repo: repo}
+}
+
+type teamExport struct {
+	ID          uuid.UUID `json:"id"`
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	Name        string    `json:"name"`
+	Role        string    `json:"role"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (uc *TeamDataUseCase) ExportAccountData(accountID uuid.UUID) ([]privacy.Section, error) {
+	teams, err := uc.repo.ListByAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := make([]teamExport, 0, len(teams))
+	for _, team := range teams {
+		exported = append(exported, teamExport{
+			ID:          team.ID,
+			WorkspaceID: team.WorkspaceID,
+			Name:        team.Name,
+			Role:        team.Role,
+			CreatedAt:   team.CreatedAt,
+		})
+	}
+
+	return []privacy.Section{{Name: "teams", Data: exported}}, nil
+}
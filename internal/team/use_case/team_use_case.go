@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	projectEntity "trilha-api/internal/project/entity"
+	"trilha-api/internal/team/entity"
+	"trilha-api/internal/team/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBlankTeamName      = errors.New("blank team name")
+	ErrUnknownProjectRole = errors.New("unknown team project role")
+	ErrUnknownTeamRole    = errors.New("unknown team role")
+)
+
+// projectRoles are the roles a team may grant on its projects.
+var projectRoles = map[string]bool{
+	projectEntity.ProjectRoleAdmin:     true,
+	projectEntity.ProjectRoleEditor:    true,
+	projectEntity.ProjectRoleCommenter: true,
+	projectEntity.ProjectRoleViewer:    true,
+}
+
+// teamRoles are the roles a member may hold in a team.
+var teamRoles = map[string]bool{
+	entity.TeamRoleLead:   true,
+	entity.TeamRoleMember: true,
+}
+
+//go:generate mockgen -source=team_use_case.go -destination=../mocks/team_use_case_mock.go -package=mocks
+type TeamUseCaseInterface interface {
+	Create(team *entity.TeamEntity) error
+	Find(team *entity.TeamEntity) error
+	ListByWorkspace(workspaceID uuid.UUID) ([]entity.TeamEntity, error)
+	Update(team *entity.TeamEntity) error
+	Delete(team *entity.TeamEntity) error
+	ListMembers(team *entity.TeamEntity) ([]entity.TeamMemberEntity, error)
+	SetMember(member *entity.TeamMemberEntity) error
+	RemoveMember(member *entity.TeamMemberEntity) error
+	ListProjects(team *entity.TeamEntity) ([]entity.TeamProjectEntity, error)
+	AssignProject(project *entity.TeamProjectEntity) error
+	UnassignProject(project *entity.TeamProjectEntity) error
+}
+
+type TeamUseCase struct {
+	repo repository.TeamRepositoryInterface
+}
+
+func New(repo repository.TeamRepositoryInterface) *TeamUseCase {
+	return &TeamUseCase{repo: repo}
+}
+
+// Create stores a new team of team.WorkspaceID. Its members are granted the
+// editor role on its projects unless team.ProjectRole says otherwise.
+func (uc *TeamUseCase) Create(team *entity.TeamEntity) error {
+	if team.ProjectRole == "" {
+		team.ProjectRole = projectEntity.ProjectRoleEditor
+	}
+
+	if err := normalize(team); err != nil {
+		return err
+	}
+
+	return uc.repo.Create(team)
+}
+
+func (uc *TeamUseCase) Find(team *entity.TeamEntity) error {
+	return uc.repo.Find(team)
+}
+
+func (uc *TeamUseCase) ListByWorkspace(workspaceID uuid.UUID) ([]entity.TeamEntity, error) {
+	return uc.repo.ListByWorkspace(workspaceID)
+}
+
+func (uc *TeamUseCase) Update(team *entity.TeamEntity) error {
+	if err := normalize(team); err != nil {
+		return err
+	}
+
+	return uc.repo.Update(team)
+}
+
+// Delete removes the team. Its members keep their place in the workspace but
+// lose the roles the team granted them on its projects.
+func (uc *TeamUseCase) Delete(team *entity.TeamEntity) error {
+	return uc.repo.Delete(team)
+}
+
+// ListMembers returns the members of the team, failing with sql.ErrNoRows
+// when the workspace has no such team.
+func (uc *TeamUseCase) ListMembers(team *entity.TeamEntity) ([]entity.TeamMemberEntity, error) {
+	if err := uc.repo.Find(team); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListMembers(team.WorkspaceID, team.ID)
+}
+
+// SetMember adds a member of the workspace to the team with member.Role, or
+// changes its role when it is in the team already.
+func (uc *TeamUseCase) SetMember(member *entity.TeamMemberEntity) error {
+	if !teamRoles[member.Role] {
+		return ErrUnknownTeamRole
+	}
+
+	if err := uc.repo.Find(&entity.TeamEntity{ID: member.TeamID, WorkspaceID: member.WorkspaceID}); err != nil {
+		return err
+	}
+
+	if err := uc.repo.SetMember(member); err != nil {
+		return err
+	}
+
+	return uc.repo.FindMember(member)
+}
+
+func (uc *TeamUseCase) RemoveMember(member *entity.TeamMemberEntity) error {
+	return uc.repo.RemoveMember(member)
+}
+
+// ListProjects returns the projects assigned to the team, failing with
+// sql.ErrNoRows when the workspace has no such team.
+func (uc *TeamUseCase) ListProjects(team *entity.TeamEntity) ([]entity.TeamProjectEntity, error) {
+	if err := uc.repo.Find(team); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListProjects(team.WorkspaceID, team.ID)
+}
+
+// AssignProject assigns a project of the workspace to the team. Assigning a
+// project twice leaves it assigned.
+func (uc *TeamUseCase) AssignProject(project *entity.TeamProjectEntity) error {
+	if err := uc.repo.Find(&entity.TeamEntity{ID: project.TeamID, WorkspaceID: project.WorkspaceID}); err != nil {
+		return err
+	}
+
+	return uc.repo.AssignProject(project)
+}
+
+func (uc *TeamUseCase) UnassignProject(project *entity.TeamProjectEntity) error {
+	return uc.repo.UnassignProject(project)
+}
+
+// normalize trims the name of the team and checks its project role.
+func normalize(team *entity.TeamEntity) error {
+	team.Name = strings.TrimSpace(team.Name)
+
+	if team.Name == "" {
+		return ErrBlankTeamName
+	}
+
+	if !projectRoles[team.ProjectRole] {
+		return ErrUnknownProjectRole
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"testing"
+	projectEntity "trilha-api/internal/project/entity"
+	"trilha-api/internal/team/entity"
+	"trilha-api/internal/team/mocks"
+	usecase "trilha-api/internal/team/use_case"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*mocks.MockTeamRepositoryInterface, *usecase.TeamUseCase) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockTeamRepositoryInterface(ctrl)
+
+	return repo, usecase.New(repo)
+}
+
+func TestTeamUseCase_Create(t *testing.T) {
+	repo, uc := setup(t)
+
+	t.Run("should trim the name and default the project role to editor", func(t *testing.T) {
+		team := &entity.TeamEntity{WorkspaceID: uuid.New(), Name: "  Design "}
+
+		repo.EXPECT().Create(team).Return(nil)
+
+		assert.NoError(t, uc.Create(team))
+		assert.Equal(t, "Design", team.Name)
+		assert.Equal(t, projectEntity.ProjectRoleEditor, team.ProjectRole)
+	})
+
+	t.Run("should refuse blank names", func(t *testing.T) {
+		err := uc.Create(&entity.TeamEntity{Name: "   "})
+
+		assert.ErrorIs(t, err, usecase.ErrBlankTeamName)
+	})
+
+	t.Run("should refuse roles other than the project roles", func(t *testing.T) {
+		for _, role := range []string{"member", "guest", "workspace_owner", "team_lead", "unknown"} {
+			err := uc.Create(&entity.TeamEntity{Name: "Design", ProjectRole: role})
+
+			assert.ErrorIs(t, err, usecase.ErrUnknownProjectRole, role)
+		}
+	})
+}
+
+func TestTeamUseCase_ListMembers(t *testing.T) {
+	repo, uc := setup(t)
+
+	team := &entity.TeamEntity{ID: uuid.New(), WorkspaceID: uuid.New()}
+
+	t.Run("should list the members of the team", func(t *testing.T) {
+		members := []entity.TeamMemberEntity{{AccountID: uuid.New(), Role: entity.TeamRoleLead}}
+
+		repo.EXPECT().Find(team).Return(nil)
+		repo.EXPECT().ListMembers(team.WorkspaceID, team.ID).Return(members, nil)
+
+		found, err := uc.ListMembers(team)
+
+		assert.NoError(t, err)
+		assert.Equal(t, members, found)
+	})
+
+	t.Run("should fail when the workspace has no such team", func(t *testing.T) {
+		repo.EXPECT().Find(team).Return(sql.ErrNoRows)
+
+		_, err := uc.ListMembers(team)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestTeamUseCase_SetMember(t *testing.T) {
+	repo, uc := setup(t)
+
+	member := &entity.TeamMemberEntity{WorkspaceID: uuid.New(), TeamID: uuid.New(), AccountID: uuid.New()}
+	team := &entity.TeamEntity{ID: member.TeamID, WorkspaceID: member.WorkspaceID}
+
+	t.Run("should set the member and load it back", func(t *testing.T) {
+		member.Role = entity.TeamRoleMember
+
+		repo.EXPECT().Find(team).Return(nil)
+		repo.EXPECT().SetMember(member).Return(nil)
+		repo.EXPECT().FindMember(member).Return(nil)
+
+		assert.NoError(t, uc.SetMember(member))
+	})
+
+	t.Run("should refuse roles other than the team roles", func(t *testing.T) {
+		member.Role = "workspace_owner"
+
+		assert.ErrorIs(t, uc.SetMember(member), usecase.ErrUnknownTeamRole)
+	})
+
+	t.Run("should fail when the workspace has no such team", func(t *testing.T) {
+		member.Role = entity.TeamRoleLead
+
+		repo.EXPECT().Find(team).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.SetMember(member), sql.ErrNoRows)
+	})
+}
+
+func TestTeamUseCase_AssignProject(t *testing.T) {
+	repo, uc := setup(t)
+
+	project := &entity.TeamProjectEntity{WorkspaceID: uuid.New(), TeamID: uuid.New(), ProjectID: uuid.New()}
+	team := &entity.TeamEntity{ID: project.TeamID, WorkspaceID: project.WorkspaceID}
+
+	t.Run("should assign the project to the team", func(t *testing.T) {
+		repo.EXPECT().Find(team).Return(nil)
+		repo.EXPECT().AssignProject(project).Return(nil)
+
+		assert.NoError(t, uc.AssignProject(project))
+	})
+
+	t.Run("should fail when the workspace has no such team", func(t *testing.T) {
+		repo.EXPECT().Find(team).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.AssignProject(project), sql.ErrNoRows)
+	})
+}
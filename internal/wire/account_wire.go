@@ -7,9 +7,21 @@ import (
 	"trilha-api/internal/account/handler"
 	"trilha-api/internal/account/repository"
 	usecase "trilha-api/internal/account/use_case"
+	projectUsecase "trilha-api/internal/project/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
 	sqlc "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/oidc"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/storage"
+	"trilha-api/internal/shared/tenant"
+	taskUsecase "trilha-api/internal/task/use_case"
+	workspaceRepository "trilha-api/internal/workspace/repository"
 
+	"github.com/go-webauthn/webauthn/webauthn"
 	w "github.com/google/wire"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var set_account_repository_dependency = w.NewSet(
@@ -17,17 +29,516 @@ var set_account_repository_dependency = w.NewSet(
 	w.Bind(new(repository.AccountRepositoryInterface), new(*repository.AccountRepository)),
 )
 
+var set_refresh_token_repository_dependency = w.NewSet(
+	repository.NewRefreshTokenRepository,
+	w.Bind(new(repository.RefreshTokenRepositoryInterface), new(*repository.RefreshTokenRepository)),
+)
+
+var set_session_repository_dependency = w.NewSet(
+	repository.NewSessionRepository,
+	w.Bind(new(repository.SessionRepositoryInterface), new(*repository.SessionRepository)),
+)
+
+var set_password_reset_token_repository_dependency = w.NewSet(
+	repository.NewPasswordResetTokenRepository,
+	w.Bind(new(repository.PasswordResetTokenRepositoryInterface), new(*repository.PasswordResetTokenRepository)),
+)
+
+var set_email_change_request_repository_dependency = w.NewSet(
+	repository.NewEmailChangeRequestRepository,
+	w.Bind(new(repository.EmailChangeRequestRepositoryInterface), new(*repository.EmailChangeRequestRepository)),
+)
+
+var set_recovery_code_repository_dependency = w.NewSet(
+	repository.NewRecoveryCodeRepository,
+	w.Bind(new(repository.RecoveryCodeRepositoryInterface), new(*repository.RecoveryCodeRepository)),
+)
+
+var set_personal_access_token_repository_dependency = w.NewSet(
+	repository.NewPersonalAccessTokenRepository,
+	w.Bind(new(repository.PersonalAccessTokenRepositoryInterface), new(*repository.PersonalAccessTokenRepository)),
+)
+
+var set_sign_in_throttle_repository_dependency = w.NewSet(
+	repository.NewSignInThrottleRepository,
+	w.Bind(new(repository.SignInThrottleRepositoryInterface), new(*repository.SignInThrottleRepository)),
+)
+
+var set_account_identity_repository_dependency = w.NewSet(
+	repository.NewAccountIdentityRepository,
+	w.Bind(new(repository.AccountIdentityRepositoryInterface), new(*repository.AccountIdentityRepository)),
+)
+
+var set_oidc_login_request_repository_dependency = w.NewSet(
+	repository.NewOIDCLoginRequestRepository,
+	w.Bind(new(repository.OIDCLoginRequestRepositoryInterface), new(*repository.OIDCLoginRequestRepository)),
+)
+
+var set_passkey_repository_dependency = w.NewSet(
+	repository.NewPasskeyRepository,
+	w.Bind(new(repository.PasskeyRepositoryInterface), new(*repository.PasskeyRepository)),
+)
+
+var set_passkey_challenge_repository_dependency = w.NewSet(
+	repository.NewPasskeyChallengeRepository,
+	w.Bind(new(repository.PasskeyChallengeRepositoryInterface), new(*repository.PasskeyChallengeRepository)),
+)
+
+var set_impersonation_repository_dependency = w.NewSet(
+	repository.NewImpersonationRepository,
+	w.Bind(new(repository.ImpersonationRepositoryInterface), new(*repository.ImpersonationRepository)),
+)
+
+var set_data_job_repository_dependency = w.NewSet(
+	repository.NewDataJobRepository,
+	w.Bind(new(repository.DataJobRepositoryInterface), new(*repository.DataJobRepository)),
+)
+
+var set_email_normalizer_dependency = w.NewSet(
+	usecase.NewEmailNormalizer,
+)
+
 var set_account_usecase_dependency = w.NewSet(
 	usecase.New,
 	w.Bind(new(usecase.AccountUseCaseInterface), new(*usecase.AccountUseCase)),
 )
 
-func NewAccountHandler(db *sqlc.Queries) *handler.AccountHandler {
+var set_session_usecase_dependency = w.NewSet(
+	usecase.NewSessionUseCase,
+	w.Bind(new(usecase.SessionUseCaseInterface), new(*usecase.SessionUseCase)),
+)
+
+var set_password_reset_usecase_dependency = w.NewSet(
+	usecase.NewPasswordResetUseCase,
+	w.Bind(new(usecase.PasswordResetUseCaseInterface), new(*usecase.PasswordResetUseCase)),
+)
+
+var set_email_verification_usecase_dependency = w.NewSet(
+	usecase.NewEmailVerificationUseCase,
+	w.Bind(new(usecase.EmailVerificationUseCaseInterface), new(*usecase.EmailVerificationUseCase)),
+)
+
+var set_email_change_usecase_dependency = w.NewSet(
+	usecase.NewEmailChangeUseCase,
+	w.Bind(new(usecase.EmailChangeUseCaseInterface), new(*usecase.EmailChangeUseCase)),
+)
+
+var set_two_factor_usecase_dependency = w.NewSet(
+	usecase.NewTwoFactorUseCase,
+	w.Bind(new(usecase.TwoFactorUseCaseInterface), new(*usecase.TwoFactorUseCase)),
+)
+
+var set_sign_in_throttle_usecase_dependency = w.NewSet(
+	usecase.NewSignInThrottleUseCase,
+	w.Bind(new(usecase.SignInThrottleUseCaseInterface), new(*usecase.SignInThrottleUseCase)),
+)
+
+var set_personal_access_token_usecase_dependency = w.NewSet(
+	usecase.NewPersonalAccessTokenUseCase,
+	w.Bind(new(usecase.PersonalAccessTokenUseCaseInterface), new(*usecase.PersonalAccessTokenUseCase)),
+)
+
+var set_oidc_usecase_dependency = w.NewSet(
+	usecase.NewOIDCUseCase,
+	w.Bind(new(usecase.OIDCUseCaseInterface), new(*usecase.OIDCUseCase)),
+)
+
+var set_avatar_usecase_dependency = w.NewSet(
+	usecase.NewAvatarUseCase,
+	w.Bind(new(usecase.AvatarUseCaseInterface), new(*usecase.AvatarUseCase)),
+)
+
+var set_passkey_usecase_dependency = w.NewSet(
+	usecase.NewPasskeyUseCase,
+	w.Bind(new(usecase.PasskeyUseCaseInterface), new(*usecase.PasskeyUseCase)),
+)
+
+var set_admin_account_usecase_dependency = w.NewSet(
+	usecase.NewAdminAccountUseCase,
+	w.Bind(new(usecase.AdminAccountUseCaseInterface), new(*usecase.AdminAccountUseCase)),
+)
+
+var set_impersonation_usecase_dependency = w.NewSet(
+	usecase.NewImpersonationUseCase,
+	w.Bind(new(usecase.ImpersonationUseCaseInterface), new(*usecase.ImpersonationUseCase)),
+)
+
+var set_data_job_usecase_dependency = w.NewSet(
+	usecase.NewAccountDataUseCase,
+	provideDataExporters,
+	provideDataErasers,
+	usecase.NewDataJobUseCase,
+	w.Bind(new(usecase.DataJobUseCaseInterface), new(*usecase.DataJobUseCase)),
+)
+
+func NewAccountHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	hasher password.Hasher,
+	passwordPolicy *password.Policy,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+	store storage.Storage,
+	avatarConfig config.AvatarConfig,
+) *handler.AccountHandler {
 	w.Build(
 		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
 		set_account_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		set_refresh_token_repository_dependency,
+		set_session_repository_dependency,
+		set_recovery_code_repository_dependency,
+		set_sign_in_throttle_repository_dependency,
+		set_session_usecase_dependency,
+		set_email_verification_usecase_dependency,
+		set_sign_in_throttle_usecase_dependency,
+		set_two_factor_usecase_dependency,
+		set_email_normalizer_dependency,
 		set_account_usecase_dependency,
+		set_avatar_usecase_dependency,
 		handler.New,
 	)
 	return &handler.AccountHandler{}
 }
+
+func NewSessionHandler(db *sqlc.Queries, tokens auth.TokenManager) *handler.SessionHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_refresh_token_repository_dependency,
+		set_session_repository_dependency,
+		set_session_usecase_dependency,
+		handler.NewSessionHandler,
+	)
+	return &handler.SessionHandler{}
+}
+
+func NewPasswordResetHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	hasher password.Hasher,
+	passwordPolicy *password.Policy,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+) *handler.PasswordResetHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_refresh_token_repository_dependency,
+		set_session_repository_dependency,
+		set_password_reset_token_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		set_session_usecase_dependency,
+		set_email_normalizer_dependency,
+		set_password_reset_usecase_dependency,
+		handler.NewPasswordResetHandler,
+	)
+	return &handler.PasswordResetHandler{}
+}
+
+func NewEmailVerificationHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+) *handler.EmailVerificationHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_email_verification_usecase_dependency,
+		handler.NewEmailVerificationHandler,
+	)
+	return &handler.EmailVerificationHandler{}
+}
+
+func NewEmailChangeHandler(
+	db *sqlc.Queries,
+	mail mailer.Mailer,
+	hasher password.Hasher,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+) *handler.EmailChangeHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_email_change_request_repository_dependency,
+		set_email_normalizer_dependency,
+		set_email_change_usecase_dependency,
+		handler.NewEmailChangeHandler,
+	)
+	return &handler.EmailChangeHandler{}
+}
+
+func NewTwoFactorHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+	store storage.Storage,
+	avatarConfig config.AvatarConfig,
+) *handler.TwoFactorHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_refresh_token_repository_dependency,
+		set_session_repository_dependency,
+		set_recovery_code_repository_dependency,
+		set_sign_in_throttle_repository_dependency,
+		set_session_usecase_dependency,
+		set_email_normalizer_dependency,
+		set_sign_in_throttle_usecase_dependency,
+		set_two_factor_usecase_dependency,
+		set_avatar_usecase_dependency,
+		handler.NewTwoFactorHandler,
+	)
+	return &handler.TwoFactorHandler{}
+}
+
+func NewSignInThrottleHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+) *handler.SignInThrottleHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_sign_in_throttle_repository_dependency,
+		set_email_normalizer_dependency,
+		set_sign_in_throttle_usecase_dependency,
+		handler.NewSignInThrottleHandler,
+	)
+	return &handler.SignInThrottleHandler{}
+}
+
+func NewOIDCHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	providers oidc.Providers,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+	oidcConfig config.OIDCConfig,
+	store storage.Storage,
+	avatarConfig config.AvatarConfig,
+) *handler.OIDCHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_refresh_token_repository_dependency,
+		set_session_repository_dependency,
+		set_recovery_code_repository_dependency,
+		set_sign_in_throttle_repository_dependency,
+		set_account_identity_repository_dependency,
+		set_oidc_login_request_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		set_passkey_repository_dependency,
+		set_passkey_challenge_repository_dependency,
+		set_session_usecase_dependency,
+		set_sign_in_throttle_usecase_dependency,
+		set_two_factor_usecase_dependency,
+		set_email_normalizer_dependency,
+		set_oidc_usecase_dependency,
+		set_avatar_usecase_dependency,
+		handler.NewOIDCHandler,
+	)
+	return &handler.OIDCHandler{}
+}
+
+func NewPasskeyHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	relyingParty *webauthn.WebAuthn,
+	webAuthnConfig config.WebAuthnConfig,
+	store storage.Storage,
+	avatarConfig config.AvatarConfig,
+) *handler.PasskeyHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_refresh_token_repository_dependency,
+		set_session_repository_dependency,
+		set_passkey_repository_dependency,
+		set_passkey_challenge_repository_dependency,
+		set_session_usecase_dependency,
+		set_passkey_usecase_dependency,
+		set_avatar_usecase_dependency,
+		handler.NewPasskeyHandler,
+	)
+	return &handler.PasskeyHandler{}
+}
+
+func NewAvatarHandler(
+	db *sqlc.Queries,
+	store storage.Storage,
+	avatarConfig config.AvatarConfig,
+) *handler.AvatarHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_avatar_usecase_dependency,
+		handler.NewAvatarHandler,
+	)
+	return &handler.AvatarHandler{}
+}
+
+func NewAdminAccountHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	hasher password.Hasher,
+	passwordPolicy *password.Policy,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+	store storage.Storage,
+	avatarConfig config.AvatarConfig,
+) *handler.AdminAccountHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_refresh_token_repository_dependency,
+		set_session_repository_dependency,
+		set_password_reset_token_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		set_session_usecase_dependency,
+		set_email_normalizer_dependency,
+		set_password_reset_usecase_dependency,
+		set_avatar_usecase_dependency,
+		set_admin_account_usecase_dependency,
+		handler.NewAdminAccountHandler,
+	)
+	return &handler.AdminAccountHandler{}
+}
+
+func NewImpersonationHandler(db *sqlc.Queries, tokens auth.TokenManager, authConfig config.AuthConfig) *handler.ImpersonationHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_impersonation_repository_dependency,
+		set_impersonation_usecase_dependency,
+		handler.NewImpersonationHandler,
+	)
+	return &handler.ImpersonationHandler{}
+}
+
+func NewDataJobHandler(
+	db *sqlc.Queries,
+	pool *pgxpool.Pool,
+	store storage.Storage,
+	archives storage.Private,
+	avatarConfig config.AvatarConfig,
+	privacyConfig config.PrivacyConfig,
+) *handler.DataJobHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_session_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		set_passkey_repository_dependency,
+		set_account_identity_repository_dependency,
+		set_email_change_request_repository_dependency,
+		set_recovery_code_repository_dependency,
+		set_data_job_repository_dependency,
+		w.Bind(new(tenant.Beginner), new(*pgxpool.Pool)),
+		set_tenant_scope_dependency,
+		set_workspace_repository_dependency,
+		set_workspace_invitation_repository_dependency,
+		set_workspace_data_usecase_dependency,
+		w.Bind(new(projectUsecase.AccountWorkspaces), new(*workspaceRepository.WorkspaceRepository)),
+		set_project_repository_dependency,
+		set_project_data_usecase_dependency,
+		set_team_repository_dependency,
+		set_team_data_usecase_dependency,
+		w.Bind(new(taskUsecase.AccountWorkspaces), new(*workspaceRepository.WorkspaceRepository)),
+		set_task_repository_dependency,
+		set_task_data_usecase_dependency,
+		set_avatar_usecase_dependency,
+		set_data_job_usecase_dependency,
+		handler.NewDataJobHandler,
+	)
+	return &handler.DataJobHandler{}
+}
+
+func NewPersonalAccessTokenHandler(db *sqlc.Queries) *handler.PersonalAccessTokenHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		set_personal_access_token_usecase_dependency,
+		handler.NewPersonalAccessTokenHandler,
+	)
+	return &handler.PersonalAccessTokenHandler{}
+}
+
+// NewPersonalAccessTokenAuthenticator builds the lookup used by the auth
+// middleware to accept personal access tokens.
+func NewPersonalAccessTokenAuthenticator(db *sqlc.Queries) auth.PersonalAccessTokenAuthenticator {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		usecase.NewPersonalAccessTokenUseCase,
+		w.Bind(new(auth.PersonalAccessTokenAuthenticator), new(*usecase.PersonalAccessTokenUseCase)),
+	)
+	return nil
+}
+
+// NewImpersonationTracker builds the lookup used by the impersonation
+// middleware to check impersonations and keep their audit trail.
+func NewImpersonationTracker(db *sqlc.Queries, tokens auth.TokenManager, authConfig config.AuthConfig) auth.ImpersonationTracker {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_impersonation_repository_dependency,
+		usecase.NewImpersonationUseCase,
+		w.Bind(new(auth.ImpersonationTracker), new(*usecase.ImpersonationUseCase)),
+	)
+	return nil
+}
+
+// NewDataJobWorker builds the use case run by the background worker to carry
+// out exports and erasures of personal data.
+func NewDataJobWorker(
+	db *sqlc.Queries,
+	pool *pgxpool.Pool,
+	store storage.Storage,
+	archives storage.Private,
+	avatarConfig config.AvatarConfig,
+	privacyConfig config.PrivacyConfig,
+) *usecase.DataJobUseCase {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_session_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		set_passkey_repository_dependency,
+		set_account_identity_repository_dependency,
+		set_email_change_request_repository_dependency,
+		set_recovery_code_repository_dependency,
+		set_data_job_repository_dependency,
+		w.Bind(new(tenant.Beginner), new(*pgxpool.Pool)),
+		set_tenant_scope_dependency,
+		set_workspace_repository_dependency,
+		set_workspace_invitation_repository_dependency,
+		set_workspace_data_usecase_dependency,
+		w.Bind(new(projectUsecase.AccountWorkspaces), new(*workspaceRepository.WorkspaceRepository)),
+		set_project_repository_dependency,
+		set_project_data_usecase_dependency,
+		set_team_repository_dependency,
+		set_team_data_usecase_dependency,
+		w.Bind(new(taskUsecase.AccountWorkspaces), new(*workspaceRepository.WorkspaceRepository)),
+		set_task_repository_dependency,
+		set_task_data_usecase_dependency,
+		set_avatar_usecase_dependency,
+		usecase.NewAccountDataUseCase,
+		provideDataExporters,
+		provideDataErasers,
+		usecase.NewDataJobUseCase,
+	)
+	return nil
+}
@@ -0,0 +1,31 @@
+package wire
+
+import (
+	accountUsecase "trilha-api/internal/account/use_case"
+	projectUsecase "trilha-api/internal/project/use_case"
+	"trilha-api/internal/shared/privacy"
+	taskUsecase "trilha-api/internal/task/use_case"
+	teamUsecase "trilha-api/internal/team/use_case"
+	workspaceUsecase "trilha-api/internal/workspace/use_case"
+)
+
+// provideDataExporters lists the modules whose data goes into the exports of
+// personal data.
+func provideDataExporters(
+	accountData *accountUsecase.AccountDataUseCase,
+	workspaceData *workspaceUsecase.WorkspaceDataUseCase,
+	projectData *projectUsecase.ProjectDataUseCase,
+	teamData *teamUsecase.TeamDataUseCase,
+	taskData *taskUsecase.TaskDataUseCase,
+) []privacy.Exporter {
+	return []privacy.Exporter{accountData, workspaceData, projectData, teamData, taskData}
+}
+
+// provideDataErasers lists the modules whose data is erased with an account.
+// The account module anonymizes the account itself, so it comes last.
+func provideDataErasers(
+	accountData *accountUsecase.AccountDataUseCase,
+	workspaceData *workspaceUsecase.WorkspaceDataUseCase,
+) []privacy.Eraser {
+	return []privacy.Eraser{workspaceData, accountData}
+}
@@ -0,0 +1,44 @@
+//go:build wireinject
+// +build wireinject
+
+package wire
+
+import (
+	"trilha-api/internal/project/handler"
+	"trilha-api/internal/project/repository"
+	usecase "trilha-api/internal/project/use_case"
+	"trilha-api/internal/shared/tenant"
+
+	w "github.com/google/wire"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var set_tenant_scope_dependency = w.NewSet(
+	tenant.NewTxScope,
+	w.Bind(new(tenant.Scope), new(*tenant.TxScope)),
+)
+
+var set_project_repository_dependency = w.NewSet(
+	repository.New,
+	w.Bind(new(repository.ProjectRepositoryInterface), new(*repository.ProjectRepository)),
+)
+
+var set_project_usecase_dependency = w.NewSet(
+	usecase.New,
+	w.Bind(new(usecase.ProjectUseCaseInterface), new(*usecase.ProjectUseCase)),
+)
+
+var set_project_data_usecase_dependency = w.NewSet(
+	usecase.NewProjectDataUseCase,
+)
+
+func NewProjectHandler(pool *pgxpool.Pool) *handler.ProjectHandler {
+	w.Build(
+		w.Bind(new(tenant.Beginner), new(*pgxpool.Pool)),
+		set_tenant_scope_dependency,
+		set_project_repository_dependency,
+		set_project_usecase_dependency,
+		handler.New,
+	)
+	return &handler.ProjectHandler{}
+}
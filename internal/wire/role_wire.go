@@ -0,0 +1,47 @@
+//go:build wireinject
+// +build wireinject
+
+package wire
+
+import (
+	"trilha-api/internal/role/handler"
+	"trilha-api/internal/role/repository"
+	usecase "trilha-api/internal/role/use_case"
+	"trilha-api/internal/shared/authz"
+	sqlc "trilha-api/internal/shared/database/sqlc"
+
+	w "github.com/google/wire"
+)
+
+var set_role_repository_dependency = w.NewSet(
+	repository.New,
+	w.Bind(new(repository.RoleRepositoryInterface), new(*repository.RoleRepository)),
+)
+
+var set_role_usecase_dependency = w.NewSet(
+	usecase.New,
+	w.Bind(new(usecase.RoleUseCaseInterface), new(*usecase.RoleUseCase)),
+)
+
+func NewRoleHandler(db *sqlc.Queries) *handler.RoleHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_role_repository_dependency,
+		set_role_usecase_dependency,
+		handler.New,
+	)
+	return &handler.RoleHandler{}
+}
+
+// NewPolicy builds the policy used by middleware.RequirePermission, backed by
+// the roles stored in the database.
+func NewPolicy(db *sqlc.Queries) *authz.Policy {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_role_repository_dependency,
+		usecase.New,
+		w.Bind(new(authz.PermissionChecker), new(*usecase.RoleUseCase)),
+		authz.NewPolicy,
+	)
+	return &authz.Policy{}
+}
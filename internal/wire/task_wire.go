@@ -0,0 +1,39 @@
+//go:build wireinject
+// +build wireinject
+
+package wire
+
+import (
+	"trilha-api/internal/shared/tenant"
+	"trilha-api/internal/task/handler"
+	"trilha-api/internal/task/repository"
+	usecase "trilha-api/internal/task/use_case"
+
+	w "github.com/google/wire"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var set_task_repository_dependency = w.NewSet(
+	repository.New,
+	w.Bind(new(repository.TaskRepositoryInterface), new(*repository.TaskRepository)),
+)
+
+var set_task_usecase_dependency = w.NewSet(
+	usecase.New,
+	w.Bind(new(usecase.TaskUseCaseInterface), new(*usecase.TaskUseCase)),
+)
+
+var set_task_data_usecase_dependency = w.NewSet(
+	usecase.NewTaskDataUseCase,
+)
+
+func NewTaskHandler(pool *pgxpool.Pool) *handler.TaskHandler {
+	w.Build(
+		w.Bind(new(tenant.Beginner), new(*pgxpool.Pool)),
+		set_tenant_scope_dependency,
+		set_task_repository_dependency,
+		set_task_usecase_dependency,
+		handler.New,
+	)
+	return &handler.TaskHandler{}
+}
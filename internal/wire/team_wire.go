@@ -0,0 +1,41 @@
+//go:build wireinject
+// +build wireinject
+
+package wire
+
+import (
+	sqlc "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/tenant"
+	"trilha-api/internal/team/handler"
+	"trilha-api/internal/team/repository"
+	usecase "trilha-api/internal/team/use_case"
+
+	w "github.com/google/wire"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var set_team_repository_dependency = w.NewSet(
+	repository.New,
+	w.Bind(new(repository.TeamRepositoryInterface), new(*repository.TeamRepository)),
+)
+
+var set_team_usecase_dependency = w.NewSet(
+	usecase.New,
+	w.Bind(new(usecase.TeamUseCaseInterface), new(*usecase.TeamUseCase)),
+)
+
+var set_team_data_usecase_dependency = w.NewSet(
+	usecase.NewTeamDataUseCase,
+)
+
+func NewTeamHandler(db *sqlc.Queries, pool *pgxpool.Pool) *handler.TeamHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		w.Bind(new(tenant.Beginner), new(*pgxpool.Pool)),
+		set_tenant_scope_dependency,
+		set_team_repository_dependency,
+		set_team_usecase_dependency,
+		handler.New,
+	)
+	return &handler.TeamHandler{}
+}
@@ -7,24 +7,448 @@
 package wire
 
 import (
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/google/wire"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"trilha-api/internal/account/handler"
 	"trilha-api/internal/account/repository"
 	"trilha-api/internal/account/use_case"
+	handler2 "trilha-api/internal/project/handler"
+	repository3 "trilha-api/internal/project/repository"
+	usecase3 "trilha-api/internal/project/use_case"
+	handler3 "trilha-api/internal/role/handler"
+	repository6 "trilha-api/internal/role/repository"
+	usecase6 "trilha-api/internal/role/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/authz"
+	"trilha-api/internal/shared/config"
 	"trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/oidc"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/shared/storage"
+	"trilha-api/internal/shared/tenant"
+	handler4 "trilha-api/internal/task/handler"
+	repository5 "trilha-api/internal/task/repository"
+	usecase5 "trilha-api/internal/task/use_case"
+	handler5 "trilha-api/internal/team/handler"
+	repository4 "trilha-api/internal/team/repository"
+	usecase4 "trilha-api/internal/team/use_case"
+	handler6 "trilha-api/internal/workspace/handler"
+	repository2 "trilha-api/internal/workspace/repository"
+	usecase2 "trilha-api/internal/workspace/use_case"
 )
 
 // Injectors from account_wire.go:
 
-func NewAccountHandler(db2 *db.Queries) *handler.AccountHandler {
+func NewAccountHandler(db2 *db.Queries, tokens auth.TokenManager, mail mailer.Mailer, hasher password.Hasher, passwordPolicy *password.Policy, authConfig config.AuthConfig, mailConfig config.MailConfig, store storage.Storage, avatarConfig config.AvatarConfig) *handler.AccountHandler {
 	accountRepository := repository.New(db2)
-	accountUseCase := usecase.New(accountRepository)
-	accountHandler := handler.New(accountUseCase)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	sessionUseCase := usecase.NewSessionUseCase(accountRepository, refreshTokenRepository, sessionRepository, tokens)
+	emailVerificationUseCase := usecase.NewEmailVerificationUseCase(accountRepository, tokens, mail, authConfig, mailConfig)
+	recoveryCodeRepository := repository.NewRecoveryCodeRepository(db2)
+	signInThrottleRepository := repository.NewSignInThrottleRepository(db2)
+	emailNormalizer := usecase.NewEmailNormalizer(authConfig)
+	signInThrottleUseCase := usecase.NewSignInThrottleUseCase(signInThrottleRepository, accountRepository, tokens, mail, emailNormalizer, authConfig, mailConfig)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(accountRepository, recoveryCodeRepository, sessionUseCase, signInThrottleUseCase, tokens, authConfig)
+	accountUseCase := usecase.New(accountRepository, personalAccessTokenRepository, sessionUseCase, emailVerificationUseCase, twoFactorUseCase, signInThrottleUseCase, hasher, passwordPolicy, emailNormalizer)
+	avatarUseCase := usecase.NewAvatarUseCase(accountRepository, store, avatarConfig)
+	accountHandler := handler.New(accountUseCase, avatarUseCase)
 	return accountHandler
 }
 
+func NewSessionHandler(db2 *db.Queries, tokens auth.TokenManager) *handler.SessionHandler {
+	accountRepository := repository.New(db2)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	sessionUseCase := usecase.NewSessionUseCase(accountRepository, refreshTokenRepository, sessionRepository, tokens)
+	sessionHandler := handler.NewSessionHandler(sessionUseCase)
+	return sessionHandler
+}
+
+func NewPasswordResetHandler(db2 *db.Queries, tokens auth.TokenManager, mail mailer.Mailer, hasher password.Hasher, passwordPolicy *password.Policy, authConfig config.AuthConfig, mailConfig config.MailConfig) *handler.PasswordResetHandler {
+	accountRepository := repository.New(db2)
+	passwordResetTokenRepository := repository.NewPasswordResetTokenRepository(db2)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	sessionUseCase := usecase.NewSessionUseCase(accountRepository, refreshTokenRepository, sessionRepository, tokens)
+	emailNormalizer := usecase.NewEmailNormalizer(authConfig)
+	passwordResetUseCase := usecase.NewPasswordResetUseCase(accountRepository, passwordResetTokenRepository, personalAccessTokenRepository, sessionUseCase, mail, hasher, passwordPolicy, emailNormalizer, authConfig, mailConfig)
+	passwordResetHandler := handler.NewPasswordResetHandler(passwordResetUseCase)
+	return passwordResetHandler
+}
+
+func NewEmailVerificationHandler(db2 *db.Queries, tokens auth.TokenManager, mail mailer.Mailer, authConfig config.AuthConfig, mailConfig config.MailConfig) *handler.EmailVerificationHandler {
+	accountRepository := repository.New(db2)
+	emailVerificationUseCase := usecase.NewEmailVerificationUseCase(accountRepository, tokens, mail, authConfig, mailConfig)
+	emailVerificationHandler := handler.NewEmailVerificationHandler(emailVerificationUseCase)
+	return emailVerificationHandler
+}
+
+func NewEmailChangeHandler(db2 *db.Queries, mail mailer.Mailer, hasher password.Hasher, authConfig config.AuthConfig, mailConfig config.MailConfig) *handler.EmailChangeHandler {
+	accountRepository := repository.New(db2)
+	emailChangeRequestRepository := repository.NewEmailChangeRequestRepository(db2)
+	emailNormalizer := usecase.NewEmailNormalizer(authConfig)
+	emailChangeUseCase := usecase.NewEmailChangeUseCase(accountRepository, emailChangeRequestRepository, mail, hasher, emailNormalizer, authConfig, mailConfig)
+	emailChangeHandler := handler.NewEmailChangeHandler(emailChangeUseCase)
+	return emailChangeHandler
+}
+
+func NewTwoFactorHandler(db2 *db.Queries, tokens auth.TokenManager, mail mailer.Mailer, authConfig config.AuthConfig, mailConfig config.MailConfig, store storage.Storage, avatarConfig config.AvatarConfig) *handler.TwoFactorHandler {
+	accountRepository := repository.New(db2)
+	recoveryCodeRepository := repository.NewRecoveryCodeRepository(db2)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	sessionUseCase := usecase.NewSessionUseCase(accountRepository, refreshTokenRepository, sessionRepository, tokens)
+	signInThrottleRepository := repository.NewSignInThrottleRepository(db2)
+	emailNormalizer := usecase.NewEmailNormalizer(authConfig)
+	signInThrottleUseCase := usecase.NewSignInThrottleUseCase(signInThrottleRepository, accountRepository, tokens, mail, emailNormalizer, authConfig, mailConfig)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(accountRepository, recoveryCodeRepository, sessionUseCase, signInThrottleUseCase, tokens, authConfig)
+	avatarUseCase := usecase.NewAvatarUseCase(accountRepository, store, avatarConfig)
+	twoFactorHandler := handler.NewTwoFactorHandler(twoFactorUseCase, avatarUseCase)
+	return twoFactorHandler
+}
+
+func NewSignInThrottleHandler(db2 *db.Queries, tokens auth.TokenManager, mail mailer.Mailer, authConfig config.AuthConfig, mailConfig config.MailConfig) *handler.SignInThrottleHandler {
+	signInThrottleRepository := repository.NewSignInThrottleRepository(db2)
+	accountRepository := repository.New(db2)
+	emailNormalizer := usecase.NewEmailNormalizer(authConfig)
+	signInThrottleUseCase := usecase.NewSignInThrottleUseCase(signInThrottleRepository, accountRepository, tokens, mail, emailNormalizer, authConfig, mailConfig)
+	signInThrottleHandler := handler.NewSignInThrottleHandler(signInThrottleUseCase)
+	return signInThrottleHandler
+}
+
+func NewOIDCHandler(db2 *db.Queries, tokens auth.TokenManager, mail mailer.Mailer, providers oidc.Providers, authConfig config.AuthConfig, mailConfig config.MailConfig, oidcConfig config.OIDCConfig, store storage.Storage, avatarConfig config.AvatarConfig) *handler.OIDCHandler {
+	accountRepository := repository.New(db2)
+	accountIdentityRepository := repository.NewAccountIdentityRepository(db2)
+	oidcLoginRequestRepository := repository.NewOIDCLoginRequestRepository(db2)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	recoveryCodeRepository := repository.NewRecoveryCodeRepository(db2)
+	passkeyRepository := repository.NewPasskeyRepository(db2)
+	passkeyChallengeRepository := repository.NewPasskeyChallengeRepository(db2)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	sessionUseCase := usecase.NewSessionUseCase(accountRepository, refreshTokenRepository, sessionRepository, tokens)
+	signInThrottleRepository := repository.NewSignInThrottleRepository(db2)
+	emailNormalizer := usecase.NewEmailNormalizer(authConfig)
+	signInThrottleUseCase := usecase.NewSignInThrottleUseCase(signInThrottleRepository, accountRepository, tokens, mail, emailNormalizer, authConfig, mailConfig)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(accountRepository, recoveryCodeRepository, sessionUseCase, signInThrottleUseCase, tokens, authConfig)
+	oidcUseCase := usecase.NewOIDCUseCase(accountRepository, accountIdentityRepository, oidcLoginRequestRepository, personalAccessTokenRepository, recoveryCodeRepository, passkeyRepository, passkeyChallengeRepository, sessionUseCase, twoFactorUseCase, providers, emailNormalizer, oidcConfig)
+	avatarUseCase := usecase.NewAvatarUseCase(accountRepository, store, avatarConfig)
+	oidcHandler := handler.NewOIDCHandler(oidcUseCase, avatarUseCase)
+	return oidcHandler
+}
+
+func NewPasskeyHandler(db2 *db.Queries, tokens auth.TokenManager, relyingParty *webauthn.WebAuthn, webAuthnConfig config.WebAuthnConfig, store storage.Storage, avatarConfig config.AvatarConfig) *handler.PasskeyHandler {
+	accountRepository := repository.New(db2)
+	passkeyRepository := repository.NewPasskeyRepository(db2)
+	passkeyChallengeRepository := repository.NewPasskeyChallengeRepository(db2)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	sessionUseCase := usecase.NewSessionUseCase(accountRepository, refreshTokenRepository, sessionRepository, tokens)
+	passkeyUseCase := usecase.NewPasskeyUseCase(accountRepository, passkeyRepository, passkeyChallengeRepository, sessionUseCase, relyingParty, webAuthnConfig)
+	avatarUseCase := usecase.NewAvatarUseCase(accountRepository, store, avatarConfig)
+	passkeyHandler := handler.NewPasskeyHandler(passkeyUseCase, avatarUseCase)
+	return passkeyHandler
+}
+
+func NewAvatarHandler(db2 *db.Queries, store storage.Storage, avatarConfig config.AvatarConfig) *handler.AvatarHandler {
+	accountRepository := repository.New(db2)
+	avatarUseCase := usecase.NewAvatarUseCase(accountRepository, store, avatarConfig)
+	avatarHandler := handler.NewAvatarHandler(avatarUseCase, avatarConfig)
+	return avatarHandler
+}
+
+func NewAdminAccountHandler(db2 *db.Queries, tokens auth.TokenManager, mail mailer.Mailer, hasher password.Hasher, passwordPolicy *password.Policy, authConfig config.AuthConfig, mailConfig config.MailConfig, store storage.Storage, avatarConfig config.AvatarConfig) *handler.AdminAccountHandler {
+	accountRepository := repository.New(db2)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	sessionUseCase := usecase.NewSessionUseCase(accountRepository, refreshTokenRepository, sessionRepository, tokens)
+	passwordResetTokenRepository := repository.NewPasswordResetTokenRepository(db2)
+	emailNormalizer := usecase.NewEmailNormalizer(authConfig)
+	passwordResetUseCase := usecase.NewPasswordResetUseCase(accountRepository, passwordResetTokenRepository, personalAccessTokenRepository, sessionUseCase, mail, hasher, passwordPolicy, emailNormalizer, authConfig, mailConfig)
+	avatarUseCase := usecase.NewAvatarUseCase(accountRepository, store, avatarConfig)
+	adminAccountUseCase := usecase.NewAdminAccountUseCase(accountRepository, personalAccessTokenRepository, sessionUseCase, passwordResetUseCase, avatarUseCase)
+	adminAccountHandler := handler.NewAdminAccountHandler(adminAccountUseCase, avatarUseCase)
+	return adminAccountHandler
+}
+
+func NewImpersonationHandler(db2 *db.Queries, tokens auth.TokenManager, authConfig config.AuthConfig) *handler.ImpersonationHandler {
+	accountRepository := repository.New(db2)
+	impersonationRepository := repository.NewImpersonationRepository(db2)
+	impersonationUseCase := usecase.NewImpersonationUseCase(accountRepository, impersonationRepository, tokens, authConfig)
+	impersonationHandler := handler.NewImpersonationHandler(impersonationUseCase)
+	return impersonationHandler
+}
+
+func NewDataJobHandler(db2 *db.Queries, pool *pgxpool.Pool, store storage.Storage, archives storage.Private, avatarConfig config.AvatarConfig, privacyConfig config.PrivacyConfig) *handler.DataJobHandler {
+	accountRepository := repository.New(db2)
+	dataJobRepository := repository.NewDataJobRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	passkeyRepository := repository.NewPasskeyRepository(db2)
+	accountIdentityRepository := repository.NewAccountIdentityRepository(db2)
+	emailChangeRequestRepository := repository.NewEmailChangeRequestRepository(db2)
+	recoveryCodeRepository := repository.NewRecoveryCodeRepository(db2)
+	avatarUseCase := usecase.NewAvatarUseCase(accountRepository, store, avatarConfig)
+	accountDataUseCase := usecase.NewAccountDataUseCase(accountRepository, sessionRepository, personalAccessTokenRepository, passkeyRepository, accountIdentityRepository, emailChangeRequestRepository, recoveryCodeRepository, avatarUseCase)
+	workspaceRepository := repository2.New(db2)
+	workspaceInvitationRepository := repository2.NewWorkspaceInvitationRepository(db2)
+	workspaceDataUseCase := usecase2.NewWorkspaceDataUseCase(workspaceRepository, workspaceInvitationRepository)
+	txScope := tenant.NewTxScope(pool)
+	projectRepository := repository3.New(txScope)
+	projectDataUseCase := usecase3.NewProjectDataUseCase(projectRepository, workspaceRepository)
+	teamRepository := repository4.New(db2, txScope)
+	teamDataUseCase := usecase4.NewTeamDataUseCase(teamRepository)
+	taskRepository := repository5.New(txScope)
+	taskDataUseCase := usecase5.NewTaskDataUseCase(taskRepository, workspaceRepository)
+	v := provideDataExporters(accountDataUseCase, workspaceDataUseCase, projectDataUseCase, teamDataUseCase, taskDataUseCase)
+	v2 := provideDataErasers(accountDataUseCase, workspaceDataUseCase)
+	dataJobUseCase := usecase.NewDataJobUseCase(accountRepository, dataJobRepository, v, v2, archives, privacyConfig)
+	dataJobHandler := handler.NewDataJobHandler(dataJobUseCase)
+	return dataJobHandler
+}
+
+func NewPersonalAccessTokenHandler(db2 *db.Queries) *handler.PersonalAccessTokenHandler {
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	accountRepository := repository.New(db2)
+	personalAccessTokenUseCase := usecase.NewPersonalAccessTokenUseCase(personalAccessTokenRepository, accountRepository)
+	personalAccessTokenHandler := handler.NewPersonalAccessTokenHandler(personalAccessTokenUseCase)
+	return personalAccessTokenHandler
+}
+
+// NewPersonalAccessTokenAuthenticator builds the lookup used by the auth
+// middleware to accept personal access tokens.
+func NewPersonalAccessTokenAuthenticator(db2 *db.Queries) auth.PersonalAccessTokenAuthenticator {
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	accountRepository := repository.New(db2)
+	personalAccessTokenUseCase := usecase.NewPersonalAccessTokenUseCase(personalAccessTokenRepository, accountRepository)
+	return personalAccessTokenUseCase
+}
+
+// NewImpersonationTracker builds the lookup used by the impersonation
+// middleware to check impersonations and keep their audit trail.
+func NewImpersonationTracker(db2 *db.Queries, tokens auth.TokenManager, authConfig config.AuthConfig) auth.ImpersonationTracker {
+	accountRepository := repository.New(db2)
+	impersonationRepository := repository.NewImpersonationRepository(db2)
+	impersonationUseCase := usecase.NewImpersonationUseCase(accountRepository, impersonationRepository, tokens, authConfig)
+	return impersonationUseCase
+}
+
+// NewDataJobWorker builds the use case run by the background worker to carry
+// out exports and erasures of personal data.
+func NewDataJobWorker(db2 *db.Queries, pool *pgxpool.Pool, store storage.Storage, archives storage.Private, avatarConfig config.AvatarConfig, privacyConfig config.PrivacyConfig) *usecase.DataJobUseCase {
+	accountRepository := repository.New(db2)
+	dataJobRepository := repository.NewDataJobRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	passkeyRepository := repository.NewPasskeyRepository(db2)
+	accountIdentityRepository := repository.NewAccountIdentityRepository(db2)
+	emailChangeRequestRepository := repository.NewEmailChangeRequestRepository(db2)
+	recoveryCodeRepository := repository.NewRecoveryCodeRepository(db2)
+	avatarUseCase := usecase.NewAvatarUseCase(accountRepository, store, avatarConfig)
+	accountDataUseCase := usecase.NewAccountDataUseCase(accountRepository, sessionRepository, personalAccessTokenRepository, passkeyRepository, accountIdentityRepository, emailChangeRequestRepository, recoveryCodeRepository, avatarUseCase)
+	workspaceRepository := repository2.New(db2)
+	workspaceInvitationRepository := repository2.NewWorkspaceInvitationRepository(db2)
+	workspaceDataUseCase := usecase2.NewWorkspaceDataUseCase(workspaceRepository, workspaceInvitationRepository)
+	txScope := tenant.NewTxScope(pool)
+	projectRepository := repository3.New(txScope)
+	projectDataUseCase := usecase3.NewProjectDataUseCase(projectRepository, workspaceRepository)
+	teamRepository := repository4.New(db2, txScope)
+	teamDataUseCase := usecase4.NewTeamDataUseCase(teamRepository)
+	taskRepository := repository5.New(txScope)
+	taskDataUseCase := usecase5.NewTaskDataUseCase(taskRepository, workspaceRepository)
+	v := provideDataExporters(accountDataUseCase, workspaceDataUseCase, projectDataUseCase, teamDataUseCase, taskDataUseCase)
+	v2 := provideDataErasers(accountDataUseCase, workspaceDataUseCase)
+	dataJobUseCase := usecase.NewDataJobUseCase(accountRepository, dataJobRepository, v, v2, archives, privacyConfig)
+	return dataJobUseCase
+}
+
+// Injectors from project_wire.go:
+
+func NewProjectHandler(pool *pgxpool.Pool) *handler2.ProjectHandler {
+	txScope := tenant.NewTxScope(pool)
+	projectRepository := repository3.New(txScope)
+	projectUseCase := usecase3.New(projectRepository)
+	projectHandler := handler2.New(projectUseCase)
+	return projectHandler
+}
+
+// Injectors from role_wire.go:
+
+func NewRoleHandler(db2 *db.Queries) *handler3.RoleHandler {
+	roleRepository := repository6.New(db2)
+	roleUseCase := usecase6.New(roleRepository)
+	roleHandler := handler3.New(roleUseCase)
+	return roleHandler
+}
+
+// NewPolicy builds the policy used by middleware.RequirePermission, backed by
+// the roles stored in the database.
+func NewPolicy(db2 *db.Queries) *authz.Policy {
+	roleRepository := repository6.New(db2)
+	roleUseCase := usecase6.New(roleRepository)
+	policy := authz.NewPolicy(roleUseCase)
+	return policy
+}
+
+// Injectors from task_wire.go:
+
+func NewTaskHandler(pool *pgxpool.Pool) *handler4.TaskHandler {
+	txScope := tenant.NewTxScope(pool)
+	taskRepository := repository5.New(txScope)
+	taskUseCase := usecase5.New(taskRepository)
+	taskHandler := handler4.New(taskUseCase)
+	return taskHandler
+}
+
+// Injectors from team_wire.go:
+
+func NewTeamHandler(db2 *db.Queries, pool *pgxpool.Pool) *handler5.TeamHandler {
+	txScope := tenant.NewTxScope(pool)
+	teamRepository := repository4.New(db2, txScope)
+	teamUseCase := usecase4.New(teamRepository)
+	teamHandler := handler5.New(teamUseCase)
+	return teamHandler
+}
+
+// Injectors from workspace_wire.go:
+
+func NewWorkspaceHandler(db2 *db.Queries) *handler6.WorkspaceHandler {
+	workspaceRepository := repository2.New(db2)
+	workspaceUseCase := usecase2.New(workspaceRepository)
+	workspaceHandler := handler6.New(workspaceUseCase)
+	return workspaceHandler
+}
+
+// NewWorkspaceInvitationHandler also builds the account use case, which
+// finds the invited accounts and registers the invitees that have none.
+func NewWorkspaceInvitationHandler(db2 *db.Queries, tokens auth.TokenManager, mail mailer.Mailer, hasher password.Hasher, passwordPolicy *password.Policy, authConfig config.AuthConfig, mailConfig config.MailConfig, workspaceConfig config.WorkspaceConfig) *handler6.WorkspaceInvitationHandler {
+	workspaceInvitationRepository := repository2.NewWorkspaceInvitationRepository(db2)
+	workspaceRepository := repository2.New(db2)
+	accountRepository := repository.New(db2)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db2)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db2)
+	sessionRepository := repository.NewSessionRepository(db2)
+	sessionUseCase := usecase.NewSessionUseCase(accountRepository, refreshTokenRepository, sessionRepository, tokens)
+	emailVerificationUseCase := usecase.NewEmailVerificationUseCase(accountRepository, tokens, mail, authConfig, mailConfig)
+	recoveryCodeRepository := repository.NewRecoveryCodeRepository(db2)
+	signInThrottleRepository := repository.NewSignInThrottleRepository(db2)
+	emailNormalizer := usecase.NewEmailNormalizer(authConfig)
+	signInThrottleUseCase := usecase.NewSignInThrottleUseCase(signInThrottleRepository, accountRepository, tokens, mail, emailNormalizer, authConfig, mailConfig)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(accountRepository, recoveryCodeRepository, sessionUseCase, signInThrottleUseCase, tokens, authConfig)
+	accountUseCase := usecase.New(accountRepository, personalAccessTokenRepository, sessionUseCase, emailVerificationUseCase, twoFactorUseCase, signInThrottleUseCase, hasher, passwordPolicy, emailNormalizer)
+	workspaceInvitationUseCase := usecase2.NewWorkspaceInvitationUseCase(workspaceInvitationRepository, workspaceRepository, accountUseCase, emailNormalizer, mail, mailConfig, workspaceConfig)
+	workspaceInvitationHandler := handler6.NewWorkspaceInvitationHandler(workspaceInvitationUseCase)
+	return workspaceInvitationHandler
+}
+
 // account_wire.go:
 
 var set_account_repository_dependency = wire.NewSet(repository.New, wire.Bind(new(repository.AccountRepositoryInterface), new(*repository.AccountRepository)))
 
+var set_refresh_token_repository_dependency = wire.NewSet(repository.NewRefreshTokenRepository, wire.Bind(new(repository.RefreshTokenRepositoryInterface), new(*repository.RefreshTokenRepository)))
+
+var set_session_repository_dependency = wire.NewSet(repository.NewSessionRepository, wire.Bind(new(repository.SessionRepositoryInterface), new(*repository.SessionRepository)))
+
+var set_password_reset_token_repository_dependency = wire.NewSet(repository.NewPasswordResetTokenRepository, wire.Bind(new(repository.PasswordResetTokenRepositoryInterface), new(*repository.PasswordResetTokenRepository)))
+
+var set_email_change_request_repository_dependency = wire.NewSet(repository.NewEmailChangeRequestRepository, wire.Bind(new(repository.EmailChangeRequestRepositoryInterface), new(*repository.EmailChangeRequestRepository)))
+
+var set_recovery_code_repository_dependency = wire.NewSet(repository.NewRecoveryCodeRepository, wire.Bind(new(repository.RecoveryCodeRepositoryInterface), new(*repository.RecoveryCodeRepository)))
+
+var set_personal_access_token_repository_dependency = wire.NewSet(repository.NewPersonalAccessTokenRepository, wire.Bind(new(repository.PersonalAccessTokenRepositoryInterface), new(*repository.PersonalAccessTokenRepository)))
+
+var set_sign_in_throttle_repository_dependency = wire.NewSet(repository.NewSignInThrottleRepository, wire.Bind(new(repository.SignInThrottleRepositoryInterface), new(*repository.SignInThrottleRepository)))
+
+var set_account_identity_repository_dependency = wire.NewSet(repository.NewAccountIdentityRepository, wire.Bind(new(repository.AccountIdentityRepositoryInterface), new(*repository.AccountIdentityRepository)))
+
+var set_oidc_login_request_repository_dependency = wire.NewSet(repository.NewOIDCLoginRequestRepository, wire.Bind(new(repository.OIDCLoginRequestRepositoryInterface), new(*repository.OIDCLoginRequestRepository)))
+
+var set_passkey_repository_dependency = wire.NewSet(repository.NewPasskeyRepository, wire.Bind(new(repository.PasskeyRepositoryInterface), new(*repository.PasskeyRepository)))
+
+var set_passkey_challenge_repository_dependency = wire.NewSet(repository.NewPasskeyChallengeRepository, wire.Bind(new(repository.PasskeyChallengeRepositoryInterface), new(*repository.PasskeyChallengeRepository)))
+
+var set_impersonation_repository_dependency = wire.NewSet(repository.NewImpersonationRepository, wire.Bind(new(repository.ImpersonationRepositoryInterface), new(*repository.ImpersonationRepository)))
+
+var set_data_job_repository_dependency = wire.NewSet(repository.NewDataJobRepository, wire.Bind(new(repository.DataJobRepositoryInterface), new(*repository.DataJobRepository)))
+
+var set_email_normalizer_dependency = wire.NewSet(usecase.NewEmailNormalizer)
+
 var set_account_usecase_dependency = wire.NewSet(usecase.New, wire.Bind(new(usecase.AccountUseCaseInterface), new(*usecase.AccountUseCase)))
+
+var set_session_usecase_dependency = wire.NewSet(usecase.NewSessionUseCase, wire.Bind(new(usecase.SessionUseCaseInterface), new(*usecase.SessionUseCase)))
+
+var set_password_reset_usecase_dependency = wire.NewSet(usecase.NewPasswordResetUseCase, wire.Bind(new(usecase.PasswordResetUseCaseInterface), new(*usecase.PasswordResetUseCase)))
+
+var set_email_verification_usecase_dependency = wire.NewSet(usecase.NewEmailVerificationUseCase, wire.Bind(new(usecase.EmailVerificationUseCaseInterface), new(*usecase.EmailVerificationUseCase)))
+
+var set_email_change_usecase_dependency = wire.NewSet(usecase.NewEmailChangeUseCase, wire.Bind(new(usecase.EmailChangeUseCaseInterface), new(*usecase.EmailChangeUseCase)))
+
+var set_two_factor_usecase_dependency = wire.NewSet(usecase.NewTwoFactorUseCase, wire.Bind(new(usecase.TwoFactorUseCaseInterface), new(*usecase.TwoFactorUseCase)))
+
+var set_sign_in_throttle_usecase_dependency = wire.NewSet(usecase.NewSignInThrottleUseCase, wire.Bind(new(usecase.SignInThrottleUseCaseInterface), new(*usecase.SignInThrottleUseCase)))
+
+var set_personal_access_token_usecase_dependency = wire.NewSet(usecase.NewPersonalAccessTokenUseCase, wire.Bind(new(usecase.PersonalAccessTokenUseCaseInterface), new(*usecase.PersonalAccessTokenUseCase)))
+
+var set_oidc_usecase_dependency = wire.NewSet(usecase.NewOIDCUseCase, wire.Bind(new(usecase.OIDCUseCaseInterface), new(*usecase.OIDCUseCase)))
+
+var set_avatar_usecase_dependency = wire.NewSet(usecase.NewAvatarUseCase, wire.Bind(new(usecase.AvatarUseCaseInterface), new(*usecase.AvatarUseCase)))
+
+var set_passkey_usecase_dependency = wire.NewSet(usecase.NewPasskeyUseCase, wire.Bind(new(usecase.PasskeyUseCaseInterface), new(*usecase.PasskeyUseCase)))
+
+var set_admin_account_usecase_dependency = wire.NewSet(usecase.NewAdminAccountUseCase, wire.Bind(new(usecase.AdminAccountUseCaseInterface), new(*usecase.AdminAccountUseCase)))
+
+var set_impersonation_usecase_dependency = wire.NewSet(usecase.NewImpersonationUseCase, wire.Bind(new(usecase.ImpersonationUseCaseInterface), new(*usecase.ImpersonationUseCase)))
+
+var set_data_job_usecase_dependency = wire.NewSet(usecase.NewAccountDataUseCase, provideDataExporters,
+	provideDataErasers, usecase.NewDataJobUseCase, wire.Bind(new(usecase.DataJobUseCaseInterface), new(*usecase.DataJobUseCase)),
+)
+
+// project_wire.go:
+
+var set_tenant_scope_dependency = wire.NewSet(tenant.NewTxScope, wire.Bind(new(tenant.Scope), new(*tenant.TxScope)))
+
+var set_project_repository_dependency = wire.NewSet(repository3.New, wire.Bind(new(repository3.ProjectRepositoryInterface), new(*repository3.ProjectRepository)))
+
+var set_project_usecase_dependency = wire.NewSet(usecase3.New, wire.Bind(new(usecase3.ProjectUseCaseInterface), new(*usecase3.ProjectUseCase)))
+
+var set_project_data_usecase_dependency = wire.NewSet(usecase3.NewProjectDataUseCase)
+
+// role_wire.go:
+
+var set_role_repository_dependency = wire.NewSet(repository6.New, wire.Bind(new(repository6.RoleRepositoryInterface), new(*repository6.RoleRepository)))
+
+var set_role_usecase_dependency = wire.NewSet(usecase6.New, wire.Bind(new(usecase6.RoleUseCaseInterface), new(*usecase6.RoleUseCase)))
+
+// task_wire.go:
+
+var set_task_repository_dependency = wire.NewSet(repository5.New, wire.Bind(new(repository5.TaskRepositoryInterface), new(*repository5.TaskRepository)))
+
+var set_task_usecase_dependency = wire.NewSet(usecase5.New, wire.Bind(new(usecase5.TaskUseCaseInterface), new(*usecase5.TaskUseCase)))
+
+var set_task_data_usecase_dependency = wire.NewSet(usecase5.NewTaskDataUseCase)
+
+// team_wire.go:
+
+var set_team_repository_dependency = wire.NewSet(repository4.New, wire.Bind(new(repository4.TeamRepositoryInterface), new(*repository4.TeamRepository)))
+
+var set_team_usecase_dependency = wire.NewSet(usecase4.New, wire.Bind(new(usecase4.TeamUseCaseInterface), new(*usecase4.TeamUseCase)))
+
+var set_team_data_usecase_dependency = wire.NewSet(usecase4.NewTeamDataUseCase)
+
+// workspace_wire.go:
+
+var set_workspace_repository_dependency = wire.NewSet(repository2.New, wire.Bind(new(repository2.WorkspaceRepositoryInterface), new(*repository2.WorkspaceRepository)))
+
+var set_workspace_invitation_repository_dependency = wire.NewSet(repository2.NewWorkspaceInvitationRepository, wire.Bind(new(repository2.WorkspaceInvitationRepositoryInterface), new(*repository2.WorkspaceInvitationRepository)))
+
+var set_workspace_usecase_dependency = wire.NewSet(usecase2.New, wire.Bind(new(usecase2.WorkspaceUseCaseInterface), new(*usecase2.WorkspaceUseCase)))
+
+var set_workspace_invitation_usecase_dependency = wire.NewSet(usecase2.NewWorkspaceInvitationUseCase, wire.Bind(new(usecase2.WorkspaceInvitationUseCaseInterface), new(*usecase2.WorkspaceInvitationUseCase)))
+
+var set_workspace_data_usecase_dependency = wire.NewSet(usecase2.NewWorkspaceDataUseCase)
@@ -0,0 +1,88 @@
+//go:build wireinject
+// +build wireinject
+
+package wire
+
+import (
+	accountUsecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/auth"
+	"trilha-api/internal/shared/config"
+	sqlc "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/workspace/handler"
+	"trilha-api/internal/workspace/repository"
+	usecase "trilha-api/internal/workspace/use_case"
+
+	w "github.com/google/wire"
+)
+
+var set_workspace_repository_dependency = w.NewSet(
+	repository.New,
+	w.Bind(new(repository.WorkspaceRepositoryInterface), new(*repository.WorkspaceRepository)),
+)
+
+var set_workspace_invitation_repository_dependency = w.NewSet(
+	repository.NewWorkspaceInvitationRepository,
+	w.Bind(new(repository.WorkspaceInvitationRepositoryInterface), new(*repository.WorkspaceInvitationRepository)),
+)
+
+var set_workspace_usecase_dependency = w.NewSet(
+	usecase.New,
+	w.Bind(new(usecase.WorkspaceUseCaseInterface), new(*usecase.WorkspaceUseCase)),
+)
+
+var set_workspace_invitation_usecase_dependency = w.NewSet(
+	usecase.NewWorkspaceInvitationUseCase,
+	w.Bind(new(usecase.WorkspaceInvitationUseCaseInterface), new(*usecase.WorkspaceInvitationUseCase)),
+)
+
+var set_workspace_data_usecase_dependency = w.NewSet(
+	usecase.NewWorkspaceDataUseCase,
+)
+
+func NewWorkspaceHandler(db *sqlc.Queries) *handler.WorkspaceHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_workspace_repository_dependency,
+		set_workspace_usecase_dependency,
+		handler.New,
+	)
+	return &handler.WorkspaceHandler{}
+}
+
+// NewWorkspaceInvitationHandler also builds the account use case, which
+// finds the invited accounts and registers the invitees that have none.
+func NewWorkspaceInvitationHandler(
+	db *sqlc.Queries,
+	tokens auth.TokenManager,
+	mail mailer.Mailer,
+	hasher password.Hasher,
+	passwordPolicy *password.Policy,
+	authConfig config.AuthConfig,
+	mailConfig config.MailConfig,
+	workspaceConfig config.WorkspaceConfig,
+) *handler.WorkspaceInvitationHandler {
+	w.Build(
+		w.Bind(new(sqlc.Querier), new(*sqlc.Queries)),
+		set_account_repository_dependency,
+		set_personal_access_token_repository_dependency,
+		set_refresh_token_repository_dependency,
+		set_session_repository_dependency,
+		set_recovery_code_repository_dependency,
+		set_sign_in_throttle_repository_dependency,
+		set_session_usecase_dependency,
+		set_email_verification_usecase_dependency,
+		set_sign_in_throttle_usecase_dependency,
+		set_two_factor_usecase_dependency,
+		set_email_normalizer_dependency,
+		set_account_usecase_dependency,
+		set_workspace_repository_dependency,
+		set_workspace_invitation_repository_dependency,
+		set_workspace_invitation_usecase_dependency,
+		w.Bind(new(usecase.InviteeAccounts), new(*accountUsecase.AccountUseCase)),
+		w.Bind(new(usecase.EmailNormalizer), new(*accountUsecase.EmailNormalizer)),
+		handler.NewWorkspaceInvitationHandler,
+	)
+	return &handler.WorkspaceInvitationHandler{}
+}
@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceResponse is a workspace. CreatedBy is null once the account that
+// created it is gone; Role is the role of the caller, when listing its
+// workspaces.
+type WorkspaceResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	CreatedBy *uuid.UUID `json:"created_by"`
+	Role      string     `json:"role,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name" binding:"required,max=200"`
+}
+
+type UpdateWorkspaceRequest struct {
+	Name string `json:"name" binding:"required,max=200"`
+}
+
+type WorkspaceMemberResponse struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+// SetWorkspaceMemberRequest gives the role of a member, one of
+// workspace_owner, member and guest.
+type SetWorkspaceMemberRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// InviteWorkspaceMemberRequest invites email to join the workspace with
+// role, one of workspace_owner, member and guest.
+type InviteWorkspaceMemberRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required"`
+}
+
+// WorkspaceInvitationResponse is a pending invitation. InvitedBy is null once
+// the account that sent it is gone.
+type WorkspaceInvitationResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Email     string     `json:"email"`
+	Role      string     `json:"role"`
+	InvitedBy *uuid.UUID `json:"invited_by"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	SentAt    time.Time  `json:"sent_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type InvitationTokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// InvitationPreviewResponse tells the invitee what the invitation holding a
+// token is for, before it is accepted.
+type InvitationPreviewResponse struct {
+	WorkspaceID   uuid.UUID `json:"workspace_id"`
+	WorkspaceName string    `json:"workspace_name"`
+	Email         string    `json:"email"`
+	Role          string    `json:"role"`
+	InviterName   string    `json:"inviter_name"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// RegisterInviteeRequest creates the account of an invitee, with the email
+// the invitation was sent to.
+type RegisterInviteeRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResourceType is the resource type of the roles granted on a workspace.
+const ResourceType = "workspace"
+
+// Roles a member may hold on a workspace. Each member holds exactly one.
+const (
+	WorkspaceRoleOwner  = "workspace_owner"
+	WorkspaceRoleMember = "member"
+	WorkspaceRoleGuest  = "guest"
+)
+
+// WorkspaceEntity is a workspace. CreatedBy is uuid.Nil once the account
+// that created it is gone. Role is the role of the account the workspace was
+// listed for, when it was.
+type WorkspaceEntity struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedBy uuid.UUID
+	Role      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WorkspaceMemberEntity is an account holding Role on the workspace
+// WorkspaceID since JoinedAt.
+type WorkspaceMemberEntity struct {
+	WorkspaceID uuid.UUID
+	AccountID   uuid.UUID
+	Name        string
+	Email       string
+	Role        string
+	JoinedAt    time.Time
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceInvitationEntity asks the owner of Email to join the workspace
+// WorkspaceID with Role. Token is only known when the invitation is sent;
+// InvitedBy is uuid.Nil once the account that sent it is gone.
+// WorkspaceName and InviterName are only loaded along with the token.
+type WorkspaceInvitationEntity struct {
+	ID            uuid.UUID
+	WorkspaceID   uuid.UUID
+	WorkspaceName string
+	Email         string
+	Role          string
+	Token         string
+	TokenHash     string
+	InvitedBy     uuid.UUID
+	InviterName   string
+	ExpiresAt     time.Time
+	SentAt        time.Time
+	AcceptedAt    *time.Time
+	RevokedAt     *time.Time
+	CreatedAt     time.Time
+}
+
+// IsPending reports whether the invitation can still be accepted at now.
+func (i *WorkspaceInvitationEntity) IsPending(now time.Time) bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && now.Before(i.ExpiresAt)
+}
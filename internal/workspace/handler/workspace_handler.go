@@ -0,0 +1,336 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"trilha-api/internal/shared/auth"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/workspace/dto"
+	"trilha-api/internal/workspace/entity"
+	"trilha-api/internal/workspace/repository"
+	usecase "trilha-api/internal/workspace/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WorkspaceHandler struct {
+	usecase usecase.WorkspaceUseCaseInterface
+}
+
+func New(uc usecase.WorkspaceUseCaseInterface) *WorkspaceHandler {
+	return &WorkspaceHandler{usecase: uc}
+}
+
+// List returns the workspaces the caller belongs to, with its role on each.
+func (h *WorkspaceHandler) List(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	workspaces, err := h.usecase.ListByAccount(principal.AccountID)
+
+	if err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	res := make([]dto.WorkspaceResponse, 0, len(workspaces))
+	for i := range workspaces {
+		res = append(res, toWorkspaceResponse(&workspaces[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.WorkspaceResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+// Create stores a new workspace, owned by the caller.
+func (h *WorkspaceHandler) Create(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.CreateWorkspaceRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	workspace := &entity.WorkspaceEntity{Name: req.Name, CreatedBy: principal.AccountID}
+
+	if err := h.usecase.Create(workspace); err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.WorkspaceResponse]{
+		Status: http.StatusCreated,
+		Data:   toWorkspaceResponse(workspace),
+	})
+}
+
+func (h *WorkspaceHandler) Find(c *gin.Context) {
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Find(workspace); err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.WorkspaceResponse]{
+		Status: http.StatusOK,
+		Data:   toWorkspaceResponse(workspace),
+	})
+}
+
+func (h *WorkspaceHandler) Update(c *gin.Context) {
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.UpdateWorkspaceRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	workspace.Name = req.Name
+
+	if err := h.usecase.Update(workspace); err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.WorkspaceResponse]{
+		Status: http.StatusOK,
+		Data:   toWorkspaceResponse(workspace),
+	})
+}
+
+func (h *WorkspaceHandler) Delete(c *gin.Context) {
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Delete(workspace); err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *WorkspaceHandler) ListMembers(c *gin.Context) {
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	members, err := h.usecase.ListMembers(workspace.ID)
+
+	if err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	res := make([]dto.WorkspaceMemberResponse, 0, len(members))
+	for i := range members {
+		res = append(res, toWorkspaceMemberResponse(&members[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.WorkspaceMemberResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+// SetMember changes the role of a member of the workspace. Accounts join
+// through invitations only.
+func (h *WorkspaceHandler) SetMember(c *gin.Context) {
+	member, ok := parseMember(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.SetWorkspaceMemberRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	member.Role = req.Role
+
+	if err := h.usecase.SetMember(member); err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.WorkspaceMemberResponse]{
+		Status: http.StatusOK,
+		Data:   toWorkspaceMemberResponse(member),
+	})
+}
+
+func (h *WorkspaceHandler) RemoveMember(c *gin.Context) {
+	member, ok := parseMember(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.RemoveMember(member); err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Leave takes the caller out of the workspace.
+func (h *WorkspaceHandler) Leave(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	member := &entity.WorkspaceMemberEntity{WorkspaceID: workspace.ID, AccountID: principal.AccountID}
+
+	if err := h.usecase.RemoveMember(member); err != nil {
+		respondWorkspaceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// requirePrincipal returns the authenticated caller, answering 401 when the
+// route was reached without one.
+func requirePrincipal(c *gin.Context) (*auth.Principal, bool) {
+	principal, ok := auth.CurrentPrincipal(c)
+
+	if !ok {
+		c.JSON(http.StatusUnauthorized, sharedDto.APIResponse[any]{
+			Status:  http.StatusUnauthorized,
+			Message: "Authentication required",
+		})
+	}
+
+	return principal, ok
+}
+
+func parseWorkspace(c *gin.Context) (*entity.WorkspaceEntity, bool) {
+	workspaceID, err := uuid.Parse(c.Param("ws"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid workspace ID")
+		return nil, false
+	}
+
+	return &entity.WorkspaceEntity{ID: workspaceID}, true
+}
+
+func parseMember(c *gin.Context) (*entity.WorkspaceMemberEntity, bool) {
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	accountID, err := uuid.Parse(c.Param("account_id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid account ID")
+		return nil, false
+	}
+
+	return &entity.WorkspaceMemberEntity{WorkspaceID: workspace.ID, AccountID: accountID}, true
+}
+
+func respondBadRequest(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, sharedDto.APIResponse[any]{
+		Status:  http.StatusBadRequest,
+		Message: message,
+	})
+}
+
+func respondWorkspaceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Workspace or member not found",
+		})
+	case errors.Is(err, repository.ErrAlreadyMember):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Account is already a member of the workspace",
+		})
+	case errors.Is(err, usecase.ErrLastWorkspaceOwner):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "The workspace must keep at least one owner",
+		})
+	case errors.Is(err, usecase.ErrUnknownWorkspaceRole):
+		respondBadRequest(c, "role must be one of workspace_owner, member and guest")
+	case errors.Is(err, usecase.ErrBlankWorkspaceName):
+		respondBadRequest(c, "name cannot be blank")
+	default:
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
+
+func toWorkspaceResponse(workspace *entity.WorkspaceEntity) dto.WorkspaceResponse {
+	var createdBy *uuid.UUID
+	if workspace.CreatedBy != uuid.Nil {
+		createdBy = &workspace.CreatedBy
+	}
+
+	return dto.WorkspaceResponse{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		CreatedBy: createdBy,
+		Role:      workspace.Role,
+		CreatedAt: workspace.CreatedAt,
+		UpdatedAt: workspace.UpdatedAt,
+	}
+}
+
+func toWorkspaceMemberResponse(member *entity.WorkspaceMemberEntity) dto.WorkspaceMemberResponse {
+	return dto.WorkspaceMemberResponse{
+		AccountID: member.AccountID,
+		Name:      member.Name,
+		Email:     member.Email,
+		Role:      member.Role,
+		JoinedAt:  member.JoinedAt,
+	}
+}
@@ -0,0 +1,227 @@
+package handler_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trilha-api/internal/shared/auth"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/workspace/dto"
+	"trilha-api/internal/workspace/entity"
+	"trilha-api/internal/workspace/handler"
+	"trilha-api/internal/workspace/mocks"
+	usecase "trilha-api/internal/workspace/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*gin.Engine, *mocks.MockWorkspaceUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockWorkspaceUseCaseInterface(ctrl)
+	h := handler.New(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.GET("/api/v1/workspaces", h.List)
+	router.POST("/api/v1/workspaces", h.Create)
+	router.GET("/api/v1/workspaces/:ws", h.Find)
+	router.PATCH("/api/v1/workspaces/:ws", h.Update)
+	router.DELETE("/api/v1/workspaces/:ws", h.Delete)
+	router.POST("/api/v1/workspaces/:ws/leave", h.Leave)
+	router.GET("/api/v1/workspaces/:ws/members", h.ListMembers)
+	router.PUT("/api/v1/workspaces/:ws/members/:account_id", h.SetMember)
+	router.DELETE("/api/v1/workspaces/:ws/members/:account_id", h.RemoveMember)
+
+	return router, mock
+}
+
+// fakeAuthentication authenticates requests as the account in the
+// X-Account-ID header, standing in for the auth middleware.
+func fakeAuthentication() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if accountID, err := uuid.Parse(c.GetHeader("X-Account-ID")); err == nil {
+			auth.SetPrincipal(c, &auth.Principal{AccountID: accountID, Verified: true})
+		}
+		c.Next()
+	}
+}
+
+func send(router *gin.Engine, method, path string, accountID uuid.UUID, body any) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(method, path, &buf)
+	r.Header.Set("Content-Type", "application/json")
+	if accountID != uuid.Nil {
+		r.Header.Set("X-Account-ID", accountID.String())
+	}
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func TestWorkspaceHandler_Create(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return status 201 and the workspace owned by the caller", func(t *testing.T) {
+		mockUseCase.EXPECT().Create(gomock.Any()).DoAndReturn(func(workspace *entity.WorkspaceEntity) error {
+			assert.Equal(t, accountID, workspace.CreatedBy)
+			assert.Equal(t, "Cliente A", workspace.Name)
+			workspace.ID = uuid.New()
+			workspace.Role = entity.WorkspaceRoleOwner
+			return nil
+		})
+
+		w := send(router, http.MethodPost, "/api/v1/workspaces", accountID, dto.CreateWorkspaceRequest{Name: "Cliente A"})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.WorkspaceResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.WorkspaceRoleOwner, responseBody.Data.Role)
+		assert.Equal(t, &accountID, responseBody.Data.CreatedBy)
+	})
+
+	t.Run("should return status 400 without a name", func(t *testing.T) {
+		w := send(router, http.MethodPost, "/api/v1/workspaces", accountID, dto.CreateWorkspaceRequest{})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := send(router, http.MethodPost, "/api/v1/workspaces", uuid.Nil, dto.CreateWorkspaceRequest{Name: "Cliente A"})
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestWorkspaceHandler_List(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return the workspaces of the caller", func(t *testing.T) {
+		mockUseCase.EXPECT().ListByAccount(accountID).Return([]entity.WorkspaceEntity{
+			{ID: uuid.New(), Name: "Cliente A", Role: entity.WorkspaceRoleOwner},
+			{ID: uuid.New(), Name: "Cliente B", Role: entity.WorkspaceRoleGuest},
+		}, nil)
+
+		w := send(router, http.MethodGet, "/api/v1/workspaces", accountID, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[[]dto.WorkspaceResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Len(t, responseBody.Data, 2)
+		assert.Nil(t, responseBody.Data[1].CreatedBy)
+	})
+}
+
+func TestWorkspaceHandler_Find(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+	workspaceID := uuid.New()
+
+	t.Run("should return status 404 when the workspace does not exist", func(t *testing.T) {
+		mockUseCase.EXPECT().Find(&entity.WorkspaceEntity{ID: workspaceID}).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodGet, "/api/v1/workspaces/"+workspaceID.String(), accountID, nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 for an invalid ID", func(t *testing.T) {
+		w := send(router, http.MethodGet, "/api/v1/workspaces/mordor", accountID, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestWorkspaceHandler_SetMember(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	callerID := uuid.New()
+	workspaceID := uuid.New()
+	accountID := uuid.New()
+	path := "/api/v1/workspaces/" + workspaceID.String() + "/members/" + accountID.String()
+
+	t.Run("should return status 200 and the membership", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).DoAndReturn(func(member *entity.WorkspaceMemberEntity) error {
+			assert.Equal(t, workspaceID, member.WorkspaceID)
+			assert.Equal(t, accountID, member.AccountID)
+			assert.Equal(t, entity.WorkspaceRoleGuest, member.Role)
+			member.Name = "Sam"
+			return nil
+		})
+
+		w := send(router, http.MethodPut, path, callerID, dto.SetWorkspaceMemberRequest{Role: entity.WorkspaceRoleGuest})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.WorkspaceMemberResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Sam", responseBody.Data.Name)
+	})
+
+	t.Run("should return status 400 for an unknown role", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).Return(usecase.ErrUnknownWorkspaceRole)
+
+		w := send(router, http.MethodPut, path, callerID, dto.SetWorkspaceMemberRequest{Role: "system_admin"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 404 when the account is not a member", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodPut, path, callerID, dto.SetWorkspaceMemberRequest{Role: entity.WorkspaceRoleMember})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 409 when demoting the last owner", func(t *testing.T) {
+		mockUseCase.EXPECT().SetMember(gomock.Any()).Return(usecase.ErrLastWorkspaceOwner)
+
+		w := send(router, http.MethodPut, path, callerID, dto.SetWorkspaceMemberRequest{Role: entity.WorkspaceRoleMember})
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestWorkspaceHandler_Leave(t *testing.T) {
+	router, mockUseCase := setup(t)
+
+	accountID := uuid.New()
+	workspaceID := uuid.New()
+
+	t.Run("should remove the caller from the workspace", func(t *testing.T) {
+		mockUseCase.EXPECT().RemoveMember(&entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: accountID}).Return(nil)
+
+		w := send(router, http.MethodPost, "/api/v1/workspaces/"+workspaceID.String()+"/leave", accountID, nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 409 when the caller is the last owner", func(t *testing.T) {
+		mockUseCase.EXPECT().RemoveMember(gomock.Any()).Return(usecase.ErrLastWorkspaceOwner)
+
+		w := send(router, http.MethodPost, "/api/v1/workspaces/"+workspaceID.String()+"/leave", accountID, nil)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	accountEntity "trilha-api/internal/account/entity"
+	accountRepository "trilha-api/internal/account/repository"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/workspace/dto"
+	"trilha-api/internal/workspace/entity"
+	"trilha-api/internal/workspace/repository"
+	usecase "trilha-api/internal/workspace/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WorkspaceInvitationHandler struct {
+	usecase usecase.WorkspaceInvitationUseCaseInterface
+}
+
+func NewWorkspaceInvitationHandler(uc usecase.WorkspaceInvitationUseCaseInterface) *WorkspaceInvitationHandler {
+	return &WorkspaceInvitationHandler{usecase: uc}
+}
+
+// Invite emails an invitation to join the workspace, sent by the caller.
+func (h *WorkspaceInvitationHandler) Invite(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.InviteWorkspaceMemberRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	invitation := &entity.WorkspaceInvitationEntity{
+		WorkspaceID: workspace.ID,
+		Email:       req.Email,
+		Role:        req.Role,
+		InvitedBy:   principal.AccountID,
+	}
+
+	if err := h.usecase.Invite(invitation); err != nil {
+		respondInvitationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.WorkspaceInvitationResponse]{
+		Status: http.StatusCreated,
+		Data:   toWorkspaceInvitationResponse(invitation),
+	})
+}
+
+func (h *WorkspaceInvitationHandler) ListPending(c *gin.Context) {
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return
+	}
+
+	invitations, err := h.usecase.ListPending(workspace.ID)
+
+	if err != nil {
+		respondInvitationError(c, err)
+		return
+	}
+
+	res := make([]dto.WorkspaceInvitationResponse, 0, len(invitations))
+	for i := range invitations {
+		res = append(res, toWorkspaceInvitationResponse(&invitations[i]))
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[[]dto.WorkspaceInvitationResponse]{
+		Status: http.StatusOK,
+		Data:   res,
+	})
+}
+
+func (h *WorkspaceInvitationHandler) Resend(c *gin.Context) {
+	invitation, ok := parseInvitation(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Resend(invitation); err != nil {
+		respondInvitationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.WorkspaceInvitationResponse]{
+		Status: http.StatusOK,
+		Data:   toWorkspaceInvitationResponse(invitation),
+	})
+}
+
+func (h *WorkspaceInvitationHandler) Revoke(c *gin.Context) {
+	invitation, ok := parseInvitation(c)
+
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.Revoke(invitation); err != nil {
+		respondInvitationError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Preview tells who the invitation holding the token was sent to and for
+// which workspace, so the invitee can choose to sign in or register.
+func (h *WorkspaceInvitationHandler) Preview(c *gin.Context) {
+	req := dto.InvitationTokenRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	invitation := &entity.WorkspaceInvitationEntity{Token: req.Token}
+
+	if err := h.usecase.Preview(invitation); err != nil {
+		respondInvitationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.InvitationPreviewResponse]{
+		Status: http.StatusOK,
+		Data: dto.InvitationPreviewResponse{
+			WorkspaceID:   invitation.WorkspaceID,
+			WorkspaceName: invitation.WorkspaceName,
+			Email:         invitation.Email,
+			Role:          invitation.Role,
+			InviterName:   invitation.InviterName,
+			ExpiresAt:     invitation.ExpiresAt,
+		},
+	})
+}
+
+// Accept makes the caller a member of the workspace it was invited to.
+func (h *WorkspaceInvitationHandler) Accept(c *gin.Context) {
+	principal, ok := requirePrincipal(c)
+
+	if !ok {
+		return
+	}
+
+	req := dto.InvitationTokenRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	workspace, err := h.usecase.Accept(&entity.WorkspaceInvitationEntity{Token: req.Token}, principal.AccountID)
+
+	if err != nil {
+		respondInvitationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sharedDto.APIResponse[dto.WorkspaceResponse]{
+		Status: http.StatusOK,
+		Data:   toWorkspaceResponse(workspace),
+	})
+}
+
+// Register creates the account of an invitee with the email the invitation
+// was sent to and makes it a member of the workspace. The account still has
+// to verify its email and sign in as any other.
+func (h *WorkspaceInvitationHandler) Register(c *gin.Context) {
+	req := dto.RegisterInviteeRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	account := &accountEntity.AccountEntity{Name: req.Name, Password: req.Password}
+
+	workspace, err := h.usecase.Register(&entity.WorkspaceInvitationEntity{Token: req.Token}, account)
+
+	if err != nil {
+		if respondPasswordPolicy(c, "password", err) {
+			return
+		}
+		respondInvitationError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sharedDto.APIResponse[dto.WorkspaceResponse]{
+		Status: http.StatusCreated,
+		Data:   toWorkspaceResponse(workspace),
+	})
+}
+
+func parseInvitation(c *gin.Context) (*entity.WorkspaceInvitationEntity, bool) {
+	workspace, ok := parseWorkspace(c)
+
+	if !ok {
+		return nil, false
+	}
+
+	invitationID, err := uuid.Parse(c.Param("invitation_id"))
+
+	if err != nil {
+		respondBadRequest(c, "Invalid invitation ID")
+		return nil, false
+	}
+
+	return &entity.WorkspaceInvitationEntity{ID: invitationID, WorkspaceID: workspace.ID}, true
+}
+
+// respondPasswordPolicy answers 422 with an error per broken rule when err
+// tells that the password sent in field does not follow the password policy,
+// and reports whether it did.
+func respondPasswordPolicy(c *gin.Context, field string, err error) bool {
+	var policyErr *password.PolicyError
+
+	if !errors.As(err, &policyErr) {
+		return false
+	}
+
+	fieldErrors := make([]sharedDto.FieldError, len(policyErr.Violations))
+	for i, violation := range policyErr.Violations {
+		fieldErrors[i] = sharedDto.FieldError{
+			Field:   field,
+			Code:    violation.Code,
+			Message: violation.Message,
+		}
+	}
+
+	c.JSON(http.StatusUnprocessableEntity, sharedDto.APIResponse[[]sharedDto.FieldError]{
+		Status:  http.StatusUnprocessableEntity,
+		Data:    fieldErrors,
+		Message: "Password does not meet the requirements",
+	})
+
+	return true
+}
+
+func respondInvitationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, sharedDto.APIResponse[any]{
+			Status:  http.StatusNotFound,
+			Message: "Invitation not found",
+		})
+	case errors.Is(err, usecase.ErrInvalidInvitation):
+		respondBadRequest(c, "Invalid or expired invitation")
+	case errors.Is(err, usecase.ErrInvitationForAnotherEmail):
+		c.JSON(http.StatusForbidden, sharedDto.APIResponse[any]{
+			Status:  http.StatusForbidden,
+			Message: "The invitation was sent to another email",
+		})
+	case errors.Is(err, repository.ErrAlreadyMember):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "Account is already a member of the workspace",
+		})
+	case errors.Is(err, repository.ErrInvitationPending):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "An invitation is already pending for this email",
+		})
+	case errors.Is(err, accountRepository.ErrEmailAlreadyInUse):
+		c.JSON(http.StatusConflict, sharedDto.APIResponse[any]{
+			Status:  http.StatusConflict,
+			Message: "An account with this email already exists, sign in to accept the invitation",
+		})
+	case errors.Is(err, usecase.ErrUnknownWorkspaceRole):
+		respondBadRequest(c, "role must be one of workspace_owner, member and guest")
+	default:
+		c.JSON(http.StatusInternalServerError, sharedDto.APIResponse[any]{
+			Status:  http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	}
+}
+
+func toWorkspaceInvitationResponse(invitation *entity.WorkspaceInvitationEntity) dto.WorkspaceInvitationResponse {
+	var invitedBy *uuid.UUID
+	if invitation.InvitedBy != uuid.Nil {
+		invitedBy = &invitation.InvitedBy
+	}
+
+	return dto.WorkspaceInvitationResponse{
+		ID:        invitation.ID,
+		Email:     invitation.Email,
+		Role:      invitation.Role,
+		InvitedBy: invitedBy,
+		ExpiresAt: invitation.ExpiresAt,
+		SentAt:    invitation.SentAt,
+		CreatedAt: invitation.CreatedAt,
+	}
+}
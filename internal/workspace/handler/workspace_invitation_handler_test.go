@@ -0,0 +1,270 @@
+package handler_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+	accountEntity "trilha-api/internal/account/entity"
+	accountRepository "trilha-api/internal/account/repository"
+	sharedDto "trilha-api/internal/shared/dto"
+	"trilha-api/internal/shared/password"
+	"trilha-api/internal/workspace/dto"
+	"trilha-api/internal/workspace/entity"
+	"trilha-api/internal/workspace/handler"
+	"trilha-api/internal/workspace/mocks"
+	"trilha-api/internal/workspace/repository"
+	usecase "trilha-api/internal/workspace/use_case"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupInvitations(t *testing.T) (*gin.Engine, *mocks.MockWorkspaceInvitationUseCaseInterface) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mock := mocks.NewMockWorkspaceInvitationUseCaseInterface(ctrl)
+	h := handler.NewWorkspaceInvitationHandler(mock)
+	router := gin.Default()
+	router.Use(fakeAuthentication())
+
+	router.GET("/api/v1/workspaces/:ws/invitations", h.ListPending)
+	router.POST("/api/v1/workspaces/:ws/invitations", h.Invite)
+	router.POST("/api/v1/workspaces/:ws/invitations/:invitation_id/resend", h.Resend)
+	router.DELETE("/api/v1/workspaces/:ws/invitations/:invitation_id", h.Revoke)
+	router.POST("/api/v1/invitations/preview", h.Preview)
+	router.POST("/api/v1/invitations/accept", h.Accept)
+	router.POST("/api/v1/invitations/register", h.Register)
+
+	return router, mock
+}
+
+func TestWorkspaceInvitationHandler_Invite(t *testing.T) {
+	router, mockUseCase := setupInvitations(t)
+
+	accountID := uuid.New()
+	workspaceID := uuid.New()
+	path := "/api/v1/workspaces/" + workspaceID.String() + "/invitations"
+	req := dto.InviteWorkspaceMemberRequest{Email: "ana@example.com", Role: entity.WorkspaceRoleMember}
+
+	t.Run("should return status 201 and the invitation sent by the caller", func(t *testing.T) {
+		mockUseCase.EXPECT().Invite(gomock.Any()).DoAndReturn(func(invitation *entity.WorkspaceInvitationEntity) error {
+			assert.Equal(t, workspaceID, invitation.WorkspaceID)
+			assert.Equal(t, accountID, invitation.InvitedBy)
+			assert.Equal(t, "ana@example.com", invitation.Email)
+			invitation.ID = uuid.New()
+			invitation.Token = "token"
+			return nil
+		})
+
+		w := send(router, http.MethodPost, path, accountID, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.NotContains(t, w.Body.String(), "token")
+
+		var responseBody sharedDto.APIResponse[dto.WorkspaceInvitationResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.WorkspaceRoleMember, responseBody.Data.Role)
+		assert.Equal(t, &accountID, responseBody.Data.InvitedBy)
+	})
+
+	t.Run("should return status 400 with an invalid email", func(t *testing.T) {
+		w := send(router, http.MethodPost, path, accountID, dto.InviteWorkspaceMemberRequest{Email: "ana", Role: entity.WorkspaceRoleMember})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 400 with an unknown role", func(t *testing.T) {
+		mockUseCase.EXPECT().Invite(gomock.Any()).Return(usecase.ErrUnknownWorkspaceRole)
+
+		w := send(router, http.MethodPost, path, accountID, dto.InviteWorkspaceMemberRequest{Email: "ana@example.com", Role: "admin"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return status 409 when the email belongs to a member", func(t *testing.T) {
+		mockUseCase.EXPECT().Invite(gomock.Any()).Return(repository.ErrAlreadyMember)
+
+		w := send(router, http.MethodPost, path, accountID, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 409 when the email was invited already", func(t *testing.T) {
+		mockUseCase.EXPECT().Invite(gomock.Any()).Return(repository.ErrInvitationPending)
+
+		w := send(router, http.MethodPost, path, accountID, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestWorkspaceInvitationHandler_ListPending(t *testing.T) {
+	router, mockUseCase := setupInvitations(t)
+
+	workspaceID := uuid.New()
+
+	t.Run("should return status 200 and the pending invitations", func(t *testing.T) {
+		mockUseCase.EXPECT().ListPending(workspaceID).Return([]entity.WorkspaceInvitationEntity{
+			{ID: uuid.New(), Email: "ana@example.com", Role: entity.WorkspaceRoleGuest},
+		}, nil)
+
+		w := send(router, http.MethodGet, "/api/v1/workspaces/"+workspaceID.String()+"/invitations", uuid.New(), nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[[]dto.WorkspaceInvitationResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Len(t, responseBody.Data, 1)
+		assert.Nil(t, responseBody.Data[0].InvitedBy)
+	})
+}
+
+func TestWorkspaceInvitationHandler_ResendAndRevoke(t *testing.T) {
+	router, mockUseCase := setupInvitations(t)
+
+	workspaceID := uuid.New()
+	invitationID := uuid.New()
+	path := "/api/v1/workspaces/" + workspaceID.String() + "/invitations/" + invitationID.String()
+
+	t.Run("should return status 200 when the invitation is resent", func(t *testing.T) {
+		mockUseCase.EXPECT().Resend(&entity.WorkspaceInvitationEntity{ID: invitationID, WorkspaceID: workspaceID}).Return(nil)
+
+		w := send(router, http.MethodPost, path+"/resend", uuid.New(), nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return status 204 when the invitation is revoked", func(t *testing.T) {
+		mockUseCase.EXPECT().Revoke(&entity.WorkspaceInvitationEntity{ID: invitationID, WorkspaceID: workspaceID}).Return(nil)
+
+		w := send(router, http.MethodDelete, path, uuid.New(), nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return status 404 when no invitation is pending", func(t *testing.T) {
+		mockUseCase.EXPECT().Revoke(gomock.Any()).Return(sql.ErrNoRows)
+
+		w := send(router, http.MethodDelete, path, uuid.New(), nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return status 400 with an invalid invitation ID", func(t *testing.T) {
+		w := send(router, http.MethodDelete, "/api/v1/workspaces/"+workspaceID.String()+"/invitations/invalid", uuid.New(), nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestWorkspaceInvitationHandler_Preview(t *testing.T) {
+	router, mockUseCase := setupInvitations(t)
+
+	t.Run("should return status 200 and what the invitation is for", func(t *testing.T) {
+		mockUseCase.EXPECT().Preview(gomock.Any()).DoAndReturn(func(invitation *entity.WorkspaceInvitationEntity) error {
+			assert.Equal(t, "token", invitation.Token)
+			invitation.WorkspaceName = "Cliente A"
+			invitation.InviterName = "Bruno"
+			invitation.ExpiresAt = time.Now().Add(time.Hour)
+			return nil
+		})
+
+		w := send(router, http.MethodPost, "/api/v1/invitations/preview", uuid.Nil, dto.InvitationTokenRequest{Token: "token"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.InvitationPreviewResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "Cliente A", responseBody.Data.WorkspaceName)
+		assert.Equal(t, "Bruno", responseBody.Data.InviterName)
+	})
+
+	t.Run("should return status 400 with an invalid or expired token", func(t *testing.T) {
+		mockUseCase.EXPECT().Preview(gomock.Any()).Return(usecase.ErrInvalidInvitation)
+
+		w := send(router, http.MethodPost, "/api/v1/invitations/preview", uuid.Nil, dto.InvitationTokenRequest{Token: "token"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestWorkspaceInvitationHandler_Accept(t *testing.T) {
+	router, mockUseCase := setupInvitations(t)
+
+	accountID := uuid.New()
+
+	t.Run("should return status 200 and the workspace joined", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		mockUseCase.EXPECT().Accept(&entity.WorkspaceInvitationEntity{Token: "token"}, accountID).
+			Return(&entity.WorkspaceEntity{ID: workspaceID, Name: "Cliente A", Role: entity.WorkspaceRoleMember}, nil)
+
+		w := send(router, http.MethodPost, "/api/v1/invitations/accept", accountID, dto.InvitationTokenRequest{Token: "token"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var responseBody sharedDto.APIResponse[dto.WorkspaceResponse]
+		err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+		assert.NoError(t, err)
+		assert.Equal(t, workspaceID, responseBody.Data.ID)
+		assert.Equal(t, entity.WorkspaceRoleMember, responseBody.Data.Role)
+	})
+
+	t.Run("should return status 403 when the invitation was sent to another email", func(t *testing.T) {
+		mockUseCase.EXPECT().Accept(gomock.Any(), accountID).Return(nil, usecase.ErrInvitationForAnotherEmail)
+
+		w := send(router, http.MethodPost, "/api/v1/invitations/accept", accountID, dto.InvitationTokenRequest{Token: "token"})
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return status 401 when not authenticated", func(t *testing.T) {
+		w := send(router, http.MethodPost, "/api/v1/invitations/accept", uuid.Nil, dto.InvitationTokenRequest{Token: "token"})
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestWorkspaceInvitationHandler_Register(t *testing.T) {
+	router, mockUseCase := setupInvitations(t)
+
+	req := dto.RegisterInviteeRequest{Token: "token", Name: "Ana", Password: "Secret#2024"}
+
+	t.Run("should return status 201 and the workspace joined", func(t *testing.T) {
+		mockUseCase.EXPECT().Register(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(invitation *entity.WorkspaceInvitationEntity, account *accountEntity.AccountEntity) (*entity.WorkspaceEntity, error) {
+				assert.Equal(t, "token", invitation.Token)
+				assert.Equal(t, "Ana", account.Name)
+				assert.Equal(t, "Secret#2024", account.Password)
+				return &entity.WorkspaceEntity{ID: uuid.New(), Role: entity.WorkspaceRoleGuest}, nil
+			})
+
+		w := send(router, http.MethodPost, "/api/v1/invitations/register", uuid.Nil, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return status 409 when the email has an account already", func(t *testing.T) {
+		mockUseCase.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil, accountRepository.ErrEmailAlreadyInUse)
+
+		w := send(router, http.MethodPost, "/api/v1/invitations/register", uuid.Nil, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return status 422 when the password breaks the policy", func(t *testing.T) {
+		mockUseCase.EXPECT().Register(gomock.Any(), gomock.Any()).
+			Return(nil, &password.PolicyError{Violations: []password.Violation{{Code: "too_short", Message: "too short"}}})
+
+		w := send(router, http.MethodPost, "/api/v1/invitations/register", uuid.Nil, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workspace_invitation_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=workspace_invitation_repository.go -destination=../mocks/workspace_invitation_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/workspace/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkspaceInvitationRepositoryInterface is a mock of WorkspaceInvitationRepositoryInterface interface.
+type MockWorkspaceInvitationRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceInvitationRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkspaceInvitationRepositoryInterfaceMockRecorder is the mock recorder for MockWorkspaceInvitationRepositoryInterface.
+type MockWorkspaceInvitationRepositoryInterfaceMockRecorder struct {
+	mock *MockWorkspaceInvitationRepositoryInterface
+}
+
+// NewMockWorkspaceInvitationRepositoryInterface creates a new mock instance.
+func NewMockWorkspaceInvitationRepositoryInterface(ctrl *gomock.Controller) *MockWorkspaceInvitationRepositoryInterface {
+	mock := &MockWorkspaceInvitationRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceInvitationRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceInvitationRepositoryInterface) EXPECT() *MockWorkspaceInvitationRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) Accept(invitation *entity.WorkspaceInvitationEntity, accountID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", invitation, accountID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) Accept(invitation, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).Accept), invitation, accountID)
+}
+
+// Create mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) Create(invitation *entity.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) Create(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).Create), invitation)
+}
+
+// DeleteAllByAccount mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) DeleteAllByAccount(accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllByAccount", accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllByAccount indicates an expected call of DeleteAllByAccount.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) DeleteAllByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllByAccount", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).DeleteAllByAccount), accountID)
+}
+
+// FindByHash mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) FindByHash(invitation *entity.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) FindByHash(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).FindByHash), invitation)
+}
+
+// FindPending mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) FindPending(invitation *entity.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) FindPending(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).FindPending), invitation)
+}
+
+// ListByAccount mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceInvitationEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.WorkspaceInvitationEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).ListByAccount), accountID)
+}
+
+// ListPending mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) ListPending(workspaceID uuid.UUID) ([]entity.WorkspaceInvitationEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", workspaceID)
+	ret0, _ := ret[0].([]entity.WorkspaceInvitationEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) ListPending(workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).ListPending), workspaceID)
+}
+
+// Renew mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) Renew(invitation *entity.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) Renew(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).Renew), invitation)
+}
+
+// Revoke mocks base method.
+func (m *MockWorkspaceInvitationRepositoryInterface) Revoke(invitation *entity.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockWorkspaceInvitationRepositoryInterfaceMockRecorder) Revoke(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockWorkspaceInvitationRepositoryInterface)(nil).Revoke), invitation)
+}
@@ -0,0 +1,248 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workspace_invitation_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=workspace_invitation_use_case.go -destination=../mocks/workspace_invitation_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/account/entity"
+	entity0 "trilha-api/internal/workspace/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInviteeAccounts is a mock of InviteeAccounts interface.
+type MockInviteeAccounts struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteeAccountsMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteeAccountsMockRecorder is the mock recorder for MockInviteeAccounts.
+type MockInviteeAccountsMockRecorder struct {
+	mock *MockInviteeAccounts
+}
+
+// NewMockInviteeAccounts creates a new mock instance.
+func NewMockInviteeAccounts(ctrl *gomock.Controller) *MockInviteeAccounts {
+	mock := &MockInviteeAccounts{ctrl: ctrl}
+	mock.recorder = &MockInviteeAccountsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteeAccounts) EXPECT() *MockInviteeAccountsMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockInviteeAccounts) Find(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockInviteeAccountsMockRecorder) Find(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockInviteeAccounts)(nil).Find), account)
+}
+
+// FindByEmail mocks base method.
+func (m *MockInviteeAccounts) FindByEmail(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockInviteeAccountsMockRecorder) FindByEmail(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockInviteeAccounts)(nil).FindByEmail), account)
+}
+
+// RegisterVerified mocks base method.
+func (m *MockInviteeAccounts) RegisterVerified(account *entity.AccountEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterVerified", account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterVerified indicates an expected call of RegisterVerified.
+func (mr *MockInviteeAccountsMockRecorder) RegisterVerified(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterVerified", reflect.TypeOf((*MockInviteeAccounts)(nil).RegisterVerified), account)
+}
+
+// MockEmailNormalizer is a mock of EmailNormalizer interface.
+type MockEmailNormalizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailNormalizerMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailNormalizerMockRecorder is the mock recorder for MockEmailNormalizer.
+type MockEmailNormalizerMockRecorder struct {
+	mock *MockEmailNormalizer
+}
+
+// NewMockEmailNormalizer creates a new mock instance.
+func NewMockEmailNormalizer(ctrl *gomock.Controller) *MockEmailNormalizer {
+	mock := &MockEmailNormalizer{ctrl: ctrl}
+	mock.recorder = &MockEmailNormalizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailNormalizer) EXPECT() *MockEmailNormalizerMockRecorder {
+	return m.recorder
+}
+
+// Normalize mocks base method.
+func (m *MockEmailNormalizer) Normalize(email string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Normalize", email)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Normalize indicates an expected call of Normalize.
+func (mr *MockEmailNormalizerMockRecorder) Normalize(email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Normalize", reflect.TypeOf((*MockEmailNormalizer)(nil).Normalize), email)
+}
+
+// MockWorkspaceInvitationUseCaseInterface is a mock of WorkspaceInvitationUseCaseInterface interface.
+type MockWorkspaceInvitationUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceInvitationUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkspaceInvitationUseCaseInterfaceMockRecorder is the mock recorder for MockWorkspaceInvitationUseCaseInterface.
+type MockWorkspaceInvitationUseCaseInterfaceMockRecorder struct {
+	mock *MockWorkspaceInvitationUseCaseInterface
+}
+
+// NewMockWorkspaceInvitationUseCaseInterface creates a new mock instance.
+func NewMockWorkspaceInvitationUseCaseInterface(ctrl *gomock.Controller) *MockWorkspaceInvitationUseCaseInterface {
+	mock := &MockWorkspaceInvitationUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceInvitationUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceInvitationUseCaseInterface) EXPECT() *MockWorkspaceInvitationUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockWorkspaceInvitationUseCaseInterface) Accept(invitation *entity0.WorkspaceInvitationEntity, accountID uuid.UUID) (*entity0.WorkspaceEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", invitation, accountID)
+	ret0, _ := ret[0].(*entity0.WorkspaceEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockWorkspaceInvitationUseCaseInterfaceMockRecorder) Accept(invitation, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockWorkspaceInvitationUseCaseInterface)(nil).Accept), invitation, accountID)
+}
+
+// Invite mocks base method.
+func (m *MockWorkspaceInvitationUseCaseInterface) Invite(invitation *entity0.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockWorkspaceInvitationUseCaseInterfaceMockRecorder) Invite(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockWorkspaceInvitationUseCaseInterface)(nil).Invite), invitation)
+}
+
+// ListPending mocks base method.
+func (m *MockWorkspaceInvitationUseCaseInterface) ListPending(workspaceID uuid.UUID) ([]entity0.WorkspaceInvitationEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", workspaceID)
+	ret0, _ := ret[0].([]entity0.WorkspaceInvitationEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockWorkspaceInvitationUseCaseInterfaceMockRecorder) ListPending(workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockWorkspaceInvitationUseCaseInterface)(nil).ListPending), workspaceID)
+}
+
+// Preview mocks base method.
+func (m *MockWorkspaceInvitationUseCaseInterface) Preview(invitation *entity0.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *MockWorkspaceInvitationUseCaseInterfaceMockRecorder) Preview(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*MockWorkspaceInvitationUseCaseInterface)(nil).Preview), invitation)
+}
+
+// Register mocks base method.
+func (m *MockWorkspaceInvitationUseCaseInterface) Register(invitation *entity0.WorkspaceInvitationEntity, account *entity.AccountEntity) (*entity0.WorkspaceEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", invitation, account)
+	ret0, _ := ret[0].(*entity0.WorkspaceEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockWorkspaceInvitationUseCaseInterfaceMockRecorder) Register(invitation, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockWorkspaceInvitationUseCaseInterface)(nil).Register), invitation, account)
+}
+
+// Resend mocks base method.
+func (m *MockWorkspaceInvitationUseCaseInterface) Resend(invitation *entity0.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockWorkspaceInvitationUseCaseInterfaceMockRecorder) Resend(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockWorkspaceInvitationUseCaseInterface)(nil).Resend), invitation)
+}
+
+// Revoke mocks base method.
+func (m *MockWorkspaceInvitationUseCaseInterface) Revoke(invitation *entity0.WorkspaceInvitationEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", invitation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockWorkspaceInvitationUseCaseInterfaceMockRecorder) Revoke(invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockWorkspaceInvitationUseCaseInterface)(nil).Revoke), invitation)
+}
@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workspace_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=workspace_repository.go -destination=../mocks/workspace_repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/workspace/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkspaceRepositoryInterface is a mock of WorkspaceRepositoryInterface interface.
+type MockWorkspaceRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkspaceRepositoryInterfaceMockRecorder is the mock recorder for MockWorkspaceRepositoryInterface.
+type MockWorkspaceRepositoryInterfaceMockRecorder struct {
+	mock *MockWorkspaceRepositoryInterface
+}
+
+// NewMockWorkspaceRepositoryInterface creates a new mock instance.
+func NewMockWorkspaceRepositoryInterface(ctrl *gomock.Controller) *MockWorkspaceRepositoryInterface {
+	mock := &MockWorkspaceRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceRepositoryInterface) EXPECT() *MockWorkspaceRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ChangeMemberRole mocks base method.
+func (m *MockWorkspaceRepositoryInterface) ChangeMemberRole(member *entity.WorkspaceMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMemberRole", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMemberRole indicates an expected call of ChangeMemberRole.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) ChangeMemberRole(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMemberRole", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).ChangeMemberRole), member)
+}
+
+// Create mocks base method.
+func (m *MockWorkspaceRepositoryInterface) Create(workspace *entity.WorkspaceEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) Create(workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).Create), workspace)
+}
+
+// Delete mocks base method.
+func (m *MockWorkspaceRepositoryInterface) Delete(workspace *entity.WorkspaceEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) Delete(workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).Delete), workspace)
+}
+
+// Find mocks base method.
+func (m *MockWorkspaceRepositoryInterface) Find(workspace *entity.WorkspaceEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) Find(workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).Find), workspace)
+}
+
+// FindMember mocks base method.
+func (m *MockWorkspaceRepositoryInterface) FindMember(member *entity.WorkspaceMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindMember indicates an expected call of FindMember.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) FindMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMember", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).FindMember), member)
+}
+
+// ListByAccount mocks base method.
+func (m *MockWorkspaceRepositoryInterface) ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.WorkspaceEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).ListByAccount), accountID)
+}
+
+// ListMembers mocks base method.
+func (m *MockWorkspaceRepositoryInterface) ListMembers(workspaceID uuid.UUID) ([]entity.WorkspaceMemberEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", workspaceID)
+	ret0, _ := ret[0].([]entity.WorkspaceMemberEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) ListMembers(workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).ListMembers), workspaceID)
+}
+
+// ListWorkspaceIDs mocks base method.
+func (m *MockWorkspaceRepositoryInterface) ListWorkspaceIDs(accountID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspaceIDs", accountID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkspaceIDs indicates an expected call of ListWorkspaceIDs.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) ListWorkspaceIDs(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaceIDs", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).ListWorkspaceIDs), accountID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockWorkspaceRepositoryInterface) RemoveMember(member *entity.WorkspaceMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) RemoveMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).RemoveMember), member)
+}
+
+// Update mocks base method.
+func (m *MockWorkspaceRepositoryInterface) Update(workspace *entity.WorkspaceEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWorkspaceRepositoryInterfaceMockRecorder) Update(workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkspaceRepositoryInterface)(nil).Update), workspace)
+}
@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workspace_use_case.go
+//
+// Generated by this command:
+//
+//	mockgen -source=workspace_use_case.go -destination=../mocks/workspace_use_case_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	entity "trilha-api/internal/workspace/entity"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkspaceUseCaseInterface is a mock of WorkspaceUseCaseInterface interface.
+type MockWorkspaceUseCaseInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceUseCaseInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkspaceUseCaseInterfaceMockRecorder is the mock recorder for MockWorkspaceUseCaseInterface.
+type MockWorkspaceUseCaseInterfaceMockRecorder struct {
+	mock *MockWorkspaceUseCaseInterface
+}
+
+// NewMockWorkspaceUseCaseInterface creates a new mock instance.
+func NewMockWorkspaceUseCaseInterface(ctrl *gomock.Controller) *MockWorkspaceUseCaseInterface {
+	mock := &MockWorkspaceUseCaseInterface{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceUseCaseInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceUseCaseInterface) EXPECT() *MockWorkspaceUseCaseInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWorkspaceUseCaseInterface) Create(workspace *entity.WorkspaceEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkspaceUseCaseInterfaceMockRecorder) Create(workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkspaceUseCaseInterface)(nil).Create), workspace)
+}
+
+// Delete mocks base method.
+func (m *MockWorkspaceUseCaseInterface) Delete(workspace *entity.WorkspaceEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWorkspaceUseCaseInterfaceMockRecorder) Delete(workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorkspaceUseCaseInterface)(nil).Delete), workspace)
+}
+
+// Find mocks base method.
+func (m *MockWorkspaceUseCaseInterface) Find(workspace *entity.WorkspaceEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockWorkspaceUseCaseInterfaceMockRecorder) Find(workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockWorkspaceUseCaseInterface)(nil).Find), workspace)
+}
+
+// ListByAccount mocks base method.
+func (m *MockWorkspaceUseCaseInterface) ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccount", accountID)
+	ret0, _ := ret[0].([]entity.WorkspaceEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccount indicates an expected call of ListByAccount.
+func (mr *MockWorkspaceUseCaseInterfaceMockRecorder) ListByAccount(accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockWorkspaceUseCaseInterface)(nil).ListByAccount), accountID)
+}
+
+// ListMembers mocks base method.
+func (m *MockWorkspaceUseCaseInterface) ListMembers(workspaceID uuid.UUID) ([]entity.WorkspaceMemberEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", workspaceID)
+	ret0, _ := ret[0].([]entity.WorkspaceMemberEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockWorkspaceUseCaseInterfaceMockRecorder) ListMembers(workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockWorkspaceUseCaseInterface)(nil).ListMembers), workspaceID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockWorkspaceUseCaseInterface) RemoveMember(member *entity.WorkspaceMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockWorkspaceUseCaseInterfaceMockRecorder) RemoveMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockWorkspaceUseCaseInterface)(nil).RemoveMember), member)
+}
+
+// SetMember mocks base method.
+func (m *MockWorkspaceUseCaseInterface) SetMember(member *entity.WorkspaceMemberEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockWorkspaceUseCaseInterfaceMockRecorder) SetMember(member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockWorkspaceUseCaseInterface)(nil).SetMember), member)
+}
+
+// Update mocks base method.
+func (m *MockWorkspaceUseCaseInterface) Update(workspace *entity.WorkspaceEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", workspace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWorkspaceUseCaseInterfaceMockRecorder) Update(workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkspaceUseCaseInterface)(nil).Update), workspace)
+}
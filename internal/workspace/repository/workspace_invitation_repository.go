@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+	"trilha-api/internal/workspace/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolationCode is the Postgres SQLSTATE for unique constraint violations.
+const uniqueViolationCode = "23505"
+
+var ErrInvitationPending = errors.New("invitation already pending for the email")
+
+// WorkspaceInvitationRepository keeps the invitations to join workspaces.
+// Their tokens are only stored hashed.
+type WorkspaceInvitationRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=workspace_invitation_repository.go -destination=../mocks/workspace_invitation_repository_mock.go -package=mocks
+
+type WorkspaceInvitationRepositoryInterface interface {
+	Create(invitation *entity.WorkspaceInvitationEntity) error
+	ListPending(workspaceID uuid.UUID) ([]entity.WorkspaceInvitationEntity, error)
+	FindPending(invitation *entity.WorkspaceInvitationEntity) error
+	FindByHash(invitation *entity.WorkspaceInvitationEntity) error
+	Renew(invitation *entity.WorkspaceInvitationEntity) error
+	Revoke(invitation *entity.WorkspaceInvitationEntity) error
+	Accept(invitation *entity.WorkspaceInvitationEntity, accountID uuid.UUID) (bool, error)
+	ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceInvitationEntity, error)
+	DeleteAllByAccount(accountID uuid.UUID) error
+}
+
+func NewWorkspaceInvitationRepository(db db.Querier) *WorkspaceInvitationRepository {
+	return &WorkspaceInvitationRepository{db: db}
+}
+
+// Create stores the invitation, failing with ErrInvitationPending when its
+// email already has one neither accepted nor revoked in the workspace.
+func (r *WorkspaceInvitationRepository) Create(invitation *entity.WorkspaceInvitationEntity) error {
+	fields := db.CreateWorkspaceInvitationParams{
+		WorkspaceID: invitation.WorkspaceID,
+		Email:       invitation.Email,
+		Role:        invitation.Role,
+		TokenHash:   invitation.TokenHash,
+		InvitedBy:   invitation.InvitedBy,
+		ExpiresAt:   utils.TimeToPgTimestamp(&invitation.ExpiresAt),
+	}
+
+	created, err := r.db.CreateWorkspaceInvitation(context.Background(), fields)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return ErrInvitationPending
+		}
+		return fmt.Errorf("erro ao registrar convite para o workspace: %w", err)
+	}
+
+	*invitation = toWorkspaceInvitationEntity(created, invitation.Token)
+
+	return nil
+}
+
+// ListPending returns the invitations of the workspace neither accepted nor
+// revoked, expired ones included.
+func (r *WorkspaceInvitationRepository) ListPending(workspaceID uuid.UUID) ([]entity.WorkspaceInvitationEntity, error) {
+	rows, err := r.db.ListPendingWorkspaceInvitations(context.Background(), workspaceID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar convites do workspace: %w", err)
+	}
+
+	invitations := make([]entity.WorkspaceInvitationEntity, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, toWorkspaceInvitationEntity(row, ""))
+	}
+
+	return invitations, nil
+}
+
+// FindPending loads the invitation invitation.ID of invitation.WorkspaceID
+// as long as it was neither accepted nor revoked.
+func (r *WorkspaceInvitationRepository) FindPending(invitation *entity.WorkspaceInvitationEntity) error {
+	fields := db.FindPendingWorkspaceInvitationParams{
+		ID:          invitation.ID,
+		WorkspaceID: invitation.WorkspaceID,
+	}
+
+	found, err := r.db.FindPendingWorkspaceInvitation(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*invitation = toWorkspaceInvitationEntity(found, "")
+
+	return nil
+}
+
+// FindByHash loads the invitation holding invitation.TokenHash, along with
+// the name of its workspace and of the account that sent it.
+func (r *WorkspaceInvitationRepository) FindByHash(invitation *entity.WorkspaceInvitationEntity) error {
+	found, err := r.db.FindWorkspaceInvitationByHash(context.Background(), invitation.TokenHash)
+
+	if err != nil {
+		return err
+	}
+
+	*invitation = toWorkspaceInvitationEntity(db.WorkspaceInvitation{
+		ID:          found.ID,
+		WorkspaceID: found.WorkspaceID,
+		Email:       found.Email,
+		Role:        found.Role,
+		TokenHash:   found.TokenHash,
+		InvitedBy:   found.InvitedBy,
+		ExpiresAt:   found.ExpiresAt,
+		SentAt:      found.SentAt,
+		AcceptedAt:  found.AcceptedAt,
+		AcceptedBy:  found.AcceptedBy,
+		RevokedAt:   found.RevokedAt,
+		CreatedAt:   found.CreatedAt,
+	}, invitation.Token)
+	invitation.WorkspaceName = found.WorkspaceName
+	invitation.InviterName = found.InviterName
+
+	return nil
+}
+
+// Renew replaces the token of a pending invitation with
+// invitation.TokenHash, postponing it to invitation.ExpiresAt, and records
+// that it is sent again now.
+func (r *WorkspaceInvitationRepository) Renew(invitation *entity.WorkspaceInvitationEntity) error {
+	fields := db.RenewWorkspaceInvitationParams{
+		ID:          invitation.ID,
+		WorkspaceID: invitation.WorkspaceID,
+		TokenHash:   invitation.TokenHash,
+		ExpiresAt:   utils.TimeToPgTimestamp(&invitation.ExpiresAt),
+	}
+
+	renewed, err := r.db.RenewWorkspaceInvitation(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*invitation = toWorkspaceInvitationEntity(renewed, invitation.Token)
+
+	return nil
+}
+
+func (r *WorkspaceInvitationRepository) Revoke(invitation *entity.WorkspaceInvitationEntity) error {
+	fields := db.RevokeWorkspaceInvitationParams{
+		ID:          invitation.ID,
+		WorkspaceID: invitation.WorkspaceID,
+	}
+
+	rows, err := r.db.RevokeWorkspaceInvitation(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao revogar convite para o workspace: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Accept accepts the invitation on behalf of the account and makes it a
+// member of the workspace with the invited role, unless it is one already.
+// Both happen in one statement, and it reports whether the invitation could
+// still be accepted, so it is only accepted once even under concurrent
+// requests.
+func (r *WorkspaceInvitationRepository) Accept(invitation *entity.WorkspaceInvitationEntity, accountID uuid.UUID) (bool, error) {
+	fields := db.AcceptWorkspaceInvitationParams{
+		ID:         invitation.ID,
+		AcceptedBy: accountID,
+	}
+
+	accepted, err := r.db.AcceptWorkspaceInvitation(context.Background(), fields)
+
+	if err != nil {
+		return false, fmt.Errorf("erro ao aceitar convite para o workspace: %w", err)
+	}
+
+	return accepted, nil
+}
+
+// ListByAccount returns the invitations sent to the email of the account or
+// accepted by it, in every workspace, along with the names of the workspaces.
+// Their token hashes are left out.
+func (r *WorkspaceInvitationRepository) ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceInvitationEntity, error) {
+	rows, err := r.db.ListAccountWorkspaceInvitations(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar convites da conta: %w", err)
+	}
+
+	invitations := make([]entity.WorkspaceInvitationEntity, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, entity.WorkspaceInvitationEntity{
+			ID:            row.ID,
+			WorkspaceID:   row.WorkspaceID,
+			WorkspaceName: row.WorkspaceName,
+			Email:         row.Email,
+			Role:          row.Role,
+			ExpiresAt:     row.ExpiresAt.Time,
+			SentAt:        row.SentAt.Time,
+			AcceptedAt:    utils.PgTimestampToTime(row.AcceptedAt),
+			RevokedAt:     utils.PgTimestampToTime(row.RevokedAt),
+			CreatedAt:     row.CreatedAt.Time,
+		})
+	}
+
+	return invitations, nil
+}
+
+// DeleteAllByAccount removes the invitations sent to the email of the account
+// or accepted by it. It must run while the account still has its email.
+func (r *WorkspaceInvitationRepository) DeleteAllByAccount(accountID uuid.UUID) error {
+	if err := r.db.DeleteAccountWorkspaceInvitations(context.Background(), accountID); err != nil {
+		return fmt.Errorf("erro ao remover convites da conta: %w", err)
+	}
+
+	return nil
+}
+
+func toWorkspaceInvitationEntity(invitation db.WorkspaceInvitation, token string) entity.WorkspaceInvitationEntity {
+	invitedBy := uuid.Nil
+	if id := utils.PgUUIDToUUID(invitation.InvitedBy); id != nil {
+		invitedBy = *id
+	}
+
+	return entity.WorkspaceInvitationEntity{
+		ID:          invitation.ID,
+		WorkspaceID: invitation.WorkspaceID,
+		Email:       invitation.Email,
+		Role:        invitation.Role,
+		Token:       token,
+		TokenHash:   invitation.TokenHash,
+		InvitedBy:   invitedBy,
+		ExpiresAt:   invitation.ExpiresAt.Time,
+		SentAt:      invitation.SentAt.Time,
+		AcceptedAt:  utils.PgTimestampToTime(invitation.AcceptedAt),
+		RevokedAt:   utils.PgTimestampToTime(invitation.RevokedAt),
+		CreatedAt:   invitation.CreatedAt.Time,
+	}
+}
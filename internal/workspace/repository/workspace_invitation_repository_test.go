@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+	"trilha-api/internal/workspace/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupInvitations(t *testing.T) (*mocks.MockQuerier, *WorkspaceInvitationRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := NewWorkspaceInvitationRepository(dbMock)
+
+	return dbMock, repo
+}
+
+func TestWorkspaceInvitationRepository_Create(t *testing.T) {
+	dbMock, repo := setupInvitations(t)
+
+	workspaceID := uuid.New()
+	inviterID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("should store the invitation and keep its token", func(t *testing.T) {
+		params := db.CreateWorkspaceInvitationParams{
+			WorkspaceID: workspaceID,
+			Email:       "ana@example.com",
+			Role:        entity.WorkspaceRoleMember,
+			TokenHash:   "hash",
+			InvitedBy:   inviterID,
+			ExpiresAt:   utils.TimeToPgTimestamp(&expiresAt),
+		}
+
+		created := db.WorkspaceInvitation{
+			ID:          uuid.New(),
+			WorkspaceID: workspaceID,
+			Email:       "ana@example.com",
+			Role:        entity.WorkspaceRoleMember,
+			TokenHash:   "hash",
+			InvitedBy:   pgtype.UUID{Bytes: inviterID, Valid: true},
+			ExpiresAt:   utils.TimeToPgTimestamp(&expiresAt),
+		}
+
+		dbMock.EXPECT().CreateWorkspaceInvitation(context.Background(), params).Return(created, nil)
+
+		invitation := &entity.WorkspaceInvitationEntity{
+			WorkspaceID: workspaceID,
+			Email:       "ana@example.com",
+			Role:        entity.WorkspaceRoleMember,
+			Token:       "token",
+			TokenHash:   "hash",
+			InvitedBy:   inviterID,
+			ExpiresAt:   expiresAt,
+		}
+
+		assert.NoError(t, repo.Create(invitation))
+		assert.Equal(t, created.ID, invitation.ID)
+		assert.Equal(t, "token", invitation.Token)
+		assert.Equal(t, inviterID, invitation.InvitedBy)
+	})
+
+	t.Run("should tell when the email was invited already", func(t *testing.T) {
+		dbMock.EXPECT().CreateWorkspaceInvitation(context.Background(), gomock.Any()).
+			Return(db.WorkspaceInvitation{}, &pgconn.PgError{Code: uniqueViolationCode})
+
+		err := repo.Create(&entity.WorkspaceInvitationEntity{WorkspaceID: workspaceID, InvitedBy: inviterID})
+
+		assert.ErrorIs(t, err, ErrInvitationPending)
+	})
+
+	t.Run("should wrap other errors", func(t *testing.T) {
+		dbMock.EXPECT().CreateWorkspaceInvitation(context.Background(), gomock.Any()).
+			Return(db.WorkspaceInvitation{}, errors.New("database error"))
+
+		err := repo.Create(&entity.WorkspaceInvitationEntity{WorkspaceID: workspaceID, InvitedBy: inviterID})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrInvitationPending)
+	})
+}
+
+func TestWorkspaceInvitationRepository_FindByHash(t *testing.T) {
+	dbMock, repo := setupInvitations(t)
+
+	t.Run("should load the invitation with its workspace and inviter names", func(t *testing.T) {
+		found := db.FindWorkspaceInvitationByHashRow{
+			ID:            uuid.New(),
+			WorkspaceID:   uuid.New(),
+			Email:         "ana@example.com",
+			Role:          entity.WorkspaceRoleGuest,
+			TokenHash:     "hash",
+			WorkspaceName: "Cliente A",
+			InviterName:   "Bruno",
+		}
+
+		dbMock.EXPECT().FindWorkspaceInvitationByHash(context.Background(), "hash").Return(found, nil)
+
+		invitation := &entity.WorkspaceInvitationEntity{Token: "token", TokenHash: "hash"}
+
+		assert.NoError(t, repo.FindByHash(invitation))
+		assert.Equal(t, found.ID, invitation.ID)
+		assert.Equal(t, "token", invitation.Token)
+		assert.Equal(t, "Cliente A", invitation.WorkspaceName)
+		assert.Equal(t, "Bruno", invitation.InviterName)
+		assert.Equal(t, uuid.Nil, invitation.InvitedBy)
+	})
+
+	t.Run("should return the error when no invitation holds the hash", func(t *testing.T) {
+		dbMock.EXPECT().FindWorkspaceInvitationByHash(context.Background(), "unknown").
+			Return(db.FindWorkspaceInvitationByHashRow{}, sql.ErrNoRows)
+
+		err := repo.FindByHash(&entity.WorkspaceInvitationEntity{TokenHash: "unknown"})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestWorkspaceInvitationRepository_Revoke(t *testing.T) {
+	dbMock, repo := setupInvitations(t)
+
+	invitation := &entity.WorkspaceInvitationEntity{ID: uuid.New(), WorkspaceID: uuid.New()}
+	params := db.RevokeWorkspaceInvitationParams{ID: invitation.ID, WorkspaceID: invitation.WorkspaceID}
+
+	t.Run("should revoke a pending invitation", func(t *testing.T) {
+		dbMock.EXPECT().RevokeWorkspaceInvitation(context.Background(), params).Return(int64(1), nil)
+
+		assert.NoError(t, repo.Revoke(invitation))
+	})
+
+	t.Run("should return sql.ErrNoRows when no invitation is pending", func(t *testing.T) {
+		dbMock.EXPECT().RevokeWorkspaceInvitation(context.Background(), params).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.Revoke(invitation), sql.ErrNoRows)
+	})
+}
+
+func TestWorkspaceInvitationRepository_Accept(t *testing.T) {
+	dbMock, repo := setupInvitations(t)
+
+	invitation := &entity.WorkspaceInvitationEntity{ID: uuid.New()}
+	accountID := uuid.New()
+	params := db.AcceptWorkspaceInvitationParams{ID: invitation.ID, AcceptedBy: accountID}
+
+	t.Run("should report the invitation accepted and the account joined", func(t *testing.T) {
+		dbMock.EXPECT().AcceptWorkspaceInvitation(context.Background(), params).Return(true, nil)
+
+		accepted, err := repo.Accept(invitation, accountID)
+
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+	})
+
+	t.Run("should report an invitation accepted, revoked or expired before", func(t *testing.T) {
+		dbMock.EXPECT().AcceptWorkspaceInvitation(context.Background(), params).Return(false, nil)
+
+		accepted, err := repo.Accept(invitation, accountID)
+
+		assert.NoError(t, err)
+		assert.False(t, accepted)
+	})
+}
+
+func TestWorkspaceInvitationRepository_ListByAccount(t *testing.T) {
+	dbMock, repo := setupInvitations(t)
+
+	accountID := uuid.New()
+
+	t.Run("should load the invitations with the names of their workspaces", func(t *testing.T) {
+		row := db.ListAccountWorkspaceInvitationsRow{
+			ID:            uuid.New(),
+			WorkspaceID:   uuid.New(),
+			WorkspaceName: "Acme",
+			Email:         "ana@example.com",
+			Role:          entity.WorkspaceRoleMember,
+			AcceptedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+		}
+
+		dbMock.EXPECT().ListAccountWorkspaceInvitations(context.Background(), accountID).
+			Return([]db.ListAccountWorkspaceInvitationsRow{row}, nil)
+
+		invitations, err := repo.ListByAccount(accountID)
+
+		assert.NoError(t, err)
+		assert.Len(t, invitations, 1)
+		assert.Equal(t, "Acme", invitations[0].WorkspaceName)
+		assert.Equal(t, "ana@example.com", invitations[0].Email)
+		assert.NotNil(t, invitations[0].AcceptedAt)
+		assert.Nil(t, invitations[0].RevokedAt)
+	})
+
+	t.Run("should wrap errors", func(t *testing.T) {
+		dbMock.EXPECT().ListAccountWorkspaceInvitations(context.Background(), accountID).Return(nil, errors.New("db down"))
+
+		_, err := repo.ListByAccount(accountID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestWorkspaceInvitationRepository_DeleteAllByAccount(t *testing.T) {
+	dbMock, repo := setupInvitations(t)
+
+	accountID := uuid.New()
+
+	t.Run("should remove the invitations of the account", func(t *testing.T) {
+		dbMock.EXPECT().DeleteAccountWorkspaceInvitations(context.Background(), accountID).Return(nil)
+
+		assert.NoError(t, repo.DeleteAllByAccount(accountID))
+	})
+
+	t.Run("should wrap errors", func(t *testing.T) {
+		dbMock.EXPECT().DeleteAccountWorkspaceInvitations(context.Background(), accountID).Return(errors.New("db down"))
+
+		assert.Error(t, repo.DeleteAllByAccount(accountID))
+	})
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/shared/utils"
+	"trilha-api/internal/workspace/entity"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAlreadyMember = errors.New("account already a member of the workspace")
+	ErrLastOwner     = errors.New("workspace would be left without an owner")
+)
+
+// WorkspaceRepository keeps workspaces and their members, which are the
+// roles granted on them in account_roles.
+type WorkspaceRepository struct {
+	db db.Querier
+}
+
+//go:generate mockgen -source=workspace_repository.go -destination=../mocks/workspace_repository_mock.go -package=mocks
+
+type WorkspaceRepositoryInterface interface {
+	Create(workspace *entity.WorkspaceEntity) error
+	Find(workspace *entity.WorkspaceEntity) error
+	ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceEntity, error)
+	ListWorkspaceIDs(accountID uuid.UUID) ([]uuid.UUID, error)
+	Update(workspace *entity.WorkspaceEntity) error
+	Delete(workspace *entity.WorkspaceEntity) error
+	ListMembers(workspaceID uuid.UUID) ([]entity.WorkspaceMemberEntity, error)
+	FindMember(member *entity.WorkspaceMemberEntity) error
+	ChangeMemberRole(member *entity.WorkspaceMemberEntity) error
+	RemoveMember(member *entity.WorkspaceMemberEntity) error
+}
+
+func New(db db.Querier) *WorkspaceRepository {
+	return &WorkspaceRepository{db: db}
+}
+
+// Create stores the workspace and makes workspace.CreatedBy its owner.
+func (r *WorkspaceRepository) Create(workspace *entity.WorkspaceEntity) error {
+	fields := db.CreateWorkspaceParams{
+		Name:      workspace.Name,
+		CreatedBy: workspace.CreatedBy,
+	}
+
+	created, err := r.db.CreateWorkspace(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao criar workspace: %w", err)
+	}
+
+	*workspace = toWorkspaceEntity(db.Workspace(created))
+	workspace.Role = entity.WorkspaceRoleOwner
+
+	return nil
+}
+
+func (r *WorkspaceRepository) Find(workspace *entity.WorkspaceEntity) error {
+	found, err := r.db.FindWorkspace(context.Background(), workspace.ID)
+
+	if err != nil {
+		return err
+	}
+
+	*workspace = toWorkspaceEntity(found)
+
+	return nil
+}
+
+// ListByAccount returns the workspaces the account belongs to, with its
+// role on each.
+func (r *WorkspaceRepository) ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceEntity, error) {
+	rows, err := r.db.ListAccountWorkspaces(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar workspaces da conta: %w", err)
+	}
+
+	workspaces := make([]entity.WorkspaceEntity, 0, len(rows))
+	for _, row := range rows {
+		workspace := toWorkspaceEntity(db.Workspace{
+			ID:        row.ID,
+			Name:      row.Name,
+			CreatedBy: row.CreatedBy,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+		workspace.Role = row.RoleName
+		workspaces = append(workspaces, workspace)
+	}
+
+	return workspaces, nil
+}
+
+// ListWorkspaceIDs returns the IDs of the workspaces the account belongs to.
+func (r *WorkspaceRepository) ListWorkspaceIDs(accountID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := r.db.ListAccountWorkspaceIDs(context.Background(), accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar workspaces da conta: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *WorkspaceRepository) Update(workspace *entity.WorkspaceEntity) error {
+	fields := db.UpdateWorkspaceParams{
+		ID:   workspace.ID,
+		Name: workspace.Name,
+	}
+
+	updated, err := r.db.UpdateWorkspace(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*workspace = toWorkspaceEntity(updated)
+
+	return nil
+}
+
+// Delete removes the workspace for good, with its projects and the roles
+// granted on it.
+func (r *WorkspaceRepository) Delete(workspace *entity.WorkspaceEntity) error {
+	rows, err := r.db.DeleteWorkspace(context.Background(), workspace.ID)
+
+	if err != nil {
+		return fmt.Errorf("erro ao remover workspace: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *WorkspaceRepository) ListMembers(workspaceID uuid.UUID) ([]entity.WorkspaceMemberEntity, error) {
+	rows, err := r.db.ListWorkspaceMembers(context.Background(), workspaceID)
+
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar membros do workspace: %w", err)
+	}
+
+	members := make([]entity.WorkspaceMemberEntity, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, toWorkspaceMemberEntity(workspaceID, row))
+	}
+
+	return members, nil
+}
+
+// FindMember loads the membership of member.AccountID in
+// member.WorkspaceID.
+func (r *WorkspaceRepository) FindMember(member *entity.WorkspaceMemberEntity) error {
+	fields := db.FindWorkspaceMemberParams{
+		WorkspaceID: member.WorkspaceID,
+		AccountID:   member.AccountID,
+	}
+
+	found, err := r.db.FindWorkspaceMember(context.Background(), fields)
+
+	if err != nil {
+		return err
+	}
+
+	*member = toWorkspaceMemberEntity(member.WorkspaceID, db.ListWorkspaceMembersRow(found))
+
+	return nil
+}
+
+// ChangeMemberRole gives the member member.Role, failing with sql.ErrNoRows
+// when the account is not a member and ErrLastOwner when it is the only owner
+// and member.Role is another role. The check and the change are one
+// statement, so concurrent changes cannot leave the workspace without owners.
+func (r *WorkspaceRepository) ChangeMemberRole(member *entity.WorkspaceMemberEntity) error {
+	fields := db.ChangeWorkspaceMemberRoleParams{
+		RoleName:    member.Role,
+		WorkspaceID: member.WorkspaceID,
+		AccountID:   member.AccountID,
+	}
+
+	result, err := r.db.ChangeWorkspaceMemberRole(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao alterar papel do membro: %w", err)
+	}
+
+	if result.LastOwner {
+		return ErrLastOwner
+	}
+
+	if !result.Changed {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// RemoveMember takes the account out of the workspace, failing with
+// sql.ErrNoRows when it is not a member and ErrLastOwner when it is the only
+// owner. The check and the removal are one statement.
+func (r *WorkspaceRepository) RemoveMember(member *entity.WorkspaceMemberEntity) error {
+	fields := db.RemoveWorkspaceMemberParams{
+		WorkspaceID: member.WorkspaceID,
+		AccountID:   member.AccountID,
+	}
+
+	result, err := r.db.RemoveWorkspaceMember(context.Background(), fields)
+
+	if err != nil {
+		return fmt.Errorf("erro ao remover membro do workspace: %w", err)
+	}
+
+	if result.LastOwner {
+		return ErrLastOwner
+	}
+
+	if !result.Removed {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func toWorkspaceEntity(workspace db.Workspace) entity.WorkspaceEntity {
+	createdBy := uuid.Nil
+	if id := utils.PgUUIDToUUID(workspace.CreatedBy); id != nil {
+		createdBy = *id
+	}
+
+	return entity.WorkspaceEntity{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		CreatedBy: createdBy,
+		CreatedAt: workspace.CreatedAt.Time,
+		UpdatedAt: workspace.UpdatedAt.Time,
+	}
+}
+
+func toWorkspaceMemberEntity(workspaceID uuid.UUID, member db.ListWorkspaceMembersRow) entity.WorkspaceMemberEntity {
+	return entity.WorkspaceMemberEntity{
+		WorkspaceID: workspaceID,
+		AccountID:   member.AccountID,
+		Name:        member.Name,
+		Email:       member.Email,
+		Role:        member.RoleName,
+		JoinedAt:    member.CreatedAt.Time,
+	}
+}
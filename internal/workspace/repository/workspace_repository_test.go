@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"trilha-api/internal/shared/database/mocks"
+	db "trilha-api/internal/shared/database/sqlc"
+	"trilha-api/internal/workspace/entity"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*mocks.MockQuerier, *WorkspaceRepository) {
+	ctrl := gomock.NewController(t)
+
+	dbMock := mocks.NewMockQuerier(ctrl)
+	repo := New(dbMock)
+
+	return dbMock, repo
+}
+
+func TestWorkspaceRepository_Create(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	creatorID := uuid.New()
+
+	t.Run("should create the workspace owned by its creator", func(t *testing.T) {
+		params := db.CreateWorkspaceParams{Name: "Cliente A", CreatedBy: creatorID}
+
+		created := db.CreateWorkspaceRow{
+			ID:        uuid.New(),
+			Name:      "Cliente A",
+			CreatedBy: pgtype.UUID{Bytes: creatorID, Valid: true},
+			CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+		}
+
+		dbMock.EXPECT().CreateWorkspace(context.Background(), params).Return(created, nil)
+
+		workspace := &entity.WorkspaceEntity{Name: "Cliente A", CreatedBy: creatorID}
+
+		assert.NoError(t, repo.Create(workspace))
+		assert.Equal(t, created.ID, workspace.ID)
+		assert.Equal(t, creatorID, workspace.CreatedBy)
+		assert.Equal(t, entity.WorkspaceRoleOwner, workspace.Role)
+	})
+
+	t.Run("should wrap errors", func(t *testing.T) {
+		dbMock.EXPECT().CreateWorkspace(context.Background(), gomock.Any()).Return(db.CreateWorkspaceRow{}, errors.New("database error"))
+
+		assert.Error(t, repo.Create(&entity.WorkspaceEntity{Name: "Cliente A", CreatedBy: creatorID}))
+	})
+}
+
+func TestWorkspaceRepository_ListByAccount(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	accountID := uuid.New()
+
+	t.Run("should list the workspaces with the role of the account", func(t *testing.T) {
+		dbMock.EXPECT().ListAccountWorkspaces(context.Background(), accountID).Return([]db.ListAccountWorkspacesRow{
+			{ID: uuid.New(), Name: "Cliente A", RoleName: entity.WorkspaceRoleOwner},
+			{ID: uuid.New(), Name: "Cliente B", RoleName: entity.WorkspaceRoleGuest},
+		}, nil)
+
+		workspaces, err := repo.ListByAccount(accountID)
+
+		assert.NoError(t, err)
+		assert.Len(t, workspaces, 2)
+		assert.Equal(t, entity.WorkspaceRoleGuest, workspaces[1].Role)
+		assert.Equal(t, uuid.Nil, workspaces[1].CreatedBy)
+	})
+}
+
+func TestWorkspaceRepository_Delete(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	workspace := &entity.WorkspaceEntity{ID: uuid.New()}
+
+	t.Run("should delete the workspace", func(t *testing.T) {
+		dbMock.EXPECT().DeleteWorkspace(context.Background(), workspace.ID).Return(int64(1), nil)
+
+		assert.NoError(t, repo.Delete(workspace))
+	})
+
+	t.Run("should return no rows when the workspace does not exist", func(t *testing.T) {
+		dbMock.EXPECT().DeleteWorkspace(context.Background(), workspace.ID).Return(int64(0), nil)
+
+		assert.ErrorIs(t, repo.Delete(workspace), sql.ErrNoRows)
+	})
+}
+
+func TestWorkspaceRepository_FindMember(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	workspaceID := uuid.New()
+	accountID := uuid.New()
+
+	t.Run("should load the membership", func(t *testing.T) {
+		params := db.FindWorkspaceMemberParams{WorkspaceID: workspaceID, AccountID: accountID}
+
+		dbMock.EXPECT().FindWorkspaceMember(context.Background(), params).Return(db.FindWorkspaceMemberRow{
+			AccountID: accountID,
+			Name:      "Frodo",
+			RoleName:  entity.WorkspaceRoleMember,
+		}, nil)
+
+		member := &entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: accountID}
+
+		assert.NoError(t, repo.FindMember(member))
+		assert.Equal(t, workspaceID, member.WorkspaceID)
+		assert.Equal(t, "Frodo", member.Name)
+		assert.Equal(t, entity.WorkspaceRoleMember, member.Role)
+	})
+
+	t.Run("should return no rows when the account is not a member", func(t *testing.T) {
+		dbMock.EXPECT().FindWorkspaceMember(context.Background(), gomock.Any()).Return(db.FindWorkspaceMemberRow{}, sql.ErrNoRows)
+
+		err := repo.FindMember(&entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: accountID})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestWorkspaceRepository_RemoveMember(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	member := &entity.WorkspaceMemberEntity{WorkspaceID: uuid.New(), AccountID: uuid.New()}
+
+	fields := db.RemoveWorkspaceMemberParams{WorkspaceID: member.WorkspaceID, AccountID: member.AccountID}
+
+	t.Run("should remove the member", func(t *testing.T) {
+		dbMock.EXPECT().RemoveWorkspaceMember(context.Background(), fields).Return(db.RemoveWorkspaceMemberRow{Removed: true}, nil)
+
+		assert.NoError(t, repo.RemoveMember(member))
+	})
+
+	t.Run("should return ErrLastOwner for the only owner", func(t *testing.T) {
+		dbMock.EXPECT().RemoveWorkspaceMember(context.Background(), fields).Return(db.RemoveWorkspaceMemberRow{LastOwner: true}, nil)
+
+		assert.ErrorIs(t, repo.RemoveMember(member), ErrLastOwner)
+	})
+
+	t.Run("should return no rows when the account is not a member", func(t *testing.T) {
+		dbMock.EXPECT().RemoveWorkspaceMember(context.Background(), fields).Return(db.RemoveWorkspaceMemberRow{}, nil)
+
+		assert.ErrorIs(t, repo.RemoveMember(member), sql.ErrNoRows)
+	})
+}
+
+func TestWorkspaceRepository_ChangeMemberRole(t *testing.T) {
+	dbMock, repo := setup(t)
+
+	member := &entity.WorkspaceMemberEntity{WorkspaceID: uuid.New(), AccountID: uuid.New(), Role: entity.WorkspaceRoleMember}
+	fields := db.ChangeWorkspaceMemberRoleParams{WorkspaceID: member.WorkspaceID, AccountID: member.AccountID, RoleName: member.Role}
+
+	t.Run("should change the role of the member", func(t *testing.T) {
+		dbMock.EXPECT().ChangeWorkspaceMemberRole(context.Background(), fields).Return(db.ChangeWorkspaceMemberRoleRow{Changed: true}, nil)
+
+		assert.NoError(t, repo.ChangeMemberRole(member))
+	})
+
+	t.Run("should return ErrLastOwner when demoting the only owner", func(t *testing.T) {
+		dbMock.EXPECT().ChangeWorkspaceMemberRole(context.Background(), fields).Return(db.ChangeWorkspaceMemberRoleRow{LastOwner: true}, nil)
+
+		assert.ErrorIs(t, repo.ChangeMemberRole(member), ErrLastOwner)
+	})
+
+	t.Run("should return no rows when the account is not a member", func(t *testing.T) {
+		dbMock.EXPECT().ChangeWorkspaceMemberRole(context.Background(), fields).Return(db.ChangeWorkspaceMemberRoleRow{}, nil)
+
+		assert.ErrorIs(t, repo.ChangeMemberRole(member), sql.ErrNoRows)
+	})
+}
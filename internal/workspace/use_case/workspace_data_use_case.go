@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"time"
+	"trilha-api/internal/shared/privacy"
+	"trilha-api/internal/workspace/repository"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceDataUseCase takes part in data exports with the workspaces the
+// account belongs to and the invitations sent to it. Memberships are not
+// erased with the account: it keeps its place, anonymized, until removed
+// from the workspace. Its invitations are erased, since they hold its email.
+type WorkspaceDataUseCase struct {
+	repo           repository.WorkspaceRepositoryInterface
+	invitationRepo repository.WorkspaceInvitationRepositoryInterface
+}
+
+func NewWorkspaceDataUseCase(
+	repo repository.WorkspaceRepositoryInterface,
+	invitationRepo repository.WorkspaceInvitationRepositoryInterface,
+) *WorkspaceDataUseCase {
+	return &WorkspaceDataUseCase{repo: repo, invitationRepo: invitationRepo}
+}
+
+type workspaceExport struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type workspaceInvitationExport struct {
+	ID            uuid.UUID  `json:"id"`
+	WorkspaceID   uuid.UUID  `json:"workspace_id"`
+	WorkspaceName string     `json:"workspace_name"`
+	Email         string     `json:"email"`
+	Role          string     `json:"role"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	SentAt        time.Time  `json:"sent_at"`
+	AcceptedAt    *time.Time `json:"accepted_at"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func (uc *WorkspaceDataUseCase) ExportAccountData(accountID uuid.UUID) ([]privacy.Section, error) {
+	workspaces, err := uc.repo.ListByAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := make([]workspaceExport, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		exported = append(exported, workspaceExport{
+			ID:        workspace.ID,
+			Name:      workspace.Name,
+			Role:      workspace.Role,
+			CreatedAt: workspace.CreatedAt,
+		})
+	}
+
+	invitations, err := uc.invitationRepo.ListByAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	exportedInvitations := make([]workspaceInvitationExport, 0, len(invitations))
+	for _, invitation := range invitations {
+		exportedInvitations = append(exportedInvitations, workspaceInvitationExport{
+			ID:            invitation.ID,
+			WorkspaceID:   invitation.WorkspaceID,
+			WorkspaceName: invitation.WorkspaceName,
+			Email:         invitation.Email,
+			Role:          invitation.Role,
+			ExpiresAt:     invitation.ExpiresAt,
+			SentAt:        invitation.SentAt,
+			AcceptedAt:    invitation.AcceptedAt,
+			RevokedAt:     invitation.RevokedAt,
+			CreatedAt:     invitation.CreatedAt,
+		})
+	}
+
+	return []privacy.Section{
+		{Name: "workspaces", Data: exported},
+		{Name: "workspace_invitations", Data: exportedInvitations},
+	}, nil
+}
+
+// EraseAccountData removes the invitations sent to the email of the account.
+// It runs before the account is anonymized, while its email is still known.
+func (uc *WorkspaceDataUseCase) EraseAccountData(accountID uuid.UUID) error {
+	return uc.invitationRepo.DeleteAllByAccount(accountID)
+}
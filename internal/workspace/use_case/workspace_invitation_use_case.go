@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+	accountEntity "trilha-api/internal/account/entity"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/utils"
+	"trilha-api/internal/workspace/entity"
+	"trilha-api/internal/workspace/repository"
+
+	"github.com/google/uuid"
+)
+
+const invitationTokenSize = 32
+
+var (
+	ErrInvalidInvitation         = errors.New("invalid workspace invitation")
+	ErrInvitationForAnotherEmail = errors.New("workspace invitation sent to another email")
+)
+
+// InviteeAccounts finds the accounts invitations are sent to and registers
+// the invitees that have none yet.
+type InviteeAccounts interface {
+	Find(account *accountEntity.AccountEntity) error
+	FindByEmail(account *accountEntity.AccountEntity) error
+	RegisterVerified(account *accountEntity.AccountEntity) error
+}
+
+// EmailNormalizer puts emails in the form accounts store them in.
+type EmailNormalizer interface {
+	Normalize(email string) string
+}
+
+//go:generate mockgen -source=workspace_invitation_use_case.go -destination=../mocks/workspace_invitation_use_case_mock.go -package=mocks
+type WorkspaceInvitationUseCaseInterface interface {
+	Invite(invitation *entity.WorkspaceInvitationEntity) error
+	ListPending(workspaceID uuid.UUID) ([]entity.WorkspaceInvitationEntity, error)
+	Resend(invitation *entity.WorkspaceInvitationEntity) error
+	Revoke(invitation *entity.WorkspaceInvitationEntity) error
+	Preview(invitation *entity.WorkspaceInvitationEntity) error
+	Accept(invitation *entity.WorkspaceInvitationEntity, accountID uuid.UUID) (*entity.WorkspaceEntity, error)
+	Register(invitation *entity.WorkspaceInvitationEntity, account *accountEntity.AccountEntity) (*entity.WorkspaceEntity, error)
+}
+
+type WorkspaceInvitationUseCase struct {
+	repo       repository.WorkspaceInvitationRepositoryInterface
+	workspaces repository.WorkspaceRepositoryInterface
+	accounts   InviteeAccounts
+	emails     EmailNormalizer
+	mailer     mailer.Mailer
+	tokenTTL   time.Duration
+	appURL     string
+}
+
+func NewWorkspaceInvitationUseCase(
+	repo repository.WorkspaceInvitationRepositoryInterface,
+	workspaces repository.WorkspaceRepositoryInterface,
+	accounts InviteeAccounts,
+	emails EmailNormalizer,
+	mail mailer.Mailer,
+	mailConfig config.MailConfig,
+	workspaceConfig config.WorkspaceConfig,
+) *WorkspaceInvitationUseCase {
+	return &WorkspaceInvitationUseCase{
+		repo:       repo,
+		workspaces: workspaces,
+		accounts:   accounts,
+		emails:     emails,
+		mailer:     mail,
+		tokenTTL:   workspaceConfig.InvitationTTL,
+		appURL:     mailConfig.AppURL,
+	}
+}
+
+// Invite stores an invitation for invitation.Email to join the workspace
+// with invitation.Role and emails it the link to accept it. It fails with
+// repository.ErrAlreadyMember when the email belongs to a member already and
+// repository.ErrInvitationPending when it was invited already.
+func (uc *WorkspaceInvitationUseCase) Invite(invitation *entity.WorkspaceInvitationEntity) error {
+	if !workspaceRoles[invitation.Role] {
+		return ErrUnknownWorkspaceRole
+	}
+
+	invitation.Email = uc.emails.Normalize(invitation.Email)
+
+	if err := uc.checkNotMember(invitation); err != nil {
+		return err
+	}
+
+	if err := uc.issueToken(invitation); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Create(invitation); err != nil {
+		return err
+	}
+
+	// The invitation is already stored at this point; a failed email can be
+	// retried through the resend endpoint.
+	if err := uc.send(invitation); err != nil {
+		log.Printf("Erro ao enviar convite %s para o workspace %s: %v", invitation.ID, invitation.WorkspaceID, err)
+	}
+
+	return nil
+}
+
+// ListPending returns the invitations of the workspace neither accepted nor
+// revoked, expired ones included.
+func (uc *WorkspaceInvitationUseCase) ListPending(workspaceID uuid.UUID) ([]entity.WorkspaceInvitationEntity, error) {
+	return uc.repo.ListPending(workspaceID)
+}
+
+// Resend emails the invitation again with a new token, whose link is valid
+// for a whole new period. The link sent before stops working.
+func (uc *WorkspaceInvitationUseCase) Resend(invitation *entity.WorkspaceInvitationEntity) error {
+	if err := uc.repo.FindPending(invitation); err != nil {
+		return err
+	}
+
+	if err := uc.issueToken(invitation); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Renew(invitation); err != nil {
+		return err
+	}
+
+	return uc.send(invitation)
+}
+
+// Revoke cancels a pending invitation, failing with sql.ErrNoRows when there
+// is none.
+func (uc *WorkspaceInvitationUseCase) Revoke(invitation *entity.WorkspaceInvitationEntity) error {
+	return uc.repo.Revoke(invitation)
+}
+
+// Preview loads the invitation holding invitation.Token, failing with
+// ErrInvalidInvitation unless it can still be accepted.
+func (uc *WorkspaceInvitationUseCase) Preview(invitation *entity.WorkspaceInvitationEntity) error {
+	invitation.TokenHash = utils.HashToken(invitation.Token)
+
+	if err := uc.repo.FindByHash(invitation); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidInvitation
+		}
+		return err
+	}
+
+	if !invitation.IsPending(time.Now().UTC()) {
+		return ErrInvalidInvitation
+	}
+
+	return nil
+}
+
+// Accept makes the account a member of the workspace the invitation holding
+// invitation.Token was sent for. The invitation must have been sent to the
+// email of the account, or it fails with ErrInvitationForAnotherEmail.
+func (uc *WorkspaceInvitationUseCase) Accept(invitation *entity.WorkspaceInvitationEntity, accountID uuid.UUID) (*entity.WorkspaceEntity, error) {
+	if err := uc.Preview(invitation); err != nil {
+		return nil, err
+	}
+
+	account := &accountEntity.AccountEntity{ID: accountID}
+
+	if err := uc.accounts.Find(account); err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(uc.emails.Normalize(account.Email), invitation.Email) {
+		return nil, ErrInvitationForAnotherEmail
+	}
+
+	return uc.join(invitation, account.ID)
+}
+
+// Register creates the account of an invitee that has none yet, with the
+// email the invitation holding invitation.Token was sent to, and makes it a
+// member of the workspace. The email counts as verified, since the link of
+// the invitation was sent to it. The errors of registering an account are
+// returned as they are.
+func (uc *WorkspaceInvitationUseCase) Register(invitation *entity.WorkspaceInvitationEntity, account *accountEntity.AccountEntity) (*entity.WorkspaceEntity, error) {
+	if err := uc.Preview(invitation); err != nil {
+		return nil, err
+	}
+
+	account.Email = invitation.Email
+
+	if err := uc.accounts.RegisterVerified(account); err != nil {
+		return nil, err
+	}
+
+	return uc.join(invitation, account.ID)
+}
+
+// join accepts the invitation on behalf of the account, which grants it the
+// invited role, returning the workspace with the role the account holds on
+// it. An account that became a member meanwhile keeps its role.
+func (uc *WorkspaceInvitationUseCase) join(invitation *entity.WorkspaceInvitationEntity, accountID uuid.UUID) (*entity.WorkspaceEntity, error) {
+	accepted, err := uc.repo.Accept(invitation, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, ErrInvalidInvitation
+	}
+
+	member := &entity.WorkspaceMemberEntity{WorkspaceID: invitation.WorkspaceID, AccountID: accountID}
+
+	if err := uc.workspaces.FindMember(member); err != nil {
+		return nil, err
+	}
+
+	workspace := &entity.WorkspaceEntity{ID: invitation.WorkspaceID}
+
+	if err := uc.workspaces.Find(workspace); err != nil {
+		return nil, err
+	}
+
+	workspace.Role = member.Role
+
+	return workspace, nil
+}
+
+// checkNotMember fails with repository.ErrAlreadyMember when the invited
+// email belongs to an account that is a member of the workspace already.
+func (uc *WorkspaceInvitationUseCase) checkNotMember(invitation *entity.WorkspaceInvitationEntity) error {
+	account := &accountEntity.AccountEntity{Email: invitation.Email}
+
+	if err := uc.accounts.FindByEmail(account); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	member := &entity.WorkspaceMemberEntity{WorkspaceID: invitation.WorkspaceID, AccountID: account.ID}
+
+	err := uc.workspaces.FindMember(member)
+	switch {
+	case err == nil:
+		return repository.ErrAlreadyMember
+	case errors.Is(err, sql.ErrNoRows):
+		return nil
+	default:
+		return err
+	}
+}
+
+// issueToken gives the invitation a new token, valid from now on.
+func (uc *WorkspaceInvitationUseCase) issueToken(invitation *entity.WorkspaceInvitationEntity) error {
+	token, err := utils.GenerateRandomToken(invitationTokenSize)
+	if err != nil {
+		return err
+	}
+
+	invitation.Token = token
+	invitation.TokenHash = utils.HashToken(token)
+	invitation.ExpiresAt = time.Now().UTC().Add(uc.tokenTTL)
+
+	return nil
+}
+
+// send emails the link to accept the invitation, naming the workspace and,
+// while its account exists, who sent it.
+func (uc *WorkspaceInvitationUseCase) send(invitation *entity.WorkspaceInvitationEntity) error {
+	workspace := &entity.WorkspaceEntity{ID: invitation.WorkspaceID}
+
+	if err := uc.workspaces.Find(workspace); err != nil {
+		return err
+	}
+
+	intro := fmt.Sprintf("Você foi convidado para participar do workspace %s.", workspace.Name)
+
+	if invitation.InvitedBy != uuid.Nil {
+		inviter := &accountEntity.AccountEntity{ID: invitation.InvitedBy}
+		err := uc.accounts.Find(inviter)
+
+		switch {
+		case err == nil:
+			intro = fmt.Sprintf("%s convidou você para participar do workspace %s.", inviter.Name, workspace.Name)
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+	}
+
+	link := fmt.Sprintf("%s/invitations/accept?token=%s", uc.appURL, url.QueryEscape(invitation.Token))
+
+	return uc.mailer.Send(mailer.Message{
+		To:      invitation.Email,
+		Subject: "Convite para o workspace " + workspace.Name,
+		Body: fmt.Sprintf(
+			"Olá.\n\n%s Para aceitar o convite, acesse o link abaixo, entrando na sua conta ou criando uma:\n\n%s\n\nO link expira em %s. Se você não esperava este convite, ignore este email.\n",
+			intro, link, uc.tokenTTL,
+		),
+	})
+}
@@ -0,0 +1,356 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	accountEntity "trilha-api/internal/account/entity"
+	accountUsecase "trilha-api/internal/account/use_case"
+	"trilha-api/internal/shared/config"
+	"trilha-api/internal/shared/mailer"
+	"trilha-api/internal/shared/utils"
+	"trilha-api/internal/workspace/entity"
+	"trilha-api/internal/workspace/mocks"
+	"trilha-api/internal/workspace/repository"
+	usecase "trilha-api/internal/workspace/use_case"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type invitationMocks struct {
+	invitations *mocks.MockWorkspaceInvitationRepositoryInterface
+	workspaces  *mocks.MockWorkspaceRepositoryInterface
+	accounts    *mocks.MockInviteeAccounts
+	mailer      *mailer.MemoryMailer
+}
+
+func setupInvitations(t *testing.T) (*invitationMocks, *usecase.WorkspaceInvitationUseCase) {
+	ctrl := gomock.NewController(t)
+
+	m := &invitationMocks{
+		invitations: mocks.NewMockWorkspaceInvitationRepositoryInterface(ctrl),
+		workspaces:  mocks.NewMockWorkspaceRepositoryInterface(ctrl),
+		accounts:    mocks.NewMockInviteeAccounts(ctrl),
+		mailer:      mailer.NewMemoryMailer(),
+	}
+
+	uc := usecase.NewWorkspaceInvitationUseCase(
+		m.invitations,
+		m.workspaces,
+		m.accounts,
+		accountUsecase.NewEmailNormalizer(config.AuthConfig{EmailLocalPart: config.EmailLocalPartPreserve}),
+		m.mailer,
+		config.MailConfig{AppURL: "http://trilha.test"},
+		config.WorkspaceConfig{InvitationTTL: time.Hour},
+	)
+
+	return m, uc
+}
+
+// expectWorkspace answers the lookups of the workspace the invitation is for.
+func expectWorkspace(m *invitationMocks, name string) {
+	m.workspaces.EXPECT().Find(gomock.Any()).DoAndReturn(func(workspace *entity.WorkspaceEntity) error {
+		workspace.Name = name
+		return nil
+	})
+}
+
+func TestWorkspaceInvitationUseCase_Invite(t *testing.T) {
+	m, uc := setupInvitations(t)
+
+	workspaceID := uuid.New()
+	inviterID := uuid.New()
+
+	t.Run("should store a hashed token and email the link to accept it", func(t *testing.T) {
+		var storedHash string
+
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).Return(sql.ErrNoRows)
+		m.invitations.EXPECT().Create(gomock.Any()).DoAndReturn(func(invitation *entity.WorkspaceInvitationEntity) error {
+			assert.Equal(t, "Ana@example.com", invitation.Email)
+			assert.NotEmpty(t, invitation.Token)
+			assert.Equal(t, utils.HashToken(invitation.Token), invitation.TokenHash)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), invitation.ExpiresAt, time.Minute)
+			storedHash = invitation.TokenHash
+			invitation.ID = uuid.New()
+			return nil
+		})
+		expectWorkspace(m, "Cliente A")
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(func(account *accountEntity.AccountEntity) error {
+			assert.Equal(t, inviterID, account.ID)
+			account.Name = "Bruno"
+			return nil
+		})
+
+		invitation := &entity.WorkspaceInvitationEntity{
+			WorkspaceID: workspaceID,
+			Email:       " Ana@EXAMPLE.com ",
+			Role:        entity.WorkspaceRoleMember,
+			InvitedBy:   inviterID,
+		}
+
+		require.NoError(t, uc.Invite(invitation))
+
+		msg, ok := m.mailer.Last()
+		require.True(t, ok)
+		assert.Equal(t, "Ana@example.com", msg.To)
+		assert.Contains(t, msg.Body, "Bruno convidou você para participar do workspace Cliente A.")
+		assert.Contains(t, msg.Body, "http://trilha.test/invitations/accept?token=")
+
+		token := msg.Body[strings.Index(msg.Body, "token=")+len("token="):]
+		token = token[:strings.Index(token, "\n")]
+		assert.Equal(t, storedHash, utils.HashToken(token))
+	})
+
+	t.Run("should refuse an email that belongs to a member", func(t *testing.T) {
+		memberID := uuid.New()
+		sent := len(m.mailer.Messages())
+
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).DoAndReturn(func(account *accountEntity.AccountEntity) error {
+			account.ID = memberID
+			return nil
+		})
+		m.workspaces.EXPECT().FindMember(gomock.Any()).DoAndReturn(func(member *entity.WorkspaceMemberEntity) error {
+			assert.Equal(t, memberID, member.AccountID)
+			assert.Equal(t, workspaceID, member.WorkspaceID)
+			return nil
+		})
+
+		err := uc.Invite(&entity.WorkspaceInvitationEntity{
+			WorkspaceID: workspaceID,
+			Email:       "carla@example.com",
+			Role:        entity.WorkspaceRoleGuest,
+			InvitedBy:   inviterID,
+		})
+
+		assert.ErrorIs(t, err, repository.ErrAlreadyMember)
+		assert.Len(t, m.mailer.Messages(), sent)
+	})
+
+	t.Run("should invite an account that is not a member", func(t *testing.T) {
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).Return(nil)
+		m.workspaces.EXPECT().FindMember(gomock.Any()).Return(sql.ErrNoRows)
+		m.invitations.EXPECT().Create(gomock.Any()).Return(nil)
+		expectWorkspace(m, "Cliente A")
+		m.accounts.EXPECT().Find(gomock.Any()).Return(nil)
+
+		err := uc.Invite(&entity.WorkspaceInvitationEntity{
+			WorkspaceID: workspaceID,
+			Email:       "davi@example.com",
+			Role:        entity.WorkspaceRoleGuest,
+			InvitedBy:   inviterID,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should refuse an unknown role", func(t *testing.T) {
+		err := uc.Invite(&entity.WorkspaceInvitationEntity{WorkspaceID: workspaceID, Email: "ana@example.com", Role: "admin"})
+
+		assert.ErrorIs(t, err, usecase.ErrUnknownWorkspaceRole)
+	})
+
+	t.Run("should keep the invitation when the email cannot be sent", func(t *testing.T) {
+		m.accounts.EXPECT().FindByEmail(gomock.Any()).Return(sql.ErrNoRows)
+		m.invitations.EXPECT().Create(gomock.Any()).Return(nil)
+		m.workspaces.EXPECT().Find(gomock.Any()).Return(errors.New("database error"))
+
+		err := uc.Invite(&entity.WorkspaceInvitationEntity{
+			WorkspaceID: workspaceID,
+			Email:       "ana@example.com",
+			Role:        entity.WorkspaceRoleMember,
+			InvitedBy:   inviterID,
+		})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestWorkspaceInvitationUseCase_Resend(t *testing.T) {
+	m, uc := setupInvitations(t)
+
+	t.Run("should email a new token to a pending invitation", func(t *testing.T) {
+		invitation := &entity.WorkspaceInvitationEntity{ID: uuid.New(), WorkspaceID: uuid.New()}
+
+		m.invitations.EXPECT().FindPending(invitation).DoAndReturn(func(found *entity.WorkspaceInvitationEntity) error {
+			found.Email = "ana@example.com"
+			found.TokenHash = "old"
+			return nil
+		})
+		m.invitations.EXPECT().Renew(invitation).DoAndReturn(func(renewed *entity.WorkspaceInvitationEntity) error {
+			assert.NotEqual(t, "old", renewed.TokenHash)
+			assert.Equal(t, utils.HashToken(renewed.Token), renewed.TokenHash)
+			return nil
+		})
+		expectWorkspace(m, "Cliente A")
+
+		require.NoError(t, uc.Resend(invitation))
+
+		msg, ok := m.mailer.Last()
+		require.True(t, ok)
+		assert.Equal(t, "ana@example.com", msg.To)
+		assert.Contains(t, msg.Body, "Você foi convidado para participar do workspace Cliente A.")
+	})
+
+	t.Run("should return sql.ErrNoRows when no invitation is pending", func(t *testing.T) {
+		m.invitations.EXPECT().FindPending(gomock.Any()).Return(sql.ErrNoRows)
+
+		err := uc.Resend(&entity.WorkspaceInvitationEntity{ID: uuid.New(), WorkspaceID: uuid.New()})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestWorkspaceInvitationUseCase_Accept(t *testing.T) {
+	m, uc := setupInvitations(t)
+
+	workspaceID := uuid.New()
+	accountID := uuid.New()
+
+	// expectInvitation answers the lookup of the token with an invitation
+	// for ana@example.com expiring at expiresAt.
+	expectInvitation := func(expiresAt time.Time) {
+		m.invitations.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(invitation *entity.WorkspaceInvitationEntity) error {
+			assert.Equal(t, utils.HashToken("token"), invitation.TokenHash)
+			invitation.ID = uuid.New()
+			invitation.WorkspaceID = workspaceID
+			invitation.Email = "ana@example.com"
+			invitation.Role = entity.WorkspaceRoleMember
+			invitation.ExpiresAt = expiresAt
+			return nil
+		})
+	}
+
+	expectAccount := func(email string) {
+		m.accounts.EXPECT().Find(gomock.Any()).DoAndReturn(func(account *accountEntity.AccountEntity) error {
+			account.Email = email
+			return nil
+		})
+	}
+
+	t.Run("should make the invitee a member with the invited role", func(t *testing.T) {
+		expectInvitation(time.Now().Add(time.Hour))
+		expectAccount("ana@EXAMPLE.com")
+		m.invitations.EXPECT().Accept(gomock.Any(), accountID).Return(true, nil)
+		m.workspaces.EXPECT().FindMember(gomock.Any()).DoAndReturn(func(member *entity.WorkspaceMemberEntity) error {
+			assert.Equal(t, workspaceID, member.WorkspaceID)
+			assert.Equal(t, accountID, member.AccountID)
+			member.Role = entity.WorkspaceRoleMember
+			return nil
+		})
+		expectWorkspace(m, "Cliente A")
+
+		workspace, err := uc.Accept(&entity.WorkspaceInvitationEntity{Token: "token"}, accountID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Cliente A", workspace.Name)
+		assert.Equal(t, entity.WorkspaceRoleMember, workspace.Role)
+	})
+
+	t.Run("should keep the role of an account that is a member already", func(t *testing.T) {
+		expectInvitation(time.Now().Add(time.Hour))
+		expectAccount("ana@example.com")
+		m.invitations.EXPECT().Accept(gomock.Any(), accountID).Return(true, nil)
+		m.workspaces.EXPECT().FindMember(gomock.Any()).DoAndReturn(func(member *entity.WorkspaceMemberEntity) error {
+			member.Role = entity.WorkspaceRoleOwner
+			return nil
+		})
+		expectWorkspace(m, "Cliente A")
+
+		workspace, err := uc.Accept(&entity.WorkspaceInvitationEntity{Token: "token"}, accountID)
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.WorkspaceRoleOwner, workspace.Role)
+	})
+
+	t.Run("should refuse an account with another email", func(t *testing.T) {
+		expectInvitation(time.Now().Add(time.Hour))
+		expectAccount("bruno@example.com")
+
+		_, err := uc.Accept(&entity.WorkspaceInvitationEntity{Token: "token"}, accountID)
+
+		assert.ErrorIs(t, err, usecase.ErrInvitationForAnotherEmail)
+	})
+
+	t.Run("should refuse an expired invitation", func(t *testing.T) {
+		expectInvitation(time.Now().Add(-time.Minute))
+
+		_, err := uc.Accept(&entity.WorkspaceInvitationEntity{Token: "token"}, accountID)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidInvitation)
+	})
+
+	t.Run("should refuse an unknown token", func(t *testing.T) {
+		m.invitations.EXPECT().FindByHash(gomock.Any()).Return(sql.ErrNoRows)
+
+		_, err := uc.Accept(&entity.WorkspaceInvitationEntity{Token: "unknown"}, accountID)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidInvitation)
+	})
+
+	t.Run("should refuse an invitation accepted meanwhile", func(t *testing.T) {
+		expectInvitation(time.Now().Add(time.Hour))
+		expectAccount("ana@example.com")
+		m.invitations.EXPECT().Accept(gomock.Any(), accountID).Return(false, nil)
+
+		_, err := uc.Accept(&entity.WorkspaceInvitationEntity{Token: "token"}, accountID)
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidInvitation)
+	})
+}
+
+func TestWorkspaceInvitationUseCase_Register(t *testing.T) {
+	m, uc := setupInvitations(t)
+
+	workspaceID := uuid.New()
+
+	expectInvitation := func() {
+		m.invitations.EXPECT().FindByHash(gomock.Any()).DoAndReturn(func(invitation *entity.WorkspaceInvitationEntity) error {
+			invitation.WorkspaceID = workspaceID
+			invitation.Email = "ana@example.com"
+			invitation.Role = entity.WorkspaceRoleGuest
+			invitation.ExpiresAt = time.Now().Add(time.Hour)
+			return nil
+		})
+	}
+
+	t.Run("should register the account with the invited email verified and make it a member", func(t *testing.T) {
+		accountID := uuid.New()
+
+		expectInvitation()
+		m.accounts.EXPECT().RegisterVerified(gomock.Any()).DoAndReturn(func(account *accountEntity.AccountEntity) error {
+			assert.Equal(t, "ana@example.com", account.Email)
+			assert.Equal(t, "Ana", account.Name)
+			account.ID = accountID
+			return nil
+		})
+		m.invitations.EXPECT().Accept(gomock.Any(), accountID).Return(true, nil)
+		m.workspaces.EXPECT().FindMember(gomock.Any()).DoAndReturn(func(member *entity.WorkspaceMemberEntity) error {
+			member.Role = entity.WorkspaceRoleGuest
+			return nil
+		})
+		expectWorkspace(m, "Cliente A")
+
+		account := &accountEntity.AccountEntity{Name: "Ana", Email: "other@example.com", Password: "secret"}
+		workspace, err := uc.Register(&entity.WorkspaceInvitationEntity{Token: "token"}, account)
+
+		require.NoError(t, err)
+		assert.Equal(t, workspaceID, workspace.ID)
+		assert.Equal(t, entity.WorkspaceRoleGuest, workspace.Role)
+	})
+
+	t.Run("should not accept the invitation when the account cannot be registered", func(t *testing.T) {
+		registerErr := errors.New("email in use")
+
+		expectInvitation()
+		m.accounts.EXPECT().RegisterVerified(gomock.Any()).Return(registerErr)
+
+		_, err := uc.Register(&entity.WorkspaceInvitationEntity{Token: "token"}, &accountEntity.AccountEntity{Name: "Ana"})
+
+		assert.ErrorIs(t, err, registerErr)
+	})
+}
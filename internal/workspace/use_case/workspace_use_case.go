@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"trilha-api/internal/workspace/entity"
+	"trilha-api/internal/workspace/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBlankWorkspaceName   = errors.New("blank workspace name")
+	ErrUnknownWorkspaceRole = errors.New("unknown workspace role")
+	ErrLastWorkspaceOwner   = errors.New("workspace must keep an owner")
+)
+
+// workspaceRoles are the roles a member may hold on a workspace.
+var workspaceRoles = map[string]bool{
+	entity.WorkspaceRoleOwner:  true,
+	entity.WorkspaceRoleMember: true,
+	entity.WorkspaceRoleGuest:  true,
+}
+
+//go:generate mockgen -source=workspace_use_case.go -destination=../mocks/workspace_use_case_mock.go -package=mocks
+type WorkspaceUseCaseInterface interface {
+	Create(workspace *entity.WorkspaceEntity) error
+	Find(workspace *entity.WorkspaceEntity) error
+	ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceEntity, error)
+	Update(workspace *entity.WorkspaceEntity) error
+	Delete(workspace *entity.WorkspaceEntity) error
+	ListMembers(workspaceID uuid.UUID) ([]entity.WorkspaceMemberEntity, error)
+	SetMember(member *entity.WorkspaceMemberEntity) error
+	RemoveMember(member *entity.WorkspaceMemberEntity) error
+}
+
+type WorkspaceUseCase struct {
+	repo repository.WorkspaceRepositoryInterface
+}
+
+func New(repo repository.WorkspaceRepositoryInterface) *WorkspaceUseCase {
+	return &WorkspaceUseCase{repo: repo}
+}
+
+// Create stores a new workspace owned by workspace.CreatedBy.
+func (uc *WorkspaceUseCase) Create(workspace *entity.WorkspaceEntity) error {
+	if err := normalize(workspace); err != nil {
+		return err
+	}
+
+	return uc.repo.Create(workspace)
+}
+
+func (uc *WorkspaceUseCase) Find(workspace *entity.WorkspaceEntity) error {
+	return uc.repo.Find(workspace)
+}
+
+// ListByAccount returns the workspaces the account belongs to, with its role
+// on each.
+func (uc *WorkspaceUseCase) ListByAccount(accountID uuid.UUID) ([]entity.WorkspaceEntity, error) {
+	return uc.repo.ListByAccount(accountID)
+}
+
+func (uc *WorkspaceUseCase) Update(workspace *entity.WorkspaceEntity) error {
+	if err := normalize(workspace); err != nil {
+		return err
+	}
+
+	return uc.repo.Update(workspace)
+}
+
+// Delete removes the workspace for good, with its projects.
+func (uc *WorkspaceUseCase) Delete(workspace *entity.WorkspaceEntity) error {
+	return uc.repo.Delete(workspace)
+}
+
+func (uc *WorkspaceUseCase) ListMembers(workspaceID uuid.UUID) ([]entity.WorkspaceMemberEntity, error) {
+	return uc.repo.ListMembers(workspaceID)
+}
+
+// SetMember changes the role of a member of the workspace to member.Role and
+// loads the resulting membership. Accounts only join through invitations, so
+// it fails with sql.ErrNoRows when the account is not a member, and with
+// ErrLastWorkspaceOwner rather than leave the workspace without an owner.
+func (uc *WorkspaceUseCase) SetMember(member *entity.WorkspaceMemberEntity) error {
+	if !workspaceRoles[member.Role] {
+		return ErrUnknownWorkspaceRole
+	}
+
+	current := &entity.WorkspaceMemberEntity{WorkspaceID: member.WorkspaceID, AccountID: member.AccountID}
+	if err := uc.repo.FindMember(current); err != nil {
+		return err
+	}
+
+	if current.Role == member.Role {
+		*member = *current
+		return nil
+	}
+
+	if err := uc.repo.ChangeMemberRole(member); err != nil {
+		return lastOwner(err)
+	}
+
+	return uc.repo.FindMember(member)
+}
+
+// RemoveMember takes the account out of the workspace, failing with
+// sql.ErrNoRows when it is not a member and ErrLastWorkspaceOwner when it is
+// the only owner left.
+func (uc *WorkspaceUseCase) RemoveMember(member *entity.WorkspaceMemberEntity) error {
+	return lastOwner(uc.repo.RemoveMember(member))
+}
+
+// lastOwner reports the refusal of the repository to leave a workspace
+// without owners as ErrLastWorkspaceOwner.
+func lastOwner(err error) error {
+	if errors.Is(err, repository.ErrLastOwner) {
+		return ErrLastWorkspaceOwner
+	}
+	return err
+}
+
+// normalize trims the name of the workspace, which may not be blank.
+func normalize(workspace *entity.WorkspaceEntity) error {
+	workspace.Name = strings.TrimSpace(workspace.Name)
+
+	if workspace.Name == "" {
+		return ErrBlankWorkspaceName
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package usecase_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"trilha-api/internal/workspace/entity"
+	"trilha-api/internal/workspace/mocks"
+	"trilha-api/internal/workspace/repository"
+	usecase "trilha-api/internal/workspace/use_case"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*mocks.MockWorkspaceRepositoryInterface, *usecase.WorkspaceUseCase) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockWorkspaceRepositoryInterface(ctrl)
+
+	return repo, usecase.New(repo)
+}
+
+func TestWorkspaceUseCase_Create(t *testing.T) {
+	repo, uc := setup(t)
+
+	t.Run("should store the workspace with its name trimmed", func(t *testing.T) {
+		workspace := &entity.WorkspaceEntity{Name: "  Cliente A ", CreatedBy: uuid.New()}
+
+		repo.EXPECT().Create(workspace).Return(nil)
+
+		assert.NoError(t, uc.Create(workspace))
+		assert.Equal(t, "Cliente A", workspace.Name)
+	})
+
+	t.Run("should refuse a blank name", func(t *testing.T) {
+		err := uc.Create(&entity.WorkspaceEntity{Name: "   ", CreatedBy: uuid.New()})
+
+		assert.ErrorIs(t, err, usecase.ErrBlankWorkspaceName)
+	})
+}
+
+func TestWorkspaceUseCase_SetMember(t *testing.T) {
+	repo, uc := setup(t)
+
+	workspaceID := uuid.New()
+	accountID := uuid.New()
+
+	t.Run("should not add an account that is not a member yet", func(t *testing.T) {
+		member := &entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: accountID, Role: entity.WorkspaceRoleGuest}
+
+		repo.EXPECT().FindMember(gomock.Any()).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, uc.SetMember(member), sql.ErrNoRows)
+	})
+
+	t.Run("should change the role of a member", func(t *testing.T) {
+		member := &entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: accountID, Role: entity.WorkspaceRoleOwner}
+
+		gomock.InOrder(
+			repo.EXPECT().FindMember(gomock.Any()).DoAndReturn(func(current *entity.WorkspaceMemberEntity) error {
+				current.Role = entity.WorkspaceRoleMember
+				return nil
+			}),
+			repo.EXPECT().ChangeMemberRole(member).Return(nil),
+			repo.EXPECT().FindMember(member).Return(nil),
+		)
+
+		assert.NoError(t, uc.SetMember(member))
+	})
+
+	t.Run("should keep the last owner", func(t *testing.T) {
+		member := &entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: accountID, Role: entity.WorkspaceRoleMember}
+
+		repo.EXPECT().FindMember(gomock.Any()).DoAndReturn(func(current *entity.WorkspaceMemberEntity) error {
+			current.WorkspaceID = workspaceID
+			current.Role = entity.WorkspaceRoleOwner
+			return nil
+		})
+		repo.EXPECT().ChangeMemberRole(member).Return(repository.ErrLastOwner)
+
+		assert.ErrorIs(t, uc.SetMember(member), usecase.ErrLastWorkspaceOwner)
+	})
+
+	t.Run("should refuse roles that are not workspace roles", func(t *testing.T) {
+		member := &entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: accountID, Role: "system_admin"}
+
+		assert.ErrorIs(t, uc.SetMember(member), usecase.ErrUnknownWorkspaceRole)
+	})
+}
+
+func TestWorkspaceUseCase_RemoveMember(t *testing.T) {
+	repo, uc := setup(t)
+
+	workspaceID := uuid.New()
+
+	t.Run("should remove a member", func(t *testing.T) {
+		member := &entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: uuid.New()}
+
+		repo.EXPECT().RemoveMember(member).Return(nil)
+
+		assert.NoError(t, uc.RemoveMember(member))
+	})
+
+	t.Run("should keep the last owner", func(t *testing.T) {
+		member := &entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: uuid.New()}
+
+		repo.EXPECT().RemoveMember(member).Return(repository.ErrLastOwner)
+
+		assert.ErrorIs(t, uc.RemoveMember(member), usecase.ErrLastWorkspaceOwner)
+	})
+
+	t.Run("should return no rows when the account is not a member", func(t *testing.T) {
+		repo.EXPECT().RemoveMember(gomock.Any()).Return(sql.ErrNoRows)
+
+		err := uc.RemoveMember(&entity.WorkspaceMemberEntity{WorkspaceID: workspaceID, AccountID: uuid.New()})
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestWorkspaceDataUseCase_ExportAccountData(t *testing.T) {
+	t.Run("should export the workspaces of the account with its role and its invitations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockWorkspaceRepositoryInterface(ctrl)
+		invitations := mocks.NewMockWorkspaceInvitationRepositoryInterface(ctrl)
+		uc := usecase.NewWorkspaceDataUseCase(repo, invitations)
+		accountID := uuid.New()
+
+		repo.EXPECT().ListByAccount(accountID).Return([]entity.WorkspaceEntity{
+			{ID: uuid.New(), Name: "Cliente A", Role: entity.WorkspaceRoleMember},
+		}, nil)
+		invitations.EXPECT().ListByAccount(accountID).Return([]entity.WorkspaceInvitationEntity{
+			{ID: uuid.New(), WorkspaceName: "Cliente B", Email: "ana@example.com", Role: entity.WorkspaceRoleMember, TokenHash: "hash"},
+		}, nil)
+
+		sections, err := uc.ExportAccountData(accountID)
+
+		require.NoError(t, err)
+		require.Len(t, sections, 2)
+		assert.Equal(t, "workspaces", sections[0].Name)
+		assert.Equal(t, "workspace_invitations", sections[1].Name)
+
+		exported, err := json.Marshal(sections[0].Data)
+		require.NoError(t, err)
+		assert.Contains(t, string(exported), `"role":"member"`)
+
+		exported, err = json.Marshal(sections[1].Data)
+		require.NoError(t, err)
+		assert.Contains(t, string(exported), `"workspace_name":"Cliente B"`)
+		assert.NotContains(t, string(exported), "hash")
+	})
+}
+
+func TestWorkspaceDataUseCase_EraseAccountData(t *testing.T) {
+	t.Run("should remove the invitations sent to the account", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		invitations := mocks.NewMockWorkspaceInvitationRepositoryInterface(ctrl)
+		uc := usecase.NewWorkspaceDataUseCase(mocks.NewMockWorkspaceRepositoryInterface(ctrl), invitations)
+		accountID := uuid.New()
+
+		invitations.EXPECT().DeleteAllByAccount(accountID).Return(nil)
+
+		assert.NoError(t, uc.EraseAccountData(accountID))
+	})
+}